@@ -0,0 +1,51 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package factory
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/bccsp/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteFactoryName(t *testing.T) {
+	f := &RemoteFactory{}
+	assert.Equal(t, f.Name(), RemoteFactoryName)
+}
+
+func TestRemoteFactoryGetInvalidArgs(t *testing.T) {
+	f := &RemoteFactory{}
+
+	_, err := f.Get(nil)
+	assert.EqualError(t, err, "Invalid config. It must not be nil.")
+
+	_, err = f.Get(&FactoryOpts{})
+	assert.EqualError(t, err, "Invalid config. It must not be nil.")
+
+	opts := &FactoryOpts{
+		RemoteOpts: &remote.RemoteOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+		},
+	}
+	_, err = f.Get(opts)
+	assert.EqualError(t, err, "Invalid config: missing property 'Address'")
+}
+
+func TestGetBCCSPFromOptsRemote(t *testing.T) {
+	opts := &FactoryOpts{
+		ProviderName: "REMOTE",
+		RemoteOpts: &remote.RemoteOpts{
+			SecLevel:   256,
+			HashFamily: "SHA2",
+			Address:    "127.0.0.1:7443",
+		},
+	}
+	_, err := GetBCCSPFromOpts(opts)
+	assert.EqualError(t, err, "Could not initialize BCCSP REMOTE: Invalid TLS config: a client certificate and key are required to authenticate to the remote signer")
+}
@@ -513,23 +513,23 @@ var _ = Describe("Idemix Bridge", func() {
 
 		Context("verify", func() {
 			It("fail on nil issuer Public key", func() {
-				err := SignatureScheme.Verify(nil, nil, nil, nil, 0, nil, 0)
+				err := SignatureScheme.Verify(nil, nil, nil, nil, 0, nil, 0, false)
 				Expect(err.Error()).To(BeEquivalentTo("invalid issuer public key, expected *IssuerPublicKey, got [<nil>]"))
 			})
 
 			It("fail on nil signature", func() {
-				err := SignatureScheme.Verify(issuerPublicKey, nil, nil, nil, 0, nil, 0)
+				err := SignatureScheme.Verify(issuerPublicKey, nil, nil, nil, 0, nil, 0, false)
 				Expect(err.Error()).To(BeEquivalentTo("cannot verify idemix signature: received nil input"))
 			})
 
 			It("fail on invalid signature", func() {
-				err := SignatureScheme.Verify(issuerPublicKey, []byte{0, 1, 2, 3, 4}, nil, nil, 0, nil, 0)
+				err := SignatureScheme.Verify(issuerPublicKey, []byte{0, 1, 2, 3, 4}, nil, nil, 0, nil, 0, false)
 				Expect(err.Error()).To(BeEquivalentTo("proto: idemix.Signature: illegal tag 0 (wire type 0)"))
 			})
 
 			It("fail on invalid attributes", func() {
 				err := SignatureScheme.Verify(issuerPublicKey, nil, nil,
-					[]bccsp.IdemixAttribute{{Type: -1}}, 0, nil, 0)
+					[]bccsp.IdemixAttribute{{Type: -1}}, 0, nil, 0, false)
 				Expect(err.Error()).To(BeEquivalentTo("attribute type not allowed or supported [-1] at position [0]"))
 			})
 		})
@@ -964,6 +964,7 @@ var _ = Describe("Idemix Bridge", func() {
 				VerifyAttributes []bccsp.IdemixAttribute
 				RhIndex          int
 				Epoch            int
+				IgnoreEpoch      bool
 				errMessage       string
 				validity         bool
 			)
@@ -976,6 +977,7 @@ var _ = Describe("Idemix Bridge", func() {
 				digest = []byte("a digest")
 				RhIndex = 4
 				Epoch = 0
+				IgnoreEpoch = false
 				errMessage = ""
 			})
 
@@ -1041,6 +1043,110 @@ var _ = Describe("Idemix Bridge", func() {
 				}
 			})
 
+			It("the signature against a plain signature cri of an epoch in which the handle is unrevoked is valid", func() {
+				validity = true
+				Epoch = 1
+				SignAttributes = []bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+				}
+				VerifyAttributes = SignAttributes
+
+				var err error
+				cri, err = CriSigner.Sign(
+					RevocationKey,
+					nil,
+					&bccsp.IdemixCRISignerOpts{
+						Epoch:               Epoch,
+						RevocationAlgorithm: bccsp.AlgPlainSignature,
+						UnrevokedHandles: [][]byte{
+							cryptolib.BigToBytes(FP256BN.NewBIGint(5)),
+							cryptolib.BigToBytes(cryptolib.HashModOrder([]byte{0, 1, 2, 3})),
+						},
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("the signature verified against a different epoch is not valid", func() {
+				validity = false
+				errMessage = "signature invalid: signature epoch 1 differs from the current epoch 0"
+				SignAttributes = []bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+				}
+				VerifyAttributes = SignAttributes
+
+				var err error
+				cri, err = CriSigner.Sign(
+					RevocationKey,
+					nil,
+					&bccsp.IdemixCRISignerOpts{
+						Epoch:               1,
+						RevocationAlgorithm: bccsp.AlgPlainSignature,
+						UnrevokedHandles:    [][]byte{cryptolib.BigToBytes(cryptolib.HashModOrder([]byte{0, 1, 2, 3}))},
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("the signature verified against a different epoch is valid when the epoch is ignored", func() {
+				validity = true
+				IgnoreEpoch = true
+				SignAttributes = []bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+				}
+				VerifyAttributes = SignAttributes
+
+				var err error
+				cri, err = CriSigner.Sign(
+					RevocationKey,
+					nil,
+					&bccsp.IdemixCRISignerOpts{
+						Epoch:               1,
+						RevocationAlgorithm: bccsp.AlgNoRevocation,
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("the signature using revocation is not valid when the epoch is ignored", func() {
+				validity = false
+				IgnoreEpoch = true
+				errMessage = "signature invalid: revocation algorithm 1 requires the epoch to be checked"
+				Epoch = 1
+				SignAttributes = []bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+					{Type: bccsp.IdemixHiddenAttribute},
+				}
+				VerifyAttributes = SignAttributes
+
+				var err error
+				cri, err = CriSigner.Sign(
+					RevocationKey,
+					nil,
+					&bccsp.IdemixCRISignerOpts{
+						Epoch:               Epoch,
+						RevocationAlgorithm: bccsp.AlgPlainSignature,
+						UnrevokedHandles:    [][]byte{cryptolib.BigToBytes(cryptolib.HashModOrder([]byte{0, 1, 2, 3}))},
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			AfterEach(func() {
 				var err error
 				signature, err = Signer.Sign(
@@ -1067,6 +1173,7 @@ var _ = Describe("Idemix Bridge", func() {
 						Attributes:          VerifyAttributes,
 						RhIndex:             RhIndex,
 						Epoch:               Epoch,
+						IgnoreEpoch:         IgnoreEpoch,
 					},
 				)
 
@@ -1189,6 +1296,36 @@ var _ = Describe("Idemix Bridge", func() {
 
 			})
 
+			It("fails when the revocation handle is revoked in the cri epoch", func() {
+				var err error
+				cri, err = CriSigner.Sign(
+					RevocationKey,
+					nil,
+					&bccsp.IdemixCRISignerOpts{
+						Epoch:               2,
+						RevocationAlgorithm: bccsp.AlgPlainSignature,
+						UnrevokedHandles:    [][]byte{cryptolib.BigToBytes(FP256BN.NewBIGint(5))},
+					},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				signature, err := Signer.Sign(
+					UserKey,
+					[]byte("a message"),
+					&bccsp.IdemixSignerOpts{
+						Credential: credential,
+						Nym:        NymKey,
+						IssuerPK:   IssuerPublicKey,
+						Attributes: SignAttributes,
+						RhIndex:    4,
+						Epoch:      2,
+						CRI:        cri,
+					},
+				)
+				Expect(err.Error()).To(BeEquivalentTo("failed creating new signature: failed to compute non-revoked proof: the revocation handle is not among the unrevoked handles of epoch 2"))
+				Expect(signature).To(BeNil())
+			})
+
 			It("fails when the credential is nil", func() {
 				credential[4] = 0
 				signature, err := Signer.Sign(
@@ -59,6 +59,9 @@ func (*Revocation) Verify(pk *ecdsa.PublicKey, criRaw []byte, epoch int, alg bcc
 	if err != nil {
 		return err
 	}
+	if int(cri.Epoch) != epoch {
+		return errors.Errorf("CRI epoch %d differs from the expected epoch %d", cri.Epoch, epoch)
+	}
 
 	return cryptolib.VerifyEpochPK(
 		pk,
@@ -91,8 +91,8 @@ func (s *SignatureScheme) Sign(cred []byte, sk handlers.Big, Nym handlers.Ecp, R
 }
 
 // Verify checks that an idemix signature is valid with the respect to the passed issuer public key, digest, attributes,
-// revocation index (rhIndex), revocation public key, and epoch.
-func (*SignatureScheme) Verify(ipk handlers.IssuerPublicKey, signature, digest []byte, attributes []bccsp.IdemixAttribute, rhIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int) (err error) {
+// revocation index (rhIndex), revocation public key, and epoch, unless ignoreEpoch is true.
+func (*SignatureScheme) Verify(ipk handlers.IssuerPublicKey, signature, digest []byte, attributes []bccsp.IdemixAttribute, rhIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int, ignoreEpoch bool) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = errors.Errorf("failure [%s]", r)
@@ -131,6 +131,16 @@ func (*SignatureScheme) Verify(ipk handlers.IssuerPublicKey, signature, digest [
 		return
 	}
 
+	if ignoreEpoch {
+		return sig.VerIgnoringEpoch(
+			disclosure,
+			iipk.PK,
+			digest,
+			attrValues,
+			rhIndex,
+			revocationPublicKey)
+	}
+
 	return sig.Ver(
 		disclosure,
 		iipk.PK,
@@ -105,9 +105,11 @@ type Revocation interface {
 	// Users can use the CRI to prove that they are not revoked.
 	// Note that when not using revocation (i.e., alg = ALG_NO_REVOCATION), the entered unrevokedHandles are not used,
 	// and the resulting CRI can be used by any signer.
+	// When using ALG_PLAIN_SIGNATURE, the resulting CRI can only be used by the signers whose
+	// revocation handle is among the unrevoked ones.
 	Sign(key *ecdsa.PrivateKey, unrevokedHandles [][]byte, epoch int, alg bccsp.RevocationAlgorithm) ([]byte, error)
 
-	// Verify verifies that the revocation PK for a certain epoch is valid,
+	// Verify verifies that the CRI belongs to the passed epoch and that its revocation PK is valid,
 	// by checking that it was signed with the long term revocation key.
 	// Note that even if we use no revocation (i.e., alg = ALG_NO_REVOCATION), we need
 	// to verify the signature to make sure the issuer indeed signed that no revocation
@@ -147,8 +149,10 @@ type SignatureScheme interface {
 	// attributes: as described above;
 	// rhIndex: revocation handle index relative to attributes;
 	// revocationPublicKey: revocation public key;
-	// epoch: revocation epoch.
-	Verify(ipk IssuerPublicKey, signature, msg []byte, attributes []bccsp.IdemixAttribute, rhIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int) error
+	// epoch: revocation epoch;
+	// ignoreEpoch: true if the epoch the signature was produced against is not checked, in which case
+	// signatures using revocation are not accepted.
+	Verify(ipk IssuerPublicKey, signature, msg []byte, attributes []bccsp.IdemixAttribute, rhIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int, ignoreEpoch bool) error
 }
 
 // NymSignatureScheme is a local interface to decouple from the idemix implementation
@@ -31,7 +31,7 @@ type SignatureScheme struct {
 		result1 []byte
 		result2 error
 	}
-	VerifyStub        func(pk handlers.IssuerPublicKey, signature, digest []byte, attributes []bccsp.IdemixAttribute, hIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int) error
+	VerifyStub        func(pk handlers.IssuerPublicKey, signature, digest []byte, attributes []bccsp.IdemixAttribute, hIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int, ignoreEpoch bool) error
 	verifyMutex       sync.RWMutex
 	verifyArgsForCall []struct {
 		pk                  handlers.IssuerPublicKey
@@ -41,6 +41,7 @@ type SignatureScheme struct {
 		hIndex              int
 		revocationPublicKey *ecdsa.PublicKey
 		epoch               int
+		ignoreEpoch         bool
 	}
 	verifyReturns struct {
 		result1 error
@@ -131,7 +132,7 @@ func (fake *SignatureScheme) SignReturnsOnCall(i int, result1 []byte, result2 er
 	}{result1, result2}
 }
 
-func (fake *SignatureScheme) Verify(pk handlers.IssuerPublicKey, signature []byte, digest []byte, attributes []bccsp.IdemixAttribute, hIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int) error {
+func (fake *SignatureScheme) Verify(pk handlers.IssuerPublicKey, signature []byte, digest []byte, attributes []bccsp.IdemixAttribute, hIndex int, revocationPublicKey *ecdsa.PublicKey, epoch int, ignoreEpoch bool) error {
 	var signatureCopy []byte
 	if signature != nil {
 		signatureCopy = make([]byte, len(signature))
@@ -157,11 +158,12 @@ func (fake *SignatureScheme) Verify(pk handlers.IssuerPublicKey, signature []byt
 		hIndex              int
 		revocationPublicKey *ecdsa.PublicKey
 		epoch               int
-	}{pk, signatureCopy, digestCopy, attributesCopy, hIndex, revocationPublicKey, epoch})
-	fake.recordInvocation("Verify", []interface{}{pk, signatureCopy, digestCopy, attributesCopy, hIndex, revocationPublicKey, epoch})
+		ignoreEpoch         bool
+	}{pk, signatureCopy, digestCopy, attributesCopy, hIndex, revocationPublicKey, epoch, ignoreEpoch})
+	fake.recordInvocation("Verify", []interface{}{pk, signatureCopy, digestCopy, attributesCopy, hIndex, revocationPublicKey, epoch, ignoreEpoch})
 	fake.verifyMutex.Unlock()
 	if fake.VerifyStub != nil {
-		return fake.VerifyStub(pk, signature, digest, attributes, hIndex, revocationPublicKey, epoch)
+		return fake.VerifyStub(pk, signature, digest, attributes, hIndex, revocationPublicKey, epoch, ignoreEpoch)
 	}
 	if specificReturn {
 		return ret.result1
@@ -175,10 +177,10 @@ func (fake *SignatureScheme) VerifyCallCount() int {
 	return len(fake.verifyArgsForCall)
 }
 
-func (fake *SignatureScheme) VerifyArgsForCall(i int) (handlers.IssuerPublicKey, []byte, []byte, []bccsp.IdemixAttribute, int, *ecdsa.PublicKey, int) {
+func (fake *SignatureScheme) VerifyArgsForCall(i int) (handlers.IssuerPublicKey, []byte, []byte, []bccsp.IdemixAttribute, int, *ecdsa.PublicKey, int, bool) {
 	fake.verifyMutex.RLock()
 	defer fake.verifyMutex.RUnlock()
-	return fake.verifyArgsForCall[i].pk, fake.verifyArgsForCall[i].signature, fake.verifyArgsForCall[i].digest, fake.verifyArgsForCall[i].attributes, fake.verifyArgsForCall[i].hIndex, fake.verifyArgsForCall[i].revocationPublicKey, fake.verifyArgsForCall[i].epoch
+	return fake.verifyArgsForCall[i].pk, fake.verifyArgsForCall[i].signature, fake.verifyArgsForCall[i].digest, fake.verifyArgsForCall[i].attributes, fake.verifyArgsForCall[i].hIndex, fake.verifyArgsForCall[i].revocationPublicKey, fake.verifyArgsForCall[i].epoch, fake.verifyArgsForCall[i].ignoreEpoch
 }
 
 func (fake *SignatureScheme) VerifyReturns(result1 error) {
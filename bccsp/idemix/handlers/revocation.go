@@ -179,6 +179,11 @@ func (s *CriSigner) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]b
 	if !ok {
 		return nil, errors.New("invalid options, expected *IdemixCRISignerOpts")
 	}
+	switch criOpts.RevocationAlgorithm {
+	case bccsp.AlgNoRevocation, bccsp.AlgPlainSignature:
+	default:
+		return nil, errors.Errorf("unsupported revocation algorithm [%d]", criOpts.RevocationAlgorithm)
+	}
 
 	return s.Revocation.Sign(
 		revocationSecretKey.privKey,
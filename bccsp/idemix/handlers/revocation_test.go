@@ -307,6 +307,19 @@ var _ = Describe("CRI", func() {
 				})
 			})
 
+			Context("and the revocation algorithm is not supported", func() {
+				It("returns an error", func() {
+					signature, err := CriSigner.Sign(
+						handlers.NewRevocationSecretKey(nil, false),
+						nil,
+						&bccsp.IdemixCRISignerOpts{RevocationAlgorithm: bccsp.RevocationAlgorithm(42)},
+					)
+					Expect(err).To(MatchError("unsupported revocation algorithm [42]"))
+					Expect(signature).To(BeNil())
+					Expect(fakeRevocation.SignCallCount()).To(Equal(0))
+				})
+			})
+
 		})
 	})
 
@@ -92,6 +92,7 @@ func (v *Verifier) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.Sign
 		signerOpts.RhIndex,
 		rpk.pubKey,
 		signerOpts.Epoch,
+		signerOpts.IgnoreEpoch,
 	)
 	if err != nil {
 		return false, err
@@ -20,6 +20,9 @@ const (
 const (
 	// AlgNoRevocation means no revocation support
 	AlgNoRevocation RevocationAlgorithm = iota
+	// AlgPlainSignature means revocation based on signatures on the unrevoked
+	// revocation handles, valid under a fresh key of the revocation authority for every epoch
+	AlgPlainSignature
 )
 
 // IdemixIssuerKeyGenOpts contains the options for the Idemix Issuer key-generation.
@@ -221,6 +224,9 @@ type IdemixSignerOpts struct {
 	CRI []byte
 	// Epoch is the revocation epoch the signature should be produced against
 	Epoch int
+	// IgnoreEpoch steers whether the verification skips the check that the signature was produced
+	// against the revocation information of Epoch. Signatures using revocation are then not accepted
+	IgnoreEpoch bool
 	// RevocationPublicKey is the revocation public key
 	RevocationPublicKey Key
 	// H is the hash function to be used
@@ -0,0 +1,93 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RemoteOpts contains options for the RemoteFactory
+type RemoteOpts struct {
+	// Default algorithms when not specified (Deprecated?)
+	SecLevel   int    `mapstructure:"security" json:"security"`
+	HashFamily string `mapstructure:"hash" json:"hash"`
+
+	// Keystore options, for the keys that are not held by the remote signer
+	Ephemeral     bool               `mapstructure:"tempkeys,omitempty" json:"tempkeys,omitempty"`
+	FileKeystore  *FileKeystoreOpts  `mapstructure:"filekeystore,omitempty" json:"filekeystore,omitempty"`
+	DummyKeystore *DummyKeystoreOpts `mapstructure:"dummykeystore,omitempty" json:"dummykeystore,omitempty"`
+
+	// Address is the host:port the remote signer listens on
+	Address string `mapstructure:"address" json:"address"`
+	// Timeout bounds the time spent connecting to the remote signer,
+	// and each request to it. Defaults to 10 seconds.
+	Timeout time.Duration `mapstructure:"timeout" json:"timeout"`
+	// TLS configures the mutual TLS connection to the remote signer
+	TLS TLSOpts `mapstructure:"tls" json:"tls"`
+}
+
+// TLSOpts contains the TLS credentials used to connect to the remote signer
+type TLSOpts struct {
+	// Certificate is the path of the PEM encoded client certificate
+	Certificate string `mapstructure:"certificate" json:"certificate"`
+	// Key is the path of the PEM encoded client private key
+	Key string `mapstructure:"key" json:"key"`
+	// RootCAs are the paths of the PEM encoded CA certificates
+	// the certificate of the remote signer is verified against
+	RootCAs []string `mapstructure:"rootcas" json:"rootcas"`
+	// ServerNameOverride is the name the certificate of the remote
+	// signer is verified against, instead of the host of Address
+	ServerNameOverride string `mapstructure:"servernameoverride,omitempty" json:"servernameoverride,omitempty"`
+}
+
+// FileKeystoreOpts configures the keystore of the keys
+// that are not held by the remote signer
+type FileKeystoreOpts struct {
+	KeyStorePath string `mapstructure:"keystore" json:"keystore" yaml:"KeyStore"`
+}
+
+// DummyKeystoreOpts is placeholder for testing purposes
+type DummyKeystoreOpts struct{}
+
+// clientConfig returns the configuration of
+// the mutual TLS connection to the remote signer
+func (o TLSOpts) clientConfig() (*tls.Config, error) {
+	if o.Certificate == "" || o.Key == "" {
+		return nil, errors.New("a client certificate and key are required to authenticate to the remote signer")
+	}
+	if len(o.RootCAs) == 0 {
+		return nil, errors.New("root CAs are required to authenticate the remote signer")
+	}
+
+	cert, err := tls.LoadX509KeyPair(o.Certificate, o.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed loading client certificate and key")
+	}
+
+	rootCAs := x509.NewCertPool()
+	for _, path := range o.RootCAs {
+		pemBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed reading root CA %s", path)
+		}
+		if !rootCAs.AppendCertsFromPEM(pemBytes) {
+			return nil, errors.Errorf("no certificate found in root CA %s", path)
+		}
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		ServerName:   o.ServerNameOverride,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
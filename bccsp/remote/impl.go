@@ -0,0 +1,229 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/hyperledger/fabric/common/flogging"
+	pb "github.com/hyperledger/fabric/protos/bccsp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const defaultTimeout = 10 * time.Second
+
+var logger = flogging.MustGetLogger("bccsp_remote")
+
+// New returns a BCCSP that forwards the operations on private keys to the
+// remote signer configured by opts, so that they never leave it. All the
+// other operations are performed in software, with the given KeyStore.
+func New(opts RemoteOpts, keyStore bccsp.KeyStore) (bccsp.BCCSP, error) {
+	swCSP, err := sw.NewWithParams(opts.SecLevel, opts.HashFamily, keyStore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed initializing fallback SW BCCSP")
+	}
+
+	if opts.Address == "" {
+		return nil, errors.New("Invalid config: missing property 'Address'")
+	}
+	tlsConfig, err := opts.TLS.clientConfig()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Invalid TLS config")
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, opts.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed connecting to remote signer at %s", opts.Address)
+	}
+	logger.Debugf("Connected to remote signer at %s", opts.Address)
+
+	return &impl{
+		BCCSP:   swCSP,
+		client:  pb.NewRemoteSignerClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+type impl struct {
+	bccsp.BCCSP
+
+	client  pb.RemoteSignerClient
+	timeout time.Duration
+}
+
+// KeyImport imports a key from its raw representation using opts.
+// The opts argument should be appropriate for the primitive used.
+// Private keys are imported into the remote signer.
+func (csp *impl) KeyImport(raw interface{}, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	// Validate arguments
+	if raw == nil {
+		return nil, errors.New("Invalid raw. Cannot be nil")
+	}
+
+	if opts == nil {
+		return nil, errors.New("Invalid Opts parameter. It must not be nil")
+	}
+
+	switch opts.(type) {
+	case *bccsp.ECDSAPrivateKeyImportOpts, *bccsp.ED25519PrivateKeyImportOpts:
+		der, ok := raw.([]byte)
+		if !ok || len(der) == 0 {
+			return nil, errors.New("Invalid raw material. Expected non-empty byte array.")
+		}
+		return csp.importPrivateKey(der, opts)
+
+	default:
+		return csp.BCCSP.KeyImport(raw, opts)
+	}
+}
+
+func (csp *impl) importPrivateKey(der []byte, opts bccsp.KeyImportOpts) (bccsp.Key, error) {
+	lowLevelKey, err := utils.DERToPrivateKey(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed parsing private key")
+	}
+
+	switch lowLevelKey.(type) {
+	case *ecdsa.PrivateKey:
+		if _, ok := opts.(*bccsp.ECDSAPrivateKeyImportOpts); !ok {
+			return nil, errors.New("Failed casting to Ed25519 private key. Invalid raw material.")
+		}
+	case ed25519.PrivateKey:
+		if _, ok := opts.(*bccsp.ED25519PrivateKeyImportOpts); !ok {
+			return nil, errors.New("Failed casting to ECDSA private key. Invalid raw material.")
+		}
+	default:
+		return nil, errors.Errorf("Unsupported private key type %T", lowLevelKey)
+	}
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(lowLevelKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed marshalling private key")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csp.timeout)
+	defer cancel()
+	resp, err := csp.client.KeyImport(ctx, &pb.KeyImportRequest{PrivateKey: pkcs8})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed importing private key into remote signer")
+	}
+
+	return csp.privateKey(resp.Ski, resp.PublicKey)
+}
+
+// GetKey returns the key this CSP associates to
+// the Subject Key Identifier ski.
+func (csp *impl) GetKey(ski []byte) (bccsp.Key, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), csp.timeout)
+	defer cancel()
+	resp, err := csp.client.GetKey(ctx, &pb.GetKeyRequest{Ski: ski})
+	if err == nil {
+		return csp.privateKey(ski, resp.PublicKey)
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, errors.Wrapf(err, "Failed getting key [%x] from remote signer", ski)
+	}
+	return csp.BCCSP.GetKey(ski)
+}
+
+// privateKey returns the private key held by the remote
+// signer with the given SKI and PKIX encoded public key
+func (csp *impl) privateKey(ski, pkix []byte) (bccsp.Key, error) {
+	lowLevelKey, err := x509.ParsePKIXPublicKey(pkix)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed parsing public key returned by remote signer")
+	}
+
+	var pub bccsp.Key
+	var ecdsaPub *ecdsa.PublicKey
+	switch lowLevelKey := lowLevelKey.(type) {
+	case *ecdsa.PublicKey:
+		ecdsaPub = lowLevelKey
+		pub, err = csp.BCCSP.KeyImport(lowLevelKey, &bccsp.ECDSAGoPublicKeyImportOpts{Temporary: true})
+	case ed25519.PublicKey:
+		pub, err = csp.BCCSP.KeyImport(lowLevelKey, &bccsp.ED25519GoPublicKeyImportOpts{Temporary: true})
+	default:
+		return nil, errors.Errorf("Unsupported public key type %T returned by remote signer", lowLevelKey)
+	}
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed importing public key returned by remote signer")
+	}
+
+	if !bytes.Equal(pub.SKI(), ski) {
+		return nil, errors.Errorf("Public key returned by remote signer doesn't match SKI [%x]", ski)
+	}
+	return &privateKey{ski: ski, pub: pub, ecdsaPub: ecdsaPub}, nil
+}
+
+// Sign signs digest using key k.
+// The opts argument should be appropriate for the primitive used.
+//
+// Note that when a signature of a hash of a larger message is needed,
+// the caller is responsible for hashing the larger message and passing
+// the hash (as digest).
+func (csp *impl) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	// Validate arguments
+	if k == nil {
+		return nil, errors.New("Invalid Key. It must not be nil")
+	}
+	if len(digest) == 0 {
+		return nil, errors.New("Invalid digest. Cannot be empty")
+	}
+
+	remoteKey, isRemote := k.(*privateKey)
+	if !isRemote {
+		return csp.BCCSP.Sign(k, digest, opts)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), csp.timeout)
+	defer cancel()
+	resp, err := csp.client.Sign(ctx, &pb.SignRequest{Ski: remoteKey.ski, Digest: digest})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed signing with key [%x] held by remote signer", remoteKey.ski)
+	}
+	if remoteKey.ecdsaPub == nil {
+		return resp.Signature, nil
+	}
+
+	// The remote signer isn't trusted to produce low-S signatures,
+	// which are the only ECDSA signatures that are accepted
+	signature, err := utils.SignatureToLowS(remoteKey.ecdsaPub, resp.Signature)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Invalid signature returned by remote signer")
+	}
+	return signature, nil
+}
+
+// Verify verifies signature against key k and digest
+func (csp *impl) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	if remoteKey, isRemote := k.(*privateKey); isRemote {
+		return csp.BCCSP.Verify(remoteKey.pub, signature, digest, opts)
+	}
+	return csp.BCCSP.Verify(k, signature, digest, opts)
+}
@@ -0,0 +1,355 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	pb "github.com/hyperledger/fabric/protos/bccsp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// testSigner is an InMemorySigner served over mutual TLS,
+// along with the options to connect to it
+type testSigner struct {
+	*InMemorySigner
+	server *grpc.Server
+	opts   RemoteOpts
+	ca     tlsgen.CA
+	dir    string
+	highS  bool // whether to return ECDSA signatures with a high S
+}
+
+// Sign signs a digest with the private key, and turns ECDSA
+// signatures into high-S signatures if the signer is set to
+func (ts *testSigner) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	resp, err := ts.InMemorySigner.Sign(ctx, req)
+	if err != nil || !ts.highS {
+		return resp, err
+	}
+	r, s, err := utils.UnmarshalECDSASignature(resp.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) != 1 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	resp.Signature, err = utils.MarshalECDSASignature(r, s)
+	return resp, err
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	dir, err := ioutil.TempDir("", "remotesigner")
+	require.NoError(t, err)
+
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	serverKeyPair, err := ca.NewServerCertKeyPair("127.0.0.1")
+	require.NoError(t, err)
+	serverCert, err := tls.X509KeyPair(serverKeyPair.Cert, serverKeyPair.Key)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(ca.CertBytes())
+
+	signer, err := NewInMemorySigner()
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	ts := &testSigner{InMemorySigner: signer, server: server, ca: ca, dir: dir}
+	pb.RegisterRemoteSignerServer(server, ts)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+
+	ts.opts = RemoteOpts{
+		SecLevel:   256,
+		HashFamily: "SHA2",
+		Address:    listener.Addr().String(),
+		Timeout:    5 * time.Second,
+		TLS:        ts.clientTLSOpts(t, ca),
+	}
+	return ts
+}
+
+// clientTLSOpts writes a client certificate issued by the given
+// CA, and the CA of the signer, and returns the TLS options to use them
+func (ts *testSigner) clientTLSOpts(t *testing.T, ca tlsgen.CA) TLSOpts {
+	clientKeyPair, err := ca.NewClientCertKeyPair()
+	require.NoError(t, err)
+	certDir, err := ioutil.TempDir(ts.dir, "client")
+	require.NoError(t, err)
+
+	opts := TLSOpts{
+		Certificate: filepath.Join(certDir, "client.crt"),
+		Key:         filepath.Join(certDir, "client.key"),
+		RootCAs:     []string{filepath.Join(certDir, "ca.crt")},
+	}
+	require.NoError(t, ioutil.WriteFile(opts.Certificate, clientKeyPair.Cert, 0600))
+	require.NoError(t, ioutil.WriteFile(opts.Key, clientKeyPair.Key, 0600))
+	require.NoError(t, ioutil.WriteFile(opts.RootCAs[0], ts.ca.CertBytes(), 0600))
+	return opts
+}
+
+func (ts *testSigner) stop() {
+	ts.server.Stop()
+	os.RemoveAll(ts.dir)
+}
+
+func TestRemoteECDSAKey(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	csp, err := New(ts.opts, sw.NewDummyKeyStore())
+	require.NoError(t, err)
+
+	lowLevelKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(lowLevelKey)
+	require.NoError(t, err)
+	ski := sha256.Sum256(elliptic.Marshal(lowLevelKey.Curve, lowLevelKey.X, lowLevelKey.Y))
+
+	k, err := csp.KeyImport(der, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+	require.NoError(t, err)
+	assert.Equal(t, ski[:], k.SKI())
+	assert.True(t, k.Private())
+	assert.False(t, k.Symmetric())
+	_, err = k.Bytes()
+	assert.EqualError(t, err, "Not supported.")
+
+	k, err = csp.GetKey(ski[:])
+	require.NoError(t, err)
+	assert.True(t, k.Private())
+	pk, err := k.PublicKey()
+	require.NoError(t, err)
+	assert.False(t, pk.Private())
+	assert.Equal(t, ski[:], pk.SKI())
+
+	digest, err := csp.Hash([]byte("message"), &bccsp.SHAOpts{})
+	require.NoError(t, err)
+	signature, err := csp.Sign(k, digest, nil)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(&lowLevelKey.PublicKey, digest, signature))
+	valid, err := csp.Verify(k, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = csp.Verify(pk, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	// The private key is used through a crypto.Signer,
+	// as MSP signing identities do
+	cryptoSigner, err := signer.New(csp, k)
+	require.NoError(t, err)
+	signature, err = cryptoSigner.Sign(rand.Reader, digest, nil)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(&lowLevelKey.PublicKey, digest, signature))
+}
+
+func TestRemoteECDSAHighS(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+	ts.highS = true
+
+	csp, err := New(ts.opts, sw.NewDummyKeyStore())
+	require.NoError(t, err)
+
+	lowLevelKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(lowLevelKey)
+	require.NoError(t, err)
+	k, err := csp.KeyImport(der, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+	require.NoError(t, err)
+
+	// The high-S signatures of the remote signer are normalized to low-S
+	digest, err := csp.Hash([]byte("message"), &bccsp.SHAOpts{})
+	require.NoError(t, err)
+	signature, err := csp.Sign(k, digest, nil)
+	require.NoError(t, err)
+	assert.True(t, ecdsa.VerifyASN1(&lowLevelKey.PublicKey, digest, signature))
+	_, s, err := utils.UnmarshalECDSASignature(signature)
+	require.NoError(t, err)
+	lowS, err := utils.IsLowS(&lowLevelKey.PublicKey, s)
+	require.NoError(t, err)
+	assert.True(t, lowS)
+	valid, err := csp.Verify(k, signature, digest, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestRemoteEd25519Key(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	csp, err := New(ts.opts, sw.NewDummyKeyStore())
+	require.NoError(t, err)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	ski := sha256.Sum256(pub)
+
+	_, err = csp.KeyImport(der, &bccsp.ECDSAPrivateKeyImportOpts{})
+	assert.EqualError(t, err, "Failed casting to ECDSA private key. Invalid raw material.")
+
+	k, err := csp.KeyImport(der, &bccsp.ED25519PrivateKeyImportOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, ski[:], k.SKI())
+
+	signature, err := csp.Sign(k, []byte("message"), nil)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(pub, []byte("message"), signature))
+	valid, err := csp.Verify(k, signature, []byte("message"), nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestRemoteFallback(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	csp, err := New(ts.opts, sw.NewInMemoryKeyStore())
+	require.NoError(t, err)
+
+	// Keys that aren't held by the remote signer are
+	// generated, and looked up, in the local keystore
+	k, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{})
+	require.NoError(t, err)
+	_, isRemote := k.(*privateKey)
+	assert.False(t, isRemote)
+	local, err := csp.GetKey(k.SKI())
+	require.NoError(t, err)
+	assert.Equal(t, k, local)
+
+	_, err = csp.GetKey([]byte{1, 2, 3})
+	assert.Error(t, err)
+
+	// Public keys are imported locally
+	lowLevelKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pk, err := csp.KeyImport(&lowLevelKey.PublicKey, &bccsp.ECDSAGoPublicKeyImportOpts{Temporary: true})
+	require.NoError(t, err)
+	assert.False(t, pk.Private())
+	_, err = ts.GetKey(nil, &pb.GetKeyRequest{Ski: pk.SKI()})
+	assert.Error(t, err)
+}
+
+func TestRemoteSignerUnavailable(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	csp, err := New(ts.opts, sw.NewDummyKeyStore())
+	require.NoError(t, err)
+	lowLevelKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(lowLevelKey)
+	require.NoError(t, err)
+	k, err := csp.KeyImport(der, &bccsp.ECDSAPrivateKeyImportOpts{})
+	require.NoError(t, err)
+
+	ts.server.Stop()
+
+	// Failing to reach the remote signer doesn't fall back to the local keystore
+	_, err = csp.GetKey(k.SKI())
+	assert.Contains(t, err.Error(), "Failed getting key")
+	_, err = csp.Sign(k, []byte{1, 2, 3}, nil)
+	assert.Contains(t, err.Error(), "Failed signing with key")
+}
+
+func TestRemoteSignerAuthentication(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	// Clients with a certificate that isn't issued by
+	// a CA trusted by the remote signer are rejected
+	otherCA, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	opts := ts.opts
+	opts.TLS = ts.clientTLSOpts(t, otherCA)
+	opts.Timeout = time.Second
+	csp, err := New(opts, sw.NewDummyKeyStore())
+	if err == nil {
+		_, err = csp.GetKey([]byte{1, 2, 3})
+	}
+	assert.Error(t, err)
+}
+
+func TestNewInvalidOpts(t *testing.T) {
+	ts := newTestSigner(t)
+	defer ts.stop()
+
+	opts := ts.opts
+	opts.Address = ""
+	_, err := New(opts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid config: missing property 'Address'")
+
+	opts = ts.opts
+	opts.TLS.Key = ""
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid TLS config: a client certificate and key are required to authenticate to the remote signer")
+
+	opts = ts.opts
+	opts.TLS.RootCAs = nil
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.EqualError(t, err, "Invalid TLS config: root CAs are required to authenticate the remote signer")
+
+	opts = ts.opts
+	opts.TLS.RootCAs = []string{opts.TLS.Certificate + ".missing"}
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.Contains(t, err.Error(), "failed reading root CA")
+
+	opts = ts.opts
+	opts.TLS.RootCAs = []string{opts.TLS.Key}
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.Contains(t, err.Error(), "no certificate found in root CA")
+
+	opts = ts.opts
+	opts.SecLevel = 0
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.Contains(t, err.Error(), "Failed initializing fallback SW BCCSP")
+
+	opts = ts.opts
+	opts.Address = "127.0.0.1:1"
+	opts.Timeout = 100 * time.Millisecond
+	_, err = New(opts, sw.NewDummyKeyStore())
+	assert.Contains(t, err.Error(), "Failed connecting to remote signer at 127.0.0.1:1")
+}
+
+func TestInMemorySignerInvalidRequests(t *testing.T) {
+	signer, err := NewInMemorySigner()
+	require.NoError(t, err)
+
+	_, err = signer.KeyImport(nil, &pb.KeyImportRequest{PrivateKey: []byte{1, 2, 3}})
+	assert.Contains(t, err.Error(), "invalid private key")
+	_, err = signer.Sign(nil, &pb.SignRequest{Ski: []byte{1, 2, 3}, Digest: []byte{1}})
+	assert.Contains(t, err.Error(), "no private key with SKI 010203")
+}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/sw"
+	pb "github.com/hyperledger/fabric/protos/bccsp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InMemorySigner is a reference implementation of the RemoteSigner
+// service, which holds the private keys it is given in memory. It serves
+// as a stand-in for key management services in tests, and as an example
+// for those implementing the RemoteSigner service.
+type InMemorySigner struct {
+	csp bccsp.BCCSP
+}
+
+// NewInMemorySigner creates an InMemorySigner without any key
+func NewInMemorySigner() (*InMemorySigner, error) {
+	csp, err := sw.NewWithParams(256, "SHA2", sw.NewInMemoryKeyStore())
+	if err != nil {
+		return nil, err
+	}
+	return &InMemorySigner{csp: csp}, nil
+}
+
+// GetKey returns the public key of the private key
+// with the given subject key identifier
+func (s *InMemorySigner) GetKey(_ context.Context, req *pb.GetKeyRequest) (*pb.GetKeyResponse, error) {
+	k, err := s.getPrivateKey(req.Ski)
+	if err != nil {
+		return nil, err
+	}
+	pkix, err := publicKeyBytes(k)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetKeyResponse{PublicKey: pkix}, nil
+}
+
+// KeyImport imports a private key
+func (s *InMemorySigner) KeyImport(_ context.Context, req *pb.KeyImportRequest) (*pb.KeyImportResponse, error) {
+	lowLevelKey, err := x509.ParsePKCS8PrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid private key: %s", err)
+	}
+
+	var opts bccsp.KeyImportOpts
+	switch lowLevelKey.(type) {
+	case *ecdsa.PrivateKey:
+		opts = &bccsp.ECDSAPrivateKeyImportOpts{}
+	case ed25519.PrivateKey:
+		opts = &bccsp.ED25519PrivateKeyImportOpts{}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported private key type %T", lowLevelKey)
+	}
+
+	k, err := s.csp.KeyImport(req.PrivateKey, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed importing private key: %s", err)
+	}
+	pkix, err := publicKeyBytes(k)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.KeyImportResponse{Ski: k.SKI(), PublicKey: pkix}, nil
+}
+
+// Sign signs a digest with the private key
+// with the given subject key identifier
+func (s *InMemorySigner) Sign(_ context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	k, err := s.getPrivateKey(req.Ski)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.csp.Sign(k, req.Digest, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed signing: %s", err)
+	}
+	return &pb.SignResponse{Signature: signature}, nil
+}
+
+func (s *InMemorySigner) getPrivateKey(ski []byte) (bccsp.Key, error) {
+	k, err := s.csp.GetKey(ski)
+	if err != nil || !k.Private() {
+		return nil, status.Errorf(codes.NotFound, "no private key with SKI %x", ski)
+	}
+	return k, nil
+}
+
+func publicKeyBytes(k bccsp.Key) ([]byte, error) {
+	pub, err := k.PublicKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed getting public key: %s", err)
+	}
+	pkix, err := pub.Bytes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed marshalling public key: %s", err)
+	}
+	return pkix, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package sw
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/hyperledger/fabric/bccsp"
+)
+
+// Ed25519 hashes the message as part of the signature scheme, and its
+// signatures are not malleable. Hence, the digest passed to the signer and
+// verifier is signed as is, and it may as well be the message itself.
+
+func signEd25519(k ed25519.PrivateKey, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return ed25519.Sign(k, digest), nil
+}
+
+func verifyEd25519(k ed25519.PublicKey, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	if len(signature) != ed25519.SignatureSize {
+		return false, fmt.Errorf("Invalid signature length [%d]. Must be %d bytes.", len(signature), ed25519.SignatureSize)
+	}
+
+	return ed25519.Verify(k, digest, signature), nil
+}
+
+type ed25519Signer struct{}
+
+func (s *ed25519Signer) Sign(k bccsp.Key, digest []byte, opts bccsp.SignerOpts) ([]byte, error) {
+	return signEd25519(k.(*ed25519PrivateKey).privKey, digest, opts)
+}
+
+type ed25519PrivateKeyVerifier struct{}
+
+func (v *ed25519PrivateKeyVerifier) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifyEd25519(k.(*ed25519PrivateKey).privKey.Public().(ed25519.PublicKey), signature, digest, opts)
+}
+
+type ed25519PublicKeyKeyVerifier struct{}
+
+func (v *ed25519PublicKeyKeyVerifier) Verify(k bccsp.Key, signature, digest []byte, opts bccsp.SignerOpts) (bool, error) {
+	return verifyEd25519(k.(*ed25519PublicKey).pubKey, signature, digest, opts)
+}
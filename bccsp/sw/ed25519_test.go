@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package sw
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyEd25519(t *testing.T) {
+	t.Parallel()
+
+	// Generate a key
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	msg := []byte("hello world")
+	sigma, err := signEd25519(privKey, msg, nil)
+	assert.NoError(t, err)
+
+	valid, err := verifyEd25519(pubKey, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifyEd25519(pubKey, sigma, []byte("hello wörld"), nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = verifyEd25519(pubKey, sigma[1:], msg, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid signature length [63]. Must be 64 bytes.")
+}
+
+func TestEd25519SignerSign(t *testing.T) {
+	t.Parallel()
+
+	signer := &ed25519Signer{}
+	verifierPrivateKey := &ed25519PrivateKeyVerifier{}
+	verifierPublicKey := &ed25519PublicKeyKeyVerifier{}
+
+	// Generate a key
+	_, lowLevelKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	k := &ed25519PrivateKey{lowLevelKey}
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+
+	// Sign
+	msg := []byte("Hello World")
+	sigma, err := signer.Sign(k, msg, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, sigma)
+
+	// Verify
+	valid, err := verifyEd25519(lowLevelKey.Public().(ed25519.PublicKey), sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifierPrivateKey.Verify(k, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = verifierPublicKey.Verify(pk, sigma, msg, nil)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestEd25519PrivateKey(t *testing.T) {
+	t.Parallel()
+
+	lowLevelPubKey, lowLevelKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	k := &ed25519PrivateKey{lowLevelKey}
+
+	assert.False(t, k.Symmetric())
+	assert.True(t, k.Private())
+
+	_, err = k.Bytes()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Not supported.")
+
+	k.privKey = nil
+	ski := k.SKI()
+	assert.Nil(t, ski)
+
+	k.privKey = lowLevelKey
+	ski = k.SKI()
+	hash := sha256.New()
+	hash.Write(lowLevelPubKey)
+	ski2 := hash.Sum(nil)
+	assert.Equal(t, ski2, ski, "SKI is not computed in the right way.")
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, pk)
+	ed25519PK, ok := pk.(*ed25519PublicKey)
+	assert.True(t, ok)
+	assert.Equal(t, lowLevelPubKey, ed25519PK.pubKey)
+	assert.Equal(t, ski, pk.SKI())
+}
+
+func TestEd25519PublicKey(t *testing.T) {
+	t.Parallel()
+
+	lowLevelKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	k := &ed25519PublicKey{lowLevelKey}
+
+	assert.False(t, k.Symmetric())
+	assert.False(t, k.Private())
+
+	k.pubKey = nil
+	ski := k.SKI()
+	assert.Nil(t, ski)
+
+	k.pubKey = lowLevelKey
+	ski = k.SKI()
+	hash := sha256.New()
+	hash.Write(lowLevelKey)
+	ski2 := hash.Sum(nil)
+	assert.Equal(t, ski, ski2, "SKI is not computed in the right way.")
+
+	pk, err := k.PublicKey()
+	assert.NoError(t, err)
+	assert.Equal(t, k, pk)
+
+	bytes, err := k.Bytes()
+	assert.NoError(t, err)
+	bytes2, err := x509.MarshalPKIXPublicKey(k.pubKey)
+	assert.NoError(t, err)
+	assert.Equal(t, bytes2, bytes, "bytes are not computed in the right way.")
+}
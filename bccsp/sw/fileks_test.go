@@ -17,6 +17,7 @@ package sw
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/hex"
@@ -130,3 +131,37 @@ func TestReInitKeyStore(t *testing.T) {
 	err = fbKs.Init(nil, ksPath, false)
 	assert.EqualError(t, err, "KeyStore already initilized.")
 }
+
+func TestEd25519KeyStore(t *testing.T) {
+	ksPath, err := ioutil.TempDir("", "bccspks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(ksPath)
+
+	ks, err := NewFileBasedKeyStore(nil, ksPath, false)
+	assert.NoError(t, err)
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	sk := &ed25519PrivateKey{privKey}
+	assert.NoError(t, ks.StoreKey(sk))
+	k, err := ks.GetKey(sk.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, sk, k)
+
+	pk := &ed25519PublicKey{pubKey}
+	assert.NoError(t, ks.StoreKey(pk))
+	k, err = ks.GetKey(pk.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, pk, k)
+
+	// A key stored under another name is found by searching the keystore
+	rawKey, err := utils.PrivateKeyToPEM(privKey, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Remove(filepath.Join(ksPath, hex.EncodeToString(sk.SKI())+"_sk")))
+	assert.NoError(t, os.Remove(filepath.Join(ksPath, hex.EncodeToString(pk.SKI())+"_pk")))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(ksPath, "key.pem"), rawKey, 0600))
+	k, err = ks.GetKey(sk.SKI())
+	assert.NoError(t, err)
+	assert.Equal(t, sk, k)
+}
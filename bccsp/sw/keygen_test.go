@@ -17,6 +17,7 @@ limitations under the License.
 package sw
 
 import (
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"errors"
 	"reflect"
@@ -71,6 +72,19 @@ func TestECDSAKeyGenerator(t *testing.T) {
 	assert.Equal(t, ecdsaK.privKey.Curve, elliptic.P256())
 }
 
+func TestEd25519KeyGenerator(t *testing.T) {
+	t.Parallel()
+
+	kg := &ed25519KeyGenerator{}
+
+	k, err := kg.KeyGen(nil)
+	assert.NoError(t, err)
+
+	ed25519K, ok := k.(*ed25519PrivateKey)
+	assert.True(t, ok)
+	assert.Len(t, ed25519K.privKey, ed25519.PrivateKeySize)
+}
+
 func TestRSAKeyGenerator(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,7 @@ limitations under the License.
 package sw
 
 import (
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -176,6 +177,87 @@ func TestECDSAGoPublicKeyImportOptsKeyImporter(t *testing.T) {
 	assert.Contains(t, err.Error(), "Invalid raw material. Expected *ecdsa.PublicKey.")
 }
 
+func TestEd25519PKIXPublicKeyImportOptsKeyImporter(t *testing.T) {
+	t.Parallel()
+
+	ki := ed25519PKIXPublicKeyImportOptsKeyImporter{}
+
+	_, err := ki.KeyImport("Hello World", &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected byte array.")
+
+	_, err = ki.KeyImport([]byte(nil), &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw. It must not be nil.")
+
+	_, err = ki.KeyImport([]byte{0}, &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed converting PKIX to Ed25519 public key [")
+
+	k, err := rsa.GenerateKey(rand.Reader, 512)
+	assert.NoError(t, err)
+	raw, err := utils.PublicKeyToDER(&k.PublicKey)
+	assert.NoError(t, err)
+	_, err = ki.KeyImport(raw, &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed casting to Ed25519 public key. Invalid raw material.")
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	raw, err = utils.PublicKeyToDER(pub)
+	assert.NoError(t, err)
+	pk, err := ki.KeyImport(raw, &mocks2.KeyImportOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, &ed25519PublicKey{pub}, pk)
+}
+
+func TestEd25519PrivateKeyImportOptsKeyImporter(t *testing.T) {
+	t.Parallel()
+
+	ki := ed25519PrivateKeyImportOptsKeyImporter{}
+
+	_, err := ki.KeyImport("Hello World", &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected byte array.")
+
+	_, err = ki.KeyImport([]byte(nil), &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw. It must not be nil.")
+
+	_, err = ki.KeyImport([]byte{0}, &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed converting PKCS#8 to Ed25519 private key")
+
+	k, err := rsa.GenerateKey(rand.Reader, 512)
+	assert.NoError(t, err)
+	raw := x509.MarshalPKCS1PrivateKey(k)
+	_, err = ki.KeyImport(raw, &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed casting to Ed25519 private key. Invalid raw material.")
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	raw, err = x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	sk, err := ki.KeyImport(raw, &mocks2.KeyImportOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, &ed25519PrivateKey{priv}, sk)
+}
+
+func TestEd25519GoPublicKeyImportOptsKeyImporter(t *testing.T) {
+	t.Parallel()
+
+	ki := ed25519GoPublicKeyImportOptsKeyImporter{}
+
+	_, err := ki.KeyImport("Hello World", &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected ed25519.PublicKey.")
+
+	_, err = ki.KeyImport(nil, &mocks2.KeyImportOpts{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid raw material. Expected ed25519.PublicKey.")
+}
+
 func TestRSAGoPublicKeyImportOptsKeyImporter(t *testing.T) {
 	t.Parallel()
 
@@ -207,5 +289,5 @@ func TestX509PublicKeyImportOptsKeyImporter(t *testing.T) {
 	cert.PublicKey = "Hello world"
 	_, err = ki.KeyImport(cert, &mocks2.KeyImportOpts{})
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Certificate's public key type not recognized. Supported keys: [ECDSA, RSA]")
+	assert.Contains(t, err.Error(), "Certificate's public key type not recognized. Supported keys: [ECDSA, ED25519, RSA]")
 }
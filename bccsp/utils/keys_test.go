@@ -18,6 +18,7 @@ package utils
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -318,6 +319,53 @@ func TestECDSAKeys(t *testing.T) {
 	}
 }
 
+func TestEd25519Keys(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	// Private Key PEM format
+	rawPEM, err := PrivateKeyToPEM(privKey, nil)
+	assert.NoError(t, err)
+	pemBlock, _ := pem.Decode(rawPEM)
+	assert.Equal(t, "PRIVATE KEY", pemBlock.Type)
+	keyFromPEM, err := PEMtoPrivateKey(rawPEM, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, keyFromPEM)
+
+	// Private Key Encrypted PEM format
+	encPEM, err := PrivateKeyToPEM(privKey, []byte("passwd"))
+	assert.NoError(t, err)
+	keyFromPEM, err = PEMtoPrivateKey(encPEM, []byte("passwd"))
+	assert.NoError(t, err)
+	assert.Equal(t, privKey, keyFromPEM)
+
+	// Public Key PEM format
+	rawPEM, err = PublicKeyToPEM(pubKey, nil)
+	assert.NoError(t, err)
+	pubFromPEM, err := PEMtoPublicKey(rawPEM, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, pubFromPEM)
+
+	// Public Key Encrypted PEM format
+	encPEM, err = PublicKeyToPEM(pubKey, []byte("passwd"))
+	assert.NoError(t, err)
+	pubFromPEM, err = PEMtoPublicKey(encPEM, []byte("passwd"))
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, pubFromPEM)
+
+	// Public Key DER format
+	der, err := PublicKeyToDER(pubKey)
+	assert.NoError(t, err)
+	pubFromDER, err := DERToPublicKey(der)
+	assert.NoError(t, err)
+	assert.Equal(t, pubKey, pubFromDER)
+
+	_, err = PrivateKeyToPEM(ed25519.PrivateKey(nil), nil)
+	assert.Error(t, err)
+	_, err = PublicKeyToPEM(ed25519.PublicKey(nil), nil)
+	assert.Error(t, err)
+}
+
 func TestAESKey(t *testing.T) {
 	k := []byte{0, 1, 2, 3, 4, 5}
 	pem := AEStoPEM(k)
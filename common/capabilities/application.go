@@ -22,6 +22,9 @@ const (
 	// ApplicationV1_3 is the capabilties string for standard new non-backwards compatible fabric v1.3 application capabilities.
 	ApplicationV1_3 = "V1_3"
 
+	// ApplicationV1_4_3 is the capabilties string for standard new non-backwards compatible fabric v1.4.3 application capabilities.
+	ApplicationV1_4_3 = "V1_4_3"
+
 	// ApplicationPvtDataExperimental is the capabilties string for private data using the experimental feature of collections/sideDB.
 	ApplicationPvtDataExperimental = "V1_1_PVTDATA_EXPERIMENTAL"
 
@@ -37,6 +40,7 @@ type ApplicationProvider struct {
 	v11                     bool
 	v12                     bool
 	v13                     bool
+	v143                    bool
 	v11PvtDataExperimental  bool
 	v14FabTokenExperimental bool
 }
@@ -48,6 +52,7 @@ func NewApplicationProvider(capabilities map[string]*cb.Capability) *Application
 	_, ap.v11 = capabilities[ApplicationV1_1]
 	_, ap.v12 = capabilities[ApplicationV1_2]
 	_, ap.v13 = capabilities[ApplicationV1_3]
+	_, ap.v143 = capabilities[ApplicationV1_4_3]
 	_, ap.v11PvtDataExperimental = capabilities[ApplicationPvtDataExperimental]
 	_, ap.v14FabTokenExperimental = capabilities[ApplicationFabTokenExperimental]
 	return ap
@@ -60,44 +65,44 @@ func (ap *ApplicationProvider) Type() string {
 
 // ACLs returns whether ACLs may be specified in the channel application config
 func (ap *ApplicationProvider) ACLs() bool {
-	return ap.v12 || ap.v13
+	return ap.v12 || ap.v13 || ap.v143
 }
 
 // ForbidDuplicateTXIdInBlock specifies whether two transactions with the same TXId are permitted
 // in the same block or whether we mark the second one as TxValidationCode_DUPLICATE_TXID
 func (ap *ApplicationProvider) ForbidDuplicateTXIdInBlock() bool {
-	return ap.v11 || ap.v12 || ap.v13
+	return ap.v11 || ap.v12 || ap.v13 || ap.v143
 }
 
 // PrivateChannelData returns true if support for private channel data (a.k.a. collections) is enabled.
 // In v1.1, the private channel data is experimental and has to be enabled explicitly.
 // In v1.2, the private channel data is enabled by default.
 func (ap *ApplicationProvider) PrivateChannelData() bool {
-	return ap.v11PvtDataExperimental || ap.v12 || ap.v13
+	return ap.v11PvtDataExperimental || ap.v12 || ap.v13 || ap.v143
 }
 
 // CollectionUpgrade returns true if this channel is configured to allow updates to
 // existing collection or add new collections through chaincode upgrade (as introduced in v1.2)
 func (ap ApplicationProvider) CollectionUpgrade() bool {
-	return ap.v12 || ap.v13
+	return ap.v12 || ap.v13 || ap.v143
 }
 
 // V1_1Validation returns true is this channel is configured to perform stricter validation
 // of transactions (as introduced in v1.1).
 func (ap *ApplicationProvider) V1_1Validation() bool {
-	return ap.v11 || ap.v12 || ap.v13
+	return ap.v11 || ap.v12 || ap.v13 || ap.v143
 }
 
 // V1_2Validation returns true if this channel is configured to perform stricter validation
 // of transactions (as introduced in v1.2).
 func (ap *ApplicationProvider) V1_2Validation() bool {
-	return ap.v12 || ap.v13
+	return ap.v12 || ap.v13 || ap.v143
 }
 
 // V1_3Validation returns true if this channel is configured to perform stricter validation
 // of transactions (as introduced in v1.3).
 func (ap *ApplicationProvider) V1_3Validation() bool {
-	return ap.v13
+	return ap.v13 || ap.v143
 }
 
 // MetadataLifecycle indicates whether the peer should use the deprecated and problematic
@@ -110,7 +115,19 @@ func (ap *ApplicationProvider) MetadataLifecycle() bool {
 // KeyLevelEndorsement returns true if this channel supports endorsement
 // policies expressible at a ledger key granularity, as described in FAB-8812
 func (ap *ApplicationProvider) KeyLevelEndorsement() bool {
-	return ap.v13
+	return ap.v13 || ap.v143
+}
+
+// CollectionEndorsementPolicies returns true if this channel validates the writes
+// to private data collections against the endorsement policies of the collections
+func (ap *ApplicationProvider) CollectionEndorsementPolicies() bool {
+	return ap.v143
+}
+
+// MemberOnlyWrite returns true if this channel rejects the transactions whose creator
+// writes to a member-only-write private data collection without being a member of it
+func (ap *ApplicationProvider) MemberOnlyWrite() bool {
+	return ap.v143
 }
 
 // FabToken returns true if support for fabric token functions is enabled.
@@ -128,6 +145,8 @@ func (ap *ApplicationProvider) HasCapability(capability string) bool {
 		return true
 	case ApplicationV1_3:
 		return true
+	case ApplicationV1_4_3:
+		return true
 	case ApplicationPvtDataExperimental:
 		return true
 	case ApplicationResourcesTreeExperimental:
@@ -53,6 +53,25 @@ func TestApplicationV13(t *testing.T) {
 	assert.True(t, ap.ACLs())
 	assert.True(t, ap.CollectionUpgrade())
 	assert.True(t, ap.PrivateChannelData())
+	assert.False(t, ap.CollectionEndorsementPolicies())
+	assert.False(t, ap.MemberOnlyWrite())
+}
+
+func TestApplicationV143(t *testing.T) {
+	ap := NewApplicationProvider(map[string]*cb.Capability{
+		ApplicationV1_4_3: {},
+	})
+	assert.NoError(t, ap.Supported())
+	assert.True(t, ap.ForbidDuplicateTXIdInBlock())
+	assert.True(t, ap.V1_1Validation())
+	assert.True(t, ap.V1_2Validation())
+	assert.True(t, ap.V1_3Validation())
+	assert.True(t, ap.KeyLevelEndorsement())
+	assert.True(t, ap.ACLs())
+	assert.True(t, ap.CollectionUpgrade())
+	assert.True(t, ap.PrivateChannelData())
+	assert.True(t, ap.CollectionEndorsementPolicies())
+	assert.True(t, ap.MemberOnlyWrite())
 }
 
 func TestApplicationPvtDataExperimental(t *testing.T) {
@@ -74,6 +93,7 @@ func TestHasCapability(t *testing.T) {
 	assert.True(t, ap.HasCapability(ApplicationV1_1))
 	assert.True(t, ap.HasCapability(ApplicationV1_2))
 	assert.True(t, ap.HasCapability(ApplicationV1_3))
+	assert.True(t, ap.HasCapability(ApplicationV1_4_3))
 	assert.True(t, ap.HasCapability(ApplicationPvtDataExperimental))
 	assert.True(t, ap.HasCapability(ApplicationResourcesTreeExperimental))
 	assert.False(t, ap.HasCapability("default"))
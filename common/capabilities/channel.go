@@ -19,13 +19,17 @@ const (
 
 	// ChannelV1_3 is the capabilties string for standard new non-backwards compatible fabric v1.3 channel capabilities.
 	ChannelV1_3 = "V1_3"
+
+	// ChannelV1_4_3 is the capabilties string for standard new non-backwards compatible fabric v1.4.3 channel capabilities.
+	ChannelV1_4_3 = "V1_4_3"
 )
 
 // ChannelProvider provides capabilities information for channel level config.
 type ChannelProvider struct {
 	*registry
-	v11 bool
-	v13 bool
+	v11  bool
+	v13  bool
+	v143 bool
 }
 
 // NewChannelProvider creates a channel capabilities provider.
@@ -34,6 +38,7 @@ func NewChannelProvider(capabilities map[string]*cb.Capability) *ChannelProvider
 	cp.registry = newRegistry(cp, capabilities)
 	_, cp.v11 = capabilities[ChannelV1_1]
 	_, cp.v13 = capabilities[ChannelV1_3]
+	_, cp.v143 = capabilities[ChannelV1_4_3]
 	return cp
 }
 
@@ -46,6 +51,8 @@ func (cp *ChannelProvider) Type() string {
 func (cp *ChannelProvider) HasCapability(capability string) bool {
 	switch capability {
 	// Add new capability names here
+	case ChannelV1_4_3:
+		return true
 	case ChannelV1_3:
 		return true
 	case ChannelV1_1:
@@ -58,6 +65,8 @@ func (cp *ChannelProvider) HasCapability(capability string) bool {
 // MSPVersion returns the level of MSP support required by this channel.
 func (cp *ChannelProvider) MSPVersion() msp.MSPVersion {
 	switch {
+	case cp.v143:
+		return msp.MSPv1_4_3
 	case cp.v13:
 		return msp.MSPv1_3
 	case cp.v11:
@@ -36,3 +36,13 @@ func TestChannelV13(t *testing.T) {
 	assert.NoError(t, op.Supported())
 	assert.True(t, op.MSPVersion() == msp.MSPv1_3)
 }
+
+func TestChannelV143(t *testing.T) {
+	op := NewChannelProvider(map[string]*cb.Capability{
+		ChannelV1_1:   {},
+		ChannelV1_3:   {},
+		ChannelV1_4_3: {},
+	})
+	assert.NoError(t, op.Supported())
+	assert.True(t, op.MSPVersion() == msp.MSPv1_4_3)
+}
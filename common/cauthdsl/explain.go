@@ -0,0 +1,135 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cauthdsl
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mb "github.com/hyperledger/fabric/protos/msp"
+)
+
+// indexedSignature is an IdentityAndSignature which
+// remembers its position in the signature set
+type indexedSignature struct {
+	IdentityAndSignature
+	index int
+}
+
+// Explain evaluates the policy against the given signature set the same way
+// Evaluate does, and returns which principals were matched by which identities
+func (p *policy) Explain(signatureSet []*cb.SignedData) *policies.Explanation {
+	if p == nil {
+		return &policies.Explanation{Policy: "SignaturePolicy", Notes: []string{"No such policy"}}
+	}
+
+	// Identities are deduplicated like in deduplicate, except that
+	// the identities which are left out are recorded
+	var notes []string
+	ids := make(map[string]struct{})
+	signatures := make([]*indexedSignature, 0, len(signatureSet))
+	for i, sd := range signatureSet {
+		signature := &deserializeAndVerify{
+			signedData:   sd,
+			deserializer: p.deserializer,
+		}
+		identity, err := signature.Identity()
+		if err != nil {
+			notes = append(notes, fmt.Sprintf("identity %d could not be deserialized: %s", i, err))
+			continue
+		}
+		key := identity.GetIdentifier().Mspid + identity.GetIdentifier().Id
+		if _, ok := ids[key]; ok {
+			notes = append(notes, fmt.Sprintf("identity %d is a duplicate of a previous identity", i))
+			continue
+		}
+		ids[key] = struct{}{}
+		signatures = append(signatures, &indexedSignature{IdentityAndSignature: signature, index: i})
+	}
+
+	explanation := explain(p.signaturePolicy.Rule, p.signaturePolicy.Identities, signatures, make([]bool, len(signatures)))
+	explanation.Notes = append(notes, explanation.Notes...)
+	return explanation
+}
+
+// explain mirrors the evaluation of the functions built by compile,
+// and records the outcome of the evaluation of every element of the policy
+func explain(policy *cb.SignaturePolicy, identities []*mb.MSPPrincipal, signatures []*indexedSignature, used []bool) *policies.Explanation {
+	if policy == nil {
+		return &policies.Explanation{Policy: "Empty policy element"}
+	}
+
+	switch t := policy.Type.(type) {
+	case *cb.SignaturePolicy_NOutOf_:
+		explanation := &policies.Explanation{
+			Policy:   fmt.Sprintf("OutOf(%d)", t.NOutOf.N),
+			Required: int(t.NOutOf.N),
+		}
+		verified := int32(0)
+		_used := make([]bool, len(used))
+		for _, rule := range t.NOutOf.Rules {
+			copy(_used, used)
+			subExplanation := explain(rule, identities, signatures, _used)
+			if subExplanation.Satisfied {
+				verified++
+				copy(used, _used)
+			}
+			explanation.SubPolicies = append(explanation.SubPolicies, subExplanation)
+		}
+		explanation.Satisfied = verified >= t.NOutOf.N
+		if !explanation.Satisfied {
+			explanation.Missing = int(t.NOutOf.N - verified)
+		}
+		return explanation
+	case *cb.SignaturePolicy_SignedBy:
+		if t.SignedBy < 0 || t.SignedBy >= int32(len(identities)) {
+			return &policies.Explanation{Policy: fmt.Sprintf("SignedBy(%d)", t.SignedBy), Notes: []string{"identity index out of range"}}
+		}
+		principal := identities[t.SignedBy]
+		explanation := &policies.Explanation{Policy: fmt.Sprintf("SignedBy(%s)", principalString(principal))}
+		for i, signature := range signatures {
+			if used[i] {
+				explanation.Notes = append(explanation.Notes, fmt.Sprintf("identity %d is already used to satisfy another principal", signature.index))
+				continue
+			}
+			identity, err := signature.Identity()
+			if err != nil {
+				explanation.Notes = append(explanation.Notes, fmt.Sprintf("identity %d could not be deserialized: %s", signature.index, err))
+				continue
+			}
+			if err := identity.SatisfiesPrincipal(principal); err != nil {
+				explanation.Notes = append(explanation.Notes, fmt.Sprintf("identity %d of %s does not satisfy the principal: %s", signature.index, identity.GetIdentifier().Mspid, err))
+				continue
+			}
+			if err := signature.Verify(); err != nil {
+				explanation.Notes = append(explanation.Notes, fmt.Sprintf("the signature of identity %d of %s is invalid: %s", signature.index, identity.GetIdentifier().Mspid, err))
+				continue
+			}
+			used[i] = true
+			index := signature.index
+			explanation.Satisfied = true
+			explanation.MatchedBy = &index
+			return explanation
+		}
+		if len(signatures) == 0 {
+			explanation.Notes = append(explanation.Notes, "no identity to match the principal")
+		}
+		return explanation
+	default:
+		return &policies.Explanation{Policy: fmt.Sprintf("Unknown type: %T", t)}
+	}
+}
+
+// principalString describes the given principal, using the policy language
+// if the principal can be expressed in it
+func principalString(principal *mb.MSPPrincipal) string {
+	if s, err := principalToString(principal); err == nil {
+		return "'" + s + "'"
+	}
+	return fmt.Sprintf("principal of classification %s", principal.PrincipalClassification)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cauthdsl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/policies"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mb "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func explainablePolicy(t *testing.T, envelope *cb.SignaturePolicyEnvelope, deserializer *mockDeserializer) policies.ExplainablePolicy {
+	policy, _, err := NewPolicyProvider(deserializer).NewPolicy(marshalOrPanic(envelope))
+	require.NoError(t, err)
+	return policy.(policies.ExplainablePolicy)
+}
+
+func signedData(identities [][]byte, signatures [][]byte) []*cb.SignedData {
+	signatureSet := make([]*cb.SignedData, len(identities))
+	for i := range identities {
+		signatureSet[i] = &cb.SignedData{Identity: identities[i], Signature: signatures[i]}
+	}
+	return signatureSet
+}
+
+func TestExplain(t *testing.T) {
+	policy := explainablePolicy(t, Envelope(And(SignedBy(0), SignedBy(1)), signers), &mockDeserializer{})
+
+	explanation := policy.Explain(signedData(
+		[][]byte{signers[0], signers[0], signers[1]},
+		[][]byte{validSignature, validSignature, invalidSignature},
+	))
+	assert.False(t, explanation.Satisfied)
+	assert.Equal(t, "OutOf(2)", explanation.Policy)
+	assert.Equal(t, 2, explanation.Required)
+	assert.Equal(t, 1, explanation.Missing)
+	assert.Equal(t, []string{"identity 1 is a duplicate of a previous identity"}, explanation.Notes)
+	require.Len(t, explanation.SubPolicies, 2)
+
+	matched := explanation.SubPolicies[0]
+	assert.True(t, matched.Satisfied)
+	require.NotNil(t, matched.MatchedBy)
+	assert.Equal(t, 0, *matched.MatchedBy)
+
+	unmatched := explanation.SubPolicies[1]
+	assert.False(t, unmatched.Satisfied)
+	assert.Nil(t, unmatched.MatchedBy)
+	assert.Equal(t, []string{
+		"identity 0 is already used to satisfy another principal",
+		"the signature of identity 2 of Mock is invalid: Invalid signature",
+	}, unmatched.Notes)
+
+	explanation = policy.Explain(nil)
+	assert.False(t, explanation.Satisfied)
+	assert.Equal(t, 2, explanation.Missing)
+	assert.Equal(t, []string{"no identity to match the principal"}, explanation.SubPolicies[0].Notes)
+}
+
+func TestExplainMatchesEvaluate(t *testing.T) {
+	policy := explainablePolicy(t, Envelope(And(Or(And(SignedBy(0), SignedBy(1)), And(SignedBy(0), SignedBy(0))), SignedBy(0)), signers), &mockDeserializer{})
+
+	signer0, signer1 := signers[0], signers[1]
+	for _, signatureSet := range [][]*cb.SignedData{
+		signedData([][]byte{signer0, signer1, signer0}, [][]byte{validSignature, validSignature, validSignature}),
+		signedData([][]byte{signer0, signer0, signer0}, [][]byte{validSignature, validSignature, validSignature}),
+		signedData([][]byte{signer0, signer1}, [][]byte{validSignature, validSignature}),
+		signedData([][]byte{signer0, signer1, signer0}, [][]byte{validSignature, invalidSignature, validSignature}),
+		signedData([][]byte{signer0, signer1, signer1}, [][]byte{validSignature, validSignature, validSignature}),
+	} {
+		err := policy.(policies.Policy).Evaluate(signatureSet)
+		assert.Equal(t, err == nil, policy.Explain(signatureSet).Satisfied)
+	}
+}
+
+func TestExplainDeserializationFailure(t *testing.T) {
+	policy := explainablePolicy(t, Envelope(SignedBy(0), signers), &mockDeserializer{fail: errors.New("myError")})
+
+	explanation := policy.Explain(signedData([][]byte{signers[0]}, [][]byte{validSignature}))
+	assert.False(t, explanation.Satisfied)
+	assert.Equal(t, []string{
+		"identity 0 could not be deserialized: myError",
+		"no identity to match the principal",
+	}, explanation.Notes)
+}
+
+func TestPrincipalString(t *testing.T) {
+	assert.Equal(t, "'Org1MSP.admin'", principalString(&mb.MSPPrincipal{
+		PrincipalClassification: mb.MSPPrincipal_ROLE,
+		Principal:               marshalOrPanic(&mb.MSPRole{MspIdentifier: "Org1MSP", Role: mb.MSPRole_ADMIN}),
+	}))
+	assert.Equal(t, "'Org1MSP.ou(accounting)'", principalString(&mb.MSPPrincipal{
+		PrincipalClassification: mb.MSPPrincipal_ORGANIZATION_UNIT,
+		Principal:               marshalOrPanic(&mb.OrganizationUnit{MspIdentifier: "Org1MSP", OrganizationalUnitIdentifier: "accounting"}),
+	}))
+	assert.Equal(t, "'Org1MSP.identity(Y2VydA==)'", principalString(&mb.MSPPrincipal{
+		PrincipalClassification: mb.MSPPrincipal_IDENTITY,
+		Principal:               marshalOrPanic(&mb.SerializedIdentity{Mspid: "Org1MSP", IdBytes: []byte("cert")}),
+	}))
+	assert.Equal(t, "principal of classification IDENTITY", principalString(&mb.MSPPrincipal{
+		PrincipalClassification: mb.MSPPrincipal_IDENTITY,
+		Principal:               []byte("signer0"),
+	}))
+}
@@ -87,15 +87,17 @@ func (pr *provider) NewPolicy(data []byte) (policies.Policy, proto.Message, erro
 	}
 
 	return &policy{
-		evaluator:    compiled,
-		deserializer: pr.deserializer,
+		evaluator:       compiled,
+		deserializer:    pr.deserializer,
+		signaturePolicy: sigPolicy,
 	}, sigPolicy, nil
 
 }
 
 type policy struct {
-	evaluator    func([]IdentityAndSignature, []bool) bool
-	deserializer msp.IdentityDeserializer
+	evaluator       func([]IdentityAndSignature, []bool) bool
+	deserializer    msp.IdentityDeserializer
+	signaturePolicy *cb.SignaturePolicyEnvelope
 }
 
 // Evaluate takes a set of SignedData and evaluates whether this set of signatures satisfies the policy
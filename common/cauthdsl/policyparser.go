@@ -17,6 +17,8 @@ limitations under the License.
 package cauthdsl
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -50,9 +52,88 @@ var (
 		fmt.Sprintf("^([[:alnum:].-]+)([.])(%s|%s|%s|%s)$",
 			RoleAdmin, RoleMember, RoleClient, RolePeer),
 	)
-	regexErr = regexp.MustCompile("^No parameter '([^']+)' found[.]$")
+	attributeRegex = regexp.MustCompile("^([[:alnum:].-]+)[.]([[:alnum:]_-]+)=([^']+)$")
+	ouRegex        = regexp.MustCompile("^([[:alnum:].-]+)[.]ou[(]([^,()']+)(,([[:xdigit:]]+))?[)]$")
+	identityRegex  = regexp.MustCompile("^([[:alnum:].-]+)[.]identity[(]([[:alnum:]+/=]+)[)]$")
+	regexErr       = regexp.MustCompile("^No parameter '([^']+)' found[.]$")
 )
 
+// isPrincipal returns whether the given string is a principal,
+// either a role, an attribute, an organizational unit or an identity one
+func isPrincipal(s string) bool {
+	return regex.MatchString(s) || attributeRegex.MatchString(s) || ouRegex.MatchString(s) || identityRegex.MatchString(s)
+}
+
+// principalFromString builds the principal described by the given string
+func principalFromString(s string) (*msp.MSPPrincipal, error) {
+	/* a principal formed as <MSP_ID> . ou(<OU>[, <CERTIFIERS>]) requires
+	   the identity to belong to the organizational unit OU, certified by
+	   the CA whose hex encoded certificate hash is CERTIFIERS */
+	if oum := ouRegex.FindStringSubmatch(s); oum != nil {
+		certifiersIdentifier, err := hex.DecodeString(oum[4])
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing certifiers identifier %s", s)
+		}
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ORGANIZATION_UNIT,
+			Principal: utils.MarshalOrPanic(&msp.OrganizationUnit{
+				MspIdentifier:                oum[1],
+				OrganizationalUnitIdentifier: oum[2],
+				CertifiersIdentifier:         certifiersIdentifier,
+			})}, nil
+	}
+
+	/* a principal formed as <MSP_ID> . identity(<ID>) requires
+	   the identity whose base64 encoded bytes are ID */
+	if idm := identityRegex.FindStringSubmatch(s); idm != nil {
+		idBytes, err := base64.StdEncoding.DecodeString(idm[2])
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing identity %s", s)
+		}
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_IDENTITY,
+			Principal:               utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: idm[1], IdBytes: idBytes})}, nil
+	}
+
+	/* a principal formed as <MSP_ID> . <NAME> = <VALUE> requires a
+	   certified attribute that the identity must disclose with
+	   value VALUE */
+	if attrm := attributeRegex.FindStringSubmatch(s); attrm != nil {
+		return &msp.MSPPrincipal{
+			PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+			Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: attrm[1], Name: attrm[2], Value: attrm[3]})}, nil
+	}
+
+	/* otherwise, we expect it to be formed as
+	   <MSP_ID> . <ROLE>, where MSP_ID is the MSP identifier
+	   and ROLE is either a member, an admin, a client, a peer or an orderer*/
+	/* split the string */
+	subm := regex.FindAllStringSubmatch(s, -1)
+	if subm == nil || len(subm) != 1 || len(subm[0]) != 4 {
+		return nil, fmt.Errorf("Error parsing principal %s", s)
+	}
+
+	/* get the right role */
+	var r msp.MSPRole_MSPRoleType
+	switch subm[0][3] {
+	case RoleMember:
+		r = msp.MSPRole_MEMBER
+	case RoleAdmin:
+		r = msp.MSPRole_ADMIN
+	case RoleClient:
+		r = msp.MSPRole_CLIENT
+	case RolePeer:
+		r = msp.MSPRole_PEER
+	default:
+		return nil, fmt.Errorf("Error parsing role %s", s)
+	}
+
+	/* build the principal we've been told */
+	return &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{MspIdentifier: subm[0][1], Role: r})}, nil
+}
+
 // a stub function - it returns the same string as it's passed.
 // This will be evaluated by second/third passes to convert to a proto policy
 func outof(args ...interface{}) (interface{}, error) {
@@ -77,7 +158,7 @@ func outof(args ...interface{}) (interface{}, error) {
 		toret += ", "
 		switch t := arg.(type) {
 		case string:
-			if regex.MatchString(t) {
+			if isPrincipal(t) {
 				toret += "'" + t + "'"
 			} else {
 				toret += t
@@ -105,7 +186,7 @@ func firstPass(args ...interface{}) (interface{}, error) {
 		toret += ", "
 		switch t := arg.(type) {
 		case string:
-			if regex.MatchString(t) {
+			if isPrincipal(t) {
 				toret += "'" + t + "'"
 			} else {
 				toret += t
@@ -159,35 +240,15 @@ func secondPass(args ...interface{}) (interface{}, error) {
 	/* handle the rest of the arguments */
 	for _, principal := range args[2:] {
 		switch t := principal.(type) {
-		/* if it's a string, we expect it to be formed as
-		   <MSP_ID> . <ROLE>, where MSP_ID is the MSP identifier
-		   and ROLE is either a member, an admin, a client, a peer or an orderer*/
+		/* if it's a string, we expect it to be formed either as
+		   <MSP_ID> . <NAME> = <VALUE>, where NAME is the name of a
+		   certified attribute that the identity must disclose with
+		   value VALUE, or as the role principal below */
 		case string:
-			/* split the string */
-			subm := regex.FindAllStringSubmatch(t, -1)
-			if subm == nil || len(subm) != 1 || len(subm[0]) != 4 {
-				return nil, fmt.Errorf("Error parsing principal %s", t)
-			}
-
-			/* get the right role */
-			var r msp.MSPRole_MSPRoleType
-			switch subm[0][3] {
-			case RoleMember:
-				r = msp.MSPRole_MEMBER
-			case RoleAdmin:
-				r = msp.MSPRole_ADMIN
-			case RoleClient:
-				r = msp.MSPRole_CLIENT
-			case RolePeer:
-				r = msp.MSPRole_PEER
-			default:
-				return nil, fmt.Errorf("Error parsing role %s", t)
+			p, err := principalFromString(t)
+			if err != nil {
+				return nil, err
 			}
-
-			/* build the principal we've been told */
-			p := &msp.MSPPrincipal{
-				PrincipalClassification: msp.MSPPrincipal_ROLE,
-				Principal:               utils.MarshalOrPanic(&msp.MSPRole{MspIdentifier: subm[0][1], Role: r})}
 			ctx.principals = append(ctx.principals, p)
 
 			/* create a SignaturePolicy that requires a signature from
@@ -241,6 +302,30 @@ func newContext() *context {
 //	- ORG is a string (representing the MSP identifier)
 //	- ROLE takes the value of any of the RoleXXX constants representing
 //    the required role
+//
+// or, for identities disclosing certified attributes, such as Idemix ones, as:
+//
+// ORG.NAME=VALUE
+//
+// where:
+//	- NAME is the name of the attribute the identity must disclose
+//	- VALUE is the value the attribute must be equal to
+//
+// or, for identities of an organizational unit, as:
+//
+// ORG.ou(OU[,CERTIFIERS])
+//
+// where:
+//	- OU is the identifier of the organizational unit
+//	- CERTIFIERS is the optional hex encoded hash of the certificate
+//    of the CA which certifies the organizational unit
+//
+// or, for a specific identity, as:
+//
+// ORG.identity(ID)
+//
+// where:
+//	- ID is the base64 encoded serialized identity, without the MSP identifier
 func FromString(policy string) (*common.SignaturePolicyEnvelope, error) {
 	// first we translate the and/or business into outof gates
 	intermediate, err := govaluate.NewEvaluableExpressionWithFunctions(
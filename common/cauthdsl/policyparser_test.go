@@ -217,6 +217,69 @@ func TestMSPIDWIthSpecialChars(t *testing.T) {
 	assert.Equal(t, p1, p2)
 }
 
+func TestAttribute(t *testing.T) {
+	p1, err := FromString("AND('Org1.example.com.clearance=secret', OR('A.member', 'B.level=top secret 2.0'))")
+	assert.NoError(t, err)
+
+	principals := make([]*msp.MSPPrincipal, 0)
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ROLE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPRole{Role: msp.MSPRole_MEMBER, MspIdentifier: "A"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: "B", Name: "level", Value: "top secret 2.0"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               utils.MarshalOrPanic(&msp.MSPAttribute{MspIdentifier: "Org1.example.com", Name: "clearance", Value: "secret"})})
+
+	p2 := &common.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       And(SignedBy(2), Or(SignedBy(0), SignedBy(1))),
+		Identities: principals,
+	}
+
+	assert.Equal(t, p1, p2)
+
+	_, err = FromString("AND('A.clearance=', 'B.member')")
+	assert.Error(t, err)
+}
+
+func TestOrganizationalUnitAndIdentity(t *testing.T) {
+	p1, err := FromString("OR('Org1.ou(accounting)', 'Org2.ou(human resources,0a1b)', 'Org3.identity(Y2VydA==)')")
+	assert.NoError(t, err)
+
+	principals := make([]*msp.MSPPrincipal, 0)
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ORGANIZATION_UNIT,
+		Principal:               utils.MarshalOrPanic(&msp.OrganizationUnit{MspIdentifier: "Org1", OrganizationalUnitIdentifier: "accounting"})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ORGANIZATION_UNIT,
+		Principal:               utils.MarshalOrPanic(&msp.OrganizationUnit{MspIdentifier: "Org2", OrganizationalUnitIdentifier: "human resources", CertifiersIdentifier: []byte{0x0a, 0x1b}})})
+
+	principals = append(principals, &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_IDENTITY,
+		Principal:               utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "Org3", IdBytes: []byte("cert")})})
+
+	p2 := &common.SignaturePolicyEnvelope{
+		Version:    0,
+		Rule:       NOutOf(1, []*common.SignaturePolicy{SignedBy(0), SignedBy(1), SignedBy(2)}),
+		Identities: principals,
+	}
+
+	assert.Equal(t, p1, p2)
+
+	_, err = FromString("OR('Org1.ou(accounting,0a1)')")
+	assert.EqualError(t, err, "Error parsing certifiers identifier Org1.ou(accounting,0a1)")
+
+	_, err = FromString("OR('Org1.identity(Y2VydA)')")
+	assert.EqualError(t, err, "Error parsing identity Org1.identity(Y2VydA)")
+}
+
 func TestBadStringsNoPanic(t *testing.T) {
 	_, err := FromString("OR('A.member', Bmember)") // error after 1st Evaluate()
 	assert.EqualError(t, err, "unrecognized token 'Bmember' in policy string")
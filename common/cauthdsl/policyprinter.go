@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cauthdsl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// ToString returns the canonical string representation, in the language
+// parsed by FromString, of the given policy. Gates requiring a single
+// sub-policy are represented as OR, gates requiring all their sub-policies
+// as AND, and other gates as OutOf. As the language requires a gate at the
+// top level, a policy made of a single principal is represented as an OR
+// gate over that principal.
+//
+// Parsing the returned string with FromString produces a policy which is
+// satisfied by the same signature sets as the given policy, and which is
+// identical to it if the given policy was itself produced by FromString.
+func ToString(policy *common.SignaturePolicyEnvelope) (string, error) {
+	if policy == nil {
+		return "", errors.New("nil policy")
+	}
+	if policy.Version != 0 {
+		return "", errors.Errorf("unsupported policy version %d", policy.Version)
+	}
+
+	if _, ok := policy.Rule.GetType().(*common.SignaturePolicy_SignedBy); ok {
+		principal, err := signedByToString(policy.Rule, policy.Identities)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(GateOr), principal), nil
+	}
+	return ruleToString(policy.Rule, policy.Identities)
+}
+
+func ruleToString(rule *common.SignaturePolicy, identities []*msp.MSPPrincipal) (string, error) {
+	switch t := rule.GetType().(type) {
+	case *common.SignaturePolicy_NOutOf_:
+		n, rules := t.NOutOf.N, t.NOutOf.Rules
+		if len(rules) == 0 {
+			return "", errors.New("gates without sub-policies can't be expressed")
+		}
+		if n < 0 {
+			return "", errors.Errorf("invalid gate requiring %d sub-policies", n)
+		}
+
+		args := make([]string, len(rules))
+		for i, subRule := range rules {
+			var err error
+			args[i], err = ruleToString(subRule, identities)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		switch {
+		case n == 1:
+			return fmt.Sprintf("%s(%s)", strings.ToUpper(GateOr), strings.Join(args, ", ")), nil
+		case int(n) == len(rules):
+			return fmt.Sprintf("%s(%s)", strings.ToUpper(GateAnd), strings.Join(args, ", ")), nil
+		default:
+			return fmt.Sprintf("%s(%d, %s)", GateOutOf, n, strings.Join(args, ", ")), nil
+		}
+	case *common.SignaturePolicy_SignedBy:
+		return signedByToString(rule, identities)
+	default:
+		return "", errors.Errorf("unknown policy element type %T", t)
+	}
+}
+
+func signedByToString(rule *common.SignaturePolicy, identities []*msp.MSPPrincipal) (string, error) {
+	index := rule.GetSignedBy()
+	if index < 0 || index >= int32(len(identities)) {
+		return "", errors.Errorf("identity index out of range, requested %d, but identities length is %d", index, len(identities))
+	}
+	principal, err := principalToString(identities[index])
+	if err != nil {
+		return "", errors.WithMessage(err, fmt.Sprintf("principal %d can't be expressed", index))
+	}
+	return "'" + principal + "'", nil
+}
+
+// principalToString returns the representation of the given principal
+// in the policy language, if parsing it yields an identical principal
+func principalToString(principal *msp.MSPPrincipal) (string, error) {
+	var s string
+	switch principal.PrincipalClassification {
+	case msp.MSPPrincipal_ROLE:
+		role := &msp.MSPRole{}
+		if err := proto.Unmarshal(principal.Principal, role); err != nil {
+			return "", errors.Wrap(err, "failed unmarshaling role")
+		}
+		s = fmt.Sprintf("%s.%s", role.MspIdentifier, strings.ToLower(role.Role.String()))
+	case msp.MSPPrincipal_ATTRIBUTE:
+		attribute := &msp.MSPAttribute{}
+		if err := proto.Unmarshal(principal.Principal, attribute); err != nil {
+			return "", errors.Wrap(err, "failed unmarshaling attribute")
+		}
+		s = fmt.Sprintf("%s.%s=%s", attribute.MspIdentifier, attribute.Name, attribute.Value)
+	case msp.MSPPrincipal_ORGANIZATION_UNIT:
+		ou := &msp.OrganizationUnit{}
+		if err := proto.Unmarshal(principal.Principal, ou); err != nil {
+			return "", errors.Wrap(err, "failed unmarshaling organizational unit")
+		}
+		if len(ou.CertifiersIdentifier) == 0 {
+			s = fmt.Sprintf("%s.ou(%s)", ou.MspIdentifier, ou.OrganizationalUnitIdentifier)
+		} else {
+			s = fmt.Sprintf("%s.ou(%s,%x)", ou.MspIdentifier, ou.OrganizationalUnitIdentifier, ou.CertifiersIdentifier)
+		}
+	case msp.MSPPrincipal_IDENTITY:
+		id := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(principal.Principal, id); err != nil {
+			return "", errors.Wrap(err, "failed unmarshaling identity")
+		}
+		s = fmt.Sprintf("%s.identity(%s)", id.Mspid, base64.StdEncoding.EncodeToString(id.IdBytes))
+	default:
+		return "", errors.Errorf("unsupported principal classification %s", principal.PrincipalClassification)
+	}
+
+	// The string must describe exactly the same principal, which isn't the
+	// case for instance if the MSP identifier contains unsupported characters
+	parsed, err := principalFromString(s)
+	if err != nil || parsed.PrincipalClassification != principal.PrincipalClassification || !bytes.Equal(parsed.Principal, principal.Principal) {
+		return "", errors.Errorf("%s principal doesn't have a representation", principal.PrincipalClassification)
+	}
+	return s, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cauthdsl
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToStringRoundTrip(t *testing.T) {
+	for _, policy := range []string{
+		"OR('Org1.member')",
+		"AND('Org1.member', 'Org2.admin', 'Org3.client', 'Org4.peer')",
+		"OR('Org1.member', AND('Org2.member', 'Org3.member'))",
+		"OutOf(2, 'Org1.member', 'Org2.member', 'Org3.member')",
+		"OutOf(0, 'Org1.member', 'Org2.member')",
+		"OR('Org1.clearance=top secret', 'Org-2.example.com.role=auditor')",
+		"AND('Org1.ou(accounting)', 'Org1.ou(human resources,0a1b2c)')",
+		"OR('Org1.identity(LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCg==)', 'Org2.member')",
+		"OutOf(2, AND('A.member', OR('B.member', 'C.admin')), 'D.peer', OutOf(3, 'E.member', 'F.member', 'G.member', 'H.member'))",
+	} {
+		t.Run(policy, func(t *testing.T) {
+			envelope, err := FromString(policy)
+			require.NoError(t, err)
+
+			s, err := ToString(envelope)
+			require.NoError(t, err)
+			assert.Equal(t, policy, s)
+
+			reparsed, err := FromString(s)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(envelope, reparsed))
+		})
+	}
+}
+
+func TestToStringCanonical(t *testing.T) {
+	for policy, canonical := range map[string]string{
+		"and('Org1.member', 'Org2.member')":                     "AND('Org1.member', 'Org2.member')",
+		"Or('Org1.member', 'Org2.member')":                      "OR('Org1.member', 'Org2.member')",
+		"OutOf(1, 'Org1.member', 'Org2.member')":                "OR('Org1.member', 'Org2.member')",
+		"OutOf(2, 'Org1.member', 'Org2.member')":                "AND('Org1.member', 'Org2.member')",
+		"AND('Org1.member')":                                    "OR('Org1.member')",
+		"outof(2, 'Org1.member', 'Org2.member', 'Org3.member')": "OutOf(2, 'Org1.member', 'Org2.member', 'Org3.member')",
+	} {
+		envelope, err := FromString(policy)
+		require.NoError(t, err)
+		s, err := ToString(envelope)
+		require.NoError(t, err)
+		assert.Equal(t, canonical, s)
+	}
+}
+
+func TestToStringBuilderPolicies(t *testing.T) {
+	s, err := ToString(SignedByMspMember("Org1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OR('Org1.member')", s)
+
+	s, err = ToString(SignedByAnyAdmin([]string{"Org1", "Org2"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "OR('Org1.admin', 'Org2.admin')", s)
+
+	// Principals referenced several times are repeated
+	envelope := &cb.SignaturePolicyEnvelope{
+		Rule:       NOutOf(2, []*cb.SignaturePolicy{SignedBy(0), SignedBy(0), SignedBy(1)}),
+		Identities: SignedByMspPeer("Org1").Identities,
+	}
+	envelope.Identities = append(envelope.Identities, SignedByMspClient("Org2").Identities...)
+	s, err = ToString(envelope)
+	assert.NoError(t, err)
+	assert.Equal(t, "OutOf(2, 'Org1.peer', 'Org1.peer', 'Org2.client')", s)
+
+	// A single principal is wrapped in a gate
+	envelope = &cb.SignaturePolicyEnvelope{
+		Rule:       SignedBy(0),
+		Identities: SignedByMspAdmin("Org1").Identities,
+	}
+	s, err = ToString(envelope)
+	assert.NoError(t, err)
+	assert.Equal(t, "OR('Org1.admin')", s)
+}
+
+func TestToStringErrors(t *testing.T) {
+	_, err := ToString(nil)
+	assert.EqualError(t, err, "nil policy")
+
+	_, err = ToString(&cb.SignaturePolicyEnvelope{Version: 1})
+	assert.EqualError(t, err, "unsupported policy version 1")
+
+	_, err = ToString(AcceptAllPolicy)
+	assert.EqualError(t, err, "gates without sub-policies can't be expressed")
+
+	_, err = ToString(&cb.SignaturePolicyEnvelope{Rule: NOutOf(1, []*cb.SignaturePolicy{SignedBy(1)})})
+	assert.EqualError(t, err, "identity index out of range, requested 1, but identities length is 0")
+
+	_, err = ToString(&cb.SignaturePolicyEnvelope{Rule: &cb.SignaturePolicy{}})
+	assert.EqualError(t, err, "unknown policy element type <nil>")
+
+	_, err = ToString(Envelope(SignedBy(0), [][]byte{[]byte("garbage")}))
+	assert.EqualError(t, err, "principal 0 can't be expressed: failed unmarshaling identity: proto: can't skip unknown wire type 7")
+
+	_, err = ToString(&cb.SignaturePolicyEnvelope{
+		Rule: SignedBy(0),
+		Identities: []*msp.MSPPrincipal{{
+			PrincipalClassification: msp.MSPPrincipal_ROLE,
+			Principal:               utils.MarshalOrPanic(&msp.MSPRole{MspIdentifier: "Org 1", Role: msp.MSPRole_MEMBER}),
+		}},
+	})
+	assert.EqualError(t, err, "principal 0 can't be expressed: ROLE principal doesn't have a representation")
+
+	_, err = ToString(&cb.SignaturePolicyEnvelope{
+		Rule: SignedBy(0),
+		Identities: []*msp.MSPPrincipal{{
+			PrincipalClassification: msp.MSPPrincipal_ANONYMITY,
+			Principal:               utils.MarshalOrPanic(&msp.MSPIdentityAnonymity{}),
+		}},
+	})
+	assert.EqualError(t, err, "principal 0 can't be expressed: unsupported principal classification ANONYMITY")
+}
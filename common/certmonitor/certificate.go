@@ -0,0 +1,202 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/msp"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/pkg/errors"
+)
+
+// Kinds of the monitored certificates
+const (
+	KindEnrollment         = "enrollment"
+	KindTLSServer          = "tls_server"
+	KindTLSClient          = "tls_client"
+	KindRootCA             = "root_ca"
+	KindIntermediateCA     = "intermediate_ca"
+	KindTLSRootCA          = "tls_root_ca"
+	KindTLSIntermediateCA  = "tls_intermediate_ca"
+	KindConsenterTLSClient = "consenter_tls_client"
+	KindConsenterTLSServer = "consenter_tls_server"
+)
+
+// Certificate describes a certificate whose expiration is monitored
+type Certificate struct {
+	// Kind is the role of the certificate, such as KindEnrollment
+	Kind string `json:"kind"`
+	// Channel is the channel whose configuration contains the
+	// certificate, or empty for the certificates of the node itself
+	Channel string `json:"channel,omitempty"`
+	// Owner is the MSP ID of the organization, or the endpoint
+	// of the consenter, the certificate belongs to
+	Owner        string    `json:"owner,omitempty"`
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serial_number"`
+	NotAfter     time.Time `json:"not_after"`
+}
+
+// key identifies the certificate among the monitored ones
+func (c Certificate) key() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", c.Kind, c.Channel, c.Owner, c.Issuer, c.SerialNumber)
+}
+
+func newCertificate(kind, channel, owner string, cert *x509.Certificate) Certificate {
+	return Certificate{
+		Kind:         kind,
+		Channel:      channel,
+		Owner:        owner,
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		NotAfter:     cert.NotAfter,
+	}
+}
+
+// FromPEM returns the certificates of the given kind encoded in the given PEM bytes
+func FromPEM(kind, channel, owner string, pemBytes []byte) ([]Certificate, error) {
+	var certs []Certificate
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed parsing certificate")
+		}
+		certs = append(certs, newCertificate(kind, channel, owner, cert))
+	}
+	return certs, nil
+}
+
+// FromTLSCertificate returns the leaf certificate of the given TLS certificate
+func FromTLSCertificate(kind string, tlsCert tls.Certificate) ([]Certificate, error) {
+	if len(tlsCert.Certificate) == 0 {
+		return nil, nil
+	}
+	cert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing certificate")
+	}
+	return []Certificate{newCertificate(kind, "", "", cert)}, nil
+}
+
+// FromSerializedIdentity returns the certificate of the given serialized
+// identity. Identities that aren't X.509 certificates, such as Idemix
+// identities, have no certificate.
+func FromSerializedIdentity(kind string, serializedIdentity []byte) ([]Certificate, error) {
+	sID := &mspprotos.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sID); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling serialized identity")
+	}
+	if block, _ := pem.Decode(sID.IdBytes); block == nil {
+		return nil, nil
+	}
+	return FromPEM(kind, "", sID.Mspid, sID.IdBytes)
+}
+
+// ChannelCertificates returns the CA and intermediate CA certificates
+// of the MSPs defined in the given channel configuration
+func ChannelCertificates(channelID string, config *cb.Config) ([]Certificate, error) {
+	if config == nil || config.ChannelGroup == nil {
+		return nil, nil
+	}
+	return groupCertificates(channelID, config.ChannelGroup)
+}
+
+func groupCertificates(channelID string, group *cb.ConfigGroup) ([]Certificate, error) {
+	var certs []Certificate
+	if value, exists := group.Values[channelconfig.MSPKey]; exists {
+		mspConfig := &mspprotos.MSPConfig{}
+		if err := proto.Unmarshal(value.Value, mspConfig); err != nil {
+			return nil, errors.Wrap(err, "failed unmarshaling MSP configuration")
+		}
+		mspCerts, err := mspCertificates(channelID, mspConfig)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, mspCerts...)
+	}
+
+	for _, subGroup := range group.Groups {
+		subGroupCerts, err := groupCertificates(channelID, subGroup)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, subGroupCerts...)
+	}
+	return certs, nil
+}
+
+func mspCertificates(channelID string, mspConfig *mspprotos.MSPConfig) ([]Certificate, error) {
+	// Only X.509 based MSPs have CA certificates
+	if mspConfig.Type != int32(msp.FABRIC) {
+		return nil, nil
+	}
+	fabricConfig := &mspprotos.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.Config, fabricConfig); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling fabric MSP configuration")
+	}
+
+	var certs []Certificate
+	for kind, pems := range map[string][][]byte{
+		KindRootCA:            fabricConfig.RootCerts,
+		KindIntermediateCA:    fabricConfig.IntermediateCerts,
+		KindTLSRootCA:         fabricConfig.TlsRootCerts,
+		KindTLSIntermediateCA: fabricConfig.TlsIntermediateCerts,
+	} {
+		for _, pemBytes := range pems {
+			kindCerts, err := FromPEM(kind, channelID, fabricConfig.Name, pemBytes)
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("invalid %s certificate of MSP %s", kind, fabricConfig.Name))
+			}
+			certs = append(certs, kindCerts...)
+		}
+	}
+	return certs, nil
+}
+
+// ConsenterCertificates returns the TLS certificates of the
+// consenters in the given etcdraft consensus metadata
+func ConsenterCertificates(channelID string, consensusMetadata []byte) ([]Certificate, error) {
+	metadata := &etcdraft.Metadata{}
+	if err := proto.Unmarshal(consensusMetadata, metadata); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling etcdraft metadata")
+	}
+
+	var certs []Certificate
+	for _, consenter := range metadata.Consenters {
+		endpoint := fmt.Sprintf("%s:%d", consenter.Host, consenter.Port)
+		clientCerts, err := FromPEM(KindConsenterTLSClient, channelID, endpoint, consenter.ClientTlsCert)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid client TLS certificate of consenter %s", endpoint))
+		}
+		serverCerts, err := FromPEM(KindConsenterTLSServer, channelID, endpoint, consenter.ServerTlsCert)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("invalid server TLS certificate of consenter %s", endpoint))
+		}
+		certs = append(certs, clientCerts...)
+		certs = append(certs, serverCerts...)
+	}
+	return certs, nil
+}
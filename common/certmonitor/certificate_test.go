@@ -0,0 +1,210 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	cb "github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(t *testing.T, pemBytes []byte) *x509.Certificate {
+	block, _ := pem.Decode(pemBytes)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	return cert
+}
+
+func TestFromPEM(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	kp, err := ca.NewServerCertKeyPair("peer0.org1.example.com")
+	assert.NoError(t, err)
+
+	pemBytes := append(append([]byte{}, kp.Cert...), kp.Key...)
+	pemBytes = append(pemBytes, ca.CertBytes()...)
+	certs, err := FromPEM(KindTLSServer, "mychannel", "Org1MSP", pemBytes)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 2)
+
+	leaf, caCert := parse(t, kp.Cert), parse(t, ca.CertBytes())
+	assert.Equal(t, Certificate{
+		Kind:         KindTLSServer,
+		Channel:      "mychannel",
+		Owner:        "Org1MSP",
+		Subject:      leaf.Subject.String(),
+		Issuer:       caCert.Subject.String(),
+		SerialNumber: leaf.SerialNumber.String(),
+		NotAfter:     leaf.NotAfter,
+	}, certs[0])
+	assert.Equal(t, caCert.NotAfter, certs[1].NotAfter)
+
+	certs, err = FromPEM(KindTLSServer, "", "", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+
+	invalid := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{1, 2, 3}})
+	_, err = FromPEM(KindTLSServer, "", "", invalid)
+	assert.Contains(t, err.Error(), "failed parsing certificate")
+}
+
+func TestFromTLSCertificate(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	kp, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+	tlsCert, err := tls.X509KeyPair(kp.Cert, kp.Key)
+	assert.NoError(t, err)
+
+	certs, err := FromTLSCertificate(KindTLSClient, tlsCert)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, KindTLSClient, certs[0].Kind)
+	assert.Equal(t, parse(t, kp.Cert).NotAfter, certs[0].NotAfter)
+
+	certs, err = FromTLSCertificate(KindTLSClient, tls.Certificate{})
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+}
+
+func TestFromSerializedIdentity(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	kp, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+
+	sID, err := proto.Marshal(&mspprotos.SerializedIdentity{Mspid: "Org1MSP", IdBytes: kp.Cert})
+	assert.NoError(t, err)
+	certs, err := FromSerializedIdentity(KindEnrollment, sID)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, "Org1MSP", certs[0].Owner)
+	assert.Equal(t, "", certs[0].Channel)
+
+	idemixID, err := proto.Marshal(&mspprotos.SerializedIdentity{Mspid: "IdemixMSP", IdBytes: []byte{1, 2, 3}})
+	assert.NoError(t, err)
+	certs, err = FromSerializedIdentity(KindEnrollment, idemixID)
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+
+	_, err = FromSerializedIdentity(KindEnrollment, []byte{1, 2, 3})
+	assert.Contains(t, err.Error(), "failed unmarshaling serialized identity")
+}
+
+func mspGroup(t *testing.T, mspType int32, conf proto.Message) *cb.ConfigGroup {
+	confBytes, err := proto.Marshal(conf)
+	assert.NoError(t, err)
+	mspConfig, err := proto.Marshal(&mspprotos.MSPConfig{Type: mspType, Config: confBytes})
+	assert.NoError(t, err)
+	return &cb.ConfigGroup{
+		Values: map[string]*cb.ConfigValue{
+			"MSP": {Value: mspConfig},
+		},
+	}
+}
+
+func TestChannelCertificates(t *testing.T) {
+	rootCA, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	tlsRootCA, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	ordererCA, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+
+	config := &cb.Config{
+		ChannelGroup: &cb.ConfigGroup{
+			Groups: map[string]*cb.ConfigGroup{
+				"Application": {
+					Groups: map[string]*cb.ConfigGroup{
+						"Org1": mspGroup(t, 0, &mspprotos.FabricMSPConfig{
+							Name:         "Org1MSP",
+							RootCerts:    [][]byte{rootCA.CertBytes()},
+							TlsRootCerts: [][]byte{tlsRootCA.CertBytes()},
+						}),
+						"Idemix": mspGroup(t, 1, &mspprotos.IdemixMSPConfig{Name: "IdemixMSP"}),
+					},
+				},
+				"Orderer": {
+					Groups: map[string]*cb.ConfigGroup{
+						"OrdererOrg": mspGroup(t, 0, &mspprotos.FabricMSPConfig{
+							Name:              "OrdererMSP",
+							IntermediateCerts: [][]byte{ordererCA.CertBytes()},
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	certs, err := ChannelCertificates("mychannel", config)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 3)
+	byKind := make(map[string]Certificate)
+	for _, cert := range certs {
+		assert.Equal(t, "mychannel", cert.Channel)
+		byKind[cert.Kind] = cert
+	}
+	assert.Equal(t, "Org1MSP", byKind[KindRootCA].Owner)
+	assert.Equal(t, parse(t, rootCA.CertBytes()).Subject.String(), byKind[KindRootCA].Subject)
+	assert.Equal(t, "Org1MSP", byKind[KindTLSRootCA].Owner)
+	assert.Equal(t, parse(t, tlsRootCA.CertBytes()).Subject.String(), byKind[KindTLSRootCA].Subject)
+	assert.Equal(t, "OrdererMSP", byKind[KindIntermediateCA].Owner)
+
+	certs, err = ChannelCertificates("mychannel", &cb.Config{})
+	assert.NoError(t, err)
+	assert.Empty(t, certs)
+
+	config.ChannelGroup.Groups["Orderer"].Groups["OrdererOrg"].Values["MSP"].Value = []byte{1, 2, 3}
+	_, err = ChannelCertificates("mychannel", config)
+	assert.Contains(t, err.Error(), "failed unmarshaling MSP configuration")
+}
+
+func TestConsenterCertificates(t *testing.T) {
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	client, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+	server, err := ca.NewServerCertKeyPair("orderer0.example.com")
+	assert.NoError(t, err)
+
+	metadata, err := proto.Marshal(&etcdraft.Metadata{
+		Consenters: []*etcdraft.Consenter{
+			{Host: "orderer0.example.com", Port: 7050, ClientTlsCert: client.Cert, ServerTlsCert: server.Cert},
+		},
+	})
+	assert.NoError(t, err)
+
+	certs, err := ConsenterCertificates("mychannel", metadata)
+	assert.NoError(t, err)
+	assert.Len(t, certs, 2)
+	assert.Equal(t, KindConsenterTLSClient, certs[0].Kind)
+	assert.Equal(t, KindConsenterTLSServer, certs[1].Kind)
+	for _, cert := range certs {
+		assert.Equal(t, "mychannel", cert.Channel)
+		assert.Equal(t, "orderer0.example.com:7050", cert.Owner)
+	}
+
+	metadata, err = proto.Marshal(&etcdraft.Metadata{
+		Consenters: []*etcdraft.Consenter{
+			{Host: "orderer1.example.com", Port: 7050, ClientTlsCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{1}})},
+		},
+	})
+	assert.NoError(t, err)
+	_, err = ConsenterCertificates("mychannel", metadata)
+	assert.Contains(t, err.Error(), "invalid client TLS certificate of consenter orderer1.example.com:7050")
+
+	_, err = ConsenterCertificates("mychannel", []byte{1, 2, 3})
+	assert.Contains(t, err.Error(), "failed unmarshaling etcdraft metadata")
+}
@@ -0,0 +1,280 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+var logger = flogging.MustGetLogger("certmonitor")
+
+// Source returns certificates to monitor. It is invoked at every check,
+// so that certificates that are replaced or added are monitored as well.
+// Certificates returned along with an error are monitored nonetheless.
+type Source func() ([]Certificate, error)
+
+// Config contains the configuration of a Monitor
+type Config struct {
+	// Interval is the interval at which the expiration of the certificates
+	// is checked. A zero interval disables the periodic checks.
+	Interval time.Duration
+	// WarningThresholds are the amounts of time before the expiration of
+	// a certificate at which a warning is logged
+	WarningThresholds []time.Duration
+	MetricsProvider   metrics.Provider
+}
+
+// CertificateStatus is a monitored certificate along with
+// the amount of time remaining until it expires
+type CertificateStatus struct {
+	Certificate
+	ExpiresInSeconds int64 `json:"expires_in_seconds"`
+}
+
+// Monitor tracks the expiration of the certificates returned by its sources.
+// It publishes the time remaining until they expire as metrics, and logs a
+// warning whenever a certificate crosses one of the warning thresholds.
+type Monitor struct {
+	interval   time.Duration
+	thresholds []time.Duration
+	gauge      metrics.Gauge
+	now        func() time.Time
+
+	mutex    sync.Mutex
+	sources  map[string]Source
+	warned   map[string]time.Duration
+	stopChan chan struct{}
+	doneChan chan struct{}
+}
+
+// New creates a Monitor with the given configuration
+func New(conf Config) *Monitor {
+	thresholds := append([]time.Duration(nil), conf.WarningThresholds...)
+	sort.Slice(thresholds, func(i, j int) bool {
+		return thresholds[i] > thresholds[j]
+	})
+
+	return &Monitor{
+		interval:   conf.Interval,
+		thresholds: thresholds,
+		gauge:      conf.MetricsProvider.NewGauge(expiresInOpts),
+		now:        time.Now,
+		sources:    make(map[string]Source),
+		warned:     make(map[string]time.Duration),
+	}
+}
+
+// AddSource adds a source of certificates to monitor
+func (m *Monitor) AddSource(name string, source Source) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.sources[name] = source
+}
+
+// Start checks the expiration of the certificates, and starts
+// checking it periodically
+func (m *Monitor) Start() {
+	m.Check()
+
+	if m.interval == 0 {
+		logger.Info("Periodic certificate expiration checks are disabled")
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.stopChan != nil {
+		return
+	}
+	m.stopChan = make(chan struct{})
+	m.doneChan = make(chan struct{})
+	go m.run(m.stopChan, m.doneChan)
+}
+
+// Stop stops checking the expiration of the certificates
+func (m *Monitor) Stop() {
+	m.mutex.Lock()
+	stopChan, doneChan := m.stopChan, m.doneChan
+	m.stopChan, m.doneChan = nil, nil
+	m.mutex.Unlock()
+
+	if stopChan == nil {
+		return
+	}
+	close(stopChan)
+	<-doneChan
+}
+
+func (m *Monitor) run(stopChan, doneChan chan struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.Check()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// Certificates returns the current certificates of the sources,
+// ordered by the time remaining until they expire
+func (m *Monitor) Certificates() []CertificateStatus {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.certificates()
+}
+
+func (m *Monitor) certificates() []CertificateStatus {
+	now := m.now()
+	seen := make(map[string]struct{})
+	var statuses []CertificateStatus
+	for name, source := range m.sources {
+		certs, err := source()
+		if err != nil {
+			logger.Warningf("Failed obtaining certificates of %s: %s", name, err)
+		}
+		for _, cert := range certs {
+			if _, exists := seen[cert.key()]; exists {
+				continue
+			}
+			seen[cert.key()] = struct{}{}
+			statuses = append(statuses, CertificateStatus{
+				Certificate:      cert,
+				ExpiresInSeconds: int64(cert.NotAfter.Sub(now) / time.Second),
+			})
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if !statuses[i].NotAfter.Equal(statuses[j].NotAfter) {
+			return statuses[i].NotAfter.Before(statuses[j].NotAfter)
+		}
+		return statuses[i].key() < statuses[j].key()
+	})
+	return statuses
+}
+
+// Check publishes the time remaining until the certificates expire, and
+// logs a warning for the certificates that crossed a warning threshold
+// since they were last checked
+func (m *Monitor) Check() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	statuses := m.certificates()
+
+	// The gauge reports the certificate of each kind, channel and owner
+	// that expires first, so that renewed certificates replace the
+	// certificates they renew rather than being reported alongside them.
+	type series struct{ kind, channel, owner string }
+	reported := make(map[series]struct{})
+	warned := make(map[string]time.Duration)
+	for _, status := range statuses {
+		s := series{status.Kind, status.Channel, status.Owner}
+		if _, exists := reported[s]; !exists {
+			reported[s] = struct{}{}
+			m.gauge.With("kind", s.kind, "channel", s.channel, "owner", s.owner).Set(float64(status.ExpiresInSeconds))
+		}
+
+		key := status.key()
+		threshold, crossed := m.crossedThreshold(status)
+		if !crossed {
+			continue
+		}
+		warned[key] = threshold
+		if prev, exists := m.warned[key]; exists && prev == threshold {
+			continue
+		}
+		if threshold == 0 {
+			logger.Errorf("Certificate %s expired at %s", describe(status.Certificate), status.NotAfter)
+			continue
+		}
+		logger.Warningf("Certificate %s expires at %s, in less than %s", describe(status.Certificate), status.NotAfter, threshold)
+	}
+	m.warned = warned
+}
+
+// crossedThreshold returns the smallest warning threshold the given
+// certificate crossed, or zero if it expired
+func (m *Monitor) crossedThreshold(status CertificateStatus) (time.Duration, bool) {
+	remaining := time.Duration(status.ExpiresInSeconds) * time.Second
+	if remaining <= 0 {
+		return 0, true
+	}
+	for i := len(m.thresholds) - 1; i >= 0; i-- {
+		if remaining <= m.thresholds[i] {
+			return m.thresholds[i], true
+		}
+	}
+	return 0, false
+}
+
+func describe(cert Certificate) string {
+	desc := fmt.Sprintf("%s [%s]", cert.Subject, cert.Kind)
+	if cert.Owner != "" {
+		desc += fmt.Sprintf(" of %s", cert.Owner)
+	}
+	if cert.Channel != "" {
+		desc += fmt.Sprintf(" in channel %s", cert.Channel)
+	}
+	return desc
+}
+
+// ErrorResponse is the payload of the response to
+// an HTTP request that failed
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// CertificatesResponse is the payload of the response
+// to an HTTP request for the monitored certificates
+type CertificatesResponse struct {
+	Certificates []CertificateStatus `json:"certificates"`
+}
+
+// ServeHTTP lists the monitored certificates upon a GET request
+func (m *Monitor) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	resp.Header().Set("Content-Type", "application/json")
+	if req.Method != http.MethodGet {
+		resp.WriteHeader(http.StatusBadRequest)
+		m.encode(resp, &ErrorResponse{Error: fmt.Sprintf("invalid request method: %s", req.Method)})
+		return
+	}
+
+	resp.WriteHeader(http.StatusOK)
+	m.encode(resp, &CertificatesResponse{Certificates: m.Certificates()})
+}
+
+func (m *Monitor) encode(resp http.ResponseWriter, payload interface{}) {
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		logger.Errorw("failed to encode payload", "error", err)
+	}
+}
+
+var expiresInOpts = metrics.GaugeOpts{
+	Namespace:    "certificate",
+	Subsystem:    "",
+	Name:         "expires_in_seconds",
+	Help:         "Number of seconds until the certificate of the given kind, channel and owner that expires first expires.",
+	LabelNames:   []string{"kind", "channel", "owner"},
+	StatsdFormat: "%{#fqname}.%{kind}.%{channel}.%{owner}",
+}
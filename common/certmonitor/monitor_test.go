@@ -0,0 +1,192 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package certmonitor
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/flogging/floggingtest"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+var epoch = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func staticSource(certs ...Certificate) Source {
+	return func() ([]Certificate, error) {
+		return certs, nil
+	}
+}
+
+func newTestMonitor(conf Config) (*Monitor, *metricsfakes.Gauge) {
+	gauge := &metricsfakes.Gauge{}
+	gauge.WithReturns(gauge)
+	provider := &metricsfakes.Provider{}
+	provider.NewGaugeReturns(gauge)
+	conf.MetricsProvider = provider
+
+	m := New(conf)
+	m.now = func() time.Time { return epoch }
+	return m, gauge
+}
+
+func TestCheckPublishesMetrics(t *testing.T) {
+	m, gauge := newTestMonitor(Config{})
+
+	m.AddSource("local", staticSource(
+		Certificate{Kind: KindEnrollment, Owner: "Org1MSP", SerialNumber: "1", NotAfter: epoch.Add(time.Hour)},
+		Certificate{Kind: KindTLSServer, SerialNumber: "2", NotAfter: epoch.Add(time.Minute)},
+	))
+	m.AddSource("mychannel", staticSource(
+		Certificate{Kind: KindRootCA, Channel: "mychannel", Owner: "Org1MSP", SerialNumber: "3", NotAfter: epoch.Add(2 * time.Hour)},
+		// An older root CA certificate of the same MSP expires first, so it is the one reported
+		Certificate{Kind: KindRootCA, Channel: "mychannel", Owner: "Org1MSP", SerialNumber: "4", NotAfter: epoch.Add(time.Second)},
+	))
+	m.Check()
+
+	assert.Equal(t, 3, gauge.WithCallCount())
+	assert.Equal(t, []string{"kind", "root_ca", "channel", "mychannel", "owner", "Org1MSP"}, gauge.WithArgsForCall(0))
+	assert.Equal(t, float64(1), gauge.SetArgsForCall(0))
+	assert.Equal(t, []string{"kind", "tls_server", "channel", "", "owner", ""}, gauge.WithArgsForCall(1))
+	assert.Equal(t, float64(60), gauge.SetArgsForCall(1))
+	assert.Equal(t, []string{"kind", "enrollment", "channel", "", "owner", "Org1MSP"}, gauge.WithArgsForCall(2))
+	assert.Equal(t, float64(3600), gauge.SetArgsForCall(2))
+}
+
+func TestCheckLogsWarnings(t *testing.T) {
+	testLogger, recorder := floggingtest.NewTestLogger(t)
+	defer func(old *flogging.FabricLogger) {
+		logger = old
+	}(logger)
+	logger = testLogger
+
+	m, _ := newTestMonitor(Config{
+		WarningThresholds: []time.Duration{24 * time.Hour, 7 * 24 * time.Hour},
+	})
+	cert := Certificate{
+		Kind:         KindEnrollment,
+		Owner:        "Org1MSP",
+		Subject:      "CN=peer0.org1.example.com",
+		SerialNumber: "1",
+		NotAfter:     epoch.Add(30 * 24 * time.Hour),
+	}
+	m.AddSource("local", staticSource(cert))
+	m.AddSource("failing", func() ([]Certificate, error) {
+		return nil, errors.New("ledger is closed")
+	})
+
+	m.Check()
+	assert.Empty(t, recorder.MessagesContaining("CN=peer0.org1.example.com"))
+	assert.Len(t, recorder.MessagesContaining("Failed obtaining certificates of failing: ledger is closed"), 1)
+
+	// The certificate crosses the first threshold
+	m.now = func() time.Time { return cert.NotAfter.Add(-6 * 24 * time.Hour) }
+	m.Check()
+	m.Check()
+	assert.Equal(t, []string{
+		"Certificate CN=peer0.org1.example.com [enrollment] of Org1MSP expires at 2019-01-31 00:00:00 +0000 UTC, in less than 168h0m0s",
+	}, recorder.MessagesContaining("CN=peer0.org1.example.com"))
+
+	// The certificate crosses the second threshold
+	recorder.Reset()
+	m.now = func() time.Time { return cert.NotAfter.Add(-time.Hour) }
+	m.Check()
+	m.Check()
+	assert.Equal(t, []string{
+		"Certificate CN=peer0.org1.example.com [enrollment] of Org1MSP expires at 2019-01-31 00:00:00 +0000 UTC, in less than 24h0m0s",
+	}, recorder.MessagesContaining("CN=peer0.org1.example.com"))
+
+	// The certificate expires
+	recorder.Reset()
+	m.now = func() time.Time { return cert.NotAfter }
+	m.Check()
+	m.Check()
+	assert.Equal(t, []string{
+		"Certificate CN=peer0.org1.example.com [enrollment] of Org1MSP expired at 2019-01-31 00:00:00 +0000 UTC",
+	}, recorder.MessagesContaining("CN=peer0.org1.example.com"))
+	assert.Len(t, recorder.EntriesContaining("ERRO"), 1)
+}
+
+func TestCertificates(t *testing.T) {
+	m, _ := newTestMonitor(Config{})
+	root := Certificate{Kind: KindRootCA, Channel: "mychannel", Owner: "Org1MSP", SerialNumber: "1", NotAfter: epoch.Add(time.Hour)}
+	tlsCert := Certificate{Kind: KindTLSServer, SerialNumber: "2", NotAfter: epoch.Add(-time.Hour)}
+	m.AddSource("local", staticSource(tlsCert))
+	m.AddSource("mychannel", staticSource(root, root))
+
+	assert.Equal(t, []CertificateStatus{
+		{Certificate: tlsCert, ExpiresInSeconds: -3600},
+		{Certificate: root, ExpiresInSeconds: 3600},
+	}, m.Certificates())
+}
+
+func TestStartStop(t *testing.T) {
+	gt := NewGomegaWithT(t)
+
+	var checks int32
+	m := New(Config{Interval: 10 * time.Millisecond, MetricsProvider: &disabled.Provider{}})
+	m.AddSource("local", func() ([]Certificate, error) {
+		atomic.AddInt32(&checks, 1)
+		return nil, nil
+	})
+
+	m.Start()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&checks))
+	gt.Eventually(func() int32 { return atomic.LoadInt32(&checks) }, time.Second, 10*time.Millisecond).Should(BeNumerically(">", 2))
+
+	m.Stop()
+	stopped := atomic.LoadInt32(&checks)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt32(&checks))
+
+	m = New(Config{MetricsProvider: &disabled.Provider{}})
+	m.AddSource("local", func() ([]Certificate, error) {
+		atomic.AddInt32(&checks, 1)
+		return nil, nil
+	})
+	m.Start()
+	defer m.Stop()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped+1, atomic.LoadInt32(&checks))
+}
+
+func TestServeHTTP(t *testing.T) {
+	m, _ := newTestMonitor(Config{})
+	m.AddSource("local", staticSource(Certificate{
+		Kind:         KindTLSServer,
+		Subject:      "CN=peer0",
+		Issuer:       "CN=ca",
+		SerialNumber: "42",
+		NotAfter:     epoch.Add(time.Minute),
+	}))
+
+	resp := httptest.NewRecorder()
+	m.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/certificates", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"certificates": [{
+		"kind": "tls_server",
+		"subject": "CN=peer0",
+		"issuer": "CN=ca",
+		"serial_number": "42",
+		"not_after": "2019-01-01T00:01:00Z",
+		"expires_in_seconds": 60
+	}]}`, resp.Body.String())
+
+	resp = httptest.NewRecorder()
+	m.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/certificates", nil))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.JSONEq(t, `{"error": "invalid request method: PUT"}`, resp.Body.String())
+}
@@ -161,6 +161,14 @@ type ApplicationCapabilities interface {
 	// policies expressible at a ledger key granularity, as described in FAB-8812
 	KeyLevelEndorsement() bool
 
+	// CollectionEndorsementPolicies returns true if this channel validates the writes
+	// to private data collections against the endorsement policies of the collections
+	CollectionEndorsementPolicies() bool
+
+	// MemberOnlyWrite returns true if this channel rejects the transactions whose creator
+	// writes to a member-only-write private data collection without being a member of it
+	MemberOnlyWrite() bool
+
 	// FabToken returns true if this channel supports FabToken functions
 	FabToken() bool
 }
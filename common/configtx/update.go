@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/common/policies"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
 )
 
 func (vi *ValidatorImpl) verifyReadSet(readSet map[string]comparable) error {
@@ -94,6 +95,9 @@ func (vi *ValidatorImpl) verifyDeltaSet(deltaSet map[string]comparable, signedDa
 
 		// Ensure the policy is satisfied
 		if err := policy.Evaluate(signedData); err != nil {
+			if logger.IsEnabledFor(zapcore.DebugLevel) {
+				logger.Debugf("Signature set did not satisfy policy for %s:\n%s", key, policies.Explain(policy, signedData))
+			}
 			return errors.Wrapf(err, "policy for %s not satisfied", key)
 		}
 	}
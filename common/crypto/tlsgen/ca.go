@@ -42,13 +42,24 @@ type CA interface {
 }
 
 type ca struct {
-	caCert *CertKeyPair
+	caCert     *CertKeyPair
+	genPrivKey privKeyGenerator
 }
 
 func NewCA() (CA, error) {
-	c := &ca{}
+	return newCA(newPrivKey)
+}
+
+// NewEd25519CA creates a CA whose certificate, as well as the
+// certificates it generates, have Ed25519 keys
+func NewEd25519CA() (CA, error) {
+	return newCA(newEd25519PrivKey)
+}
+
+func newCA(genPrivKey privKeyGenerator) (CA, error) {
+	c := &ca{genPrivKey: genPrivKey}
 	var err error
-	c.caCert, err = newCertKeyPair(true, false, "", nil, nil)
+	c.caCert, err = newCertKeyPair(genPrivKey, true, false, "", nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -64,14 +75,14 @@ func (c *ca) CertBytes() []byte {
 // or nil, error in case of failure
 // The certificate is signed by the CA and is used as a client TLS certificate
 func (c *ca) NewClientCertKeyPair() (*CertKeyPair, error) {
-	return newCertKeyPair(false, false, "", c.caCert.Signer, c.caCert.TLSCert)
+	return newCertKeyPair(c.genPrivKey, false, false, "", c.caCert.Signer, c.caCert.TLSCert)
 }
 
 // newServerCertKeyPair returns a certificate and private key pair and nil,
 // or nil, error in case of failure
 // The certificate is signed by the CA and is used as a server TLS certificate
 func (c *ca) NewServerCertKeyPair(host string) (*CertKeyPair, error) {
-	keypair, err := newCertKeyPair(false, true, host, c.caCert.Signer, c.caCert.TLSCert)
+	keypair, err := newCertKeyPair(c.genPrivKey, false, true, host, c.caCert.Signer, c.caCert.TLSCert)
 	if err != nil {
 		return nil, err
 	}
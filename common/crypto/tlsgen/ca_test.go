@@ -38,7 +38,16 @@ func createTLSService(t *testing.T, ca CA, host string) *grpc.Server {
 func TestTLSCA(t *testing.T) {
 	// This test checks that the CA can create certificates
 	// and corresponding keys that are signed by itself
+	testTLSCA(t, NewCA)
+}
+
+func TestEd25519TLSCA(t *testing.T) {
+	// This test checks that the CA can create Ed25519 certificates
+	// and corresponding keys that are signed by itself
+	testTLSCA(t, NewEd25519CA)
+}
 
+func testTLSCA(t *testing.T, NewCA func() (CA, error)) {
 	rand.Seed(time.Now().UnixNano())
 	randomPort := 1234 + rand.Intn(1234) // some random port
 
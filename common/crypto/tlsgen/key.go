@@ -9,6 +9,7 @@ package tlsgen
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
@@ -28,16 +29,32 @@ func (p *CertKeyPair) PubKeyString() string {
 	return base64.StdEncoding.EncodeToString(p.Cert)
 }
 
-func newPrivKey() (*ecdsa.PrivateKey, []byte, error) {
+// privKeyGenerator generates a private key, and returns it
+// along with its PKCS#8 encoding and the PEM block type to encode it with
+type privKeyGenerator func() (crypto.Signer, []byte, string, error)
+
+func newPrivKey() (crypto.Signer, []byte, string, error) {
 	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
+	}
+	privBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return privateKey, privBytes, "EC PRIVATE KEY", nil
+}
+
+func newEd25519PrivKey() (crypto.Signer, []byte, string, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, "", err
 	}
 	privBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
-	return privateKey, privBytes, nil
+	return privateKey, privBytes, "PRIVATE KEY", nil
 }
 
 func newCertTemplate() (x509.Certificate, error) {
@@ -54,8 +71,8 @@ func newCertTemplate() (x509.Certificate, error) {
 	}, nil
 }
 
-func newCertKeyPair(isCA bool, isServer bool, host string, certSigner crypto.Signer, parent *x509.Certificate) (*CertKeyPair, error) {
-	privateKey, privBytes, err := newPrivKey()
+func newCertKeyPair(genPrivKey privKeyGenerator, isCA bool, isServer bool, host string, certSigner crypto.Signer, parent *x509.Certificate) (*CertKeyPair, error) {
+	privateKey, privBytes, keyType, err := genPrivKey()
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +106,7 @@ func newCertKeyPair(isCA bool, isServer bool, host string, certSigner crypto.Sig
 		parent = &template
 		certSigner = privateKey
 	}
-	rawBytes, err := x509.CreateCertificate(rand.Reader, &template, parent, &privateKey.PublicKey, certSigner)
+	rawBytes, err := x509.CreateCertificate(rand.Reader, &template, parent, privateKey.Public(), certSigner)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +117,7 @@ func newCertKeyPair(isCA bool, isServer bool, host string, certSigner crypto.Sig
 	if err != nil {
 		return nil, err
 	}
-	privKey := encodePEM("EC PRIVATE KEY", privBytes)
+	privKey := encodePEM(keyType, privBytes)
 	return &CertKeyPair{
 		Key:     privKey,
 		Cert:    pubKey,
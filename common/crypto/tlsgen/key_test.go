@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package tlsgen
 
 import (
+	"crypto/ed25519"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
@@ -16,7 +17,7 @@ import (
 )
 
 func TestCertEncoding(t *testing.T) {
-	pair, err := newCertKeyPair(false, false, "", nil, nil)
+	pair, err := newCertKeyPair(newPrivKey, false, false, "", nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, pair)
 	assert.NotEmpty(t, pair.PrivKeyString())
@@ -27,7 +28,7 @@ func TestCertEncoding(t *testing.T) {
 }
 
 func TestLoadCert(t *testing.T) {
-	pair, err := newCertKeyPair(false, false, "", nil, nil)
+	pair, err := newCertKeyPair(newPrivKey, false, false, "", nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, pair)
 	tlsCertPair, err := tls.X509KeyPair(pair.Cert, pair.Key)
@@ -38,3 +39,13 @@ func TestLoadCert(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, cert)
 }
+
+func TestLoadEd25519Cert(t *testing.T) {
+	pair, err := newCertKeyPair(newEd25519PrivKey, false, false, "", nil, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, pair)
+	tlsCertPair, err := tls.X509KeyPair(pair.Cert, pair.Key)
+	assert.NoError(t, err)
+	assert.IsType(t, ed25519.PrivateKey{}, tlsCertPair.PrivateKey)
+	assert.Equal(t, x509.PureEd25519, pair.TLSCert.SignatureAlgorithm)
+}
@@ -10,7 +10,6 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -25,7 +24,7 @@ var ErrUnexpectedEndOfBlockfile = errors.New("unexpected end of blockfile")
 // It starts from the given offset and can traverse till the end of the file
 type blockfileStream struct {
 	fileNum       int
-	file          *os.File
+	source        blockfileSource
 	reader        *bufio.Reader
 	currentOffset int64
 }
@@ -52,22 +51,12 @@ type blockPlacementInfo struct {
 // blockfileStream functions
 ////////////////////////////////////
 func newBlockfileStream(rootDir string, fileNum int, startOffset int64) (*blockfileStream, error) {
-	filePath := deriveBlockfilePath(rootDir, fileNum)
-	logger.Debugf("newBlockfileStream(): filePath=[%s], startOffset=[%d]", filePath, startOffset)
-	var file *os.File
-	var err error
-	if file, err = os.OpenFile(filePath, os.O_RDONLY, 0600); err != nil {
-		return nil, errors.Wrapf(err, "error opening block file %s", filePath)
-	}
-	var newPosition int64
-	if newPosition, err = file.Seek(startOffset, 0); err != nil {
-		return nil, errors.Wrapf(err, "error seeking block file [%s] to startOffset [%d]", filePath, startOffset)
-	}
-	if newPosition != startOffset {
-		panic(fmt.Sprintf("Could not seek block file [%s] to startOffset [%d]. New position = [%d]",
-			filePath, startOffset, newPosition))
+	logger.Debugf("newBlockfileStream(): rootDir=[%s], fileNum=[%d], startOffset=[%d]", rootDir, fileNum, startOffset)
+	source, err := openBlockfileSource(rootDir, fileNum)
+	if err != nil {
+		return nil, err
 	}
-	s := &blockfileStream{fileNum, file, bufio.NewReader(file), startOffset}
+	s := &blockfileStream{fileNum, source, bufio.NewReader(&blockfileSourceReader{source, startOffset}), startOffset}
 	return s, nil
 }
 
@@ -83,17 +72,17 @@ func (s *blockfileStream) nextBlockBytes() ([]byte, error) {
 func (s *blockfileStream) nextBlockBytesAndPlacementInfo() ([]byte, *blockPlacementInfo, error) {
 	var lenBytes []byte
 	var err error
-	var fileInfo os.FileInfo
+	var fileSize int64
 	moreContentAvailable := true
 
-	if fileInfo, err = s.file.Stat(); err != nil {
-		return nil, nil, errors.Wrapf(err, "error getting block file stat")
+	if fileSize, err = s.source.size(); err != nil {
+		return nil, nil, err
 	}
-	if s.currentOffset == fileInfo.Size() {
+	if s.currentOffset == fileSize {
 		logger.Debugf("Finished reading file number [%d]", s.fileNum)
 		return nil, nil, nil
 	}
-	remainingBytes := fileInfo.Size() - s.currentOffset
+	remainingBytes := fileSize - s.currentOffset
 	// Peek 8 or smaller number of bytes (if remaining bytes are less than 8)
 	// Assumption is that a block size would be small enough to be represented in 8 bytes varint
 	peekBytes := 8
@@ -139,7 +128,7 @@ func (s *blockfileStream) nextBlockBytesAndPlacementInfo() ([]byte, *blockPlacem
 }
 
 func (s *blockfileStream) close() error {
-	return errors.WithStack(s.file.Close())
+	return s.source.close()
 }
 
 ///////////////////////////////////
@@ -0,0 +1,266 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+const (
+	compressedBlockfileSuffix = ".sz"
+	tmpFileSuffix             = ".tmp"
+	// compressionChunkSize is the size of the uncompressed data that is compressed as an independent unit.
+	// Reading a block from a compressed file requires decompressing only the chunks that overlap with the block
+	compressionChunkSize = 256 * 1024
+)
+
+// compressedBlockfileMagic marks the end of a complete compressed block file
+var compressedBlockfileMagic = []byte("FBLKSZ01")
+
+// A compressed block file consists of a sequence of chunks, each compressed independently using snappy,
+// followed by a footer and a trailer
+//   footer  = <uncompressed size><number of chunks><compressed length of chunk 0>...<compressed length of chunk n-1> (all varints)
+//   trailer = <footer length (8 bytes, big endian)><magic (8 bytes)>
+// The uncompressed contents are exactly the contents of the original block file and hence,
+// the offsets maintained in the block index remain valid for the compressed file
+
+func deriveCompressedBlockfilePath(rootDir string, suffixNum int) string {
+	return deriveBlockfilePath(rootDir, suffixNum) + compressedBlockfileSuffix
+}
+
+// compressBlockfile replaces a sealed block file by its compressed counterpart. The compressed file is
+// written to a temporary file and renamed before removing the plain file so that, at any point in time,
+// either the plain or the complete compressed file is available to the readers. This function is idempotent
+// and can be invoked again after a crash
+func compressBlockfile(rootDir string, fileNum int) error {
+	plainPath := deriveBlockfilePath(rootDir, fileNum)
+	compressedPath := deriveCompressedBlockfilePath(rootDir, fileNum)
+
+	if _, err := os.Lstat(compressedPath); err == nil {
+		// a previous attempt may have crashed after producing the compressed file
+		logger.Debugf("Compressed block file [%s] exists, removing plain block file (if any)", compressedPath)
+		return removeIfExists(plainPath)
+	}
+
+	src, err := os.Open(plainPath)
+	if err != nil {
+		return errors.Wrapf(err, "error opening block file %s", plainPath)
+	}
+	defer src.Close()
+
+	tmpPath := compressedPath + tmpFileSuffix
+	dst, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0660)
+	if err != nil {
+		return errors.Wrapf(err, "error creating file %s", tmpPath)
+	}
+	if err := writeCompressed(src, dst); err != nil {
+		dst.Close()
+		return errors.WithMessage(err, "error compressing block file "+plainPath)
+	}
+	if err := dst.Sync(); err != nil {
+		dst.Close()
+		return errors.Wrapf(err, "error syncing file %s", tmpPath)
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrapf(err, "error closing file %s", tmpPath)
+	}
+	if err := os.Rename(tmpPath, compressedPath); err != nil {
+		return errors.Wrapf(err, "error renaming file %s to %s", tmpPath, compressedPath)
+	}
+	if err := syncDir(rootDir); err != nil {
+		return err
+	}
+	return removeIfExists(plainPath)
+}
+
+func writeCompressed(src io.Reader, dst io.Writer) error {
+	buf := make([]byte, compressionChunkSize)
+	footer := proto.NewBuffer(nil)
+	var compressedLens []uint64
+	var uncompressedSize uint64
+	for {
+		n, err := io.ReadFull(src, buf)
+		if n > 0 {
+			compressed := snappy.Encode(nil, buf[:n])
+			if _, err := dst.Write(compressed); err != nil {
+				return errors.WithStack(err)
+			}
+			compressedLens = append(compressedLens, uint64(len(compressed)))
+			uncompressedSize += uint64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	footer.EncodeVarint(uncompressedSize)
+	footer.EncodeVarint(uint64(len(compressedLens)))
+	for _, l := range compressedLens {
+		footer.EncodeVarint(l)
+	}
+	trailer := make([]byte, 8)
+	binary.BigEndian.PutUint64(trailer, uint64(len(footer.Bytes())))
+	for _, b := range [][]byte{footer.Bytes(), trailer, compressedBlockfileMagic} {
+		if _, err := dst.Write(b); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// compressedBlockfile implements the interface blockfileSource for a compressed block file.
+// The most recently decompressed chunk is cached and hence, this is not safe for concurrent use
+type compressedBlockfile struct {
+	file             *os.File
+	uncompressedSize int64
+	// chunkOffsets[i] is the offset of the i-th compressed chunk in the file and
+	// the last entry is the offset where the last chunk ends
+	chunkOffsets   []int64
+	cachedChunkNum int
+	cachedChunk    []byte
+}
+
+func newCompressedBlockfile(filePath string) (*compressedBlockfile, error) {
+	file, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening compressed block file %s", filePath)
+	}
+	f := &compressedBlockfile{file: file, cachedChunkNum: -1}
+	if err := f.loadFooter(); err != nil {
+		file.Close()
+		return nil, errors.WithMessage(err, "error loading compressed block file "+filePath)
+	}
+	return f, nil
+}
+
+func (f *compressedBlockfile) loadFooter() error {
+	fileInfo, err := f.file.Stat()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	trailerLen := int64(8 + len(compressedBlockfileMagic))
+	if fileInfo.Size() < trailerLen {
+		return errors.New("file is too short to be a compressed block file")
+	}
+	trailer := make([]byte, trailerLen)
+	if _, err := f.file.ReadAt(trailer, fileInfo.Size()-trailerLen); err != nil {
+		return errors.WithStack(err)
+	}
+	if !bytes.Equal(trailer[8:], compressedBlockfileMagic) {
+		return errors.New("unexpected trailer in compressed block file")
+	}
+	footerLen := int64(binary.BigEndian.Uint64(trailer[:8]))
+	footerOffset := fileInfo.Size() - trailerLen - footerLen
+	if footerOffset < 0 {
+		return errors.Errorf("invalid footer length [%d]", footerLen)
+	}
+	footerBytes := make([]byte, footerLen)
+	if _, err := f.file.ReadAt(footerBytes, footerOffset); err != nil {
+		return errors.WithStack(err)
+	}
+
+	footer := proto.NewBuffer(footerBytes)
+	uncompressedSize, err := footer.DecodeVarint()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	numChunks, err := footer.DecodeVarint()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	chunkOffsets := make([]int64, numChunks+1)
+	for i := uint64(0); i < numChunks; i++ {
+		compressedLen, err := footer.DecodeVarint()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		chunkOffsets[i+1] = chunkOffsets[i] + int64(compressedLen)
+	}
+	if chunkOffsets[numChunks] != footerOffset {
+		return errors.Errorf("compressed chunks end at [%d] whereas footer starts at [%d]", chunkOffsets[numChunks], footerOffset)
+	}
+	f.uncompressedSize = int64(uncompressedSize)
+	f.chunkOffsets = chunkOffsets
+	return nil
+}
+
+// ReadAt implements function in interface io.ReaderAt. The offset refers to the uncompressed contents
+func (f *compressedBlockfile) ReadAt(b []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.Errorf("negative offset [%d]", off)
+	}
+	n := 0
+	for n < len(b) {
+		if off >= f.uncompressedSize {
+			return n, io.EOF
+		}
+		chunkNum := int(off / compressionChunkSize)
+		chunk, err := f.chunk(chunkNum)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(b[n:], chunk[off-int64(chunkNum)*compressionChunkSize:])
+		n += copied
+		off += int64(copied)
+	}
+	return n, nil
+}
+
+func (f *compressedBlockfile) chunk(chunkNum int) ([]byte, error) {
+	if chunkNum == f.cachedChunkNum {
+		return f.cachedChunk, nil
+	}
+	compressed := make([]byte, f.chunkOffsets[chunkNum+1]-f.chunkOffsets[chunkNum])
+	if _, err := f.file.ReadAt(compressed, f.chunkOffsets[chunkNum]); err != nil {
+		return nil, errors.Wrapf(err, "error reading compressed chunk [%d]", chunkNum)
+	}
+	chunk, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decompressing chunk [%d]", chunkNum)
+	}
+	f.cachedChunkNum = chunkNum
+	f.cachedChunk = chunk
+	return chunk, nil
+}
+
+func (f *compressedBlockfile) size() (int64, error) {
+	return f.uncompressedSize, nil
+}
+
+func (f *compressedBlockfile) close() error {
+	return errors.WithStack(f.file.Close())
+}
+
+func removeIfExists(filePath string) error {
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "error removing file %s", filePath)
+	}
+	return nil
+}
+
+func syncDir(dirPath string) error {
+	dir, err := os.Open(filepath.Clean(dirPath))
+	if err != nil {
+		return errors.Wrapf(err, "error opening dir %s", dirPath)
+	}
+	defer dir.Close()
+	if err := dir.Sync(); err != nil {
+		return errors.Wrapf(err, "error syncing dir %s", dirPath)
+	}
+	return nil
+}
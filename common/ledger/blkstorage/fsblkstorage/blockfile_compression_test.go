@@ -0,0 +1,107 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressBlockfile(t *testing.T) {
+	for _, size := range []int{0, 10, compressionChunkSize, 3*compressionChunkSize + 123} {
+		testCompressBlockfile(t, size)
+	}
+}
+
+func testCompressBlockfile(t *testing.T, size int) {
+	rootDir := testPath()
+	defer os.RemoveAll(rootDir)
+	content := make([]byte, size)
+	rand.Read(content[:size/2]) // second half is left as zeros so as to be compressible
+	assert.NoError(t, ioutil.WriteFile(deriveBlockfilePath(rootDir, 0), content, 0660))
+
+	assert.NoError(t, compressBlockfile(rootDir, 0))
+	_, err := os.Stat(deriveBlockfilePath(rootDir, 0))
+	assert.True(t, os.IsNotExist(err))
+
+	source, err := openBlockfileSource(rootDir, 0)
+	assert.NoError(t, err)
+	defer source.close()
+	assert.IsType(t, &compressedBlockfile{}, source)
+	sourceSize, err := source.size()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(size), sourceSize)
+
+	// read the whole content and the segments that span across the chunks
+	b := make([]byte, size)
+	n, err := source.ReadAt(b, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, size, n)
+	assert.Equal(t, content, b)
+	for _, off := range []int{1, compressionChunkSize - 5, compressionChunkSize, 2*compressionChunkSize + 7} {
+		if off+20 > size {
+			continue
+		}
+		b := make([]byte, 20)
+		_, err := source.ReadAt(b, int64(off))
+		assert.NoError(t, err)
+		assert.Equal(t, content[off:off+20], b)
+	}
+
+	// reading past the end of the content
+	if size < 5 {
+		return
+	}
+	b = make([]byte, 10)
+	n, err = source.ReadAt(b, int64(size-5))
+	assert.Equal(t, 5, n)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestCompressBlockfileAfterCrash(t *testing.T) {
+	rootDir := testPath()
+	defer os.RemoveAll(rootDir)
+	content := []byte("some block file content")
+	plainPath := deriveBlockfilePath(rootDir, 0)
+	assert.NoError(t, ioutil.WriteFile(plainPath, content, 0660))
+
+	// a crash during writing of the compressed file leaves a partial temporary file behind
+	tmpPath := deriveCompressedBlockfilePath(rootDir, 0) + tmpFileSuffix
+	assert.NoError(t, ioutil.WriteFile(tmpPath, []byte("partial"), 0660))
+	assert.NoError(t, compressBlockfile(rootDir, 0))
+
+	// a crash after renaming the compressed file leaves the plain file behind
+	assert.NoError(t, ioutil.WriteFile(plainPath, content, 0660))
+	assert.NoError(t, compressBlockfile(rootDir, 0))
+	_, err := os.Stat(plainPath)
+	assert.True(t, os.IsNotExist(err))
+
+	reader, err := newBlockfileReader(rootDir, 0)
+	assert.NoError(t, err)
+	defer reader.close()
+	b, err := reader.read(5, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, content[5:10], b)
+}
+
+func TestOpenCorruptedCompressedBlockfile(t *testing.T) {
+	rootDir := testPath()
+	defer os.RemoveAll(rootDir)
+	assert.NoError(t, ioutil.WriteFile(deriveCompressedBlockfilePath(rootDir, 0), []byte("not a compressed block file"), 0660))
+	_, err := openBlockfileSource(rootDir, 0)
+	assert.Contains(t, err.Error(), "unexpected trailer in compressed block file")
+
+	_, err = openBlockfileSource(rootDir, 1)
+	assert.Contains(t, err.Error(), "error opening block file")
+	assert.True(t, os.IsNotExist(errors.Cause(err)))
+}
@@ -90,7 +90,7 @@ func retrieveLastFileSuffix(rootDir string) (int, error) {
 			logger.Debugf("Skipping File name = %s", name)
 			continue
 		}
-		fileSuffix := strings.TrimPrefix(name, blockfilePrefix)
+		fileSuffix := strings.TrimSuffix(strings.TrimPrefix(name, blockfilePrefix), compressedBlockfileSuffix)
 		fileNum, err := strconv.Atoi(fileSuffix)
 		if err != nil {
 			return -1, err
@@ -104,12 +104,18 @@ func retrieveLastFileSuffix(rootDir string) (int, error) {
 }
 
 func isBlockFileName(name string) bool {
-	return strings.HasPrefix(name, blockfilePrefix)
+	// skip the temporary files that may be left behind by a crash during compression or archival of a sealed block file
+	return strings.HasPrefix(name, blockfilePrefix) &&
+		!strings.HasSuffix(name, tmpFileSuffix) && !strings.HasSuffix(name, symlinkTmpSuffix)
 }
 
 func getFileInfoOrPanic(rootDir string, fileNum int) os.FileInfo {
 	filePath := deriveBlockfilePath(rootDir, fileNum)
-	fileInfo, err := os.Lstat(filePath)
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		// a sealed block file may have been compressed
+		fileInfo, err = os.Stat(deriveCompressedBlockfilePath(rootDir, fileNum))
+	}
 	if err != nil {
 		panic(errors.Wrapf(err, "error retrieving file info for file number %d", fileNum))
 	}
@@ -36,6 +36,7 @@ var (
 )
 
 type blockfileMgr struct {
+	ledgerID          string
 	rootDir           string
 	conf              *Conf
 	db                *leveldbhelper.DBHandle
@@ -44,6 +45,7 @@ type blockfileMgr struct {
 	cpInfoCond        *sync.Cond
 	currentFileWriter *blockfileWriter
 	bcInfo            atomic.Value
+	sealedBlockfiles  *sealedBlockfilesWorker
 }
 
 /*
@@ -96,7 +98,7 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 		panic(fmt.Sprintf("Error creating block storage root dir [%s]: %s", rootDir, err))
 	}
 	// Instantiate the manager, i.e. blockFileMgr structure
-	mgr := &blockfileMgr{rootDir: rootDir, conf: conf, db: indexStore}
+	mgr := &blockfileMgr{ledgerID: id, rootDir: rootDir, conf: conf, db: indexStore}
 
 	// cp = checkpointInfo, retrieve from the database the file suffix or number of where blocks were stored.
 	// It also retrieves the current size of that file and the last block number that was written to that file.
@@ -165,6 +167,8 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 			PreviousBlockHash: previousBlockHash}
 	}
 	mgr.bcInfo.Store(bcInfo)
+	// Start compressing and archiving the sealed block files in the background, if enabled
+	mgr.startSealedBlockfilesWorker()
 	return mgr
 }
 
@@ -213,6 +217,7 @@ func deriveBlockfilePath(rootDir string, suffixNum int) string {
 }
 
 func (mgr *blockfileMgr) close() {
+	mgr.stopSealedBlockfilesWorker()
 	mgr.currentFileWriter.close()
 }
 
@@ -233,8 +238,15 @@ func (mgr *blockfileMgr) moveToNextFile() {
 	if err != nil {
 		panic(fmt.Sprintf("Could not save next block file info to db: %s", err))
 	}
+	// The previous file is sealed now. If a crash takes place before recording the sealed
+	// file, the file is recorded when the worker for the sealed files starts
+	err = mgr.recordSealedBlockfile(mgr.cpInfo.latestFileChunkSuffixNum, mgr.cpInfo.lastBlockNumber)
+	if err != nil {
+		panic(fmt.Sprintf("Could not save sealed block file info to db: %s", err))
+	}
 	mgr.currentFileWriter = nextFileWriter
 	mgr.updateCheckpoint(cpInfo)
+	mgr.notifySealedBlockfilesWorker()
 }
 
 func (mgr *blockfileMgr) addBlock(block *common.Block) error {
@@ -565,8 +577,7 @@ func (mgr *blockfileMgr) fetchBlockBytes(lp *fileLocPointer) ([]byte, error) {
 }
 
 func (mgr *blockfileMgr) fetchRawBytes(lp *fileLocPointer) ([]byte, error) {
-	filePath := deriveBlockfilePath(mgr.rootDir, lp.fileSuffixNum)
-	reader, err := newBlockfileReader(filePath)
+	reader, err := newBlockfileReader(mgr.rootDir, lp.fileSuffixNum)
 	if err != nil {
 		return nil, err
 	}
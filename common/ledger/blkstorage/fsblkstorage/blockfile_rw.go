@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package fsblkstorage
 
 import (
+	"io"
 	"os"
 
 	"github.com/pkg/errors"
@@ -60,21 +61,21 @@ func (w *blockfileWriter) close() error {
 
 ////  READER ////
 type blockfileReader struct {
-	file *os.File
+	source blockfileSource
 }
 
-func newBlockfileReader(filePath string) (*blockfileReader, error) {
-	file, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
+func newBlockfileReader(rootDir string, fileNum int) (*blockfileReader, error) {
+	source, err := openBlockfileSource(rootDir, fileNum)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error opening block file reader for file %s", filePath)
+		return nil, err
 	}
-	reader := &blockfileReader{file}
+	reader := &blockfileReader{source}
 	return reader, nil
 }
 
 func (r *blockfileReader) read(offset int, length int) ([]byte, error) {
 	b := make([]byte, length)
-	_, err := r.file.ReadAt(b, int64(offset))
+	_, err := r.source.ReadAt(b, int64(offset))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error reading block file for offset %d and length %d", offset, length)
 	}
@@ -82,5 +83,73 @@ func (r *blockfileReader) read(offset int, length int) ([]byte, error) {
 }
 
 func (r *blockfileReader) close() error {
-	return errors.WithStack(r.file.Close())
+	return r.source.close()
+}
+
+////  SOURCE ////
+
+// blockfileSource provides random access to the contents of a block file. The offsets always refer to the
+// uncompressed contents so that the locations maintained in the block index remain valid irrespective
+// of whether the block file is stored plain or compressed
+type blockfileSource interface {
+	io.ReaderAt
+	size() (int64, error)
+	close() error
+}
+
+// openBlockfileSource opens the block file for the given file number. A sealed block file may have been
+// replaced by its compressed counterpart (or by a symlink to a file in the archive directory) and hence,
+// the compressed file is looked up if the plain file does not exist
+func openBlockfileSource(rootDir string, fileNum int) (blockfileSource, error) {
+	filePath := deriveBlockfilePath(rootDir, fileNum)
+	file, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
+	if err == nil {
+		return &plainBlockfile{file}, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "error opening block file %s", filePath)
+	}
+	compressedFile, compressedErr := newCompressedBlockfile(deriveCompressedBlockfilePath(rootDir, fileNum))
+	if compressedErr != nil {
+		if os.IsNotExist(errors.Cause(compressedErr)) {
+			return nil, errors.Wrapf(err, "error opening block file %s", filePath)
+		}
+		return nil, compressedErr
+	}
+	return compressedFile, nil
+}
+
+type plainBlockfile struct {
+	file *os.File
+}
+
+func (f *plainBlockfile) ReadAt(b []byte, off int64) (int, error) {
+	return f.file.ReadAt(b, off)
+}
+
+func (f *plainBlockfile) size() (int64, error) {
+	fileInfo, err := f.file.Stat()
+	if err != nil {
+		return 0, errors.Wrapf(err, "error getting block file stat")
+	}
+	return fileInfo.Size(), nil
+}
+
+func (f *plainBlockfile) close() error {
+	return errors.WithStack(f.file.Close())
+}
+
+// blockfileSourceReader reads a blockfileSource sequentially starting from the given offset
+type blockfileSourceReader struct {
+	source blockfileSource
+	offset int64
+}
+
+func (r *blockfileSourceReader) Read(b []byte) (int, error) {
+	n, err := r.source.ReadAt(b, r.offset)
+	r.offset += int64(n)
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
 }
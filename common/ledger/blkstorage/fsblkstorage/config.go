@@ -30,15 +30,34 @@ const (
 type Conf struct {
 	blockStorageDir  string
 	maxBlockfileSize int
+	sealedBlockfiles SealedBlockfilesConf
+}
+
+// SealedBlockfilesConf encapsulates the configurations for the block files that are sealed.
+// A block file is sealed when it reaches the max block file size and the blocks start being
+// appended to the next block file
+type SealedBlockfilesConf struct {
+	// Compress indicates whether the sealed block files are to be compressed
+	Compress bool
+	// ArchiveDir is the top level folder to which the sealed block files are moved. An empty value disables the archival
+	ArchiveDir string
+	// ArchiveAfterBlocks is the number of blocks that are to be committed after the last block of a
+	// sealed block file before the block file is moved to the archive directory
+	ArchiveAfterBlocks uint64
 }
 
 // NewConf constructs new `Conf`.
 // blockStorageDir is the top level folder under which `FsBlockStore` manages its data
 func NewConf(blockStorageDir string, maxBlockfileSize int) *Conf {
+	return NewConfWithSealedBlockfiles(blockStorageDir, maxBlockfileSize, SealedBlockfilesConf{})
+}
+
+// NewConfWithSealedBlockfiles constructs new `Conf` that, in addition, specifies the treatment of the sealed block files
+func NewConfWithSealedBlockfiles(blockStorageDir string, maxBlockfileSize int, sealedBlockfiles SealedBlockfilesConf) *Conf {
 	if maxBlockfileSize <= 0 {
 		maxBlockfileSize = defaultMaxBlockfileSize
 	}
-	return &Conf{blockStorageDir, maxBlockfileSize}
+	return &Conf{blockStorageDir, maxBlockfileSize, sealedBlockfiles}
 }
 
 func (conf *Conf) getIndexDir() string {
@@ -52,3 +71,10 @@ func (conf *Conf) getChainsDir() string {
 func (conf *Conf) getLedgerBlockDir(ledgerid string) string {
 	return filepath.Join(conf.getChainsDir(), ledgerid)
 }
+
+func (conf *Conf) getLedgerArchiveDir(ledgerid string) string {
+	if conf.sealedBlockfiles.ArchiveDir == "" {
+		return ""
+	}
+	return filepath.Join(conf.sealedBlockfiles.ArchiveDir, ChainsDir, ledgerid)
+}
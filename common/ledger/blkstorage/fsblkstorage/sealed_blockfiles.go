@@ -0,0 +1,274 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	sealedBlockfileKeyPrefix = 'f'
+	symlinkTmpSuffix         = ".lnk"
+)
+
+// sealedBlockfilesWorker compresses and archives the sealed block files in the background.
+// For each sealed block file, the number of the last block in the file is persisted in the
+// index db (see function `recordSealedBlockfile`). This is used for deciding when a sealed block
+// file is to be archived. The compression and the archival do not change the offsets maintained in
+// the block index and the blocks continue to be retrievable through the block index, as the readers
+// look up the compressed block file if the plain block file is not present, and the archived block
+// files are reachable via a symlink that replaces the block file in the ledger directory
+type sealedBlockfilesWorker struct {
+	lock   sync.Mutex
+	signal chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func (mgr *blockfileMgr) startSealedBlockfilesWorker() {
+	sealedConf := mgr.conf.sealedBlockfiles
+	if !sealedConf.Compress && sealedConf.ArchiveDir == "" {
+		return
+	}
+	w := &sealedBlockfilesWorker{
+		signal: make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	mgr.sealedBlockfiles = w
+	numSealedFiles := mgr.cpInfo.latestFileChunkSuffixNum
+	go func() {
+		defer close(w.done)
+		// bring the block files sealed before enabling the compression or the archival (or sealed
+		// before a crash) in line with the configuration
+		if err := mgr.recordMissingSealedBlockfiles(numSealedFiles); err != nil {
+			logger.Errorf("[%s] Error while recording the sealed block files: %s", mgr.ledgerID, err)
+		}
+		mgr.notifySealedBlockfilesWorker()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case <-w.signal:
+				if err := mgr.processSealedBlockfiles(); err != nil {
+					logger.Errorf("[%s] Error while processing the sealed block files: %s", mgr.ledgerID, err)
+				}
+			}
+		}
+	}()
+}
+
+func (mgr *blockfileMgr) stopSealedBlockfilesWorker() {
+	if mgr.sealedBlockfiles == nil {
+		return
+	}
+	close(mgr.sealedBlockfiles.stop)
+	<-mgr.sealedBlockfiles.done
+}
+
+func (mgr *blockfileMgr) notifySealedBlockfilesWorker() {
+	if mgr.sealedBlockfiles == nil {
+		return
+	}
+	select {
+	case mgr.sealedBlockfiles.signal <- struct{}{}:
+	default:
+		// a notification is already pending
+	}
+}
+
+// recordSealedBlockfile persists the number of the last block in the sealed block file
+func (mgr *blockfileMgr) recordSealedBlockfile(fileNum int, lastBlockNum uint64) error {
+	return mgr.db.Put(constructSealedBlockfileKey(fileNum), proto.EncodeVarint(lastBlockNum), false)
+}
+
+// recordMissingSealedBlockfiles records the sealed block files that are not recorded yet, by scanning them.
+// This is the case for the ledgers created before the block files started to be recorded, or if
+// a crash took place before the record was persisted
+func (mgr *blockfileMgr) recordMissingSealedBlockfiles(numSealedFiles int) error {
+	var lastBlockNum uint64
+	for fileNum := 0; fileNum < numSealedFiles; fileNum++ {
+		if mgr.sealedBlockfiles.stopped() {
+			return nil
+		}
+		val, err := mgr.db.Get(constructSealedBlockfileKey(fileNum))
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			lastBlockNum, _ = proto.DecodeVarint(val)
+			continue
+		}
+		logger.Infof("[%s] Scanning sealed block file [%d] for recording the last block", mgr.ledgerID, fileNum)
+		lastBlockBytes, _, _, err := scanForLastCompleteBlock(mgr.rootDir, fileNum, 0)
+		if err != nil {
+			return err
+		}
+		// an empty block file (possible if a block is bigger than the max block file size)
+		// inherits the last block of the previous file
+		if lastBlockBytes != nil {
+			info, err := extractSerializedBlockInfo(lastBlockBytes)
+			if err != nil {
+				return err
+			}
+			lastBlockNum = info.blockHeader.Number
+		}
+		if err := mgr.recordSealedBlockfile(fileNum, lastBlockNum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processSealedBlockfiles compresses the sealed block files (if enabled) and moves the sealed block files
+// to the archive directory (if enabled) once the configured number of blocks are committed after the
+// last block of the block file
+func (mgr *blockfileMgr) processSealedBlockfiles() error {
+	w := mgr.sealedBlockfiles
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	bcInfo := mgr.getBlockchainInfo()
+	if bcInfo.Height == 0 {
+		return nil
+	}
+	lastCommittedBlockNum := bcInfo.Height - 1
+	sealedConf := mgr.conf.sealedBlockfiles
+	archiveDir := mgr.conf.getLedgerArchiveDir(mgr.ledgerID)
+	if archiveDir != "" {
+		if _, err := util.CreateDirIfMissing(archiveDir); err != nil {
+			return errors.Wrapf(err, "error creating archive dir %s", archiveDir)
+		}
+	}
+
+	itr := mgr.db.GetIterator([]byte{sealedBlockfileKeyPrefix}, []byte{sealedBlockfileKeyPrefix + 1})
+	defer itr.Release()
+	for itr.Next() {
+		if w.stopped() {
+			return nil
+		}
+		fileNum := decodeSealedBlockfileKey(itr.Key())
+		lastBlockNum, _ := proto.DecodeVarint(itr.Value())
+		state, err := getSealedBlockfileState(mgr.rootDir, fileNum)
+		if err != nil {
+			return err
+		}
+		if sealedConf.Compress && !state.compressed && !state.archived {
+			logger.Infof("[%s] Compressing sealed block file [%d]", mgr.ledgerID, fileNum)
+			if err := compressBlockfile(mgr.rootDir, fileNum); err != nil {
+				return err
+			}
+			state.compressed = true
+		}
+		if archiveDir != "" && !state.archived && lastBlockNum+sealedConf.ArchiveAfterBlocks <= lastCommittedBlockNum {
+			logger.Infof("[%s] Archiving sealed block file [%d] to dir [%s]", mgr.ledgerID, fileNum, archiveDir)
+			if err := archiveBlockfile(mgr.rootDir, archiveDir, fileNum, state.compressed); err != nil {
+				return err
+			}
+		}
+	}
+	return errors.WithStack(itr.Error())
+}
+
+func (w *sealedBlockfilesWorker) stopped() bool {
+	select {
+	case <-w.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+type sealedBlockfileState struct {
+	compressed bool
+	archived   bool
+}
+
+func getSealedBlockfileState(rootDir string, fileNum int) (*sealedBlockfileState, error) {
+	state := &sealedBlockfileState{}
+	fileInfo, err := os.Lstat(deriveBlockfilePath(rootDir, fileNum))
+	if os.IsNotExist(err) {
+		state.compressed = true
+		fileInfo, err = os.Lstat(deriveCompressedBlockfilePath(rootDir, fileNum))
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error retrieving file info for block file number %d", fileNum)
+	}
+	state.archived = fileInfo.Mode()&os.ModeSymlink != 0
+	return state, nil
+}
+
+// archiveBlockfile copies the sealed block file to the archive directory and atomically replaces the block
+// file in the ledger directory by a symlink to the archived file. The archive directory is expected to be
+// on a different storage and hence, the file is copied rather than renamed. This function is idempotent and
+// can be invoked again after a crash
+func archiveBlockfile(rootDir, archiveDir string, fileNum int, compressed bool) error {
+	filePath := deriveBlockfilePath(rootDir, fileNum)
+	if compressed {
+		filePath = deriveCompressedBlockfilePath(rootDir, fileNum)
+	}
+	archivePath := filepath.Join(archiveDir, filepath.Base(filePath))
+	tmpArchivePath := archivePath + tmpFileSuffix
+	if err := copyFile(filePath, tmpArchivePath); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpArchivePath, archivePath); err != nil {
+		return errors.Wrapf(err, "error renaming file %s to %s", tmpArchivePath, archivePath)
+	}
+	if err := syncDir(archiveDir); err != nil {
+		return err
+	}
+
+	tmpSymlinkPath := filePath + symlinkTmpSuffix
+	if err := removeIfExists(tmpSymlinkPath); err != nil {
+		return err
+	}
+	if err := os.Symlink(archivePath, tmpSymlinkPath); err != nil {
+		return errors.Wrapf(err, "error creating symlink %s", tmpSymlinkPath)
+	}
+	if err := os.Rename(tmpSymlinkPath, filePath); err != nil {
+		return errors.Wrapf(err, "error renaming symlink %s to %s", tmpSymlinkPath, filePath)
+	}
+	return syncDir(rootDir)
+}
+
+func copyFile(srcPath, dstPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return errors.Wrapf(err, "error opening file %s", srcPath)
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0660)
+	if err != nil {
+		return errors.Wrapf(err, "error creating file %s", dstPath)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return errors.Wrapf(err, "error copying file %s to %s", srcPath, dstPath)
+	}
+	if err := dst.Sync(); err != nil {
+		dst.Close()
+		return errors.Wrapf(err, "error syncing file %s", dstPath)
+	}
+	return errors.WithStack(dst.Close())
+}
+
+func constructSealedBlockfileKey(fileNum int) []byte {
+	return append([]byte{sealedBlockfileKeyPrefix}, util.EncodeOrderPreservingVarUint64(uint64(fileNum))...)
+}
+
+func decodeSealedBlockfileKey(key []byte) int {
+	fileNum, _ := util.DecodeOrderPreservingVarUint64(key[1:])
+	return int(fileNum)
+}
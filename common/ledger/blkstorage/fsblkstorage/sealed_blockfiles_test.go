@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealedBlockfilesCompressionAndArchival(t *testing.T) {
+	blocks := testutil.ConstructTestBlocks(t, 100)
+	blockStorageDir := testPath()
+	archiveDir := testPath()
+	defer os.RemoveAll(archiveDir)
+	conf := NewConfWithSealedBlockfiles(blockStorageDir, maxFileSizeForBlocks(t, blocks[:10]),
+		SealedBlockfilesConf{Compress: true, ArchiveDir: archiveDir, ArchiveAfterBlocks: 50})
+	env := newTestEnv(t, conf)
+	defer env.Cleanup()
+	ledgerid := "testLedger"
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerid)
+	blkfileMgrWrapper.addBlocks(blocks)
+	mgr := blkfileMgrWrapper.blockfileMgr
+	assert.NoError(t, mgr.processSealedBlockfiles())
+
+	numSealedFiles := mgr.cpInfo.latestFileChunkSuffixNum
+	assert.True(t, numSealedFiles > 2)
+	verifySealedBlockfiles(t, mgr, numSealedFiles, 99-50)
+	testRetrieveBlocks(t, blkfileMgrWrapper, blocks)
+
+	// restart
+	blkfileMgrWrapper.close()
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerid)
+	testRetrieveBlocks(t, blkfileMgrWrapper, blocks)
+	blkfileMgrWrapper.close()
+
+	// rebuild the index from the compressed and archived block files
+	env.provider.Close()
+	assert.NoError(t, os.RemoveAll(conf.getIndexDir()))
+	env = newTestEnv(t, conf)
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerid)
+	defer blkfileMgrWrapper.close()
+	assert.Equal(t, numSealedFiles, blkfileMgrWrapper.blockfileMgr.cpInfo.latestFileChunkSuffixNum)
+	testRetrieveBlocks(t, blkfileMgrWrapper, blocks)
+}
+
+func TestSealedBlockfilesMigration(t *testing.T) {
+	blocks := testutil.ConstructTestBlocks(t, 60)
+	blockStorageDir := testPath()
+	maxFileSize := maxFileSizeForBlocks(t, blocks[:10])
+	env := newTestEnv(t, NewConf(blockStorageDir, maxFileSize))
+	ledgerid := "testLedger"
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerid)
+	blkfileMgrWrapper.addBlocks(blocks)
+	mgr := blkfileMgrWrapper.blockfileMgr
+	numSealedFiles := mgr.cpInfo.latestFileChunkSuffixNum
+	assert.True(t, numSealedFiles > 2)
+	// simulate a ledger created before the sealed block files started to be recorded
+	for fileNum := 0; fileNum < numSealedFiles; fileNum++ {
+		assert.NoError(t, mgr.db.Delete(constructSealedBlockfileKey(fileNum), true))
+	}
+	blkfileMgrWrapper.close()
+	env.provider.Close()
+
+	conf := NewConfWithSealedBlockfiles(blockStorageDir, maxFileSize, SealedBlockfilesConf{Compress: true})
+	env = newTestEnv(t, conf)
+	defer env.Cleanup()
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerid)
+	defer blkfileMgrWrapper.close()
+	mgr = blkfileMgrWrapper.blockfileMgr
+	assert.NoError(t, mgr.recordMissingSealedBlockfiles(numSealedFiles))
+	assert.NoError(t, mgr.processSealedBlockfiles())
+	verifySealedBlockfiles(t, mgr, numSealedFiles, 0)
+	for fileNum := 1; fileNum < numSealedFiles; fileNum++ {
+		val, err := mgr.db.Get(constructSealedBlockfileKey(fileNum))
+		assert.NoError(t, err)
+		lastBlockNum, _ := proto.DecodeVarint(val)
+		lp, err := mgr.index.getBlockLocByBlockNum(lastBlockNum)
+		assert.NoError(t, err)
+		assert.Equal(t, fileNum, lp.fileSuffixNum)
+	}
+	testRetrieveBlocks(t, blkfileMgrWrapper, blocks)
+}
+
+func TestRetrieveLastFileSuffixWithCompressedFiles(t *testing.T) {
+	rootDir := testPath()
+	defer os.RemoveAll(rootDir)
+	for _, fileName := range []string{"blockfile_000000.sz", "blockfile_000001.sz", "blockfile_000002",
+		"blockfile_000003.sz.tmp", "blockfile_000003.lnk"} {
+		f, err := os.Create(filepath.Join(rootDir, fileName))
+		assert.NoError(t, err)
+		f.Close()
+	}
+	lastFileSuffix, err := retrieveLastFileSuffix(rootDir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lastFileSuffix)
+}
+
+func maxFileSizeForBlocks(t *testing.T, blocks []*common.Block) int {
+	size := 0
+	for _, block := range blocks {
+		by, _, err := serializeBlock(block)
+		assert.NoError(t, err)
+		size += len(by) + len(proto.EncodeVarint(uint64(len(by))))
+	}
+	return size
+}
+
+// verifySealedBlockfiles verifies that all the sealed block files are compressed and the
+// sealed block files with the last block not greater than archivedTillBlockNum are archived
+func verifySealedBlockfiles(t *testing.T, mgr *blockfileMgr, numSealedFiles int, archivedTillBlockNum uint64) {
+	archiveDir := mgr.conf.getLedgerArchiveDir(mgr.ledgerID)
+	for fileNum := 0; fileNum < numSealedFiles; fileNum++ {
+		state, err := getSealedBlockfileState(mgr.rootDir, fileNum)
+		assert.NoError(t, err)
+		assert.True(t, state.compressed)
+		if archiveDir == "" {
+			assert.False(t, state.archived)
+			continue
+		}
+		val, err := mgr.db.Get(constructSealedBlockfileKey(fileNum))
+		assert.NoError(t, err)
+		lastBlockNum, _ := proto.DecodeVarint(val)
+		assert.Equal(t, lastBlockNum <= archivedTillBlockNum, state.archived, "block file [%d]", fileNum)
+		if state.archived {
+			_, err := os.Stat(filepath.Join(archiveDir, filepath.Base(deriveCompressedBlockfilePath(mgr.rootDir, fileNum))))
+			assert.NoError(t, err)
+		}
+	}
+	_, err := os.Stat(deriveBlockfilePath(mgr.rootDir, numSealedFiles))
+	assert.NoError(t, err)
+}
+
+func testRetrieveBlocks(t *testing.T, w *testBlockfileMgrWrapper, blocks []*common.Block) {
+	w.testGetBlockByHash(blocks)
+	w.testGetBlockByNumber(blocks, 0)
+	for _, block := range blocks {
+		txEnv, err := w.blockfileMgr.retrieveTransactionByID(txIDOf(t, block.Data.Data[0]))
+		assert.NoError(t, err)
+		assert.NotNil(t, txEnv)
+	}
+	itr, err := w.blockfileMgr.retrieveBlocks(0)
+	assert.NoError(t, err)
+	defer itr.Close()
+	for _, block := range blocks {
+		b, err := itr.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, block, b)
+	}
+}
+
+func txIDOf(t *testing.T, txEnvBytes []byte) string {
+	txid, err := extractTxID(txEnvBytes)
+	assert.NoError(t, err)
+	return txid
+}
@@ -9,7 +9,7 @@ package metadata
 // Variables defined by the Makefile and passed in with ldflags
 var Version string = "latest"
 var CommitSHA string = "development build"
-var BaseVersion string = "0.4.14"
+var BaseVersion string = "0.4.18"
 var BaseDockerLabel string = "org.hyperledger.fabric"
 var DockerNamespace string = "hyperledger"
 var BaseDockerNamespace string = "hyperledger"
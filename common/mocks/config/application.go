@@ -36,17 +36,19 @@ func (m *MockApplication) APIPolicyMapper() channelconfig.PolicyMapper {
 }
 
 type MockApplicationCapabilities struct {
-	SupportedRv                  error
-	ForbidDuplicateTXIdInBlockRv bool
-	ACLsRv                       bool
-	PrivateChannelDataRv         bool
-	CollectionUpgradeRv          bool
-	V1_1ValidationRv             bool
-	V1_2ValidationRv             bool
-	MetadataLifecycleRv          bool
-	KeyLevelEndorsementRv        bool
-	V1_3ValidationRv             bool
-	FabTokenRv                   bool
+	SupportedRv                     error
+	ForbidDuplicateTXIdInBlockRv    bool
+	ACLsRv                          bool
+	PrivateChannelDataRv            bool
+	CollectionUpgradeRv             bool
+	V1_1ValidationRv                bool
+	V1_2ValidationRv                bool
+	MetadataLifecycleRv             bool
+	KeyLevelEndorsementRv           bool
+	V1_3ValidationRv                bool
+	CollectionEndorsementPoliciesRv bool
+	MemberOnlyWriteRv               bool
+	FabTokenRv                      bool
 }
 
 func (mac *MockApplicationCapabilities) Supported() error {
@@ -89,6 +91,14 @@ func (mac *MockApplicationCapabilities) V1_3Validation() bool {
 	return mac.V1_3ValidationRv
 }
 
+func (mac *MockApplicationCapabilities) CollectionEndorsementPolicies() bool {
+	return mac.CollectionEndorsementPoliciesRv
+}
+
+func (mac *MockApplicationCapabilities) MemberOnlyWrite() bool {
+	return mac.MemberOnlyWriteRv
+}
+
 func (mac *MockApplicationCapabilities) FabToken() bool {
 	return mac.FabTokenRv
 }
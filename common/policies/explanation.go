@@ -0,0 +1,98 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+)
+
+// Explanation is the trace of the evaluation of a policy, or of an element
+// of a policy, against a set of signatures
+type Explanation struct {
+	// Name is the path of the policy, if the policy is a named policy of a channel
+	Name string `json:"name,omitempty"`
+	// Policy describes the policy, or the element of the policy, which was evaluated
+	Policy string `json:"policy"`
+	// Satisfied is whether the signature set satisfies the policy
+	Satisfied bool `json:"satisfied"`
+	// MatchedBy is the index, in the signature set, of the identity
+	// which satisfied the principal of a signed by element
+	MatchedBy *int `json:"matched_by,omitempty"`
+	// Required is the number of sub-policies which must be satisfied
+	Required int `json:"required,omitempty"`
+	// Missing is the number of additional sub-policies which must be
+	// satisfied for the policy to be satisfied
+	Missing int `json:"missing,omitempty"`
+	// Notes explains why identities of the signature set were not taken into account
+	Notes []string `json:"notes,omitempty"`
+	// SubPolicies are the explanations of the sub-policies of the policy
+	SubPolicies []*Explanation `json:"sub_policies,omitempty"`
+}
+
+// ExplainablePolicy is a Policy that can explain the outcome of its evaluation
+type ExplainablePolicy interface {
+	// Explain evaluates the policy against the given signature set, and
+	// returns the trace of the evaluation. The policy is satisfied
+	// if and only if Evaluate succeeds for the same signature set.
+	Explain(signatureSet []*cb.SignedData) *Explanation
+}
+
+// Explain evaluates the given policy against the given signature set and
+// returns the trace of the evaluation. Policies which can't explain their
+// outcome are described by the error returned by their evaluation.
+func Explain(policy Policy, signatureSet []*cb.SignedData) *Explanation {
+	if ep, ok := policy.(ExplainablePolicy); ok {
+		return ep.Explain(signatureSet)
+	}
+
+	explanation := &Explanation{Policy: fmt.Sprintf("%T", policy)}
+	if err := policy.Evaluate(signatureSet); err != nil {
+		explanation.Notes = []string{err.Error()}
+	} else {
+		explanation.Satisfied = true
+	}
+	return explanation
+}
+
+// String renders the explanation as an indented tree, one line per element of the policy
+func (e *Explanation) String() string {
+	var b bytes.Buffer
+	e.write(&b, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (e *Explanation) write(b *bytes.Buffer, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	b.WriteString(indent)
+	if e.Name != "" {
+		fmt.Fprintf(b, "%s: ", e.Name)
+	}
+	b.WriteString(e.Policy)
+	switch {
+	case e.Satisfied && e.MatchedBy != nil:
+		fmt.Fprintf(b, " is satisfied by identity %d", *e.MatchedBy)
+	case e.Satisfied:
+		b.WriteString(" is satisfied")
+	case e.Missing > 0:
+		fmt.Fprintf(b, " is not satisfied, %d more of the sub-policies must be satisfied", e.Missing)
+	default:
+		b.WriteString(" is not satisfied")
+	}
+	b.WriteString("\n")
+
+	for _, note := range e.Notes {
+		fmt.Fprintf(b, "%s  - %s\n", indent, note)
+	}
+	for _, sub := range e.SubPolicies {
+		sub.write(b, depth+1)
+	}
+}
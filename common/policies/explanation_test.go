@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policies
+
+import (
+	"testing"
+
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainUnexplainablePolicy(t *testing.T) {
+	explanation := Explain(acceptPolicy{}, nil)
+	assert.Equal(t, &Explanation{Policy: "policies.acceptPolicy", Satisfied: true}, explanation)
+
+	explanation = Explain(rejectPolicy("Foo"), nil)
+	assert.Equal(t, &Explanation{Policy: "policies.rejectPolicy", Notes: []string{"No such policy: 'Foo'"}}, explanation)
+}
+
+func TestExplainImplicitMeta(t *testing.T) {
+	managers := map[string]*ManagerImpl{
+		"Org1": {path: "Channel/Org1", policies: map[string]Policy{TestPolicyName: acceptPolicy{}}},
+		"Org2": {path: "Channel/Org2", policies: map[string]Policy{TestPolicyName: rejectPolicy(TestPolicyName)}},
+		"Org3": {path: "Channel/Org3", policies: map[string]Policy{}},
+	}
+	imp, err := newImplicitMetaPolicy(utils.MarshalOrPanic(&cb.ImplicitMetaPolicy{
+		Rule:      cb.ImplicitMetaPolicy_MAJORITY,
+		SubPolicy: TestPolicyName,
+	}), managers)
+	require.NoError(t, err)
+
+	explanation := imp.Explain(nil)
+	assert.Equal(t, "MAJORITY TestPolicyName", explanation.Policy)
+	assert.False(t, explanation.Satisfied)
+	assert.Equal(t, 2, explanation.Required)
+	assert.Equal(t, 1, explanation.Missing)
+	require.Len(t, explanation.SubPolicies, 3)
+	assert.Equal(t, "/Channel/Org1/TestPolicyName", explanation.SubPolicies[0].Name)
+	assert.True(t, explanation.SubPolicies[0].Satisfied)
+	assert.Equal(t, "/Channel/Org2/TestPolicyName", explanation.SubPolicies[1].Name)
+	assert.False(t, explanation.SubPolicies[1].Satisfied)
+	assert.Equal(t, "/Channel/Org3/TestPolicyName", explanation.SubPolicies[2].Name)
+	assert.False(t, explanation.SubPolicies[2].Satisfied)
+
+	assert.Equal(t, `MAJORITY TestPolicyName is not satisfied, 1 more of the sub-policies must be satisfied
+  /Channel/Org1/TestPolicyName: policies.acceptPolicy is satisfied
+  /Channel/Org2/TestPolicyName: policies.rejectPolicy is not satisfied
+    - No such policy: 'TestPolicyName'
+  /Channel/Org3/TestPolicyName: policies.rejectPolicy is not satisfied
+    - No such policy: 'TestPolicyName'`, explanation.String())
+}
+
+func TestExplanationString(t *testing.T) {
+	matchedBy := 1
+	explanation := &Explanation{
+		Name:   "/Channel/Application/Org1/Admins",
+		Policy: "OutOf(1)",
+		SubPolicies: []*Explanation{
+			{Policy: "SignedBy('Org1MSP.admin')", Satisfied: true, MatchedBy: &matchedBy},
+		},
+		Satisfied: true,
+	}
+	assert.Equal(t, `/Channel/Application/Org1/Admins: OutOf(1) is satisfied
+  SignedBy('Org1MSP.admin') is satisfied by identity 1`, explanation.String())
+}
@@ -9,6 +9,7 @@ package policies
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -18,6 +19,7 @@ import (
 type implicitMetaPolicy struct {
 	threshold   int
 	subPolicies []Policy
+	rule        cb.ImplicitMetaPolicy_Rule
 
 	// Only used for logging
 	managers      map[string]*ManagerImpl
@@ -58,6 +60,7 @@ func newImplicitMetaPolicy(data []byte, managers map[string]*ManagerImpl) (*impl
 	return &implicitMetaPolicy{
 		subPolicies:   subPolicies,
 		threshold:     threshold,
+		rule:          definition.Rule,
 		managers:      managers,
 		subPolicyName: definition.SubPolicy,
 	}, nil
@@ -99,3 +102,37 @@ func (imp *implicitMetaPolicy) Evaluate(signatureSet []*cb.SignedData) error {
 	}
 	return fmt.Errorf("Failed to reach implicit threshold of %d sub-policies, required %d remaining", imp.threshold, remaining)
 }
+
+// Explain evaluates all the sub-policies against the given signature set,
+// and returns the trace of their evaluation
+func (imp *implicitMetaPolicy) Explain(signatureSet []*cb.SignedData) *Explanation {
+	names := make([]string, 0, len(imp.managers))
+	for name := range imp.managers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	explanation := &Explanation{
+		Policy:   fmt.Sprintf("%s %s", imp.rule, imp.subPolicyName),
+		Required: imp.threshold,
+	}
+	satisfied := 0
+	for _, name := range names {
+		manager := imp.managers[name]
+		policy, _ := manager.GetPolicy(imp.subPolicyName)
+		subExplanation := Explain(policy, signatureSet)
+		if subExplanation.Name == "" {
+			subExplanation.Name = PathSeparator + manager.path + PathSeparator + imp.subPolicyName
+		}
+		if subExplanation.Satisfied {
+			satisfied++
+		}
+		explanation.SubPolicies = append(explanation.SubPolicies, subExplanation)
+	}
+
+	explanation.Satisfied = satisfied >= imp.threshold
+	if !explanation.Satisfied {
+		explanation.Missing = imp.threshold - satisfied
+	}
+	return explanation
+}
@@ -261,6 +261,13 @@ func (pl *policyLogger) Evaluate(signatureSet []*cb.SignedData) error {
 	return err
 }
 
+// Explain explains the evaluation of the policy, labeled with the name of the policy
+func (pl *policyLogger) Explain(signatureSet []*cb.SignedData) *Explanation {
+	explanation := Explain(pl.policy, signatureSet)
+	explanation.Name = pl.policyName
+	return explanation
+}
+
 // GetPolicy returns a policy and true if it was the policy requested, or false if it is the default reject policy
 func (pm *ManagerImpl) GetPolicy(id string) (Policy, bool) {
 	if id == "" {
@@ -0,0 +1,272 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package reload
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("reload")
+
+// ErrorResponse is the payload of the response to
+// an HTTP request to reload that failed
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// resource is something that is loaded from files,
+// and that is reloaded when these files change
+type resource struct {
+	name   string
+	paths  []string
+	reload func() error
+	digest []byte
+}
+
+// Reloader reloads the resources registered with it, either when the
+// files they are loaded from change, or when it is asked to reload them.
+// The files are polled at the configured interval; a zero interval
+// disables polling, and resources are then reloaded only on demand.
+type Reloader struct {
+	interval time.Duration
+
+	mutex     sync.Mutex
+	resources []*resource
+	stopChan  chan struct{}
+	doneChan  chan struct{}
+}
+
+// New creates a Reloader that polls the files
+// of its resources at the given interval
+func New(interval time.Duration) *Reloader {
+	return &Reloader{
+		interval: interval,
+	}
+}
+
+// Register registers a resource that is loaded from the given files or
+// directories. The given reload function is invoked whenever the contents
+// of one of them change.
+func (r *Reloader) Register(name string, reload func() error, paths ...string) error {
+	digest, err := computeDigest(paths)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("failed reading files of %s", name))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.resources = append(r.resources, &resource{
+		name:   name,
+		paths:  paths,
+		reload: reload,
+		digest: digest,
+	})
+	return nil
+}
+
+// Start starts polling the files of the registered resources
+func (r *Reloader) Start() {
+	if r.interval == 0 {
+		logger.Info("Polling of files for changes is disabled")
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.stopChan != nil {
+		return
+	}
+	r.stopChan = make(chan struct{})
+	r.doneChan = make(chan struct{})
+	go r.poll(r.stopChan, r.doneChan)
+}
+
+// Stop stops polling the files of the registered resources
+func (r *Reloader) Stop() {
+	r.mutex.Lock()
+	stopChan, doneChan := r.stopChan, r.doneChan
+	r.stopChan, r.doneChan = nil, nil
+	r.mutex.Unlock()
+
+	if stopChan == nil {
+		return
+	}
+	close(stopChan)
+	<-doneChan
+}
+
+func (r *Reloader) poll(stopChan, doneChan chan struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reloadChanged()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// reloadChanged reloads the resources whose files have changed
+func (r *Reloader) reloadChanged() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, res := range r.resources {
+		digest, err := computeDigest(res.paths)
+		if err != nil {
+			logger.Warningf("Failed reading files of %s: %s", res.name, err)
+			continue
+		}
+		if string(digest) == string(res.digest) {
+			continue
+		}
+		// The digest is recorded even if reloading fails, so that a
+		// resource whose files are being written isn't reloaded over and
+		// over again, but only once they change again.
+		res.digest = digest
+
+		logger.Infof("Files of %s changed, reloading it", res.name)
+		if err := res.reload(); err != nil {
+			logger.Errorf("Failed reloading %s: %s", res.name, err)
+		}
+	}
+}
+
+// Reload reloads all the registered resources, whether their files changed or not
+func (r *Reloader) Reload() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var failures []string
+	for _, res := range r.resources {
+		if digest, err := computeDigest(res.paths); err == nil {
+			res.digest = digest
+		}
+
+		logger.Infof("Reloading %s", res.name)
+		if err := res.reload(); err != nil {
+			logger.Errorf("Failed reloading %s: %s", res.name, err)
+			failures = append(failures, fmt.Sprintf("failed reloading %s: %s", res.name, err))
+		}
+	}
+
+	if len(failures) != 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// ServeHTTP reloads all the registered resources upon a PUT request
+func (r *Reloader) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPut {
+		sendResponse(resp, http.StatusBadRequest, fmt.Errorf("invalid request method: %s", req.Method))
+		return
+	}
+
+	if err := r.Reload(); err != nil {
+		sendResponse(resp, http.StatusInternalServerError, err)
+		return
+	}
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func sendResponse(resp http.ResponseWriter, code int, err error) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+
+	if err := json.NewEncoder(resp).Encode(&ErrorResponse{Error: err.Error()}); err != nil {
+		logger.Errorw("failed to encode payload", "error", err)
+	}
+}
+
+// computeDigest computes a digest over the names and the contents of the given
+// files, and of the files in the given directories. Paths that don't exist
+// contribute only their name to the digest.
+func computeDigest(paths []string) ([]byte, error) {
+	h := sha256.New()
+	for _, path := range paths {
+		if err := hashPath(h, path); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+// hashPath writes into the given hash the name and the contents of the given
+// file, or of the files in the given directory. Symbolic links are followed,
+// as credentials are often mounted through them.
+func hashPath(h hash.Hash, path string) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		fmt.Fprintf(h, "%s\x00missing\x00", path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		fmt.Fprintf(h, "%s\x00", path)
+		return hashFile(h, path)
+	}
+
+	names, err := readDirNames(path)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := hashPath(h, filepath.Join(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func hashFile(h hash.Hash, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(h, f)
+	return err
+}
@@ -0,0 +1,146 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package reload
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadOnChange(t *testing.T) {
+	gt := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "reload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certDir := filepath.Join(dir, "signcerts")
+	assert.NoError(t, os.Mkdir(certDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(certDir, "cert.pem"), []byte("cert"), 0644))
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("key"), 0600))
+
+	var mspReloads, tlsReloads int32
+	r := New(10 * time.Millisecond)
+	err = r.Register("msp", func() error {
+		atomic.AddInt32(&mspReloads, 1)
+		return nil
+	}, certDir)
+	assert.NoError(t, err)
+	err = r.Register("tls", func() error {
+		atomic.AddInt32(&tlsReloads, 1)
+		return errors.New("bad key")
+	}, keyFile, filepath.Join(dir, "missing.pem"))
+	assert.NoError(t, err)
+
+	r.Start()
+	defer r.Stop()
+
+	// Nothing changed, so nothing is reloaded
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&mspReloads))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tlsReloads))
+
+	// A file is added to a directory
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(certDir, "cert2.pem"), []byte("cert2"), 0644))
+	gt.Eventually(func() int32 { return atomic.LoadInt32(&mspReloads) }, time.Second, 10*time.Millisecond).Should(Equal(int32(1)))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&tlsReloads))
+
+	// A file is modified, and reloading fails
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("new key"), 0600))
+	gt.Eventually(func() int32 { return atomic.LoadInt32(&tlsReloads) }, time.Second, 10*time.Millisecond).Should(Equal(int32(1)))
+
+	// A failed reload isn't retried until the files change again
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tlsReloads))
+
+	// A missing file is created
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "missing.pem"), []byte("cert"), 0644))
+	gt.Eventually(func() int32 { return atomic.LoadInt32(&tlsReloads) }, time.Second, 10*time.Millisecond).Should(Equal(int32(2)))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&mspReloads))
+
+	r.Stop()
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("newer key"), 0600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&tlsReloads))
+}
+
+func TestReloadDisabledPolling(t *testing.T) {
+	f, err := ioutil.TempFile("", "reload")
+	assert.NoError(t, err)
+	f.Close()
+	defer os.Remove(f.Name())
+
+	var reloads int32
+	r := New(0)
+	err = r.Register("tls", func() error {
+		atomic.AddInt32(&reloads, 1)
+		return nil
+	}, f.Name())
+	assert.NoError(t, err)
+	r.Start()
+	defer r.Stop()
+
+	assert.NoError(t, ioutil.WriteFile(f.Name(), []byte("cert"), 0644))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&reloads))
+
+	assert.NoError(t, r.Reload())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reloads))
+}
+
+func TestReload(t *testing.T) {
+	var mspReloads, tlsReloads int32
+	r := New(0)
+	r.Register("msp", func() error {
+		atomic.AddInt32(&mspReloads, 1)
+		return errors.New("no signing identity")
+	})
+	r.Register("tls", func() error {
+		atomic.AddInt32(&tlsReloads, 1)
+		return errors.New("bad key")
+	})
+
+	err := r.Reload()
+	assert.EqualError(t, err, "failed reloading msp: no signing identity; failed reloading tls: bad key")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&mspReloads))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tlsReloads))
+}
+
+func TestServeHTTP(t *testing.T) {
+	var reloadErr error
+	r := New(0)
+	r.Register("tls", func() error {
+		return reloadErr
+	})
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/credentials/reload", nil))
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/credentials/reload", nil))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.JSONEq(t, `{"error": "invalid request method: GET"}`, resp.Body.String())
+
+	reloadErr = errors.New("bad key")
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/credentials/reload", nil))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error": "failed reloading tls: bad key"}`, resp.Body.String())
+}
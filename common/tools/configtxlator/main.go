@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -17,6 +18,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/metadata"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/policydsl"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/rest"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
 	"github.com/hyperledger/fabric/common/tools/protolator"
@@ -50,6 +52,7 @@ var (
 	protoDecodeType   = protoDecode.Flag("type", "The type of protobuf structure to decode from.  For example, 'common.Config'.").Required().String()
 	protoDecodeSource = protoDecode.Flag("input", "A file containing the proto message.").Default(os.Stdin.Name()).File()
 	protoDecodeDest   = protoDecode.Flag("output", "A file to write the JSON document to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	protoDecodeDSL    = protoDecode.Flag("policy_dsl", "Represent the signature policies in the policy language, for example \"AND('Org1.member', 'Org2.member')\".").Bool()
 
 	computeUpdate          = app.Command("compute_update", "Takes two marshaled common.Config messages and computes the config update which transitions between the two.")
 	computeUpdateOriginal  = computeUpdate.Flag("original", "The original config message.").File()
@@ -79,7 +82,7 @@ func main() {
 	case protoDecode.FullCommand():
 		defer (*protoDecodeSource).Close()
 		defer (*protoDecodeDest).Close()
-		err := decodeProto(*protoDecodeType, *protoDecodeSource, *protoDecodeDest)
+		err := decodeProto(*protoDecodeType, *protoDecodeSource, *protoDecodeDest, *protoDecodeDSL)
 		if err != nil {
 			app.Fatalf("Error decoding: %s", err)
 		}
@@ -133,7 +136,17 @@ func encodeProto(msgName string, input, output *os.File) error {
 	}
 	msg := reflect.New(msgType.Elem()).Interface().(proto.Message)
 
-	err := protolator.DeepUnmarshalJSON(input, msg)
+	in, err := ioutil.ReadAll(input)
+	if err != nil {
+		return errors.Wrapf(err, "error reading input")
+	}
+
+	in, err = policydsl.FromDSL(in)
+	if err != nil {
+		return errors.Wrapf(err, "error decoding input")
+	}
+
+	err = protolator.DeepUnmarshalJSON(bytes.NewReader(in), msg)
 	if err != nil {
 		return errors.Wrapf(err, "error decoding input")
 	}
@@ -151,7 +164,7 @@ func encodeProto(msgName string, input, output *os.File) error {
 	return nil
 }
 
-func decodeProto(msgName string, input, output *os.File) error {
+func decodeProto(msgName string, input, output *os.File, policyDSL bool) error {
 	msgType := proto.MessageType(msgName)
 	if msgType == nil {
 		return errors.Errorf("message of type %s unknown", msgType)
@@ -168,11 +181,25 @@ func decodeProto(msgName string, input, output *os.File) error {
 		return errors.Wrapf(err, "error unmarshaling")
 	}
 
-	err = protolator.DeepMarshalJSON(output, msg)
+	var buffer bytes.Buffer
+	err = protolator.DeepMarshalJSON(&buffer, msg)
 	if err != nil {
 		return errors.Wrapf(err, "error encoding output")
 	}
 
+	out := buffer.Bytes()
+	if policyDSL {
+		out, err = policydsl.ToDSL(out)
+		if err != nil {
+			return errors.Wrapf(err, "error encoding output")
+		}
+	}
+
+	_, err = output.Write(out)
+	if err != nil {
+		return errors.Wrapf(err, "error writing output")
+	}
+
 	return nil
 }
 
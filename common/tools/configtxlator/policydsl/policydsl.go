@@ -0,0 +1,158 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policydsl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// ToDSL replaces, in the given JSON document produced by protolator, the values
+// of the signature policies with their representation in the policy language,
+// such as "AND('Org1.member', 'Org2.member')". Only the policies which are
+// parsed back to an identical policy are replaced, so that encoding the
+// document with FromDSL produces the same messages.
+func ToDSL(doc []byte) ([]byte, error) {
+	tree, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := false
+	walkPolicies(tree, func(policy map[string]interface{}) error {
+		value, ok := policy["value"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil
+		}
+		envelope := &cb.SignaturePolicyEnvelope{}
+		if err := protolator.DeepUnmarshalJSON(bytes.NewReader(valueJSON), envelope); err != nil {
+			return nil
+		}
+		s, err := cauthdsl.ToString(envelope)
+		if err != nil {
+			return nil
+		}
+		if parsed, err := cauthdsl.FromString(s); err != nil || !proto.Equal(parsed, envelope) {
+			return nil
+		}
+		policy["value"] = s
+		replaced = true
+		return nil
+	})
+	if !replaced {
+		return doc, nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(tree); err != nil {
+		return nil, errors.Wrap(err, "error encoding JSON document")
+	}
+	return buffer.Bytes(), nil
+}
+
+// FromDSL replaces, in the given JSON document, the values of the signature
+// policies which are represented in the policy language with the JSON
+// representation of the policies, so that protolator can encode the document
+func FromDSL(doc []byte) ([]byte, error) {
+	tree, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	replaced := false
+	err = walkPolicies(tree, func(policy map[string]interface{}) error {
+		s, ok := policy["value"].(string)
+		if !ok {
+			return nil
+		}
+		envelope, err := cauthdsl.FromString(s)
+		if err != nil {
+			return errors.WithMessage(err, "error parsing policy "+s)
+		}
+		var buffer bytes.Buffer
+		if err := protolator.DeepMarshalJSON(&buffer, envelope); err != nil {
+			return errors.WithMessage(err, "error encoding policy "+s)
+		}
+		value, err := decode(buffer.Bytes())
+		if err != nil {
+			return err
+		}
+		policy["value"] = value
+		replaced = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !replaced {
+		return doc, nil
+	}
+
+	encoded, err := json.Marshal(tree)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding JSON document")
+	}
+	return encoded, nil
+}
+
+func decode(doc []byte) (interface{}, error) {
+	var tree interface{}
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, errors.Wrap(err, "error decoding JSON document")
+	}
+	return tree, nil
+}
+
+// walkPolicies calls f on every object of the given JSON tree
+// which is a common.Policy of type SIGNATURE
+func walkPolicies(tree interface{}, f func(policy map[string]interface{}) error) error {
+	switch node := tree.(type) {
+	case map[string]interface{}:
+		if isSignaturePolicy(node) {
+			return f(node)
+		}
+		for _, child := range node {
+			if err := walkPolicies(child, f); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, child := range node {
+			if err := walkPolicies(child, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isSignaturePolicy(node map[string]interface{}) bool {
+	if len(node) != 2 {
+		return false
+	}
+	policyType, ok := node["type"].(json.Number)
+	if !ok || policyType.String() != strconv.Itoa(int(cb.Policy_SIGNATURE)) {
+		return false
+	}
+	_, ok = node["value"]
+	return ok
+}
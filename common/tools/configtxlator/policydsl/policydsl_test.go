@@ -0,0 +1,89 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package policydsl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleConfig(t *testing.T) []byte {
+	channelGroup, err := encoder.NewChannelGroup(configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile))
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, protolator.DeepMarshalJSON(&buffer, &cb.Config{ChannelGroup: channelGroup}))
+	return buffer.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	doc := sampleConfig(t)
+	config := &cb.Config{}
+	require.NoError(t, protolator.DeepUnmarshalJSON(bytes.NewReader(doc), config))
+
+	dsl, err := ToDSL(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(dsl), `"value": "OR('SampleOrg.member')"`)
+	assert.NotContains(t, string(dsl), `"signed_by"`)
+
+	encoded, err := FromDSL(dsl)
+	require.NoError(t, err)
+	decoded := &cb.Config{}
+	require.NoError(t, protolator.DeepUnmarshalJSON(bytes.NewReader(encoded), decoded))
+	assert.True(t, proto.Equal(config, decoded))
+}
+
+func TestUnchangedDocuments(t *testing.T) {
+	doc := sampleConfig(t)
+
+	// Without policies in the policy language there is nothing to replace
+	encoded, err := FromDSL(doc)
+	require.NoError(t, err)
+	assert.Equal(t, doc, encoded)
+
+	// Without signature policies there is nothing to replace either
+	var buffer bytes.Buffer
+	require.NoError(t, protolator.DeepMarshalJSON(&buffer, &cb.Block{Header: &cb.BlockHeader{Number: 1}}))
+	dsl, err := ToDSL(buffer.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, buffer.Bytes(), dsl)
+}
+
+func TestPoliciesWithoutRepresentation(t *testing.T) {
+	// The principals are in a different order than the parser would produce
+	envelope := cauthdsl.SignedByAnyMember([]string{"Org1", "Org2"})
+	envelope.Rule = cauthdsl.NOutOf(1, []*cb.SignaturePolicy{cauthdsl.SignedBy(1), cauthdsl.SignedBy(0)})
+	policy := &cb.Policy{Type: int32(cb.Policy_SIGNATURE), Value: utils.MarshalOrPanic(envelope)}
+
+	var buffer bytes.Buffer
+	require.NoError(t, protolator.DeepMarshalJSON(&buffer, policy))
+	dsl, err := ToDSL(buffer.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, buffer.Bytes(), dsl)
+}
+
+func TestErrors(t *testing.T) {
+	_, err := ToDSL([]byte("garbage"))
+	assert.Contains(t, err.Error(), "error decoding JSON document")
+
+	_, err = FromDSL([]byte("garbage"))
+	assert.Contains(t, err.Error(), "error decoding JSON document")
+
+	_, err = FromDSL([]byte(`{"policy": {"type": 1, "value": "AND('Org1.member'"}}`))
+	assert.Contains(t, err.Error(), "error parsing policy AND('Org1.member'")
+}
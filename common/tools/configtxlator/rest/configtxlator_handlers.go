@@ -23,6 +23,9 @@ import (
 	"net/http"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/sanitycheck"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -122,3 +125,71 @@ func SanityCheckConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write(resBytes)
 }
+
+// ExplainPolicy evaluates a policy against a set of signed data, and returns the
+// trace of the evaluation. The policy is either the policy of the channel config
+// at the path given by the 'policy' field, or the signature policy in the
+// 'signature_policy' field, whose principals are evaluated by the MSPs of the
+// channel config. The signed data is a JSON array of objects with the base64
+// encoded 'data', 'identity' and 'signature' of each signature.
+func ExplainPolicy(w http.ResponseWriter, r *http.Request) {
+	config, err := fieldConfigProto("config", r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'config': %s\n", err)
+		return
+	}
+
+	signedDataBytes, err := fieldBytes("signed_data", r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error with field 'signed_data': %s\n", err)
+		return
+	}
+	var signedData []*cb.SignedData
+	if err := json.Unmarshal(signedDataBytes, &signedData); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error unmarshaling field 'signed_data': %s\n", err)
+		return
+	}
+
+	bundle, err := channelconfig.NewBundle(r.FormValue("channel"), config)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "Error processing config: %s\n", err)
+		return
+	}
+
+	var policy policies.Policy
+	if policyName := r.FormValue("policy"); policyName != "" {
+		var ok bool
+		policy, ok = bundle.PolicyManager().GetPolicy(policyName)
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error with field 'policy': policy %s not found in config\n", policyName)
+			return
+		}
+	} else {
+		policyBytes, err := fieldBytes("signature_policy", r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Either field 'policy' or field 'signature_policy' is required: %s\n", err)
+			return
+		}
+		policy, _, err = cauthdsl.NewPolicyProvider(bundle.MSPManager()).NewPolicy(policyBytes)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "Error with field 'signature_policy': %s\n", err)
+			return
+		}
+	}
+
+	resBytes, err := json.Marshal(policies.Explain(policy, signedData))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "Error marshaling result to JSON: %s\n", err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(resBytes)
+}
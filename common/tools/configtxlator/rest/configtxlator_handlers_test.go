@@ -24,10 +24,18 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/policies"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/common/tools/configtxlator/sanitycheck"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProtolatorComputeConfigUpdate(t *testing.T) {
@@ -181,3 +189,113 @@ func TestConfigtxlatorSanityCheckMalformedConfig(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
+
+func explainPolicyRequest(t *testing.T, files map[string][]byte, values map[string]string) *httptest.ResponseRecorder {
+	buffer := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buffer)
+	for name, content := range files {
+		ffw, err := mpw.CreateFormFile(name, name)
+		require.NoError(t, err)
+		_, err = ffw.Write(content)
+		require.NoError(t, err)
+	}
+	for name, value := range values {
+		require.NoError(t, mpw.WriteField(name, value))
+	}
+	require.NoError(t, mpw.Close())
+
+	req, err := http.NewRequest("POST", "/configtxlator/policy/explain", buffer)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", mpw.FormDataContentType())
+
+	rec := httptest.NewRecorder()
+	NewRouter().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConfigtxlatorExplainPolicy(t *testing.T) {
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+	signer := mspmgmt.GetLocalSigningIdentityOrPanic()
+	identity, err := signer.Serialize()
+	require.NoError(t, err)
+	signature, err := signer.Sign([]byte("data"))
+	require.NoError(t, err)
+	signedData, err := json.Marshal([]*cb.SignedData{{Data: []byte("data"), Identity: identity, Signature: signature}})
+	require.NoError(t, err)
+
+	channelGroup, err := encoder.NewChannelGroup(configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile))
+	require.NoError(t, err)
+	config := utils.MarshalOrPanic(&cb.Config{ChannelGroup: channelGroup})
+
+	t.Run("ConfigPolicy", func(t *testing.T) {
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"config": config, "signed_data": signedData},
+			map[string]string{"channel": "testchannel", "policy": "/Channel/Application/Writers"},
+		)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		explanation := &policies.Explanation{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), explanation))
+		assert.Equal(t, "/Channel/Application/Writers", explanation.Name)
+		assert.Equal(t, "ANY Writers", explanation.Policy)
+		assert.True(t, explanation.Satisfied)
+		require.Len(t, explanation.SubPolicies, 1)
+		assert.Equal(t, "/Channel/Application/SampleOrg/Writers", explanation.SubPolicies[0].Name)
+	})
+
+	t.Run("SignaturePolicy", func(t *testing.T) {
+		policy, err := cauthdsl.FromString("AND('SampleOrg.member', 'OtherOrg.member')")
+		require.NoError(t, err)
+
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"config": config, "signed_data": signedData, "signature_policy": utils.MarshalOrPanic(policy)},
+			map[string]string{"channel": "testchannel"},
+		)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		explanation := &policies.Explanation{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), explanation))
+		assert.False(t, explanation.Satisfied)
+		assert.Equal(t, 1, explanation.Missing)
+		require.Len(t, explanation.SubPolicies, 2)
+		assert.True(t, explanation.SubPolicies[0].Satisfied)
+		assert.Equal(t, "SignedBy('OtherOrg.member')", explanation.SubPolicies[1].Policy)
+		assert.False(t, explanation.SubPolicies[1].Satisfied)
+	})
+
+	t.Run("MissingPolicy", func(t *testing.T) {
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"config": config, "signed_data": signedData},
+			map[string]string{"channel": "testchannel"},
+		)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Either field 'policy' or field 'signature_policy' is required")
+	})
+
+	t.Run("UnknownPolicy", func(t *testing.T) {
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"config": config, "signed_data": signedData},
+			map[string]string{"channel": "testchannel", "policy": "/Channel/Application/Foo"},
+		)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "Error with field 'policy': policy /Channel/Application/Foo not found in config\n", rec.Body.String())
+	})
+
+	t.Run("MalformedSignedData", func(t *testing.T) {
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"config": config, "signed_data": []byte("Garbage")},
+			map[string]string{"channel": "testchannel", "policy": "/Channel/Application/Writers"},
+		)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Error unmarshaling field 'signed_data'")
+	})
+
+	t.Run("MissingConfig", func(t *testing.T) {
+		rec := explainPolicyRequest(t,
+			map[string][]byte{"signed_data": signedData},
+			map[string]string{"channel": "testchannel", "policy": "/Channel/Application/Writers"},
+		)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Error with field 'config'")
+	})
+}
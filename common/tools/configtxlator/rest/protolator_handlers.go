@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/gorilla/mux"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/policydsl"
 	"github.com/hyperledger/fabric/common/tools/protolator"
 )
 
@@ -69,9 +70,19 @@ func Decode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	out := buffer.Bytes()
+	if r.URL.Query().Get("policy_dsl") == "true" {
+		out, err = policydsl.ToDSL(out)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, err)
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	buffer.WriteTo(w)
+	w.Write(out)
 }
 
 func Encode(w http.ResponseWriter, r *http.Request) {
@@ -82,7 +93,21 @@ func Encode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = protolator.DeepUnmarshalJSON(r.Body, msg)
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	buf, err = policydsl.FromDSL(buf)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	err = protolator.DeepUnmarshalJSON(bytes.NewReader(buf), msg)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		fmt.Fprintln(w, err)
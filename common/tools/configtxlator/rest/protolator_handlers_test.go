@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
@@ -132,3 +133,43 @@ func TestProtolatorEncodeBadData(t *testing.T) {
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
+
+func TestProtolatorPolicyDSL(t *testing.T) {
+	policy := &cb.Policy{
+		Type:  int32(cb.Policy_SIGNATURE),
+		Value: utils.MarshalOrPanic(cauthdsl.SignedByAnyMember([]string{"Org1", "Org2"})),
+	}
+	data, err := proto.Marshal(policy)
+	assert.NoError(t, err)
+
+	url := fmt.Sprintf("/protolator/decode/%s?policy_dsl=true", proto.MessageName(policy))
+	req, _ := http.NewRequest("POST", url, bytes.NewReader(data))
+	rec := httptest.NewRecorder()
+	r := NewRouter()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"value": "OR('Org1.member', 'Org2.member')"`)
+
+	url = fmt.Sprintf("/protolator/encode/%s", proto.MessageName(policy))
+	req, _ = http.NewRequest("POST", url, bytes.NewReader(rec.Body.Bytes()))
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	outputMsg := &cb.Policy{}
+	err = proto.Unmarshal(rec.Body.Bytes(), outputMsg)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(policy, outputMsg))
+}
+
+func TestProtolatorEncodeBadPolicyDSL(t *testing.T) {
+	url := fmt.Sprintf("/protolator/encode/%s", proto.MessageName(&cb.Policy{}))
+
+	req, _ := http.NewRequest("POST", url, bytes.NewReader([]byte(`{"type": 1, "value": "AND("}`)))
+	rec := httptest.NewRecorder()
+	r := NewRouter()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
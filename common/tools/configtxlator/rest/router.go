@@ -35,6 +35,9 @@ func NewRouter() *mux.Router {
 	router.
 		HandleFunc("/configtxlator/config/verify", SanityCheckConfig).
 		Methods("POST")
+	router.
+		HandleFunc("/configtxlator/policy/explain", ExplainPolicy).
+		Methods("POST")
 
 	return router
 }
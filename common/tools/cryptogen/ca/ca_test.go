@@ -7,6 +7,7 @@ package ca_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"net"
 	"os"
@@ -167,6 +168,28 @@ func TestGenerateSignCertificate(t *testing.T) {
 
 }
 
+func TestNewEd25519CA(t *testing.T) {
+	caDir := filepath.Join(testDir, "ca")
+	certDir := filepath.Join(testDir, "certs")
+	rootCA, err := ca.NewEd25519CA(caDir, testCAName, testCAName, testCountry, testProvince, testLocality, testOrganizationalUnit, testStreetAddress, testPostalCode)
+	assert.NoError(t, err, "Error generating CA")
+	assert.IsType(t, ed25519.PublicKey{}, rootCA.SignCert.PublicKey)
+	assert.Equal(t, x509.PureEd25519, rootCA.SignCert.SignatureAlgorithm)
+
+	// keys generated by the CA are of the same type as its own
+	priv, _, err := rootCA.GeneratePrivateKey(certDir)
+	assert.NoError(t, err, "Failed to generate private key")
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key from private key")
+	assert.IsType(t, ed25519.PublicKey{}, pubKey)
+
+	cert, err := rootCA.SignCertificate(certDir, testName, nil, nil, pubKey,
+		x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{x509.ExtKeyUsageAny})
+	assert.NoError(t, err, "Failed to generate signed certificate")
+	assert.NoError(t, cert.CheckSignatureFrom(rootCA.SignCert))
+	cleanup(testDir)
+}
+
 func cleanup(dir string) {
 	os.RemoveAll(dir)
 }
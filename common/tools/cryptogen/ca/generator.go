@@ -7,7 +7,7 @@ package ca
 
 import (
 	"crypto"
-	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/utils"
 	"github.com/hyperledger/fabric/common/tools/cryptogen/csp"
 )
@@ -40,17 +41,28 @@ type CA struct {
 // NewCA creates an instance of CA and saves the signing key pair in
 // baseDir/name
 func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode string) (*CA, error) {
+	return newCA(csp.GeneratePrivateKey, baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode)
+}
+
+// NewEd25519CA creates an instance of CA with an Ed25519 signing key,
+// and saves the signing key pair in baseDir/name
+func NewEd25519CA(baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode string) (*CA, error) {
+	return newCA(csp.GenerateEd25519PrivateKey, baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode)
+}
+
+func newCA(generatePrivateKey func(string) (bccsp.Key, crypto.Signer, error),
+	baseDir, org, name, country, province, locality, orgUnit, streetAddress, postalCode string) (*CA, error) {
 
 	var response error
 	var ca *CA
 
 	err := os.MkdirAll(baseDir, 0755)
 	if err == nil {
-		priv, signer, err := csp.GeneratePrivateKey(baseDir)
+		priv, signer, err := generatePrivateKey(baseDir)
 		response = err
 		if err == nil {
 			// get public signing certificate
-			ecPubKey, err := csp.GetECPublicKey(priv)
+			pubKey, err := csp.GetPublicKey(priv)
 			response = err
 			if err == nil {
 				template := x509Template()
@@ -72,8 +84,8 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 				template.Subject = subject
 				template.SubjectKeyId = priv.SKI()
 
-				x509Cert, err := genCertificateECDSA(baseDir, name, &template, &template,
-					ecPubKey, signer)
+				x509Cert, err := genCertificate(baseDir, name, &template, &template,
+					pubKey, signer)
 				response = err
 				if err == nil {
 					ca = &CA{
@@ -94,9 +106,20 @@ func NewCA(baseDir, org, name, country, province, locality, orgUnit, streetAddre
 	return ca, response
 }
 
+// GeneratePrivateKey creates a private key of the same type as the signing key
+// of the CA, and stores it in keystorePath
+func (ca *CA) GeneratePrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
+	if ca.SignCert != nil {
+		if _, isEd25519 := ca.SignCert.PublicKey.(ed25519.PublicKey); isEd25519 {
+			return csp.GenerateEd25519PrivateKey(keystorePath)
+		}
+	}
+	return csp.GeneratePrivateKey(keystorePath)
+}
+
 // SignCertificate creates a signed certificate based on a built-in template
 // and saves it in baseDir/name
-func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecdsa.PublicKey,
+func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub crypto.PublicKey,
 	ku x509.KeyUsage, eku []x509.ExtKeyUsage) (*x509.Certificate, error) {
 
 	template := x509Template()
@@ -120,7 +143,7 @@ func (ca *CA) SignCertificate(baseDir, name string, ous, sans []string, pub *ecd
 		}
 	}
 
-	cert, err := genCertificateECDSA(baseDir, name, &template, ca.SignCert,
+	cert, err := genCertificate(baseDir, name, &template, ca.SignCert,
 		pub, ca.Signer)
 
 	if err != nil {
@@ -187,8 +210,8 @@ func x509Template() x509.Certificate {
 
 }
 
-// generate a signed X509 certificate using ECDSA
-func genCertificateECDSA(baseDir, name string, template, parent *x509.Certificate, pub *ecdsa.PublicKey,
+// generate a signed X509 certificate using ECDSA or Ed25519
+func genCertificate(baseDir, name string, template, parent *x509.Certificate, pub crypto.PublicKey,
 	priv interface{}) (*x509.Certificate, error) {
 
 	//create the x509 public cert
@@ -8,6 +8,7 @@ package csp
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/pem"
 	"io/ioutil"
@@ -18,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/bccsp/signer"
+	"github.com/hyperledger/fabric/bccsp/utils"
 	"github.com/pkg/errors"
 )
 
@@ -55,7 +57,13 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 			if block == nil {
 				return errors.Errorf("%s: wrong PEM encoding", path)
 			}
-			priv, err = csp.KeyImport(block.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+			var importOpts bccsp.KeyImportOpts = &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true}
+			if key, err := utils.DERToPrivateKey(block.Bytes); err == nil {
+				if _, isEd25519 := key.(ed25519.PrivateKey); isEd25519 {
+					importOpts = &bccsp.ED25519PrivateKeyImportOpts{Temporary: true}
+				}
+			}
+			priv, err = csp.KeyImport(block.Bytes, importOpts)
 			if err != nil {
 				return err
 			}
@@ -81,6 +89,17 @@ func LoadPrivateKey(keystorePath string) (bccsp.Key, crypto.Signer, error) {
 // GeneratePrivateKey creates a private key and stores it in keystorePath
 func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	crypto.Signer, error) {
+	return generatePrivateKey(keystorePath, &bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+}
+
+// GenerateEd25519PrivateKey creates an Ed25519 private key and stores it in keystorePath
+func GenerateEd25519PrivateKey(keystorePath string) (bccsp.Key,
+	crypto.Signer, error) {
+	return generatePrivateKey(keystorePath, &bccsp.ED25519KeyGenOpts{Temporary: false})
+}
+
+func generatePrivateKey(keystorePath string, keyGenOpts bccsp.KeyGenOpts) (bccsp.Key,
+	crypto.Signer, error) {
 
 	var err error
 	var priv bccsp.Key
@@ -100,7 +119,7 @@ func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 	csp, err := factory.GetBCCSPFromOpts(opts)
 	if err == nil {
 		// generate a key
-		priv, err = csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+		priv, err = csp.KeyGen(keyGenOpts)
 		if err == nil {
 			// create a crypto.Signer
 			s, err = signer.New(csp, priv)
@@ -110,6 +129,20 @@ func GeneratePrivateKey(keystorePath string) (bccsp.Key,
 }
 
 func GetECPublicKey(priv bccsp.Key) (*ecdsa.PublicKey, error) {
+	pubKey, err := GetPublicKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	ecPubKey, ok := pubKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an ECDSA public key")
+	}
+	return ecPubKey, nil
+}
+
+// GetPublicKey returns the public key of the given private key,
+// as an *ecdsa.PublicKey or an ed25519.PublicKey
+func GetPublicKey(priv bccsp.Key) (crypto.PublicKey, error) {
 
 	// get the public key
 	pubKey, err := priv.PublicKey()
@@ -122,9 +155,5 @@ func GetECPublicKey(priv bccsp.Key) (*ecdsa.PublicKey, error) {
 		return nil, err
 	}
 	// unmarshal using pkix
-	ecPubKey, err := x509.ParsePKIXPublicKey(pubKeyBytes)
-	if err != nil {
-		return nil, err
-	}
-	return ecPubKey.(*ecdsa.PublicKey), nil
+	return x509.ParsePKIXPublicKey(pubKeyBytes)
 }
@@ -7,6 +7,7 @@ package csp_test
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"encoding/hex"
 	"errors"
 	"os"
@@ -94,6 +95,26 @@ func TestGeneratePrivateKey(t *testing.T) {
 
 }
 
+func TestGenerateEd25519PrivateKey(t *testing.T) {
+	priv, signer, err := csp.GenerateEd25519PrivateKey(testDir)
+	assert.NoError(t, err, "Failed to generate private key")
+	assert.Equal(t, true, priv.Private(), "Failed to return private key")
+	assert.IsType(t, ed25519.PublicKey{}, signer.Public())
+
+	pubKey, err := csp.GetPublicKey(priv)
+	assert.NoError(t, err, "Failed to get public key from private key")
+	assert.Equal(t, signer.Public(), pubKey)
+
+	loadedPriv, loadedSigner, err := csp.LoadPrivateKey(testDir)
+	assert.NoError(t, err, "Failed to load private key")
+	assert.Equal(t, priv.SKI(), loadedPriv.SKI(), "Should have same subject identifier")
+	assert.Equal(t, signer.Public(), loadedSigner.Public())
+
+	_, err = csp.GetECPublicKey(priv)
+	assert.Error(t, err, "Expected an error with an Ed25519 key")
+	cleanup(testDir)
+}
+
 func TestGetECPublicKey(t *testing.T) {
 
 	priv, _, err := csp.GeneratePrivateKey(testDir)
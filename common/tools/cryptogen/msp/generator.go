@@ -59,14 +59,14 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 	// get keystore path
 	keystore := filepath.Join(mspDir, "keystore")
 
-	// generate private key
-	priv, _, err := csp.GeneratePrivateKey(keystore)
+	// generate private key of the same type as the key of the signing CA
+	priv, _, err := signCA.GeneratePrivateKey(keystore)
 	if err != nil {
 		return err
 	}
 
 	// get public key
-	ecPubKey, err := csp.GetECPublicKey(priv)
+	pubKey, err := csp.GetPublicKey(priv)
 	if err != nil {
 		return err
 	}
@@ -76,7 +76,7 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 		ous = []string{nodeOUMap[nodeType]}
 	}
 	cert, err := signCA.SignCertificate(filepath.Join(mspDir, "signcerts"),
-		name, ous, nil, ecPubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
+		name, ous, nil, pubKey, x509.KeyUsageDigitalSignature, []x509.ExtKeyUsage{})
 	if err != nil {
 		return err
 	}
@@ -115,13 +115,13 @@ func GenerateLocalMSP(baseDir, name string, sans []string, signCA *ca.CA,
 		Generate the TLS artifacts in the TLS folder
 	*/
 
-	// generate private key
-	tlsPrivKey, _, err := csp.GeneratePrivateKey(tlsDir)
+	// generate private key of the same type as the key of the TLS CA
+	tlsPrivKey, _, err := tlsCA.GeneratePrivateKey(tlsDir)
 	if err != nil {
 		return err
 	}
 	// get public key
-	tlsPubKey, err := csp.GetECPublicKey(tlsPrivKey)
+	tlsPubKey, err := csp.GetPublicKey(tlsPrivKey)
 	if err != nil {
 		return err
 	}
@@ -23,13 +23,15 @@ import (
 // AttributeNameRole is the role (member or admin) name
 // AttributeNameEnrollmentId is the enrollment id
 // AttributeNameRevocationHandle contains the revocation handle, which can be used to revoke this user
+// The issuer additionally supports the attributes with the given names, if any.
 // Generated keys are serialized to bytes.
-func GenerateIssuerKey() ([]byte, []byte, error) {
+func GenerateIssuerKey(attributeNames ...string) ([]byte, []byte, error) {
 	rng, err := idemix.GetRand()
 	if err != nil {
 		return nil, nil, err
 	}
 	AttributeNames := []string{msp.AttributeNameOU, msp.AttributeNameRole, msp.AttributeNameEnrollmentId, msp.AttributeNameRevocationHandle}
+	AttributeNames = append(AttributeNames, attributeNames...)
 	key, err := idemix.NewIssuerKey(AttributeNames, rng)
 	if err != nil {
 		return nil, nil, errors.WithMessage(err, "cannot generate CA key")
@@ -41,9 +43,11 @@ func GenerateIssuerKey() ([]byte, []byte, error) {
 
 // GenerateSignerConfig creates a new signer config.
 // It generates a fresh user secret and issues a credential
-// with four attributes (described above) using the CA's key pair.
-func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey *ecdsa.PrivateKey) ([]byte, error) {
-	attrs := make([]*FP256BN.BIG, 4)
+// with four attributes (described above) using the CA's key pair,
+// as well as with the given values of the additional attributes
+// the CA supports, which the signer discloses.
+func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, revocationHandle int, key *idemix.IssuerKey, revKey *ecdsa.PrivateKey, attributes ...*m.IdemixAttribute) ([]byte, error) {
+	attrs := make([]*FP256BN.BIG, len(key.Ipk.AttributeNames))
 
 	if ouString == "" {
 		return nil, errors.Errorf("the OU attribute value is empty")
@@ -58,6 +62,24 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 	attrs[msp.AttributeIndexEnrollmentId] = idemix.HashModOrder([]byte(enrollmentId))
 	attrs[msp.AttributeIndexRevocationHandle] = FP256BN.NewBIGint(revocationHandle)
 
+	var disclosedAttributes []*m.IdemixAttribute
+	for i := msp.AttributeIndexRevocationHandle + 1; i < len(attrs); i++ {
+		name := key.Ipk.AttributeNames[i]
+		for _, attr := range attributes {
+			if attr.Name == name {
+				attrs[i] = idemix.HashModOrder([]byte(attr.Value))
+				disclosedAttributes = append(disclosedAttributes, attr)
+				break
+			}
+		}
+		if attrs[i] == nil {
+			return nil, errors.Errorf("the value of attribute %s is missing", name)
+		}
+	}
+	if len(disclosedAttributes) != len(attributes) {
+		return nil, errors.Errorf("the CA does not support some of the attributes, or some are given more than once")
+	}
+
 	rng, err := idemix.GetRand()
 	if err != nil {
 		return nil, errors.WithMessage(err, "Error getting PRNG")
@@ -92,7 +114,39 @@ func GenerateSignerConfig(roleMask int, ouString string, enrollmentId string, re
 		Role:                            int32(roleMask),
 		EnrollmentId:                    enrollmentId,
 		CredentialRevocationInformation: criBytes,
+		DisclosedAttributes:             disclosedAttributes,
 	}
 
 	return proto.Marshal(signer)
 }
+
+// GenerateCRI creates the credential revocation information of the given epoch,
+// in which the credentials with the given revocation handles are not revoked.
+// Signers prove non-revocation against it, and it is distributed to the verifiers
+// through the MSP config.
+func GenerateCRI(epoch int, unrevokedHandles []int, revKey *ecdsa.PrivateKey) ([]byte, error) {
+	if epoch < 0 {
+		return nil, errors.Errorf("the epoch must not be negative")
+	}
+
+	rng, err := idemix.GetRand()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Error getting PRNG")
+	}
+
+	rhs := make([]*FP256BN.BIG, len(unrevokedHandles))
+	for i, rh := range unrevokedHandles {
+		rhs[i] = FP256BN.NewBIGint(rh)
+	}
+
+	cri, err := idemix.CreateCRI(revKey, rhs, epoch, idemix.ALG_PLAIN_SIGNATURE, rng)
+	if err != nil {
+		return nil, err
+	}
+	criBytes, err := proto.Marshal(cri)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal CRI")
+	}
+
+	return criBytes, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/idemix"
 	m "github.com/hyperledger/fabric/msp"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,6 +58,33 @@ func TestIdemixCa(t *testing.T) {
 	assert.NoError(t, writeSignerToFile(conf))
 	assert.NoError(t, setupMSP())
 
+	// With the credential revocation information of an epoch in which the signer is not revoked, setup should succeed
+	cri, err := GenerateCRI(1, []int{5, 1234}, revocationkey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeCRIToFile(cri))
+	assert.NoError(t, setupMSP())
+
+	// With the credential revocation information of an epoch in which the signer is revoked, setup should give an error
+	cri, err = GenerateCRI(2, []int{5}, revocationkey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeCRIToFile(cri))
+	err = setupMSP()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the revocation handle is not among the unrevoked handles of epoch 2")
+
+	// With the credential revocation information signed by another revocation key, setup should give an error
+	otherRevocationKey, err := idemix.GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+	cri, err = GenerateCRI(3, []int{1234}, otherRevocationKey)
+	assert.NoError(t, err)
+	assert.NoError(t, writeCRIToFile(cri))
+	err = setupMSP()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "credential revocation information is not valid")
+
+	_, err = GenerateCRI(-1, []int{1234}, revocationkey)
+	assert.EqualError(t, err, "the epoch must not be negative")
+
 	// Without the verifier dir present, setup should give an error
 	cleanupVerifier()
 	assert.Error(t, setupMSP())
@@ -68,6 +96,56 @@ func TestIdemixCa(t *testing.T) {
 	assert.EqualError(t, err, "the enrollment id value is empty")
 }
 
+func TestIdemixCaAttributes(t *testing.T) {
+	cleanup()
+
+	isk, ipkBytes, err := GenerateIssuerKey("clearance", "level")
+	assert.NoError(t, err)
+
+	revocationkey, err := idemix.GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+
+	ipk := &idemix.IssuerPublicKey{}
+	err = proto.Unmarshal(ipkBytes, ipk)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{m.AttributeNameOU, m.AttributeNameRole, m.AttributeNameEnrollmentId, m.AttributeNameRevocationHandle, "clearance", "level"}, ipk.AttributeNames)
+
+	encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationkey.Public())
+	assert.NoError(t, err)
+	pemEncodedRevocationPK := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
+
+	writeVerifierToFile(ipkBytes, pemEncodedRevocationPK)
+
+	key := &idemix.IssuerKey{Isk: isk, Ipk: ipk}
+
+	conf, err := GenerateSignerConfig(m.GetRoleMaskFromIdemixRole(m.MEMBER), "OU1", "enrollmentid1", 1, key, revocationkey,
+		&mspprotos.IdemixAttribute{Name: "level", Value: "3"},
+		&mspprotos.IdemixAttribute{Name: "clearance", Value: "secret"},
+	)
+	assert.NoError(t, err)
+	signerConfig := &mspprotos.IdemixMSPSignerConfig{}
+	assert.NoError(t, proto.Unmarshal(conf, signerConfig))
+	assert.Equal(t, []*mspprotos.IdemixAttribute{{Name: "clearance", Value: "secret"}, {Name: "level", Value: "3"}}, signerConfig.DisclosedAttributes)
+	cleanupSigner()
+	assert.NoError(t, writeSignerToFile(conf))
+	assert.NoError(t, setupMSP())
+
+	_, err = GenerateSignerConfig(m.GetRoleMaskFromIdemixRole(m.MEMBER), "OU1", "enrollmentid1", 1, key, revocationkey,
+		&mspprotos.IdemixAttribute{Name: "clearance", Value: "secret"},
+	)
+	assert.EqualError(t, err, "the value of attribute level is missing")
+
+	_, err = GenerateSignerConfig(m.GetRoleMaskFromIdemixRole(m.MEMBER), "OU1", "enrollmentid1", 1, key, revocationkey,
+		&mspprotos.IdemixAttribute{Name: "clearance", Value: "secret"},
+		&mspprotos.IdemixAttribute{Name: "level", Value: "3"},
+		&mspprotos.IdemixAttribute{Name: "department", Value: "finance"},
+	)
+	assert.EqualError(t, err, "the CA does not support some of the attributes, or some are given more than once")
+
+	_, _, err = GenerateIssuerKey("clearance", m.AttributeNameOU)
+	assert.Error(t, err)
+}
+
 func cleanup() error {
 	// clean up any previous files
 	err := os.RemoveAll(testDir)
@@ -106,11 +184,16 @@ func writeSignerToFile(signerBytes []byte) error {
 	return ioutil.WriteFile(filepath.Join(testDir, m.IdemixConfigDirUser, m.IdemixConfigFileSigner), signerBytes, 0644)
 }
 
+func writeCRIToFile(criBytes []byte) error {
+	return ioutil.WriteFile(filepath.Join(testDir, m.IdemixConfigDirMsp, m.IdemixConfigFileRevocationInfo), criBytes, 0644)
+}
+
 // setupMSP tests whether we can successfully setup an idemix msp
-// with the generated config bytes
+// with the generated config bytes. The MSP version supports revocation
+// epochs so that the credential revocation information is checked
 func setupMSP() error {
 	// setup an idemix msp from the test directory
-	msp, err := m.New(&m.IdemixNewOpts{NewBaseOpts: m.NewBaseOpts{Version: m.MSPv1_1}})
+	msp, err := m.New(&m.IdemixNewOpts{NewBaseOpts: m.NewBaseOpts{Version: m.MSPv1_4_3}})
 	if err != nil {
 		return errors.Wrap(err, "Getting MSP failed")
 	}
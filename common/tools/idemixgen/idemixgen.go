@@ -25,8 +25,10 @@ import (
 	"github.com/hyperledger/fabric/common/tools/idemixgen/metadata"
 	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/msp"
+	m "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/alecthomas/kingpin.v2"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -42,11 +44,25 @@ var (
 	outputDir = app.Flag("output", "The output directory in which to place artifacts").Default("idemix-config").String()
 
 	genIssuerKey            = app.Command("ca-keygen", "Generate CA key material")
+	genIssuerKeyAttributes  = genIssuerKey.Flag("attribute", "The name of an attribute the CA supports in addition to the default ones, can be repeated").Strings()
 	genSignerConfig         = app.Command("signerconfig", "Generate a default signer for this Idemix MSP")
 	genCredOU               = genSignerConfig.Flag("org-unit", "The Organizational Unit of the default signer").Short('u').String()
 	genCredIsAdmin          = genSignerConfig.Flag("admin", "Make the default signer admin").Short('a').Bool()
 	genCredEnrollmentId     = genSignerConfig.Flag("enrollmentId", "The enrollment id of the default signer").Short('e').String()
 	genCredRevocationHandle = genSignerConfig.Flag("revocationHandle", "The handle used to revoke this signer").Short('r').Int()
+	genCredIssuer           = genSignerConfig.Flag("issuer", "The label of the CA issuing the credential, if not the initial one").String()
+	genCredAttributes       = genSignerConfig.Flag("attribute", "The value of an additional attribute the CA supports, which the default signer discloses, can be repeated").PlaceHolder("NAME=VALUE").StringMap()
+
+	genCRI                 = app.Command("cri", "Generate the credential revocation information of an epoch for this Idemix MSP")
+	genCRIEpoch            = genCRI.Flag("epoch", "The epoch of the credential revocation information").Short('e').Required().Int()
+	genCRIUnrevokedHandles = genCRI.Flag("unrevokedHandle", "The revocation handle of a signer that is not revoked, can be repeated").Short('r').Ints()
+	genCRIIssuer           = genCRI.Flag("issuer", "The label of the CA whose credentials are revoked, if not the initial one").String()
+
+	genRollover                = app.Command("ca-rollover", "Generate the key material of a new CA, which takes over from a previous one")
+	genRolloverLabel           = genRollover.Flag("label", "The label of the new CA").Short('l').Required().String()
+	genRolloverFirstEpoch      = genRollover.Flag("firstEpoch", "The first epoch in which the credentials of the new CA are accepted").Int64()
+	genRolloverPrevious        = genRollover.Flag("previous", "The label of the previous CA, if not the initial one").String()
+	genRolloverExpirationEpoch = genRollover.Flag("expirationEpoch", "The first epoch in which the credentials of the previous CA are no longer accepted").Int64()
 
 	version = app.Command("version", "Show version information")
 )
@@ -57,19 +73,6 @@ func main() {
 	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
 
 	case genIssuerKey.FullCommand():
-		isk, ipk, err := idemixca.GenerateIssuerKey()
-		handleError(err)
-
-		revocationKey, err := idemix.GenerateLongTermRevocationKey()
-		handleError(err)
-		encodedRevocationSK, err := x509.MarshalECPrivateKey(revocationKey)
-		handleError(err)
-		pemEncodedRevocationSK := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedRevocationSK})
-		handleError(err)
-		encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationKey.Public())
-		handleError(err)
-		pemEncodedRevocationPK := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
-
 		// Prevent overwriting the existing key
 		path := filepath.Join(*outputDir, IdemixDirIssuer)
 		checkDirectoryNotExists(path, fmt.Sprintf("Directory %s already exists", path))
@@ -77,14 +80,30 @@ func main() {
 		path = filepath.Join(*outputDir, msp.IdemixConfigDirMsp)
 		checkDirectoryNotExists(path, fmt.Sprintf("Directory %s already exists", path))
 
-		// write private and public keys to the file
-		handleError(os.MkdirAll(filepath.Join(*outputDir, IdemixDirIssuer), 0770))
-		handleError(os.MkdirAll(filepath.Join(*outputDir, msp.IdemixConfigDirMsp), 0770))
-		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigIssuerSecretKey), isk)
-		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigRevocationKey), pemEncodedRevocationSK)
-		writeFile(filepath.Join(*outputDir, IdemixDirIssuer, msp.IdemixConfigFileIssuerPublicKey), ipk)
-		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileRevocationPublicKey), pemEncodedRevocationPK)
-		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigFileIssuerPublicKey), ipk)
+		writeIssuerKey("", *genIssuerKeyAttributes)
+
+	case genRollover.FullCommand():
+		if *genRolloverExpirationEpoch != 0 && *genRolloverExpirationEpoch <= *genRolloverFirstEpoch {
+			handleError(errors.Errorf("the previous CA must expire after the first epoch of the new one, for its signers to move to the new one"))
+		}
+
+		// Prevent overwriting the existing key
+		caDir, mspDir := issuerDirs(*genRolloverLabel)
+		checkDirectoryNotExists(caDir, fmt.Sprintf("Directory %s already exists", caDir))
+		checkDirectoryNotExists(mspDir, fmt.Sprintf("Directory %s already exists", mspDir))
+
+		// The new CA certifies the same attributes as the previous one
+		previousKey := readIssuerKey(*genRolloverPrevious)
+		writeIssuerKey(*genRolloverLabel, previousKey.Ipk.AttributeNames[msp.AttributeIndexRevocationHandle+1:])
+		writeValidity(*genRolloverLabel, func(validity *msp.IdemixIssuerValidity) {
+			validity.FirstEpoch = *genRolloverFirstEpoch
+		})
+
+		if *genRolloverExpirationEpoch != 0 {
+			writeValidity(*genRolloverPrevious, func(validity *msp.IdemixIssuerValidity) {
+				validity.ExpirationEpoch = *genRolloverExpirationEpoch
+			})
+		}
 
 	case genSignerConfig.FullCommand():
 		roleMask := 0
@@ -93,7 +112,11 @@ func main() {
 		} else {
 			roleMask = msp.GetRoleMaskFromIdemixRole(msp.MEMBER)
 		}
-		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(), readRevocationKey())
+		var attributes []*m.IdemixAttribute
+		for name, value := range *genCredAttributes {
+			attributes = append(attributes, &m.IdemixAttribute{Name: name, Value: value})
+		}
+		config, err := idemixca.GenerateSignerConfig(roleMask, *genCredOU, *genCredEnrollmentId, *genCredRevocationHandle, readIssuerKey(*genCredIssuer), readRevocationKey(*genCredIssuer), attributes...)
 		handleError(err)
 
 		path := filepath.Join(*outputDir, msp.IdemixConfigDirUser)
@@ -103,6 +126,19 @@ func main() {
 		handleError(os.Mkdir(filepath.Join(*outputDir, msp.IdemixConfigDirUser), 0770))
 		writeFile(filepath.Join(*outputDir, msp.IdemixConfigDirUser, msp.IdemixConfigFileSigner), config)
 
+	case genCRI.FullCommand():
+		cri, err := idemixca.GenerateCRI(*genCRIEpoch, *genCRIUnrevokedHandles, readRevocationKey(*genCRIIssuer))
+		handleError(err)
+
+		_, path := issuerDirs(*genCRIIssuer)
+		_, err = os.Stat(path)
+		if err != nil {
+			handleError(errors.Errorf("This MSP config does not contain a directory \"%s\"", path))
+		}
+
+		// Write the credential revocation information to file, replacing the one of the previous epoch
+		writeFile(filepath.Join(path, msp.IdemixConfigFileRevocationInfo), cri)
+
 	case version.FullCommand():
 		printVersion()
 	}
@@ -117,14 +153,69 @@ func writeFile(path string, contents []byte) {
 	handleError(ioutil.WriteFile(path, contents, 0640))
 }
 
-// readIssuerKey reads the issuer key from the current directory
-func readIssuerKey() *idemix.IssuerKey {
-	path := filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigIssuerSecretKey)
+// issuerDirs returns the directory with the secret key material of the CA with the given label,
+// and the one with its public key material, or the ones of the initial CA if the label is empty
+func issuerDirs(label string) (string, string) {
+	if label == "" {
+		return filepath.Join(*outputDir, IdemixDirIssuer), filepath.Join(*outputDir, msp.IdemixConfigDirMsp)
+	}
+	return filepath.Join(*outputDir, IdemixDirIssuer, msp.IdemixConfigDirIssuers, label),
+		filepath.Join(*outputDir, msp.IdemixConfigDirMsp, msp.IdemixConfigDirIssuers, label)
+}
+
+// writeIssuerKey generates the key material of the CA with the given label, certifying
+// the given attributes in addition to the default ones, and writes it to files
+func writeIssuerKey(label string, attributeNames []string) {
+	isk, ipk, err := idemixca.GenerateIssuerKey(attributeNames...)
+	handleError(err)
+
+	revocationKey, err := idemix.GenerateLongTermRevocationKey()
+	handleError(err)
+	encodedRevocationSK, err := x509.MarshalECPrivateKey(revocationKey)
+	handleError(err)
+	pemEncodedRevocationSK := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedRevocationSK})
+	encodedRevocationPK, err := x509.MarshalPKIXPublicKey(revocationKey.Public())
+	handleError(err)
+	pemEncodedRevocationPK := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: encodedRevocationPK})
+
+	// write private and public keys to the file
+	caDir, mspDir := issuerDirs(label)
+	handleError(os.MkdirAll(caDir, 0770))
+	handleError(os.MkdirAll(mspDir, 0770))
+	writeFile(filepath.Join(caDir, IdemixConfigIssuerSecretKey), isk)
+	writeFile(filepath.Join(caDir, IdemixConfigRevocationKey), pemEncodedRevocationSK)
+	writeFile(filepath.Join(caDir, msp.IdemixConfigFileIssuerPublicKey), ipk)
+	writeFile(filepath.Join(mspDir, msp.IdemixConfigFileRevocationPublicKey), pemEncodedRevocationPK)
+	writeFile(filepath.Join(mspDir, msp.IdemixConfigFileIssuerPublicKey), ipk)
+}
+
+// writeValidity updates the window of epochs in which the credentials
+// of the CA with the given label are accepted
+func writeValidity(label string, update func(*msp.IdemixIssuerValidity)) {
+	_, mspDir := issuerDirs(label)
+	path := filepath.Join(mspDir, msp.IdemixConfigFileIssuerValidity)
+
+	validity := &msp.IdemixIssuerValidity{}
+	validityBytes, err := ioutil.ReadFile(path)
+	if err == nil {
+		handleError(yaml.Unmarshal(validityBytes, validity))
+	}
+	update(validity)
+
+	validityBytes, err = yaml.Marshal(validity)
+	handleError(err)
+	writeFile(path, validityBytes)
+}
+
+// readIssuerKey reads the key of the CA with the given label
+func readIssuerKey(label string) *idemix.IssuerKey {
+	caDir, _ := issuerDirs(label)
+	path := filepath.Join(caDir, IdemixConfigIssuerSecretKey)
 	isk, err := ioutil.ReadFile(path)
 	if err != nil {
 		handleError(errors.Wrapf(err, "failed to open issuer secret key file: %s", path))
 	}
-	path = filepath.Join(*outputDir, IdemixDirIssuer, msp.IdemixConfigFileIssuerPublicKey)
+	path = filepath.Join(caDir, msp.IdemixConfigFileIssuerPublicKey)
 	ipkBytes, err := ioutil.ReadFile(path)
 	if err != nil {
 		handleError(errors.Wrapf(err, "failed to open issuer public key file: %s", path))
@@ -136,8 +227,10 @@ func readIssuerKey() *idemix.IssuerKey {
 	return key
 }
 
-func readRevocationKey() *ecdsa.PrivateKey {
-	path := filepath.Join(*outputDir, IdemixDirIssuer, IdemixConfigRevocationKey)
+// readRevocationKey reads the revocation key of the CA with the given label
+func readRevocationKey(label string) *ecdsa.PrivateKey {
+	caDir, _ := issuerDirs(label)
+	path := filepath.Join(caDir, IdemixConfigRevocationKey)
 	keyBytes, err := ioutil.ReadFile(path)
 	if err != nil {
 		handleError(errors.Wrapf(err, "failed to open revocation secret key file: %s", path))
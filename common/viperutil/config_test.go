@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/hyperledger/fabric/orderer/mocks/util"
@@ -447,8 +448,9 @@ func TestStringFromFileEnv(t *testing.T) {
 
 func TestEnhancedExactUnmarshalKey(t *testing.T) {
 	type Nested struct {
-		Key     string
-		BoolVar bool
+		Key      string
+		BoolVar  bool
+		Duration time.Duration
 	}
 
 	type nestedKey struct {
@@ -460,7 +462,8 @@ func TestEnhancedExactUnmarshalKey(t *testing.T) {
 		"  Nested:\n" +
 		"    Nested:\n" +
 		"      Key: BAD\n" +
-		"      BoolVar: true\n"
+		"      BoolVar: true\n" +
+		"      Duration: 5s\n"
 
 	envVar := "VIPERUTIL_TOP_NESTED_NESTED_KEY"
 	envVal := "GOOD"
@@ -491,6 +494,10 @@ func TestEnhancedExactUnmarshalKey(t *testing.T) {
 		t.Fatalf(`Expected: "%t", Actual: "%t"`, true, uconf.Nested.BoolVar)
 	}
 
+	if uconf.Nested.Duration != 5*time.Second {
+		t.Fatalf(`Expected: "%s", Actual: "%s"`, 5*time.Second, uconf.Nested.Duration)
+	}
+
 }
 
 func TestDecodeOpaqueField(t *testing.T) {
@@ -28,6 +28,8 @@ const (
 	Qscc_GetBlockByHash     = "qscc/GetBlockByHash"
 	Qscc_GetTransactionByID = "qscc/GetTransactionByID"
 	Qscc_GetBlockByTxID     = "qscc/GetBlockByTxID"
+	Qscc_GetStateHash       = "qscc/GetStateHash"
+	Qscc_VerifyStateHash    = "qscc/VerifyStateHash"
 
 	//Cscc resources
 	Cscc_JoinChain                = "cscc/JoinChain"
@@ -9,10 +9,18 @@ package admin
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/transientstore"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -34,13 +42,38 @@ type AccessControlEvaluator interface {
 	Evaluate(signatureSet []*common.SignedData) error
 }
 
+// TransientStoreProvider provides the transient stores of the channels of the peer
+type TransientStoreProvider interface {
+	// StoreForChannel returns the transient store of the given channel,
+	// or nil if the peer has not joined the channel
+	StoreForChannel(channel string) transientstore.Store
+	// Channels returns the channels that have a transient store
+	Channels() []string
+}
+
+// ReconcilerProvider provides the private data reconcilers of the channels of the peer
+type ReconcilerProvider interface {
+	// Reconciler returns the private data reconciler of the given channel,
+	// or nil if the peer has not joined the channel
+	Reconciler(channel string) privdata.PvtDataReconciler
+}
+
+// GossipViewProvider provides the membership and the channels as seen by the gossip service of the peer
+type GossipViewProvider interface {
+	// View returns the membership and the channels as seen by the gossip service
+	View() *service.View
+}
+
 // NewAdminServer creates and returns a Admin service instance.
-func NewAdminServer(ace AccessControlEvaluator) *ServerAdmin {
+func NewAdminServer(ace AccessControlEvaluator, tsp TransientStoreProvider, rp ReconcilerProvider, gvp GossipViewProvider) *ServerAdmin {
 	s := &ServerAdmin{
 		v: &validator{
 			ace: ace,
 		},
-		specAtStartup: flogging.Global.Spec(),
+		specAtStartup:   flogging.Global.Spec(),
+		transientStores: tsp,
+		reconcilers:     rp,
+		gossipView:      gvp,
 	}
 	return s
 }
@@ -49,7 +82,10 @@ func NewAdminServer(ace AccessControlEvaluator) *ServerAdmin {
 type ServerAdmin struct {
 	v requestValidator
 
-	specAtStartup string
+	specAtStartup   string
+	transientStores TransientStoreProvider
+	reconcilers     ReconcilerProvider
+	gossipView      GossipViewProvider
 }
 
 func (s *ServerAdmin) GetStatus(ctx context.Context, env *common.Envelope) (*pb.ServerStatus, error) {
@@ -140,3 +176,215 @@ func (s *ServerAdmin) SetLogSpec(ctx context.Context, env *common.Envelope) (*pb
 	}
 	return logResponse, nil
 }
+
+func (s *ServerAdmin) GetTransientStoreEntries(ctx context.Context, env *common.Envelope) (*pb.TransientStoreEntriesResponse, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetTransientStoreEntriesReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	channels := s.transientStores.Channels()
+	if request.ChannelId != "" {
+		channels = []string{request.ChannelId}
+	}
+
+	response := &pb.TransientStoreEntriesResponse{}
+	for _, channel := range channels {
+		store := s.transientStores.StoreForChannel(channel)
+		if store == nil {
+			return nil, status.Errorf(codes.NotFound, "transient store for channel %s not found", channel)
+		}
+		pendingEntries, err := store.GetPendingEntries(int(request.MaxEntries))
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("failed retrieving pending entries of transient store for channel %s", channel))
+		}
+		channelEntries := &pb.ChannelTransientStoreEntries{
+			ChannelId:    channel,
+			TotalEntries: pendingEntries.TotalEntries,
+			TotalSize:    pendingEntries.TotalSize,
+		}
+		for _, entry := range pendingEntries.Oldest {
+			receivedAt, err := ptypes.TimestampProto(entry.ReceivedAt)
+			if err != nil {
+				return nil, err
+			}
+			channelEntries.Oldest = append(channelEntries.Oldest, &pb.TransientStoreEntry{
+				TxId:                  entry.TxID,
+				ReceivedAt:            receivedAt,
+				ReceivedAtBlockHeight: entry.ReceivedAtBlockHeight,
+				Size:                  entry.Size,
+			})
+		}
+		response.Channels = append(response.Channels, channelEntries)
+	}
+	return response, nil
+}
+
+func (s *ServerAdmin) GetReconciliationStatus(ctx context.Context, env *common.Envelope) (*pb.ReconciliationStatusResponse, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetReconciliationStatusReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	reconciler, err := s.reconciler(request.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliationStatus, err := reconciler.Status()
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("failed retrieving reconciliation status for channel %s", request.ChannelId))
+	}
+	response := &pb.ReconciliationStatusResponse{
+		ChannelId: request.ChannelId,
+		LastError: reconciliationStatus.LastError,
+	}
+	if response.LastAttempt, err = timestampProto(reconciliationStatus.LastAttempt); err != nil {
+		return nil, err
+	}
+	for _, missing := range reconciliationStatus.Missing {
+		lastAttempt, err := timestampProto(missing.LastAttempt)
+		if err != nil {
+			return nil, err
+		}
+		response.MissingPvtData = append(response.MissingPvtData, &pb.MissingPvtDataStatus{
+			Chaincode:   missing.Namespace,
+			Collection:  missing.Collection,
+			BlockNums:   missing.BlockNums,
+			LastAttempt: lastAttempt,
+			LastError:   missing.LastError,
+		})
+	}
+	return response, nil
+}
+
+func (s *ServerAdmin) Reconcile(ctx context.Context, env *common.Envelope) (*pb.ReconcileResponse, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetReconcileReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	if request.StartBlock > request.EndBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block range [%d - %d]", request.StartBlock, request.EndBlock)
+	}
+	reconciler, err := s.reconciler(request.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciled, err := reconciler.ReconcileBlockRange(request.StartBlock, request.EndBlock)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("failed reconciling blocks range [%d - %d] for channel %s", request.StartBlock, request.EndBlock, request.ChannelId))
+	}
+	return &pb.ReconcileResponse{Reconciled: uint64(reconciled)}, nil
+}
+
+func (s *ServerAdmin) reconciler(channel string) (privdata.PvtDataReconciler, error) {
+	if channel == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel ID must be provided")
+	}
+	reconciler := s.reconcilers.Reconciler(channel)
+	if reconciler == nil {
+		return nil, status.Errorf(codes.NotFound, "reconciler for channel %s not found", channel)
+	}
+	return reconciler, nil
+}
+
+func (s *ServerAdmin) GetGossipView(ctx context.Context, env *common.Envelope) (*pb.GossipViewResponse, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetGossipViewReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+
+	view := s.gossipView.View()
+	response := &pb.GossipViewResponse{
+		Self:  gossipMember(view.Self),
+		Alive: gossipMembers(view.Alive),
+		Dead:  gossipMembers(view.Dead),
+	}
+	for _, channelView := range view.Channels {
+		if request.ChannelId != "" && request.ChannelId != channelView.ChainID {
+			continue
+		}
+		channel := &pb.GossipChannelView{
+			ChannelId:      channelView.ChainID,
+			Self:           gossipMember(channelView.Self),
+			Peers:          gossipMembers(channelView.Peers),
+			LeaderElection: channelView.LeaderElection,
+			IsLeader:       channelView.IsLeader,
+			LeaderPkiId:    channelView.Leader,
+		}
+		var orgs []string
+		for org := range channelView.AnchorPeers {
+			orgs = append(orgs, org)
+		}
+		sort.Strings(orgs)
+		for _, org := range orgs {
+			orgAnchorPeers := &pb.GossipOrgAnchorPeers{MspId: org}
+			for _, anchorPeer := range channelView.AnchorPeers[org] {
+				orgAnchorPeers.AnchorPeers = append(orgAnchorPeers.AnchorPeers, &pb.AnchorPeer{
+					Host: anchorPeer.Host,
+					Port: int32(anchorPeer.Port),
+				})
+			}
+			channel.AnchorPeers = append(channel.AnchorPeers, orgAnchorPeers)
+		}
+		response.Channels = append(response.Channels, channel)
+	}
+	if request.ChannelId != "" && len(response.Channels) == 0 {
+		return nil, status.Errorf(codes.NotFound, "channel %s not found", request.ChannelId)
+	}
+	return response, nil
+}
+
+func gossipMembers(members []discovery.NetworkMember) []*pb.GossipMember {
+	var gossipMembers []*pb.GossipMember
+	for _, member := range members {
+		gossipMembers = append(gossipMembers, gossipMember(member))
+	}
+	sort.Slice(gossipMembers, func(i, j int) bool {
+		return gossipMembers[i].Endpoint < gossipMembers[j].Endpoint
+	})
+	return gossipMembers
+}
+
+func gossipMember(member discovery.NetworkMember) *pb.GossipMember {
+	gossipMember := &pb.GossipMember{
+		PkiId:            member.PKIid,
+		Endpoint:         member.Endpoint,
+		InternalEndpoint: member.InternalEndpoint,
+	}
+	if member.Properties != nil {
+		gossipMember.LedgerHeight = member.Properties.LedgerHeight
+		gossipMember.LeftChannel = member.Properties.LeftChannel
+		for _, chaincode := range member.Properties.Chaincodes {
+			gossipMember.Chaincodes = append(gossipMember.Chaincodes, &pb.GossipChaincode{
+				Name:    chaincode.Name,
+				Version: chaincode.Version,
+			})
+		}
+	}
+	return gossipMember
+}
+
+// timestampProto converts the given time to a timestamp, or to nil if the time is zero
+func timestampProto(t time.Time) (*timestamp.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(t)
+}
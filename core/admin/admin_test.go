@@ -8,13 +8,23 @@ package admin
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/testutil"
+	"github.com/hyperledger/fabric/core/transientstore"
+	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/protos/common"
+	proto "github.com/hyperledger/fabric/protos/gossip"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,8 +45,68 @@ func (v *mockValidator) validate(ctx context.Context, env *common.Envelope) (*pb
 	return args.Get(0).(*pb.AdminOperation), nil
 }
 
+type mockTransientStore struct {
+	transientstore.Store
+	mock.Mock
+}
+
+func (m *mockTransientStore) GetPendingEntries(maxEntries int) (*transientstore.PendingEntries, error) {
+	args := m.Called(maxEntries)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*transientstore.PendingEntries), args.Error(1)
+}
+
+type mockTransientStoreProvider map[string]transientstore.Store
+
+func (m mockTransientStoreProvider) StoreForChannel(channel string) transientstore.Store {
+	return m[channel]
+}
+
+func (m mockTransientStoreProvider) Channels() []string {
+	var channels []string
+	for channel := range m {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+type mockReconciler struct {
+	privdata.PvtDataReconciler
+	mock.Mock
+}
+
+func (m *mockReconciler) Status() (*privdata.ReconciliationStatus, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*privdata.ReconciliationStatus), args.Error(1)
+}
+
+func (m *mockReconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	args := m.Called(startBlock, endBlock)
+	return args.Int(0), args.Error(1)
+}
+
+type mockReconcilerProvider map[string]privdata.PvtDataReconciler
+
+func (m mockReconcilerProvider) Reconciler(channel string) privdata.PvtDataReconciler {
+	return m[channel]
+}
+
+type mockGossipViewProvider struct {
+	view *service.View
+}
+
+func (m *mockGossipViewProvider) View() *service.View {
+	return m.view
+}
+
 func TestGetStatus(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -46,7 +116,7 @@ func TestGetStatus(t *testing.T) {
 }
 
 func TestStartServer(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -56,10 +126,10 @@ func TestStartServer(t *testing.T) {
 }
 
 func TestForbidden(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
-	mv.On("validate").Return(nil, accessDenied).Times(7)
+	mv.On("validate").Return(nil, accessDenied).Times(11)
 
 	ctx := context.Background()
 	status, err := adminServer.GetStatus(ctx, nil)
@@ -85,10 +155,22 @@ func TestForbidden(t *testing.T) {
 
 	_, err = adminServer.StartServer(ctx, nil)
 	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.GetTransientStoreEntries(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.GetReconciliationStatus(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.Reconcile(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.GetGossipView(ctx, nil)
+	assert.Equal(t, accessDenied, err)
 }
 
 func TestLoggingCalls(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	flogging.MustGetLogger("test")
@@ -219,3 +301,266 @@ func TestLoggingCalls(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTransientStoreEntries(t *testing.T) {
+	receivedAt := time.Now()
+	store1 := &mockTransientStore{}
+	store1.On("GetPendingEntries", 5).Return(&transientstore.PendingEntries{
+		TotalEntries: 3,
+		TotalSize:    300,
+		Oldest: []*transientstore.PendingEntry{
+			{TxID: "txid-1", ReceivedAt: receivedAt, ReceivedAtBlockHeight: 10, Size: 100},
+		},
+	}, nil)
+	store2 := &mockTransientStore{}
+	store2.On("GetPendingEntries", 5).Return(&transientstore.PendingEntries{}, nil)
+	store3 := &mockTransientStore{}
+	store3.On("GetPendingEntries", 5).Return(nil, errors.New("iterator failure"))
+
+	adminServer := NewAdminServer(nil, mockTransientStoreProvider{"ch1": store1, "ch2": store2}, nil, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapTransientStoreEntriesRequest := func(req *pb.TransientStoreEntriesRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_TransientStoreEntriesReq{
+				TransientStoreEntriesReq: req,
+			},
+		}
+	}
+
+	mv.On("validate").Return(wrapTransientStoreEntriesRequest(nil), nil).Once()
+	_, err := adminServer.GetTransientStoreEntries(context.Background(), nil)
+	assert.EqualError(t, err, "request is nil")
+
+	// all channels
+	mv.On("validate").Return(wrapTransientStoreEntriesRequest(&pb.TransientStoreEntriesRequest{MaxEntries: 5}), nil).Once()
+	resp, err := adminServer.GetTransientStoreEntries(context.Background(), nil)
+	assert.NoError(t, err)
+	expectedReceivedAt, err := ptypes.TimestampProto(receivedAt)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.TransientStoreEntriesResponse{
+		Channels: []*pb.ChannelTransientStoreEntries{
+			{
+				ChannelId:    "ch1",
+				TotalEntries: 3,
+				TotalSize:    300,
+				Oldest: []*pb.TransientStoreEntry{
+					{TxId: "txid-1", ReceivedAt: expectedReceivedAt, ReceivedAtBlockHeight: 10, Size: 100},
+				},
+			},
+			{
+				ChannelId: "ch2",
+			},
+		},
+	}, resp)
+
+	// a single channel
+	mv.On("validate").Return(wrapTransientStoreEntriesRequest(&pb.TransientStoreEntriesRequest{ChannelId: "ch2", MaxEntries: 5}), nil).Once()
+	resp, err = adminServer.GetTransientStoreEntries(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.ChannelTransientStoreEntries{{ChannelId: "ch2"}}, resp.Channels)
+
+	// a channel the peer has not joined
+	mv.On("validate").Return(wrapTransientStoreEntriesRequest(&pb.TransientStoreEntriesRequest{ChannelId: "ch3", MaxEntries: 5}), nil).Once()
+	_, err = adminServer.GetTransientStoreEntries(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = transient store for channel ch3 not found")
+
+	// a failure of the transient store
+	adminServer.transientStores = mockTransientStoreProvider{"ch3": store3}
+	mv.On("validate").Return(wrapTransientStoreEntriesRequest(&pb.TransientStoreEntriesRequest{ChannelId: "ch3", MaxEntries: 5}), nil).Once()
+	_, err = adminServer.GetTransientStoreEntries(context.Background(), nil)
+	assert.EqualError(t, err, "failed retrieving pending entries of transient store for channel ch3: iterator failure")
+}
+
+func TestGetReconciliationStatus(t *testing.T) {
+	lastAttempt := time.Now()
+	reconciler1 := &mockReconciler{}
+	reconciler1.On("Status").Return(&privdata.ReconciliationStatus{
+		LastAttempt: lastAttempt,
+		Missing: []*privdata.MissingCollectionPvtData{
+			{Namespace: "cc1", Collection: "coll1", BlockNums: []uint64{3, 5}, LastAttempt: lastAttempt, LastError: "not available"},
+			{Namespace: "cc1", Collection: "coll2", BlockNums: []uint64{7}},
+		},
+	}, nil)
+	reconciler2 := &mockReconciler{}
+	reconciler2.On("Status").Return(nil, errors.New("ledger closed"))
+
+	adminServer := NewAdminServer(nil, nil, mockReconcilerProvider{"ch1": reconciler1, "ch2": reconciler2}, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapReconciliationStatusRequest := func(req *pb.ReconciliationStatusRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_ReconciliationStatusReq{
+				ReconciliationStatusReq: req,
+			},
+		}
+	}
+
+	mv.On("validate").Return(wrapReconciliationStatusRequest(nil), nil).Once()
+	_, err := adminServer.GetReconciliationStatus(context.Background(), nil)
+	assert.EqualError(t, err, "request is nil")
+
+	mv.On("validate").Return(wrapReconciliationStatusRequest(&pb.ReconciliationStatusRequest{ChannelId: "ch1"}), nil).Once()
+	resp, err := adminServer.GetReconciliationStatus(context.Background(), nil)
+	assert.NoError(t, err)
+	expectedLastAttempt, err := ptypes.TimestampProto(lastAttempt)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.ReconciliationStatusResponse{
+		ChannelId:   "ch1",
+		LastAttempt: expectedLastAttempt,
+		MissingPvtData: []*pb.MissingPvtDataStatus{
+			{Chaincode: "cc1", Collection: "coll1", BlockNums: []uint64{3, 5}, LastAttempt: expectedLastAttempt, LastError: "not available"},
+			{Chaincode: "cc1", Collection: "coll2", BlockNums: []uint64{7}},
+		},
+	}, resp)
+
+	mv.On("validate").Return(wrapReconciliationStatusRequest(&pb.ReconciliationStatusRequest{ChannelId: "ch2"}), nil).Once()
+	_, err = adminServer.GetReconciliationStatus(context.Background(), nil)
+	assert.EqualError(t, err, "failed retrieving reconciliation status for channel ch2: ledger closed")
+
+	mv.On("validate").Return(wrapReconciliationStatusRequest(&pb.ReconciliationStatusRequest{ChannelId: "ch3"}), nil).Once()
+	_, err = adminServer.GetReconciliationStatus(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = reconciler for channel ch3 not found")
+
+	mv.On("validate").Return(wrapReconciliationStatusRequest(&pb.ReconciliationStatusRequest{}), nil).Once()
+	_, err = adminServer.GetReconciliationStatus(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = channel ID must be provided")
+}
+
+func TestReconcile(t *testing.T) {
+	reconciler1 := &mockReconciler{}
+	reconciler1.On("ReconcileBlockRange", uint64(2), uint64(8)).Return(4, nil)
+	reconciler2 := &mockReconciler{}
+	reconciler2.On("ReconcileBlockRange", uint64(2), uint64(8)).Return(0, errors.New("fetch failed"))
+
+	adminServer := NewAdminServer(nil, nil, mockReconcilerProvider{"ch1": reconciler1, "ch2": reconciler2}, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapReconcileRequest := func(req *pb.ReconcileRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_ReconcileReq{
+				ReconcileReq: req,
+			},
+		}
+	}
+
+	mv.On("validate").Return(wrapReconcileRequest(nil), nil).Once()
+	_, err := adminServer.Reconcile(context.Background(), nil)
+	assert.EqualError(t, err, "request is nil")
+
+	mv.On("validate").Return(wrapReconcileRequest(&pb.ReconcileRequest{ChannelId: "ch1", StartBlock: 2, EndBlock: 8}), nil).Once()
+	resp, err := adminServer.Reconcile(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.ReconcileResponse{Reconciled: 4}, resp)
+
+	mv.On("validate").Return(wrapReconcileRequest(&pb.ReconcileRequest{ChannelId: "ch2", StartBlock: 2, EndBlock: 8}), nil).Once()
+	_, err = adminServer.Reconcile(context.Background(), nil)
+	assert.EqualError(t, err, "failed reconciling blocks range [2 - 8] for channel ch2: fetch failed")
+
+	mv.On("validate").Return(wrapReconcileRequest(&pb.ReconcileRequest{ChannelId: "ch1", StartBlock: 8, EndBlock: 2}), nil).Once()
+	_, err = adminServer.Reconcile(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid block range [8 - 2]")
+
+	mv.On("validate").Return(wrapReconcileRequest(&pb.ReconcileRequest{ChannelId: "ch3", StartBlock: 2, EndBlock: 8}), nil).Once()
+	_, err = adminServer.Reconcile(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = reconciler for channel ch3 not found")
+}
+
+func TestGetGossipView(t *testing.T) {
+	self := discovery.NetworkMember{PKIid: []byte("p0"), Endpoint: "peer0:7051", InternalEndpoint: "peer0:7051"}
+	peer1 := discovery.NetworkMember{PKIid: []byte("p1"), Endpoint: "peer1:7051"}
+	peer2 := discovery.NetworkMember{PKIid: []byte("p2"), Endpoint: "peer2:7051"}
+	peer1InChannel := peer1
+	peer1InChannel.Properties = &proto.Properties{
+		LedgerHeight: 10,
+		Chaincodes:   []*proto.Chaincode{{Name: "cc1", Version: "1.0"}},
+	}
+	selfInChannel := self
+	selfInChannel.Properties = &proto.Properties{LedgerHeight: 9}
+
+	adminServer := NewAdminServer(nil, nil, nil, &mockGossipViewProvider{view: &service.View{
+		Self:  self,
+		Alive: []discovery.NetworkMember{peer2, peer1},
+		Channels: []*service.ChannelView{
+			{
+				ChainID:        "ch1",
+				Self:           selfInChannel,
+				Peers:          []discovery.NetworkMember{peer1InChannel},
+				LeaderElection: true,
+				Leader:         peer1.PKIid,
+				AnchorPeers: map[string][]api.AnchorPeer{
+					"Org2MSP": {{Host: "peer2", Port: 7051}},
+					"Org1MSP": {{Host: "peer0", Port: 7051}, {Host: "peer1", Port: 7051}},
+				},
+			},
+			{
+				ChainID:  "ch2",
+				Self:     self,
+				IsLeader: true,
+				Leader:   self.PKIid,
+			},
+		},
+	}})
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapGossipViewRequest := func(req *pb.GossipViewRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_GossipViewReq{
+				GossipViewReq: req,
+			},
+		}
+	}
+
+	mv.On("validate").Return(wrapGossipViewRequest(nil), nil).Once()
+	_, err := adminServer.GetGossipView(context.Background(), nil)
+	assert.EqualError(t, err, "request is nil")
+
+	expectedSelf := &pb.GossipMember{PkiId: []byte("p0"), Endpoint: "peer0:7051", InternalEndpoint: "peer0:7051"}
+	expectedCh1 := &pb.GossipChannelView{
+		ChannelId: "ch1",
+		Self:      &pb.GossipMember{PkiId: []byte("p0"), Endpoint: "peer0:7051", InternalEndpoint: "peer0:7051", LedgerHeight: 9},
+		Peers: []*pb.GossipMember{
+			{PkiId: []byte("p1"), Endpoint: "peer1:7051", LedgerHeight: 10, Chaincodes: []*pb.GossipChaincode{{Name: "cc1", Version: "1.0"}}},
+		},
+		LeaderElection: true,
+		LeaderPkiId:    []byte("p1"),
+		AnchorPeers: []*pb.GossipOrgAnchorPeers{
+			{MspId: "Org1MSP", AnchorPeers: []*pb.AnchorPeer{{Host: "peer0", Port: 7051}, {Host: "peer1", Port: 7051}}},
+			{MspId: "Org2MSP", AnchorPeers: []*pb.AnchorPeer{{Host: "peer2", Port: 7051}}},
+		},
+	}
+	expectedCh2 := &pb.GossipChannelView{
+		ChannelId:   "ch2",
+		Self:        expectedSelf,
+		IsLeader:    true,
+		LeaderPkiId: []byte("p0"),
+	}
+
+	// all the channels
+	mv.On("validate").Return(wrapGossipViewRequest(&pb.GossipViewRequest{}), nil).Once()
+	resp, err := adminServer.GetGossipView(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.GossipViewResponse{
+		Self: expectedSelf,
+		Alive: []*pb.GossipMember{
+			{PkiId: []byte("p1"), Endpoint: "peer1:7051"},
+			{PkiId: []byte("p2"), Endpoint: "peer2:7051"},
+		},
+		Channels: []*pb.GossipChannelView{expectedCh1, expectedCh2},
+	}, resp)
+
+	// a single channel
+	mv.On("validate").Return(wrapGossipViewRequest(&pb.GossipViewRequest{ChannelId: "ch2"}), nil).Once()
+	resp, err = adminServer.GetGossipView(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.GossipChannelView{expectedCh2}, resp.Channels)
+
+	// a channel the peer has not joined
+	mv.On("validate").Return(wrapGossipViewRequest(&pb.GossipViewRequest{ChannelId: "ch3"}), nil).Once()
+	_, err = adminServer.GetGossipView(context.Background(), nil)
+	assert.EqualError(t, err, "rpc error: code = NotFound desc = channel ch3 not found")
+}
@@ -9,6 +9,7 @@ package chaincode
 import (
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 	"sync"
@@ -207,6 +208,8 @@ func (h *Handler) handleMessageReadyState(msg *pb.ChaincodeMessage) error {
 		go h.HandleTransaction(msg, h.HandlePutState)
 	case pb.ChaincodeMessage_DEL_STATE:
 		go h.HandleTransaction(msg, h.HandleDelState)
+	case pb.ChaincodeMessage_PURGE_PRIVATE_DATA:
+		go h.HandleTransaction(msg, h.HandlePurgePrivateData)
 	case pb.ChaincodeMessage_INVOKE_CHAINCODE:
 		go h.HandleTransaction(msg, h.HandleInvokeChaincode)
 
@@ -612,6 +615,41 @@ func hasReadAccess(chaincodeName, collection string, txContext *TransactionConte
 	return accessAllowed, err
 }
 
+func errorIfCreatorHasNoWriteAccess(chaincodeName, collection string, txContext *TransactionContext) error {
+	accessAllowed, err := hasWriteAccess(chaincodeName, collection, txContext)
+	if err != nil {
+		return err
+	}
+	if !accessAllowed {
+		return errors.Errorf("tx creator does not have write access permission on privatedata in chaincodeName:%s collectionName: %s",
+			chaincodeName, collection)
+	}
+	return nil
+}
+
+func hasWriteAccess(chaincodeName, collection string, txContext *TransactionContext) (bool, error) {
+	// check to see if write access has already been checked in the scope of this chaincode simulation
+	if txContext.AllowedCollectionWriteAccess[collection] {
+		return true, nil
+	}
+
+	cc := common.CollectionCriteria{
+		Channel:    txContext.ChainID,
+		Namespace:  chaincodeName,
+		Collection: collection,
+	}
+
+	accessAllowed, err := txContext.CollectionStore.HasWriteAccess(cc, txContext.SignedProp, txContext.TXSimulator)
+	if err != nil {
+		return false, err
+	}
+	if accessAllowed {
+		txContext.AllowedCollectionWriteAccess[collection] = accessAllowed
+	}
+
+	return accessAllowed, err
+}
+
 // Handles query to ledger to get state
 func (h *Handler) HandleGetState(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	getState := &pb.GetState{}
@@ -894,15 +932,47 @@ func (h *Handler) HandleGetHistoryForKey(msg *pb.ChaincodeMessage, txContext *Tr
 		return nil, errors.Wrap(err, "unmarshal failed")
 	}
 
-	historyIter, err := txContext.HistoryQueryExecutor.GetHistoryForKey(chaincodeName, getHistoryForKey.Key)
+	metadata, err := getHistoryQueryMetadataFromBytes(getHistoryForKey.Metadata)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, err
 	}
 
-	totalReturnLimit := calculateTotalReturnLimit(nil)
+	var historyIter commonledger.ResultsIterator
+	var totalReturnLimit int32
+	isPaginated := false
+
+	collection := getHistoryForKey.Collection
+	if isCollectionSet(collection) {
+		if txContext.IsInitTransaction {
+			return nil, errors.New("private data APIs are not allowed in chaincode Init()")
+		}
+		if metadata != nil {
+			return nil, errors.New("the history of a private data key cannot be bounded or paginated")
+		}
+		if err := errorIfCreatorHasNoReadAccess(chaincodeName, collection, txContext); err != nil {
+			return nil, err
+		}
+		totalReturnLimit = calculateTotalReturnLimit(nil)
+		historyIter, err = txContext.HistoryQueryExecutor.GetPrivateDataHistoryForKey(chaincodeName, collection, getHistoryForKey.Key)
+	} else if metadata == nil {
+		totalReturnLimit = calculateTotalReturnLimit(nil)
+		historyIter, err = txContext.HistoryQueryExecutor.GetHistoryForKey(chaincodeName, getHistoryForKey.Key)
+	} else {
+		paginationMetadata := &pb.QueryMetadata{PageSize: metadata.PageSize, Bookmark: metadata.Bookmark}
+		totalReturnLimit = calculateTotalReturnLimit(paginationMetadata)
+		options := &ledger.HistoryQueryOptions{Descending: metadata.Descending, Bookmark: metadata.Bookmark}
+		if isMetadataSetForPagination(paginationMetadata) {
+			isPaginated = true
+			options.PageSize = totalReturnLimit
+		}
+		historyIter, err = getBoundedHistoryForKey(txContext.HistoryQueryExecutor, chaincodeName, getHistoryForKey.Key, metadata, options)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	txContext.InitializeQueryContext(iterID, historyIter)
-	payload, err := h.QueryResponseBuilder.BuildQueryResponse(txContext, historyIter, iterID, false, totalReturnLimit)
+	payload, err := h.QueryResponseBuilder.BuildQueryResponse(txContext, historyIter, iterID, isPaginated, totalReturnLimit)
 	if err != nil {
 		txContext.CleanupQueryContext(iterID)
 		return nil, errors.WithStack(err)
@@ -918,6 +988,33 @@ func (h *Handler) HandleGetHistoryForKey(msg *pb.ChaincodeMessage, txContext *Tr
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payloadBytes, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+func getBoundedHistoryForKey(hqe ledger.HistoryQueryExecutor, namespace, key string,
+	metadata *pb.HistoryQueryMetadata, options *ledger.HistoryQueryOptions) (commonledger.ResultsIterator, error) {
+	if metadata.StartTime != nil || metadata.EndTime != nil {
+		if metadata.StartBlock != 0 || metadata.EndBlock != 0 {
+			return nil, errors.New("a history query cannot be bounded by both a block range and a time range")
+		}
+		return hqe.GetHistoryForKeyByTimeRange(namespace, key, metadata.StartTime, metadata.EndTime, options)
+	}
+	endBlock := metadata.EndBlock
+	if endBlock == 0 {
+		endBlock = math.MaxUint64
+	}
+	return hqe.GetHistoryForKeyByBlockRange(namespace, key, metadata.StartBlock, endBlock, options)
+}
+
+func getHistoryQueryMetadataFromBytes(metadataBytes []byte) (*pb.HistoryQueryMetadata, error) {
+	if metadataBytes != nil {
+		metadata := &pb.HistoryQueryMetadata{}
+		err := proto.Unmarshal(metadataBytes, metadata)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal failed")
+		}
+		return metadata, nil
+	}
+	return nil, nil
+}
+
 func isCollectionSet(collection string) bool {
 	return collection != ""
 }
@@ -1021,6 +1118,9 @@ func (h *Handler) HandlePutState(msg *pb.ChaincodeMessage, txContext *Transactio
 		if txContext.IsInitTransaction {
 			return nil, errors.New("private data APIs are not allowed in chaincode Init()")
 		}
+		if err := errorIfCreatorHasNoWriteAccess(chaincodeName, collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.SetPrivateData(chaincodeName, collection, putState.Key, putState.Value)
 	} else {
 		err = txContext.TXSimulator.SetState(chaincodeName, putState.Key, putState.Value)
@@ -1053,6 +1153,9 @@ func (h *Handler) HandlePutStateMetadata(msg *pb.ChaincodeMessage, txContext *Tr
 		if txContext.IsInitTransaction {
 			return nil, errors.New("private data APIs are not allowed in chaincode Init()")
 		}
+		if err := errorIfCreatorHasNoWriteAccess(chaincodeName, collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.SetPrivateDataMetadata(chaincodeName, collection, putStateMetadata.Key, metadata)
 	} else {
 		err = txContext.TXSimulator.SetStateMetadata(chaincodeName, putStateMetadata.Key, metadata)
@@ -1077,6 +1180,9 @@ func (h *Handler) HandleDelState(msg *pb.ChaincodeMessage, txContext *Transactio
 		if txContext.IsInitTransaction {
 			return nil, errors.New("private data APIs are not allowed in chaincode Init()")
 		}
+		if err := errorIfCreatorHasNoWriteAccess(chaincodeName, collection, txContext); err != nil {
+			return nil, err
+		}
 		err = txContext.TXSimulator.DeletePrivateData(chaincodeName, collection, delState.Key)
 	} else {
 		err = txContext.TXSimulator.DeleteState(chaincodeName, delState.Key)
@@ -1089,6 +1195,33 @@ func (h *Handler) HandleDelState(msg *pb.ChaincodeMessage, txContext *Transactio
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+// HandlePurgePrivateData handles requests to purge all the historical versions
+// of a key from a private data collection
+func (h *Handler) HandlePurgePrivateData(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
+	delState := &pb.DelState{}
+	err := proto.Unmarshal(msg.Payload, delState)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+
+	chaincodeName := h.ChaincodeName()
+	collection := delState.Collection
+	if !isCollectionSet(collection) {
+		return nil, errors.New("only private data can be purged")
+	}
+	if txContext.IsInitTransaction {
+		return nil, errors.New("private data APIs are not allowed in chaincode Init()")
+	}
+	if err := errorIfCreatorHasNoWriteAccess(chaincodeName, collection, txContext); err != nil {
+		return nil, err
+	}
+	if err := txContext.TXSimulator.PurgePrivateData(chaincodeName, collection, delState.Key); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
+}
+
 // Handles requests that modify ledger state
 func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	chaincodeLogger.Debugf("[%s] C-call-C", shorttxid(msg.Txid))
@@ -8,9 +8,11 @@ package chaincode_test
 
 import (
 	"io"
+	"math"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
 	"github.com/hyperledger/fabric/common/mocks/config"
 	"github.com/hyperledger/fabric/common/util"
@@ -20,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/mock"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/core/ledger"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -68,12 +71,13 @@ var _ = Describe("Handler", func() {
 
 		responseNotifier = make(chan *pb.ChaincodeMessage, 1)
 		txContext = &chaincode.TransactionContext{
-			ChainID:                 "channel-id",
-			TXSimulator:             fakeTxSimulator,
-			HistoryQueryExecutor:    fakeHistoryQueryExecutor,
-			ResponseNotifier:        responseNotifier,
-			CollectionStore:         fakeCollectionStore,
-			AllowedCollectionAccess: make(map[string]bool),
+			ChainID:                      "channel-id",
+			TXSimulator:                  fakeTxSimulator,
+			HistoryQueryExecutor:         fakeHistoryQueryExecutor,
+			ResponseNotifier:             responseNotifier,
+			CollectionStore:              fakeCollectionStore,
+			AllowedCollectionAccess:      make(map[string]bool),
+			AllowedCollectionWriteAccess: make(map[string]bool),
 		}
 
 		fakeACLProvider = &mock.ACLProvider{}
@@ -581,6 +585,8 @@ var _ = Describe("Handler", func() {
 				payload, err := proto.Marshal(request)
 				Expect(err).NotTo(HaveOccurred())
 				incomingMessage.Payload = payload
+
+				fakeCollectionStore.HasWriteAccessReturns(true, nil)
 			})
 
 			It("calls SetPrivateData on the transaction simulator", func() {
@@ -595,6 +601,29 @@ var _ = Describe("Handler", func() {
 				Expect(value).To(Equal([]byte("put-state-value")))
 			})
 
+			Context("when the tx creator does not have write access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, nil)
+				})
+
+				It("returns an error without calling SetPrivateData", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+					Expect(fakeTxSimulator.SetPrivateDataCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the write access check fails", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, errors.New("no collection config"))
+				})
+
+				It("returns the error", func() {
+					_, err := handler.HandlePutState(incomingMessage, txContext)
+					Expect(err).To(MatchError("no collection config"))
+				})
+			})
+
 			Context("when SetPrivateData fails due to ledger error", func() {
 				BeforeEach(func() {
 					fakeTxSimulator.SetPrivateDataReturns(errors.New("godzilla"))
@@ -729,6 +758,8 @@ var _ = Describe("Handler", func() {
 				payload, err := proto.Marshal(request)
 				Expect(err).NotTo(HaveOccurred())
 				incomingMessage.Payload = payload
+
+				fakeCollectionStore.HasWriteAccessReturns(true, nil)
 			})
 
 			It("calls SetPrivateDataMetadata on the transaction simulator", func() {
@@ -745,6 +776,29 @@ var _ = Describe("Handler", func() {
 				}))
 			})
 
+			Context("when the tx creator does not have write access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, nil)
+				})
+
+				It("returns an error without calling SetPrivateDataMetadata", func() {
+					_, err := handler.HandlePutStateMetadata(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+					Expect(fakeTxSimulator.SetPrivateDataMetadataCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the write access check fails", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, errors.New("no collection config"))
+				})
+
+				It("returns the error", func() {
+					_, err := handler.HandlePutStateMetadata(incomingMessage, txContext)
+					Expect(err).To(MatchError("no collection config"))
+				})
+			})
+
 			Context("when SetPrivateDataMetadata fails", func() {
 				BeforeEach(func() {
 					fakeTxSimulator.SetPrivateDataMetadataReturns(errors.New("godzilla"))
@@ -827,6 +881,8 @@ var _ = Describe("Handler", func() {
 				payload, err := proto.Marshal(request)
 				Expect(err).NotTo(HaveOccurred())
 				incomingMessage.Payload = payload
+
+				fakeCollectionStore.HasWriteAccessReturns(true, nil)
 			})
 
 			It("calls DeletePrivateData on the transaction simulator", func() {
@@ -840,6 +896,29 @@ var _ = Describe("Handler", func() {
 				Expect(key).To(Equal("del-state-key"))
 			})
 
+			Context("when the tx creator does not have write access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, nil)
+				})
+
+				It("returns an error without calling DeletePrivateData", func() {
+					_, err := handler.HandleDelState(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+					Expect(fakeTxSimulator.DeletePrivateDataCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the write access check fails", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasWriteAccessReturns(false, errors.New("no collection config"))
+				})
+
+				It("returns the error", func() {
+					_, err := handler.HandleDelState(incomingMessage, txContext)
+					Expect(err).To(MatchError("no collection config"))
+				})
+			})
+
 			Context("when DeletePrivateData fails due to ledger error", func() {
 				BeforeEach(func() {
 					fakeTxSimulator.DeletePrivateDataReturns(errors.New("mango"))
@@ -864,6 +943,105 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Describe("HandlePurgePrivateData", func() {
+		var incomingMessage *pb.ChaincodeMessage
+		var request *pb.DelState
+
+		BeforeEach(func() {
+			request = &pb.DelState{
+				Collection: "collection-name",
+				Key:        "purge-key",
+			}
+			payload, err := proto.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			incomingMessage = &pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_PURGE_PRIVATE_DATA,
+				Payload:   payload,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}
+
+			fakeCollectionStore.HasWriteAccessReturns(true, nil)
+		})
+
+		It("calls PurgePrivateData on the transaction simulator and returns a response message", func() {
+			resp, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(Equal(&pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_RESPONSE,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}))
+
+			Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(1))
+			ccname, collection, key := fakeTxSimulator.PurgePrivateDataArgsForCall(0)
+			Expect(ccname).To(Equal("cc-instance-name"))
+			Expect(collection).To(Equal("collection-name"))
+			Expect(key).To(Equal("purge-key"))
+		})
+
+		Context("when unmarshalling the request fails", func() {
+			BeforeEach(func() {
+				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+			})
+		})
+
+		Context("when collection is not set", func() {
+			BeforeEach(func() {
+				request.Collection = ""
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("only private data can be purged"))
+				Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the tx creator does not have write access", func() {
+			BeforeEach(func() {
+				fakeCollectionStore.HasWriteAccessReturns(false, nil)
+			})
+
+			It("returns an error without calling PurgePrivateData", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("tx creator does not have write access permission on privatedata in chaincodeName:cc-instance-name collectionName: collection-name"))
+				Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when PurgePrivateData fails due to ledger error", func() {
+			BeforeEach(func() {
+				fakeTxSimulator.PurgePrivateDataReturns(errors.New("papaya"))
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("papaya"))
+			})
+		})
+
+		Context("when the transaction is an Init transaction", func() {
+			BeforeEach(func() {
+				txContext.IsInitTransaction = true
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("private data APIs are not allowed in chaincode Init()"))
+			})
+		})
+	})
+
 	Describe("HandleGetState", func() {
 		var (
 			incomingMessage  *pb.ChaincodeMessage
@@ -1923,6 +2101,154 @@ var _ = Describe("Handler", func() {
 			Expect(iterID).To(Equal("generated-query-id"))
 		})
 
+		Context("when the history query is bounded by a block range", func() {
+			BeforeEach(func() {
+				metadata, err := proto.Marshal(&pb.HistoryQueryMetadata{StartBlock: 3, Descending: true, PageSize: 5, Bookmark: "4:1"})
+				Expect(err).NotTo(HaveOccurred())
+				request.Metadata = metadata
+				incomingMessage.Payload, err = proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeHistoryQueryExecutor.GetHistoryForKeyByBlockRangeReturns(fakeIterator, nil)
+			})
+
+			It("calls GetHistoryForKeyByBlockRange on the history query executor", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyCallCount()).To(Equal(0))
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyByBlockRangeCallCount()).To(Equal(1))
+				ccname, key, startBlock, endBlock, options := fakeHistoryQueryExecutor.GetHistoryForKeyByBlockRangeArgsForCall(0)
+				Expect(ccname).To(Equal("cc-instance-name"))
+				Expect(key).To(Equal("history-key"))
+				Expect(startBlock).To(Equal(uint64(3)))
+				Expect(endBlock).To(Equal(uint64(math.MaxUint64)))
+				Expect(options).To(Equal(&ledger.HistoryQueryOptions{Descending: true, PageSize: 5, Bookmark: "4:1"}))
+			})
+
+			It("builds a paginated query response", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeQueryResponseBuilder.BuildQueryResponseCallCount()).To(Equal(1))
+				_, iter, _, isPaginated, totalReturnLimit := fakeQueryResponseBuilder.BuildQueryResponseArgsForCall(0)
+				Expect(iter).To(Equal(fakeIterator))
+				Expect(isPaginated).To(BeTrue())
+				Expect(totalReturnLimit).To(Equal(int32(5)))
+			})
+		})
+
+		Context("when the history query is bounded by a time range", func() {
+			BeforeEach(func() {
+				metadata, err := proto.Marshal(&pb.HistoryQueryMetadata{EndTime: &timestamp.Timestamp{Seconds: 100}})
+				Expect(err).NotTo(HaveOccurred())
+				request.Metadata = metadata
+				incomingMessage.Payload, err = proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeHistoryQueryExecutor.GetHistoryForKeyByTimeRangeReturns(fakeIterator, nil)
+			})
+
+			It("calls GetHistoryForKeyByTimeRange on the history query executor", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyByTimeRangeCallCount()).To(Equal(1))
+				ccname, key, startTime, endTime, options := fakeHistoryQueryExecutor.GetHistoryForKeyByTimeRangeArgsForCall(0)
+				Expect(ccname).To(Equal("cc-instance-name"))
+				Expect(key).To(Equal("history-key"))
+				Expect(startTime).To(BeNil())
+				Expect(proto.Equal(endTime, &timestamp.Timestamp{Seconds: 100})).To(BeTrue())
+				Expect(options).To(Equal(&ledger.HistoryQueryOptions{}))
+
+				_, _, _, isPaginated, _ := fakeQueryResponseBuilder.BuildQueryResponseArgsForCall(0)
+				Expect(isPaginated).To(BeFalse())
+			})
+
+			Context("and by a block range", func() {
+				BeforeEach(func() {
+					metadata, err := proto.Marshal(&pb.HistoryQueryMetadata{EndBlock: 5, EndTime: &timestamp.Timestamp{Seconds: 100}})
+					Expect(err).NotTo(HaveOccurred())
+					request.Metadata = metadata
+					incomingMessage.Payload, err = proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("a history query cannot be bounded by both a block range and a time range"))
+				})
+			})
+		})
+
+		Context("when collection is set", func() {
+			BeforeEach(func() {
+				request.Collection = "collection-name"
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+
+				fakeCollectionStore.HasReadAccessReturns(true, nil)
+				fakeHistoryQueryExecutor.GetPrivateDataHistoryForKeyReturns(fakeIterator, nil)
+			})
+
+			It("calls GetPrivateDataHistoryForKey on the history query executor", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyCallCount()).To(Equal(0))
+				Expect(fakeHistoryQueryExecutor.GetPrivateDataHistoryForKeyCallCount()).To(Equal(1))
+				ccname, collection, key := fakeHistoryQueryExecutor.GetPrivateDataHistoryForKeyArgsForCall(0)
+				Expect(ccname).To(Equal("cc-instance-name"))
+				Expect(collection).To(Equal("collection-name"))
+				Expect(key).To(Equal("history-key"))
+
+				Expect(fakeQueryResponseBuilder.BuildQueryResponseCallCount()).To(Equal(1))
+				_, iter, _, isPaginated, _ := fakeQueryResponseBuilder.BuildQueryResponseArgsForCall(0)
+				Expect(iter).To(Equal(fakeIterator))
+				Expect(isPaginated).To(BeFalse())
+			})
+
+			Context("and the history query is bounded", func() {
+				BeforeEach(func() {
+					metadata, err := proto.Marshal(&pb.HistoryQueryMetadata{StartBlock: 3})
+					Expect(err).NotTo(HaveOccurred())
+					request.Metadata = metadata
+					incomingMessage.Payload, err = proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("the history of a private data key cannot be bounded or paginated"))
+				})
+			})
+
+			Context("and the tx creator does not have read access", func() {
+				BeforeEach(func() {
+					fakeCollectionStore.HasReadAccessReturns(false, nil)
+				})
+
+				It("returns the error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("tx creator does not have read access" +
+						" permission on privatedata in chaincodeName:cc-instance-name" +
+						" collectionName: collection-name"))
+				})
+			})
+
+			Context("and the transaction is an Init transaction", func() {
+				BeforeEach(func() {
+					txContext.IsInitTransaction = true
+				})
+
+				It("returns the error from errorIfInitTransaction", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("private data APIs are not allowed in chaincode Init()"))
+				})
+			})
+		})
+
 		Context("when unmarshalling the request fails", func() {
 			BeforeEach(func() {
 				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
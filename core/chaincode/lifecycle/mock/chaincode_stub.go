@@ -138,6 +138,46 @@ type ChaincodeStub struct {
 		result1 shim.HistoryQueryIteratorInterface
 		result2 error
 	}
+	GetHistoryForKeyByBlockRangeStub        func(string, uint64, uint64, bool, int32, string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error)
+	getHistoryForKeyByBlockRangeMutex       sync.RWMutex
+	getHistoryForKeyByBlockRangeArgsForCall []struct {
+		arg1 string
+		arg2 uint64
+		arg3 uint64
+		arg4 bool
+		arg5 int32
+		arg6 string
+	}
+	getHistoryForKeyByBlockRangeReturns struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}
+	getHistoryForKeyByBlockRangeReturnsOnCall map[int]struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}
+	GetHistoryForKeyByTimeRangeStub        func(string, *timestamp.Timestamp, *timestamp.Timestamp, bool, int32, string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error)
+	getHistoryForKeyByTimeRangeMutex       sync.RWMutex
+	getHistoryForKeyByTimeRangeArgsForCall []struct {
+		arg1 string
+		arg2 *timestamp.Timestamp
+		arg3 *timestamp.Timestamp
+		arg4 bool
+		arg5 int32
+		arg6 string
+	}
+	getHistoryForKeyByTimeRangeReturns struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}
+	getHistoryForKeyByTimeRangeReturnsOnCall map[int]struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}
 	GetPrivateDataStub        func(string, string) ([]byte, error)
 	getPrivateDataMutex       sync.RWMutex
 	getPrivateDataArgsForCall []struct {
@@ -182,6 +222,20 @@ type ChaincodeStub struct {
 		result1 shim.StateQueryIteratorInterface
 		result2 error
 	}
+	GetPrivateDataHistoryForKeyStub        func(string, string) (shim.HistoryQueryIteratorInterface, error)
+	getPrivateDataHistoryForKeyMutex       sync.RWMutex
+	getPrivateDataHistoryForKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPrivateDataHistoryForKeyReturns struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 error
+	}
+	getPrivateDataHistoryForKeyReturnsOnCall map[int]struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 error
+	}
 	GetPrivateDataQueryResultStub        func(string, string) (shim.StateQueryIteratorInterface, error)
 	getPrivateDataQueryResultMutex       sync.RWMutex
 	getPrivateDataQueryResultArgsForCall []struct {
@@ -399,6 +453,18 @@ type ChaincodeStub struct {
 	invokeChaincodeReturnsOnCall map[int]struct {
 		result1 peer.Response
 	}
+	PurgePrivateDataStub        func(string, string) error
+	purgePrivateDataMutex       sync.RWMutex
+	purgePrivateDataArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	purgePrivateDataReturns struct {
+		result1 error
+	}
+	purgePrivateDataReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PutPrivateDataStub        func(string, string, []byte) error
 	putPrivateDataMutex       sync.RWMutex
 	putPrivateDataArgsForCall []struct {
@@ -1109,6 +1175,148 @@ func (fake *ChaincodeStub) GetHistoryForKeyReturnsOnCall(i int, result1 shim.His
 	}{result1, result2}
 }
 
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRange(arg1 string, arg2 uint64, arg3 uint64, arg4 bool, arg5 int32, arg6 string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	ret, specificReturn := fake.getHistoryForKeyByBlockRangeReturnsOnCall[len(fake.getHistoryForKeyByBlockRangeArgsForCall)]
+	fake.getHistoryForKeyByBlockRangeArgsForCall = append(fake.getHistoryForKeyByBlockRangeArgsForCall, struct {
+		arg1 string
+		arg2 uint64
+		arg3 uint64
+		arg4 bool
+		arg5 int32
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("GetHistoryForKeyByBlockRange", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	if fake.GetHistoryForKeyByBlockRangeStub != nil {
+		return fake.GetHistoryForKeyByBlockRangeStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getHistoryForKeyByBlockRangeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRangeCallCount() int {
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	return len(fake.getHistoryForKeyByBlockRangeArgsForCall)
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRangeCalls(stub func(string, uint64, uint64, bool, int32, string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error)) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = stub
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRangeArgsForCall(i int) (string, uint64, uint64, bool, int32, string) {
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	argsForCall := fake.getHistoryForKeyByBlockRangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRangeReturns(result1 shim.HistoryQueryIteratorInterface, result2 *peer.QueryResponseMetadata, result3 error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = nil
+	fake.getHistoryForKeyByBlockRangeReturns = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByBlockRangeReturnsOnCall(i int, result1 shim.HistoryQueryIteratorInterface, result2 *peer.QueryResponseMetadata, result3 error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = nil
+	if fake.getHistoryForKeyByBlockRangeReturnsOnCall == nil {
+		fake.getHistoryForKeyByBlockRangeReturnsOnCall = make(map[int]struct {
+			result1 shim.HistoryQueryIteratorInterface
+			result2 *peer.QueryResponseMetadata
+			result3 error
+		})
+	}
+	fake.getHistoryForKeyByBlockRangeReturnsOnCall[i] = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRange(arg1 string, arg2 *timestamp.Timestamp, arg3 *timestamp.Timestamp, arg4 bool, arg5 int32, arg6 string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	ret, specificReturn := fake.getHistoryForKeyByTimeRangeReturnsOnCall[len(fake.getHistoryForKeyByTimeRangeArgsForCall)]
+	fake.getHistoryForKeyByTimeRangeArgsForCall = append(fake.getHistoryForKeyByTimeRangeArgsForCall, struct {
+		arg1 string
+		arg2 *timestamp.Timestamp
+		arg3 *timestamp.Timestamp
+		arg4 bool
+		arg5 int32
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("GetHistoryForKeyByTimeRange", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	if fake.GetHistoryForKeyByTimeRangeStub != nil {
+		return fake.GetHistoryForKeyByTimeRangeStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getHistoryForKeyByTimeRangeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRangeCallCount() int {
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
+	return len(fake.getHistoryForKeyByTimeRangeArgsForCall)
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRangeCalls(stub func(string, *timestamp.Timestamp, *timestamp.Timestamp, bool, int32, string) (shim.HistoryQueryIteratorInterface, *peer.QueryResponseMetadata, error)) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = stub
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRangeArgsForCall(i int) (string, *timestamp.Timestamp, *timestamp.Timestamp, bool, int32, string) {
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
+	argsForCall := fake.getHistoryForKeyByTimeRangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRangeReturns(result1 shim.HistoryQueryIteratorInterface, result2 *peer.QueryResponseMetadata, result3 error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = nil
+	fake.getHistoryForKeyByTimeRangeReturns = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *ChaincodeStub) GetHistoryForKeyByTimeRangeReturnsOnCall(i int, result1 shim.HistoryQueryIteratorInterface, result2 *peer.QueryResponseMetadata, result3 error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = nil
+	if fake.getHistoryForKeyByTimeRangeReturnsOnCall == nil {
+		fake.getHistoryForKeyByTimeRangeReturnsOnCall = make(map[int]struct {
+			result1 shim.HistoryQueryIteratorInterface
+			result2 *peer.QueryResponseMetadata
+			result3 error
+		})
+	}
+	fake.getHistoryForKeyByTimeRangeReturnsOnCall[i] = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 *peer.QueryResponseMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *ChaincodeStub) GetPrivateData(arg1 string, arg2 string) ([]byte, error) {
 	fake.getPrivateDataMutex.Lock()
 	ret, specificReturn := fake.getPrivateDataReturnsOnCall[len(fake.getPrivateDataArgsForCall)]
@@ -1308,6 +1516,70 @@ func (fake *ChaincodeStub) GetPrivateDataByRangeReturnsOnCall(i int, result1 shi
 	}{result1, result2}
 }
 
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKey(arg1 string, arg2 string) (shim.HistoryQueryIteratorInterface, error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	ret, specificReturn := fake.getPrivateDataHistoryForKeyReturnsOnCall[len(fake.getPrivateDataHistoryForKeyArgsForCall)]
+	fake.getPrivateDataHistoryForKeyArgsForCall = append(fake.getPrivateDataHistoryForKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetPrivateDataHistoryForKey", []interface{}{arg1, arg2})
+	fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	if fake.GetPrivateDataHistoryForKeyStub != nil {
+		return fake.GetPrivateDataHistoryForKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPrivateDataHistoryForKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKeyCallCount() int {
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
+	return len(fake.getPrivateDataHistoryForKeyArgsForCall)
+}
+
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKeyCalls(stub func(string, string) (shim.HistoryQueryIteratorInterface, error)) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = stub
+}
+
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKeyArgsForCall(i int) (string, string) {
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
+	argsForCall := fake.getPrivateDataHistoryForKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKeyReturns(result1 shim.HistoryQueryIteratorInterface, result2 error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = nil
+	fake.getPrivateDataHistoryForKeyReturns = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ChaincodeStub) GetPrivateDataHistoryForKeyReturnsOnCall(i int, result1 shim.HistoryQueryIteratorInterface, result2 error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = nil
+	if fake.getPrivateDataHistoryForKeyReturnsOnCall == nil {
+		fake.getPrivateDataHistoryForKeyReturnsOnCall = make(map[int]struct {
+			result1 shim.HistoryQueryIteratorInterface
+			result2 error
+		})
+	}
+	fake.getPrivateDataHistoryForKeyReturnsOnCall[i] = struct {
+		result1 shim.HistoryQueryIteratorInterface
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *ChaincodeStub) GetPrivateDataQueryResult(arg1 string, arg2 string) (shim.StateQueryIteratorInterface, error) {
 	fake.getPrivateDataQueryResultMutex.Lock()
 	ret, specificReturn := fake.getPrivateDataQueryResultReturnsOnCall[len(fake.getPrivateDataQueryResultArgsForCall)]
@@ -2305,6 +2577,67 @@ func (fake *ChaincodeStub) InvokeChaincodeReturnsOnCall(i int, result1 peer.Resp
 	}{result1}
 }
 
+func (fake *ChaincodeStub) PurgePrivateData(arg1 string, arg2 string) error {
+	fake.purgePrivateDataMutex.Lock()
+	ret, specificReturn := fake.purgePrivateDataReturnsOnCall[len(fake.purgePrivateDataArgsForCall)]
+	fake.purgePrivateDataArgsForCall = append(fake.purgePrivateDataArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PurgePrivateData", []interface{}{arg1, arg2})
+	fake.purgePrivateDataMutex.Unlock()
+	if fake.PurgePrivateDataStub != nil {
+		return fake.PurgePrivateDataStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.purgePrivateDataReturns
+	return fakeReturns.result1
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataCallCount() int {
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
+	return len(fake.purgePrivateDataArgsForCall)
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataCalls(stub func(string, string) error) {
+	fake.purgePrivateDataMutex.Lock()
+	defer fake.purgePrivateDataMutex.Unlock()
+	fake.PurgePrivateDataStub = stub
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataArgsForCall(i int) (string, string) {
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
+	argsForCall := fake.purgePrivateDataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataReturns(result1 error) {
+	fake.purgePrivateDataMutex.Lock()
+	defer fake.purgePrivateDataMutex.Unlock()
+	fake.PurgePrivateDataStub = nil
+	fake.purgePrivateDataReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ChaincodeStub) PurgePrivateDataReturnsOnCall(i int, result1 error) {
+	fake.purgePrivateDataMutex.Lock()
+	defer fake.purgePrivateDataMutex.Unlock()
+	fake.PurgePrivateDataStub = nil
+	if fake.purgePrivateDataReturnsOnCall == nil {
+		fake.purgePrivateDataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.purgePrivateDataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *ChaincodeStub) PutPrivateData(arg1 string, arg2 string, arg3 []byte) error {
 	var arg3Copy []byte
 	if arg3 != nil {
@@ -2728,12 +3061,18 @@ func (fake *ChaincodeStub) Invocations() map[string][][]interface{} {
 	defer fake.getFunctionAndParametersMutex.RUnlock()
 	fake.getHistoryForKeyMutex.RLock()
 	defer fake.getHistoryForKeyMutex.RUnlock()
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
 	fake.getPrivateDataMutex.RLock()
 	defer fake.getPrivateDataMutex.RUnlock()
 	fake.getPrivateDataByPartialCompositeKeyMutex.RLock()
 	defer fake.getPrivateDataByPartialCompositeKeyMutex.RUnlock()
 	fake.getPrivateDataByRangeMutex.RLock()
 	defer fake.getPrivateDataByRangeMutex.RUnlock()
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
 	fake.getPrivateDataQueryResultMutex.RLock()
 	defer fake.getPrivateDataQueryResultMutex.RUnlock()
 	fake.getPrivateDataValidationParameterMutex.RLock()
@@ -2766,6 +3105,8 @@ func (fake *ChaincodeStub) Invocations() map[string][][]interface{} {
 	defer fake.getTxTimestampMutex.RUnlock()
 	fake.invokeChaincodeMutex.RLock()
 	defer fake.invokeChaincodeMutex.RUnlock()
+	fake.purgePrivateDataMutex.RLock()
+	defer fake.purgePrivateDataMutex.RUnlock()
 	fake.putPrivateDataMutex.RLock()
 	defer fake.putPrivateDataMutex.RUnlock()
 	fake.putStateMutex.RLock()
@@ -40,6 +40,21 @@ type CollectionStore struct {
 		result1 bool
 		result2 error
 	}
+	HasWriteAccessStub        func(common.CollectionCriteria, *peer.SignedProposal, ledger.QueryExecutor) (bool, error)
+	hasWriteAccessMutex       sync.RWMutex
+	hasWriteAccessArgsForCall []struct {
+		arg1 common.CollectionCriteria
+		arg2 *peer.SignedProposal
+		arg3 ledger.QueryExecutor
+	}
+	hasWriteAccessReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasWriteAccessReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RetrieveCollectionStub        func(common.CollectionCriteria) (privdata.Collection, error)
 	retrieveCollectionMutex       sync.RWMutex
 	retrieveCollectionArgsForCall []struct {
@@ -225,6 +240,71 @@ func (fake *CollectionStore) HasReadAccessReturnsOnCall(i int, result1 bool, res
 	}{result1, result2}
 }
 
+func (fake *CollectionStore) HasWriteAccess(arg1 common.CollectionCriteria, arg2 *peer.SignedProposal, arg3 ledger.QueryExecutor) (bool, error) {
+	fake.hasWriteAccessMutex.Lock()
+	ret, specificReturn := fake.hasWriteAccessReturnsOnCall[len(fake.hasWriteAccessArgsForCall)]
+	fake.hasWriteAccessArgsForCall = append(fake.hasWriteAccessArgsForCall, struct {
+		arg1 common.CollectionCriteria
+		arg2 *peer.SignedProposal
+		arg3 ledger.QueryExecutor
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("HasWriteAccess", []interface{}{arg1, arg2, arg3})
+	fake.hasWriteAccessMutex.Unlock()
+	if fake.HasWriteAccessStub != nil {
+		return fake.HasWriteAccessStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.hasWriteAccessReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *CollectionStore) HasWriteAccessCallCount() int {
+	fake.hasWriteAccessMutex.RLock()
+	defer fake.hasWriteAccessMutex.RUnlock()
+	return len(fake.hasWriteAccessArgsForCall)
+}
+
+func (fake *CollectionStore) HasWriteAccessCalls(stub func(common.CollectionCriteria, *peer.SignedProposal, ledger.QueryExecutor) (bool, error)) {
+	fake.hasWriteAccessMutex.Lock()
+	defer fake.hasWriteAccessMutex.Unlock()
+	fake.HasWriteAccessStub = stub
+}
+
+func (fake *CollectionStore) HasWriteAccessArgsForCall(i int) (common.CollectionCriteria, *peer.SignedProposal, ledger.QueryExecutor) {
+	fake.hasWriteAccessMutex.RLock()
+	defer fake.hasWriteAccessMutex.RUnlock()
+	argsForCall := fake.hasWriteAccessArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *CollectionStore) HasWriteAccessReturns(result1 bool, result2 error) {
+	fake.hasWriteAccessMutex.Lock()
+	defer fake.hasWriteAccessMutex.Unlock()
+	fake.HasWriteAccessStub = nil
+	fake.hasWriteAccessReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *CollectionStore) HasWriteAccessReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasWriteAccessMutex.Lock()
+	defer fake.hasWriteAccessMutex.Unlock()
+	fake.HasWriteAccessStub = nil
+	if fake.hasWriteAccessReturnsOnCall == nil {
+		fake.hasWriteAccessReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasWriteAccessReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *CollectionStore) RetrieveCollection(arg1 common.CollectionCriteria) (privdata.Collection, error) {
 	fake.retrieveCollectionMutex.Lock()
 	ret, specificReturn := fake.retrieveCollectionReturnsOnCall[len(fake.retrieveCollectionArgsForCall)]
@@ -484,6 +564,8 @@ func (fake *CollectionStore) Invocations() map[string][][]interface{} {
 	defer fake.accessFilterMutex.RUnlock()
 	fake.hasReadAccessMutex.RLock()
 	defer fake.hasReadAccessMutex.RUnlock()
+	fake.hasWriteAccessMutex.RLock()
+	defer fake.hasWriteAccessMutex.RUnlock()
 	fake.retrieveCollectionMutex.RLock()
 	defer fake.retrieveCollectionMutex.RUnlock()
 	fake.retrieveCollectionAccessPolicyMutex.RLock()
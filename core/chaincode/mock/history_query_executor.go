@@ -4,7 +4,9 @@ package mock
 import (
 	sync "sync"
 
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	ledger "github.com/hyperledger/fabric/common/ledger"
+	ledgera "github.com/hyperledger/fabric/core/ledger"
 )
 
 type HistoryQueryExecutor struct {
@@ -22,6 +24,55 @@ type HistoryQueryExecutor struct {
 		result1 ledger.ResultsIterator
 		result2 error
 	}
+	GetHistoryForKeyByBlockRangeStub        func(string, string, uint64, uint64, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)
+	getHistoryForKeyByBlockRangeMutex       sync.RWMutex
+	getHistoryForKeyByBlockRangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 uint64
+		arg4 uint64
+		arg5 *ledgera.HistoryQueryOptions
+	}
+	getHistoryForKeyByBlockRangeReturns struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	getHistoryForKeyByBlockRangeReturnsOnCall map[int]struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	GetHistoryForKeyByTimeRangeStub        func(string, string, *timestamp.Timestamp, *timestamp.Timestamp, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)
+	getHistoryForKeyByTimeRangeMutex       sync.RWMutex
+	getHistoryForKeyByTimeRangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *timestamp.Timestamp
+		arg4 *timestamp.Timestamp
+		arg5 *ledgera.HistoryQueryOptions
+	}
+	getHistoryForKeyByTimeRangeReturns struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	getHistoryForKeyByTimeRangeReturnsOnCall map[int]struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	GetPrivateDataHistoryForKeyStub        func(string, string, string) (ledger.ResultsIterator, error)
+	getPrivateDataHistoryForKeyMutex       sync.RWMutex
+	getPrivateDataHistoryForKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getPrivateDataHistoryForKeyReturns struct {
+		result1 ledger.ResultsIterator
+		result2 error
+	}
+	getPrivateDataHistoryForKeyReturnsOnCall map[int]struct {
+		result1 ledger.ResultsIterator
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -90,11 +141,216 @@ func (fake *HistoryQueryExecutor) GetHistoryForKeyReturnsOnCall(i int, result1 l
 	}{result1, result2}
 }
 
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRange(arg1 string, arg2 string, arg3 uint64, arg4 uint64, arg5 *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	ret, specificReturn := fake.getHistoryForKeyByBlockRangeReturnsOnCall[len(fake.getHistoryForKeyByBlockRangeArgsForCall)]
+	fake.getHistoryForKeyByBlockRangeArgsForCall = append(fake.getHistoryForKeyByBlockRangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 uint64
+		arg4 uint64
+		arg5 *ledgera.HistoryQueryOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("GetHistoryForKeyByBlockRange", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	if fake.GetHistoryForKeyByBlockRangeStub != nil {
+		return fake.GetHistoryForKeyByBlockRangeStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getHistoryForKeyByBlockRangeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRangeCallCount() int {
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	return len(fake.getHistoryForKeyByBlockRangeArgsForCall)
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRangeCalls(stub func(string, string, uint64, uint64, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = stub
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRangeArgsForCall(i int) (string, string, uint64, uint64, *ledgera.HistoryQueryOptions) {
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	argsForCall := fake.getHistoryForKeyByBlockRangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRangeReturns(result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = nil
+	fake.getHistoryForKeyByBlockRangeReturns = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByBlockRangeReturnsOnCall(i int, result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyByBlockRangeMutex.Lock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.Unlock()
+	fake.GetHistoryForKeyByBlockRangeStub = nil
+	if fake.getHistoryForKeyByBlockRangeReturnsOnCall == nil {
+		fake.getHistoryForKeyByBlockRangeReturnsOnCall = make(map[int]struct {
+			result1 ledgera.QueryResultsIterator
+			result2 error
+		})
+	}
+	fake.getHistoryForKeyByBlockRangeReturnsOnCall[i] = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRange(arg1 string, arg2 string, arg3 *timestamp.Timestamp, arg4 *timestamp.Timestamp, arg5 *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	ret, specificReturn := fake.getHistoryForKeyByTimeRangeReturnsOnCall[len(fake.getHistoryForKeyByTimeRangeArgsForCall)]
+	fake.getHistoryForKeyByTimeRangeArgsForCall = append(fake.getHistoryForKeyByTimeRangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *timestamp.Timestamp
+		arg4 *timestamp.Timestamp
+		arg5 *ledgera.HistoryQueryOptions
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("GetHistoryForKeyByTimeRange", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	if fake.GetHistoryForKeyByTimeRangeStub != nil {
+		return fake.GetHistoryForKeyByTimeRangeStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getHistoryForKeyByTimeRangeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRangeCallCount() int {
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
+	return len(fake.getHistoryForKeyByTimeRangeArgsForCall)
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRangeCalls(stub func(string, string, *timestamp.Timestamp, *timestamp.Timestamp, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = stub
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRangeArgsForCall(i int) (string, string, *timestamp.Timestamp, *timestamp.Timestamp, *ledgera.HistoryQueryOptions) {
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
+	argsForCall := fake.getHistoryForKeyByTimeRangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRangeReturns(result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = nil
+	fake.getHistoryForKeyByTimeRangeReturns = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyByTimeRangeReturnsOnCall(i int, result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyByTimeRangeMutex.Lock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.Unlock()
+	fake.GetHistoryForKeyByTimeRangeStub = nil
+	if fake.getHistoryForKeyByTimeRangeReturnsOnCall == nil {
+		fake.getHistoryForKeyByTimeRangeReturnsOnCall = make(map[int]struct {
+			result1 ledgera.QueryResultsIterator
+			result2 error
+		})
+	}
+	fake.getHistoryForKeyByTimeRangeReturnsOnCall[i] = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKey(arg1 string, arg2 string, arg3 string) (ledger.ResultsIterator, error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	ret, specificReturn := fake.getPrivateDataHistoryForKeyReturnsOnCall[len(fake.getPrivateDataHistoryForKeyArgsForCall)]
+	fake.getPrivateDataHistoryForKeyArgsForCall = append(fake.getPrivateDataHistoryForKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetPrivateDataHistoryForKey", []interface{}{arg1, arg2, arg3})
+	fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	if fake.GetPrivateDataHistoryForKeyStub != nil {
+		return fake.GetPrivateDataHistoryForKeyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPrivateDataHistoryForKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKeyCallCount() int {
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
+	return len(fake.getPrivateDataHistoryForKeyArgsForCall)
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKeyCalls(stub func(string, string, string) (ledger.ResultsIterator, error)) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = stub
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKeyArgsForCall(i int) (string, string, string) {
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
+	argsForCall := fake.getPrivateDataHistoryForKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKeyReturns(result1 ledger.ResultsIterator, result2 error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = nil
+	fake.getPrivateDataHistoryForKeyReturns = struct {
+		result1 ledger.ResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHistoryForKeyReturnsOnCall(i int, result1 ledger.ResultsIterator, result2 error) {
+	fake.getPrivateDataHistoryForKeyMutex.Lock()
+	defer fake.getPrivateDataHistoryForKeyMutex.Unlock()
+	fake.GetPrivateDataHistoryForKeyStub = nil
+	if fake.getPrivateDataHistoryForKeyReturnsOnCall == nil {
+		fake.getPrivateDataHistoryForKeyReturnsOnCall = make(map[int]struct {
+			result1 ledger.ResultsIterator
+			result2 error
+		})
+	}
+	fake.getPrivateDataHistoryForKeyReturnsOnCall[i] = struct {
+		result1 ledger.ResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *HistoryQueryExecutor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getHistoryForKeyMutex.RLock()
 	defer fake.getHistoryForKeyMutex.RUnlock()
+	fake.getHistoryForKeyByBlockRangeMutex.RLock()
+	defer fake.getHistoryForKeyByBlockRangeMutex.RUnlock()
+	fake.getHistoryForKeyByTimeRangeMutex.RLock()
+	defer fake.getHistoryForKeyByTimeRangeMutex.RUnlock()
+	fake.getPrivateDataHistoryForKeyMutex.RLock()
+	defer fake.getPrivateDataHistoryForKeyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
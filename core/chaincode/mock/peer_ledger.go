@@ -155,6 +155,19 @@ type PeerLedger struct {
 		result1 []*ledgera.TxPvtData
 		result2 error
 	}
+	GetStateHashStub        func(uint64) ([]byte, error)
+	getStateHashMutex       sync.RWMutex
+	getStateHashArgsForCall []struct {
+		arg1 uint64
+	}
+	getStateHashReturns struct {
+		result1 []byte
+		result2 error
+	}
+	getStateHashReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	GetTransactionByIDStub        func(string) (*peer.ProcessedTransaction, error)
 	getTransactionByIDMutex       sync.RWMutex
 	getTransactionByIDArgsForCall []struct {
@@ -252,6 +265,20 @@ type PeerLedger struct {
 	purgePrivateDataReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VerifyStateHashStub        func(uint64, []byte) (bool, error)
+	verifyStateHashMutex       sync.RWMutex
+	verifyStateHashArgsForCall []struct {
+		arg1 uint64
+		arg2 []byte
+	}
+	verifyStateHashReturns struct {
+		result1 bool
+		result2 error
+	}
+	verifyStateHashReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -957,6 +984,69 @@ func (fake *PeerLedger) GetPvtDataByNumReturnsOnCall(i int, result1 []*ledgera.T
 	}{result1, result2}
 }
 
+func (fake *PeerLedger) GetStateHash(arg1 uint64) ([]byte, error) {
+	fake.getStateHashMutex.Lock()
+	ret, specificReturn := fake.getStateHashReturnsOnCall[len(fake.getStateHashArgsForCall)]
+	fake.getStateHashArgsForCall = append(fake.getStateHashArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	fake.recordInvocation("GetStateHash", []interface{}{arg1})
+	fake.getStateHashMutex.Unlock()
+	if fake.GetStateHashStub != nil {
+		return fake.GetStateHashStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getStateHashReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PeerLedger) GetStateHashCallCount() int {
+	fake.getStateHashMutex.RLock()
+	defer fake.getStateHashMutex.RUnlock()
+	return len(fake.getStateHashArgsForCall)
+}
+
+func (fake *PeerLedger) GetStateHashCalls(stub func(uint64) ([]byte, error)) {
+	fake.getStateHashMutex.Lock()
+	defer fake.getStateHashMutex.Unlock()
+	fake.GetStateHashStub = stub
+}
+
+func (fake *PeerLedger) GetStateHashArgsForCall(i int) uint64 {
+	fake.getStateHashMutex.RLock()
+	defer fake.getStateHashMutex.RUnlock()
+	argsForCall := fake.getStateHashArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *PeerLedger) GetStateHashReturns(result1 []byte, result2 error) {
+	fake.getStateHashMutex.Lock()
+	defer fake.getStateHashMutex.Unlock()
+	fake.GetStateHashStub = nil
+	fake.getStateHashReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PeerLedger) GetStateHashReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.getStateHashMutex.Lock()
+	defer fake.getStateHashMutex.Unlock()
+	fake.GetStateHashStub = nil
+	if fake.getStateHashReturnsOnCall == nil {
+		fake.getStateHashReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.getStateHashReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *PeerLedger) GetTransactionByID(arg1 string) (*peer.ProcessedTransaction, error) {
 	fake.getTransactionByIDMutex.Lock()
 	ret, specificReturn := fake.getTransactionByIDReturnsOnCall[len(fake.getTransactionByIDArgsForCall)]
@@ -1431,6 +1521,75 @@ func (fake *PeerLedger) PurgePrivateDataReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *PeerLedger) VerifyStateHash(arg1 uint64, arg2 []byte) (bool, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.verifyStateHashMutex.Lock()
+	ret, specificReturn := fake.verifyStateHashReturnsOnCall[len(fake.verifyStateHashArgsForCall)]
+	fake.verifyStateHashArgsForCall = append(fake.verifyStateHashArgsForCall, struct {
+		arg1 uint64
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("VerifyStateHash", []interface{}{arg1, arg2Copy})
+	fake.verifyStateHashMutex.Unlock()
+	if fake.VerifyStateHashStub != nil {
+		return fake.VerifyStateHashStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.verifyStateHashReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PeerLedger) VerifyStateHashCallCount() int {
+	fake.verifyStateHashMutex.RLock()
+	defer fake.verifyStateHashMutex.RUnlock()
+	return len(fake.verifyStateHashArgsForCall)
+}
+
+func (fake *PeerLedger) VerifyStateHashCalls(stub func(uint64, []byte) (bool, error)) {
+	fake.verifyStateHashMutex.Lock()
+	defer fake.verifyStateHashMutex.Unlock()
+	fake.VerifyStateHashStub = stub
+}
+
+func (fake *PeerLedger) VerifyStateHashArgsForCall(i int) (uint64, []byte) {
+	fake.verifyStateHashMutex.RLock()
+	defer fake.verifyStateHashMutex.RUnlock()
+	argsForCall := fake.verifyStateHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *PeerLedger) VerifyStateHashReturns(result1 bool, result2 error) {
+	fake.verifyStateHashMutex.Lock()
+	defer fake.verifyStateHashMutex.Unlock()
+	fake.VerifyStateHashStub = nil
+	fake.verifyStateHashReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PeerLedger) VerifyStateHashReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.verifyStateHashMutex.Lock()
+	defer fake.verifyStateHashMutex.Unlock()
+	fake.VerifyStateHashStub = nil
+	if fake.verifyStateHashReturnsOnCall == nil {
+		fake.verifyStateHashReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.verifyStateHashReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *PeerLedger) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1458,6 +1617,8 @@ func (fake *PeerLedger) Invocations() map[string][][]interface{} {
 	defer fake.getPvtDataAndBlockByNumMutex.RUnlock()
 	fake.getPvtDataByNumMutex.RLock()
 	defer fake.getPvtDataByNumMutex.RUnlock()
+	fake.getStateHashMutex.RLock()
+	defer fake.getStateHashMutex.RUnlock()
 	fake.getTransactionByIDMutex.RLock()
 	defer fake.getTransactionByIDMutex.RUnlock()
 	fake.getTxValidationCodeByTxIDMutex.RLock()
@@ -1474,6 +1635,8 @@ func (fake *PeerLedger) Invocations() map[string][][]interface{} {
 	defer fake.pruneMutex.RUnlock()
 	fake.purgePrivateDataMutex.RLock()
 	defer fake.purgePrivateDataMutex.RUnlock()
+	fake.verifyStateHashMutex.RLock()
+	defer fake.verifyStateHashMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
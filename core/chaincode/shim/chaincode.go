@@ -540,6 +540,17 @@ func (stub *ChaincodeStub) DelPrivateData(collection string, key string) error {
 	return stub.handler.handleDelState(collection, key, stub.ChannelId, stub.TxID)
 }
 
+// PurgePrivateData documentation can be found in interfaces.go
+func (stub *ChaincodeStub) PurgePrivateData(collection string, key string) error {
+	if collection == "" {
+		return fmt.Errorf("collection must not be an empty string")
+	}
+	if key == "" {
+		return fmt.Errorf("key must not be an empty string")
+	}
+	return stub.handler.handlePurgePrivateData(collection, key, stub.ChannelId, stub.TxID)
+}
+
 // GetPrivateDataByRange documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetPrivateDataByRange(collection, startKey, endKey string) (StateQueryIteratorInterface, error) {
 	if collection == "" {
@@ -557,6 +568,18 @@ func (stub *ChaincodeStub) GetPrivateDataByRange(collection, startKey, endKey st
 	return iterator, err
 }
 
+// GetPrivateDataHistoryForKey documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetPrivateDataHistoryForKey(collection, key string) (HistoryQueryIteratorInterface, error) {
+	if collection == "" {
+		return nil, fmt.Errorf("collection must not be an empty string")
+	}
+	response, err := stub.handler.handleGetHistoryForKey(collection, key, nil, stub.ChannelId, stub.TxID)
+	if err != nil {
+		return nil, err
+	}
+	return &HistoryQueryIterator{CommonIterator: &CommonIterator{stub.handler, stub.ChannelId, stub.TxID, response, 0}}, nil
+}
+
 func (stub *ChaincodeStub) createRangeKeysForPartialCompositeKey(objectType string, attributes []string) (string, string, error) {
 	partialCompositeKey, err := stub.CreateCompositeKey(objectType, attributes)
 	if err != nil {
@@ -700,13 +723,53 @@ func (stub *ChaincodeStub) GetStateByRange(startKey, endKey string) (StateQueryI
 
 // GetHistoryForKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetHistoryForKey(key string) (HistoryQueryIteratorInterface, error) {
-	response, err := stub.handler.handleGetHistoryForKey(key, stub.ChannelId, stub.TxID)
+	response, err := stub.handler.handleGetHistoryForKey("", key, nil, stub.ChannelId, stub.TxID)
 	if err != nil {
 		return nil, err
 	}
 	return &HistoryQueryIterator{CommonIterator: &CommonIterator{stub.handler, stub.ChannelId, stub.TxID, response, 0}}, nil
 }
 
+// GetHistoryForKeyByBlockRange documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetHistoryForKeyByBlockRange(key string, startBlock, endBlock uint64, descending bool,
+	pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if endBlock != 0 && startBlock > endBlock {
+		return nil, nil, errors.Errorf("start block [%d] is greater than end block [%d]", startBlock, endBlock)
+	}
+	metadata := &pb.HistoryQueryMetadata{StartBlock: startBlock, EndBlock: endBlock, Descending: descending,
+		PageSize: pageSize, Bookmark: bookmark}
+	return stub.handleGetHistoryForKey(key, metadata)
+}
+
+// GetHistoryForKeyByTimeRange documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetHistoryForKeyByTimeRange(key string, startTime, endTime *timestamp.Timestamp, descending bool,
+	pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	metadata := &pb.HistoryQueryMetadata{StartTime: startTime, EndTime: endTime, Descending: descending,
+		PageSize: pageSize, Bookmark: bookmark}
+	return stub.handleGetHistoryForKey(key, metadata)
+}
+
+func (stub *ChaincodeStub) handleGetHistoryForKey(key string,
+	metadata *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+
+	metadataBytes, err := proto.Marshal(metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	response, err := stub.handler.handleGetHistoryForKey("", key, metadataBytes, stub.ChannelId, stub.TxID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iterator := &HistoryQueryIterator{CommonIterator: &CommonIterator{stub.handler, stub.ChannelId, stub.TxID, response, 0}}
+	responseMetadata, err := createQueryResponseMetadata(response.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return iterator, responseMetadata, nil
+}
+
 //CreateCompositeKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
 	return createCompositeKey(objectType, attributes)
@@ -232,6 +232,34 @@ type ChaincodeStubInterface interface {
 	// update ledger, and should limit use to read-only chaincode operations.
 	GetHistoryForKey(key string) (HistoryQueryIteratorInterface, error)
 
+	// GetHistoryForKeyByBlockRange returns a history of key values that were
+	// committed in the blocks in the range [startBlock, endBlock]. An endBlock
+	// of zero implies no upper bound. The values are returned in the order in
+	// which they were committed or, when descending is true, the most recent
+	// value first.
+	// When an empty string is passed as a value to the bookmark argument, the returned
+	// iterator can be used to fetch the first `pageSize` values in the range.
+	// When the bookmark is a non-emptry string, the iterator can be used to fetch
+	// the first `pageSize` values between the bookmark (inclusive) and the end of the range.
+	// Note that only the bookmark present in a prior page of query results (ResponseMetadata)
+	// can be used as a value to the bookmark argument. A pageSize of zero along with
+	// an empty bookmark disables the pagination.
+	// GetHistoryForKeyByBlockRange has the same requirements and limitations as
+	// GetHistoryForKey.
+	GetHistoryForKeyByBlockRange(key string, startBlock, endBlock uint64, descending bool,
+		pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error)
+
+	// GetHistoryForKeyByTimeRange returns a history of key values that were
+	// written by the transactions with a timestamp in the range [startTime, endTime).
+	// A nil startTime or endTime implies an unbounded range on that end. As the
+	// timestamp is the one provided by the client in the proposal header, the
+	// whole history of the key is scanned by the peer for this query.
+	// The order and the pagination of the values are as in GetHistoryForKeyByBlockRange.
+	// GetHistoryForKeyByTimeRange has the same requirements and limitations as
+	// GetHistoryForKey.
+	GetHistoryForKeyByTimeRange(key string, startTime, endTime *timestamp.Timestamp, descending bool,
+		pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error)
+
 	// GetPrivateData returns the value of the specified `key` from the specified
 	// `collection`. Note that GetPrivateData doesn't read data from the
 	// private writeset, which has not been committed to the `collection`. In
@@ -258,6 +286,15 @@ type ChaincodeStubInterface interface {
 	// when the transaction is validated and successfully committed.
 	DelPrivateData(collection, key string) error
 
+	// PurgePrivateData records the specified `key` to be purged in the private writeset
+	// of the transaction. Unlike DelPrivateData, which only deletes the current value
+	// of the `key`, PurgePrivateData causes all the historical versions of the `key`
+	// to be removed from the private data store, the state database and the transient
+	// store of all the peers that are members of the collection, when the transaction
+	// is validated and successfully committed. Only the hash of the `key` is recorded
+	// in the transaction.
+	PurgePrivateData(collection, key string) error
+
 	// SetPrivateDataValidationParameter sets the key-level endorsement policy
 	// for the private data specified by `key`.
 	SetPrivateDataValidationParameter(collection, key string, ep []byte) error
@@ -303,6 +340,15 @@ type ChaincodeStubInterface interface {
 	// ledger, and should limit use to read-only chaincode operations.
 	GetPrivateDataQueryResult(collection, query string) (StateQueryIteratorInterface, error)
 
+	// GetPrivateDataHistoryForKey returns a history of key values across time
+	// for a given private data key of a `collection`. The history is available
+	// only on the peers that are members of the collection and that had the
+	// private data at the time of the commit or received it later. A value that
+	// has been purged from the collection is returned as a modification with
+	// IsPurged set and no value. GetPrivateDataHistoryForKey has the same
+	// requirements and limitations as GetHistoryForKey.
+	GetPrivateDataHistoryForKey(collection, key string) (HistoryQueryIteratorInterface, error)
+
 	// GetCreator returns `SignatureHeader.Creator` (e.g. an identity)
 	// of the `SignedProposal`. This is the identity of the agent (or user)
 	// submitting the transaction.
@@ -181,6 +181,10 @@ func (stub *MockStub) DelPrivateData(collection string, key string) error {
 	return errors.New("Not Implemented")
 }
 
+func (stub *MockStub) PurgePrivateData(collection string, key string) error {
+	return errors.New("Not Implemented")
+}
+
 func (stub *MockStub) GetPrivateDataByRange(collection, startKey, endKey string) (StateQueryIteratorInterface, error) {
 	return nil, errors.New("Not Implemented")
 }
@@ -196,6 +200,10 @@ func (stub *MockStub) GetPrivateDataQueryResult(collection, query string) (State
 	return nil, errors.New("Not Implemented")
 }
 
+func (stub *MockStub) GetPrivateDataHistoryForKey(collection, key string) (HistoryQueryIteratorInterface, error) {
+	return nil, errors.New("Not Implemented")
+}
+
 // GetState retrieves the value for a given key from the ledger
 func (stub *MockStub) GetState(key string) ([]byte, error) {
 	value := stub.State[key]
@@ -292,6 +300,20 @@ func (stub *MockStub) GetHistoryForKey(key string) (HistoryQueryIteratorInterfac
 	return nil, errors.New("not implemented")
 }
 
+// GetHistoryForKeyByBlockRange function can be invoked by a chaincode to return a history of
+// key values committed in a range of blocks.
+func (stub *MockStub) GetHistoryForKeyByBlockRange(key string, startBlock, endBlock uint64, descending bool,
+	pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+// GetHistoryForKeyByTimeRange function can be invoked by a chaincode to return a history of
+// key values written by the transactions in a range of time.
+func (stub *MockStub) GetHistoryForKeyByTimeRange(key string, startTime, endTime *timestamp.Timestamp, descending bool,
+	pageSize int32, bookmark string) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
 //GetStateByPartialCompositeKey function can be invoked by a chaincode to query the
 //state based on a given partial composite key. This function returns an
 //iterator which can be used to iterate over all composite keys whose prefix
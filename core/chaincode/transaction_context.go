@@ -37,6 +37,9 @@ type TransactionContext struct {
 	// we do not need to store the namespace in the map and
 	// collection alone is sufficient.
 	AllowedCollectionAccess map[string]bool
+	// cache used to save the result of the collection write acl,
+	// for the same reasons as AllowedCollectionAccess
+	AllowedCollectionWriteAccess map[string]bool
 }
 
 func (t *TransactionContext) InitializeQueryContext(queryID string, iter commonledger.ResultsIterator) {
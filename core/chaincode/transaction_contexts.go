@@ -72,7 +72,8 @@ func (c *TransactionContexts) Create(txParams *ccprovider.TransactionParams) (*T
 		queryIteratorMap:    map[string]commonledger.ResultsIterator{},
 		pendingQueryResults: map[string]*PendingQueryResult{},
 
-		AllowedCollectionAccess: make(map[string]bool),
+		AllowedCollectionAccess:      make(map[string]bool),
+		AllowedCollectionWriteAccess: make(map[string]bool),
 	}
 	c.contexts[ctxID] = txctx
 
@@ -22,6 +22,20 @@ func (_m *Capabilities) ACLs() bool {
 	return r0
 }
 
+// CollectionEndorsementPolicies provides a mock function with given fields:
+func (_m *Capabilities) CollectionEndorsementPolicies() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // CollectionUpgrade provides a mock function with given fields:
 func (_m *Capabilities) CollectionUpgrade() bool {
 	ret := _m.Called()
@@ -78,6 +92,20 @@ func (_m *Capabilities) KeyLevelEndorsement() bool {
 	return r0
 }
 
+// MemberOnlyWrite provides a mock function with given fields:
+func (_m *Capabilities) MemberOnlyWrite() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // MetadataLifecycle provides a mock function with given fields:
 func (_m *Capabilities) MetadataLifecycle() bool {
 	ret := _m.Called()
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/cauthdsl"
 	ledger2 "github.com/hyperledger/fabric/common/ledger"
+	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/core/handlers/validation/api"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/capabilities"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/identities"
@@ -20,6 +21,7 @@ import (
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
 )
 
 // MapBasedPluginMapper maps plugin names to their corresponding factories
@@ -185,7 +187,12 @@ func (id *PolicyEvaluator) Evaluate(policyBytes []byte, signatureSet []*common.S
 	if err != nil {
 		return err
 	}
-	return policy.Evaluate(signatureSet)
+	err = policy.Evaluate(signatureSet)
+	// Explaining the failure evaluates the policy again, so only do it if it's going to be logged
+	if err != nil && logger.IsEnabledFor(zapcore.DebugLevel) {
+		logger.Debugf("Signature set did not satisfy policy:\n%s", policies.Explain(policy, signatureSet))
+	}
+	return err
 }
 
 // DeserializeIdentity unmarshals the given identity to msp.Identity
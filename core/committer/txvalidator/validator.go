@@ -652,6 +652,10 @@ func (ds *dynamicCapabilities) ACLs() bool {
 	return ds.support.Capabilities().ACLs()
 }
 
+func (ds *dynamicCapabilities) CollectionEndorsementPolicies() bool {
+	return ds.support.Capabilities().CollectionEndorsementPolicies()
+}
+
 func (ds *dynamicCapabilities) CollectionUpgrade() bool {
 	return ds.support.Capabilities().CollectionUpgrade()
 }
@@ -669,6 +673,10 @@ func (ds *dynamicCapabilities) KeyLevelEndorsement() bool {
 	return ds.support.Capabilities().KeyLevelEndorsement()
 }
 
+func (ds *dynamicCapabilities) MemberOnlyWrite() bool {
+	return ds.support.Capabilities().MemberOnlyWrite()
+}
+
 func (ds *dynamicCapabilities) MetadataLifecycle() bool {
 	return ds.support.Capabilities().MetadataLifecycle()
 }
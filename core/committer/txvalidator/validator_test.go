@@ -1636,6 +1636,14 @@ func (m *mockLedger) GetMissingPvtDataTracker() (ledger.MissingPvtDataTracker, e
 	return args.Get(0).(ledger.MissingPvtDataTracker), nil
 }
 
+func (m *mockLedger) GetStateHash(blockNum uint64) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockLedger) VerifyStateHash(blockNum uint64, expectedStateHash []byte) (bool, error) {
+	return true, nil
+}
+
 // mockQueryExecutor mock of the query executor,
 // needed to simulate inability to access state db, e.g.
 // the case where due to db failure it's not possible to
@@ -53,6 +53,10 @@ type CollectionAccessPolicy interface {
 	// IsMemberOnlyRead returns a true if only collection members can read
 	// the private data
 	IsMemberOnlyRead() bool
+
+	// IsMemberOnlyWrite returns a true if only collection members can write
+	// the private data
+	IsMemberOnlyWrite() bool
 }
 
 // CollectionPersistenceConfigs encapsulates configurations related to persistece of a collection
@@ -95,7 +99,8 @@ type CollectionStore interface {
 	RetrieveCollectionAccessPolicy(common.CollectionCriteria) (CollectionAccessPolicy, error)
 
 	// RetrieveCollectionConfigPackage retrieves the whole configuration package
-	// for the chaincode with the supplied criteria
+	// for the chaincode with the supplied criteria. The package only contains
+	// the collections defined for the chaincode, and not the implicit ones
 	RetrieveCollectionConfigPackage(common.CollectionCriteria) (*common.CollectionConfigPackage, error)
 
 	// RetrieveCollectionPersistenceConfigs retrieves the collection's persistence related configurations
@@ -105,6 +110,10 @@ type CollectionStore interface {
 	// given collection
 	HasReadAccess(common.CollectionCriteria, *pb.SignedProposal, ledger.QueryExecutor) (bool, error)
 
+	// HasWriteAccess checks whether the creator of the signedProposal has write permission on a
+	// given collection
+	HasWriteAccess(common.CollectionCriteria, *pb.SignedProposal, ledger.QueryExecutor) (bool, error)
+
 	CollectionFilter
 }
 
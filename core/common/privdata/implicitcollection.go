@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"strings"
+
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/protos/common"
+)
+
+// ImplicitCollectionNamePrefix is the prefix of the names of the implicit
+// collections that exist, for every chaincode, for each organization
+// defined in the application section of the channel configuration
+const ImplicitCollectionNamePrefix = "_implicit_org_"
+
+// ImplicitCollectionNameForOrg returns the name of the implicit collection of the given org
+func ImplicitCollectionNameForOrg(mspID string) string {
+	return ImplicitCollectionNamePrefix + mspID
+}
+
+// MSPIDIfImplicitCollection returns true and the MSP ID of the org
+// the collection belongs to if the given collection name is the
+// name of an implicit collection; false and an empty string otherwise
+func MSPIDIfImplicitCollection(collectionName string) (bool, string) {
+	if !strings.HasPrefix(collectionName, ImplicitCollectionNamePrefix) {
+		return false, ""
+	}
+	mspID := strings.TrimPrefix(collectionName, ImplicitCollectionNamePrefix)
+	if mspID == "" {
+		return false, ""
+	}
+	return true, mspID
+}
+
+const (
+	// implicitCollectionRequiredPeerCount is the number of peers the private data of an
+	// implicit collection needs to be disseminated to for the endorsement to succeed.
+	// It is 0 so that orgs running a single peer can endorse writes to their collection
+	implicitCollectionRequiredPeerCount = 0
+
+	// implicitCollectionMaximumPeerCount is the number of other peers of the org the
+	// endorsing peer disseminates the private data of an implicit collection to, so
+	// that the data survives the loss of the endorsing peer when the org runs several
+	implicitCollectionMaximumPeerCount = 1
+)
+
+// GenerateImplicitCollectionForOrg returns the static configuration of the implicit
+// collection of the given org. The only member of the collection is the org itself
+// and its private data is never purged
+func GenerateImplicitCollectionForOrg(mspID string) *common.StaticCollectionConfig {
+	return &common.StaticCollectionConfig{
+		Name: ImplicitCollectionNameForOrg(mspID),
+		MemberOrgsPolicy: &common.CollectionPolicyConfig{
+			Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+				SignaturePolicy: cauthdsl.SignedByMspMember(mspID),
+			},
+		},
+		RequiredPeerCount: implicitCollectionRequiredPeerCount,
+		MaximumPeerCount:  implicitCollectionMaximumPeerCount,
+	}
+}
+
+// GenerateImplicitCollectionForChannelOrg returns the static configuration of the
+// implicit collection of the given org if the org is one of the given application
+// orgs of the channel. Orgs that are not part of the channel have no implicit collection
+func GenerateImplicitCollectionForChannelOrg(mspID string, channelMSPIDs []string) (*common.StaticCollectionConfig, bool) {
+	for _, channelMSPID := range channelMSPIDs {
+		if channelMSPID == mspID {
+			return GenerateImplicitCollectionForOrg(mspID), true
+		}
+	}
+	return nil, false
+}
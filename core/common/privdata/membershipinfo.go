@@ -13,12 +13,13 @@ import (
 
 // MembershipProvider can be used to check whether a peer is eligible to a collection or not
 type MembershipProvider struct {
-	selfSignedData              common.SignedData
+	selfSignedData              func() common.SignedData
 	IdentityDeserializerFactory func(chainID string) msp.IdentityDeserializer
 }
 
-// NewMembershipInfoProvider returns MembershipProvider
-func NewMembershipInfoProvider(selfSignedData common.SignedData, identityDeserializerFunc func(chainID string) msp.IdentityDeserializer) *MembershipProvider {
+// NewMembershipInfoProvider returns MembershipProvider. selfSignedData returns
+// data signed by the current identity of the peer, which may change over time
+func NewMembershipInfoProvider(selfSignedData func() common.SignedData, identityDeserializerFunc func(chainID string) msp.IdentityDeserializer) *MembershipProvider {
 	return &MembershipProvider{selfSignedData: selfSignedData, IdentityDeserializerFactory: identityDeserializerFunc}
 }
 
@@ -29,7 +30,8 @@ func (m *MembershipProvider) AmMemberOf(channelName string, collectionPolicyConf
 	if err != nil {
 		return false, err
 	}
-	if err := accessPolicy.Evaluate([]*common.SignedData{&m.selfSignedData}); err != nil {
+	selfSignedData := m.selfSignedData()
+	if err := accessPolicy.Evaluate([]*common.SignedData{&selfSignedData}); err != nil {
 		return false, nil
 	}
 	return true, nil
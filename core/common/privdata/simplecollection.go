@@ -65,6 +65,10 @@ func (sc *SimpleCollection) IsMemberOnlyRead() bool {
 	return sc.conf.MemberOnlyRead
 }
 
+func (sc *SimpleCollection) IsMemberOnlyWrite() bool {
+	return sc.conf.MemberOnlyWrite
+}
+
 // Setup configures a simple collection object based on a given
 // StaticCollectionConfig proto that has all the necessary information
 func (sc *SimpleCollection) Setup(collectionConfig *common.StaticCollectionConfig, deserializer msp.IdentityDeserializer) error {
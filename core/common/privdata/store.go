@@ -26,6 +26,10 @@ type Support interface {
 	// GetIdentityDeserializer returns an IdentityDeserializer
 	// instance for the specified chain
 	GetIdentityDeserializer(chainID string) msp.IdentityDeserializer
+
+	// GetMSPIDs returns the IDs of the application MSPs
+	// defined in the configuration of the specified channel
+	GetMSPIDs(cid string) []string
 }
 
 // StateGetter retrieves data from the state
@@ -92,7 +96,19 @@ func ParseCollectionConfig(colBytes []byte) (*common.CollectionConfigPackage, er
 	return collections, nil
 }
 
+func (c *simpleCollectionStore) retrieveImplicitCollectionConfig(cc common.CollectionCriteria, mspID string) (*common.StaticCollectionConfig, error) {
+	collConfig, exists := GenerateImplicitCollectionForChannelOrg(mspID, c.s.GetMSPIDs(cc.Channel))
+	if !exists {
+		return nil, NoSuchCollectionError(cc)
+	}
+	return collConfig, nil
+}
+
 func (c *simpleCollectionStore) retrieveCollectionConfig(cc common.CollectionCriteria, qe ledger.QueryExecutor) (*common.StaticCollectionConfig, error) {
+	if isImplicit, mspID := MSPIDIfImplicitCollection(cc.Collection); isImplicit {
+		return c.retrieveImplicitCollectionConfig(cc, mspID)
+	}
+
 	collections, err := c.retrieveCollectionConfigPackage(cc, qe)
 	if err != nil {
 		return nil, err
@@ -175,6 +191,25 @@ func (c *simpleCollectionStore) HasReadAccess(cc common.CollectionCriteria, sign
 	return hasReadAccess(signedData), nil
 }
 
+func (c *simpleCollectionStore) HasWriteAccess(cc common.CollectionCriteria, signedProposal *pb.SignedProposal, qe ledger.QueryExecutor) (bool, error) {
+	accessPolicy, err := c.retrieveSimpleCollection(cc, qe)
+	if err != nil {
+		return false, err
+	}
+
+	if !accessPolicy.IsMemberOnlyWrite() {
+		return true, nil
+	}
+
+	signedData, err := getSignedData(signedProposal)
+	if err != nil {
+		return false, err
+	}
+
+	hasWriteAccess := accessPolicy.AccessFilter()
+	return hasWriteAccess(signedData), nil
+}
+
 func getSignedData(signedProposal *pb.SignedProposal) (common.SignedData, error) {
 	proposal, err := utils.GetProposal(signedProposal.ProposalBytes)
 	if err != nil {
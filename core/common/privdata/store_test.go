@@ -22,8 +22,9 @@ import (
 )
 
 type mockStoreSupport struct {
-	Qe   *lm.MockQueryExecutor
-	QErr error
+	Qe     *lm.MockQueryExecutor
+	QErr   error
+	MSPIDs []string
 }
 
 func (c *mockStoreSupport) GetQueryExecutorForLedger(cid string) (ledger.QueryExecutor, error) {
@@ -34,6 +35,10 @@ func (c *mockStoreSupport) GetIdentityDeserializer(chainID string) msp.IdentityD
 	return &mockDeserializer{}
 }
 
+func (c *mockStoreSupport) GetMSPIDs(cid string) []string {
+	return c.MSPIDs
+}
+
 func TestCollectionStore(t *testing.T) {
 	wState := make(map[string]map[string][]byte)
 	support := &mockStoreSupport{Qe: &lm.MockQueryExecutor{State: wState}}
@@ -128,4 +133,87 @@ func TestCollectionStore(t *testing.T) {
 	allowedAccess, err = cs.HasReadAccess(ccr, signedProp, &lm.MockQueryExecutor{State: wState})
 	assert.NoError(t, err)
 	assert.False(t, allowedAccess)
+
+	// non members can write unless member only write is set
+	allowedAccess, err = cs.HasWriteAccess(ccr, signedProp, &lm.MockQueryExecutor{State: wState})
+	assert.NoError(t, err)
+	assert.True(t, allowedAccess)
+
+	cc.GetStaticCollectionConfig().MemberOnlyWrite = true
+	ccp = &common.CollectionConfigPackage{Config: []*common.CollectionConfig{cc}}
+	ccpBytes, err = proto.Marshal(ccp)
+	assert.NoError(t, err)
+	wState["lscc"][BuildCollectionKVSKey(ccr.Namespace)] = ccpBytes
+
+	allowedAccess, err = cs.HasWriteAccess(ccr, signedProp, &lm.MockQueryExecutor{State: wState})
+	assert.NoError(t, err)
+	assert.False(t, allowedAccess)
+
+	signedProp, _ = utils.MockSignedEndorserProposalOrPanic("A", &peer.ChaincodeSpec{}, []byte("signer1"), []byte("msg1"))
+	allowedAccess, err = cs.HasWriteAccess(ccr, signedProp, &lm.MockQueryExecutor{State: wState})
+	assert.NoError(t, err)
+	assert.True(t, allowedAccess)
+}
+
+func TestImplicitCollections(t *testing.T) {
+	wState := map[string]map[string][]byte{"lscc": {}}
+	support := &mockStoreSupport{Qe: &lm.MockQueryExecutor{State: wState}, MSPIDs: []string{"Org1MSP", "Org2MSP"}}
+	cs := NewSimpleCollectionStore(support)
+
+	// implicit collections exist even if the chaincode defines no collections
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: ImplicitCollectionNameForOrg("Org1MSP")}
+	c, err := cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, "_implicit_org_Org1MSP", c.CollectionID())
+	assert.Equal(t, []string{"Org1MSP"}, c.MemberOrgs())
+
+	ca, err := cs.RetrieveCollectionAccessPolicy(ccr)
+	assert.NoError(t, err)
+	assert.False(t, ca.IsMemberOnlyRead())
+	assert.False(t, ca.IsMemberOnlyWrite())
+
+	pc, err := cs.RetrieveCollectionPersistenceConfigs(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), pc.BlockToLive())
+
+	signedProp, _ := utils.MockSignedEndorserProposalOrPanic("A", &peer.ChaincodeSpec{}, []byte("signer0"), []byte("msg1"))
+	allowedAccess, err := cs.HasWriteAccess(ccr, signedProp, &lm.MockQueryExecutor{State: wState})
+	assert.NoError(t, err)
+	assert.True(t, allowedAccess)
+
+	// implicit collections of orgs that are not in the channel do not exist
+	ccr.Collection = ImplicitCollectionNameForOrg("Org3MSP")
+	_, err = cs.RetrieveCollection(ccr)
+	assert.EqualError(t, err, NoSuchCollectionError(ccr).Error())
+
+	// implicit collections are not part of the configuration package of the chaincode
+	_, err = cs.RetrieveCollectionConfigPackage(ccr)
+	assert.IsType(t, NoSuchCollectionError{}, err)
+}
+
+func TestMSPIDIfImplicitCollection(t *testing.T) {
+	isImplicit, mspID := MSPIDIfImplicitCollection("_implicit_org_Org1MSP")
+	assert.True(t, isImplicit)
+	assert.Equal(t, "Org1MSP", mspID)
+
+	for _, name := range []string{"mycollection", "_implicit_org_", "implicit_org_Org1MSP"} {
+		isImplicit, mspID = MSPIDIfImplicitCollection(name)
+		assert.False(t, isImplicit, name)
+		assert.Empty(t, mspID, name)
+	}
+
+	conf := GenerateImplicitCollectionForOrg("Org1MSP")
+	assert.Equal(t, "_implicit_org_Org1MSP", conf.Name)
+	assert.Equal(t, cauthdsl.SignedByMspMember("Org1MSP"), conf.MemberOrgsPolicy.GetSignaturePolicy())
+	assert.Equal(t, uint64(0), conf.BlockToLive)
+	assert.Equal(t, int32(0), conf.RequiredPeerCount)
+	assert.Equal(t, int32(1), conf.MaximumPeerCount)
+
+	conf, exists := GenerateImplicitCollectionForChannelOrg("Org1MSP", []string{"Org1MSP", "Org2MSP"})
+	assert.True(t, exists)
+	assert.Equal(t, GenerateImplicitCollectionForOrg("Org1MSP"), conf)
+
+	conf, exists = GenerateImplicitCollectionForChannelOrg("Org3MSP", []string{"Org1MSP", "Org2MSP"})
+	assert.False(t, exists)
+	assert.Nil(t, conf)
 }
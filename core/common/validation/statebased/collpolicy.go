@@ -0,0 +1,76 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/handlers/validation/api/state"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// CollectionEndorsementPolicyRetriever is used by validation plugins in order
+// to retrieve the endorsement policies of private data collections
+type CollectionEndorsementPolicyRetriever interface {
+	// GetCollectionEndorsementPolicy returns the serialized endorsement policy of
+	// the collection coll of chaincode cc. The function returns nil and no error if
+	// the collection does not define an endorsement policy or if the collection is
+	// not defined at all, in which case the endorsement policy of the chaincode applies.
+	GetCollectionEndorsementPolicy(cc, coll string) ([]byte, error)
+}
+
+// CollectionEndorsementPolicyCapabilities tells validation plugins whether
+// the endorsement policies of private data collections are enforced
+type CollectionEndorsementPolicyCapabilities interface {
+	// CollectionEndorsementPolicies returns true if the writes to private data collections
+	// are validated against the endorsement policies of the collections
+	CollectionEndorsementPolicies() bool
+}
+
+// CollectionEndorsementPolicyRetrieverImpl implements the interface
+// CollectionEndorsementPolicyRetriever by reading the collection
+// configuration package of the chaincode from the lscc namespace
+type CollectionEndorsementPolicyRetrieverImpl struct {
+	StateFetcher validation.StateFetcher
+}
+
+// GetCollectionEndorsementPolicy implements the method of the CollectionEndorsementPolicyRetriever interface
+func (r *CollectionEndorsementPolicyRetrieverImpl) GetCollectionEndorsementPolicy(cc, coll string) ([]byte, error) {
+	state, err := r.StateFetcher.FetchState()
+	if err != nil {
+		return nil, errors.WithMessage(err, "could not retrieve ledger")
+	}
+	defer state.Done()
+
+	values, err := state.GetStateMultipleKeys("lscc", []string{privdata.BuildCollectionKVSKey(cc)})
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("could not retrieve collection configuration for chaincode %s", cc))
+	}
+	if len(values) == 0 || len(values[0]) == 0 {
+		return nil, nil
+	}
+
+	ccp := &common.CollectionConfigPackage{}
+	if err := proto.Unmarshal(values[0], ccp); err != nil {
+		return nil, errors.Wrapf(err, "invalid collection configuration for chaincode %s", cc)
+	}
+	for _, config := range ccp.Config {
+		staticConfig := config.GetStaticCollectionConfig()
+		if staticConfig == nil || staticConfig.Name != coll {
+			continue
+		}
+		sp := staticConfig.GetEndorsementPolicy().GetSignaturePolicy()
+		if sp == nil {
+			return nil, nil
+		}
+		return proto.Marshal(sp)
+	}
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebased
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCollectionEndorsementPolicy(t *testing.T) {
+	t.Parallel()
+
+	ep := cauthdsl.SignedByAnyMember([]string{"Org1MSP"})
+	ccp := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "coll1",
+						EndorsementPolicy: &common.CollectionPolicyConfig{
+							Payload: &common.CollectionPolicyConfig_SignaturePolicy{SignaturePolicy: ep},
+						},
+					},
+				},
+			},
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{Name: "coll2"},
+				},
+			},
+		},
+	}
+	ms := &mockState{GetStateMultipleKeysRv: [][]byte{utils.MarshalOrPanic(ccp)}}
+	fetcher := &mockStateFetcher{FetchStateRv: ms}
+	retriever := &CollectionEndorsementPolicyRetrieverImpl{StateFetcher: fetcher}
+
+	collEP, err := retriever.GetCollectionEndorsementPolicy("cc", "coll1")
+	assert.NoError(t, err)
+	expectedEP, err := proto.Marshal(ep)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedEP, collEP)
+	assert.True(t, fetcher.DoneCalled())
+
+	// collection without an endorsement policy
+	collEP, err = retriever.GetCollectionEndorsementPolicy("cc", "coll2")
+	assert.NoError(t, err)
+	assert.Nil(t, collEP)
+
+	// collection not defined
+	collEP, err = retriever.GetCollectionEndorsementPolicy("cc", "coll3")
+	assert.NoError(t, err)
+	assert.Nil(t, collEP)
+
+	// chaincode without collections
+	ms.GetStateMultipleKeysRv = [][]byte{nil}
+	collEP, err = retriever.GetCollectionEndorsementPolicy("cc", "coll1")
+	assert.NoError(t, err)
+	assert.Nil(t, collEP)
+
+	// invalid collection configuration
+	ms.GetStateMultipleKeysRv = [][]byte{[]byte("barf")}
+	_, err = retriever.GetCollectionEndorsementPolicy("cc", "coll1")
+	assert.Contains(t, err.Error(), "invalid collection configuration for chaincode cc")
+
+	// ledger errors
+	ms.GetStateMultipleKeysErr = fmt.Errorf("ledger error")
+	_, err = retriever.GetCollectionEndorsementPolicy("cc", "coll1")
+	assert.EqualError(t, err, "could not retrieve collection configuration for chaincode cc: ledger error")
+
+	retriever.StateFetcher = &mockStateFetcher{FetchStateErr: fmt.Errorf("fetch error")}
+	_, err = retriever.GetCollectionEndorsementPolicy("cc", "coll1")
+	assert.EqualError(t, err, "could not retrieve ledger: fetch error")
+}
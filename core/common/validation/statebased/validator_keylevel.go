@@ -26,7 +26,10 @@ import (
 type policyChecker struct {
 	someEPChecked bool
 	ccEPChecked   bool
+	collEPChecked map[string]bool
 	vpmgr         KeyLevelValidationParameterManager
+	collPolicies  CollectionEndorsementPolicyRetriever
+	collCaps      CollectionEndorsementPolicyCapabilities
 	policySupport validation.PolicyEvaluator
 	ccEP          []byte
 	signatureSet  []*common.SignedData
@@ -56,6 +59,44 @@ func (p *policyChecker) checkCCEPIfNoEPChecked(cc string, blockNum, txNum uint64
 	return p.checkCCEPIfCondition(cc, blockNum, txNum, p.someEPChecked)
 }
 
+// checkCollEPIfNotChecked validates a write to collection coll against the endorsement
+// policy of the collection, if the collection defines one and the channel enforces the
+// endorsement policies of the collections, or against the chaincode-wide endorsement policy otherwise
+func (p *policyChecker) checkCollEPIfNotChecked(cc, coll string, blockNum, txNum uint64) commonerrors.TxValidationError {
+	if p.collPolicies == nil || !p.collCaps.CollectionEndorsementPolicies() {
+		return p.checkCCEPIfNotChecked(cc, blockNum, txNum)
+	}
+	if p.collEPChecked[coll] {
+		return nil
+	}
+
+	collEP, err := p.collPolicies.GetCollectionEndorsementPolicy(cc, coll)
+	if err != nil {
+		return &commonerrors.VSCCExecutionFailureError{
+			Err: err,
+		}
+	}
+
+	// if the collection does not define an endorsement policy, the regular cc endorsement policy needs to hold
+	if len(collEP) == 0 {
+		if err := p.checkCCEPIfNotChecked(cc, blockNum, txNum); err != nil {
+			return err
+		}
+		p.collEPChecked[coll] = true
+		return nil
+	}
+
+	// validate against the collection ep
+	err = p.policySupport.Evaluate(collEP, p.signatureSet)
+	if err != nil {
+		return policyErr(errors.Wrapf(err, "validation of endorsement policy for collection %s of chaincode %s in tx %d:%d failed", coll, cc, blockNum, txNum))
+	}
+
+	p.collEPChecked[coll] = true
+	p.someEPChecked = true
+	return nil
+}
+
 func (p *policyChecker) checkSBAndCCEP(cc, coll, key string, blockNum, txNum uint64) commonerrors.TxValidationError {
 	// see if there is a key-level validation parameter for this key
 	vp, err := p.vpmgr.GetValidationParameterForKey(cc, coll, key, blockNum, txNum)
@@ -90,8 +131,12 @@ func (p *policyChecker) checkSBAndCCEP(cc, coll, key string, blockNum, txNum uin
 		}
 	}
 
-	// if no key-level validation parameter has been specified, the regular cc endorsement policy needs to hold
+	// if no key-level validation parameter has been specified, the endorsement policy
+	// of the collection, if any, or the regular cc endorsement policy needs to hold
 	if len(vp) == 0 {
+		if coll != "" {
+			return p.checkCollEPIfNotChecked(cc, coll, blockNum, txNum)
+		}
 		return p.checkCCEPIfNotChecked(cc, blockNum, txNum)
 	}
 
@@ -118,13 +163,21 @@ type blockDependency struct {
 // KeyLevelValidator implements per-key level ep validation
 type KeyLevelValidator struct {
 	vpmgr         KeyLevelValidationParameterManager
+	collPolicies  CollectionEndorsementPolicyRetriever
+	collCaps      CollectionEndorsementPolicyCapabilities
 	policySupport validation.PolicyEvaluator
 	blockDep      blockDependency
 }
 
-func NewKeyLevelValidator(policySupport validation.PolicyEvaluator, vpmgr KeyLevelValidationParameterManager) *KeyLevelValidator {
+// NewKeyLevelValidator returns a KeyLevelValidator. The endorsement policies of the
+// collections are retrieved using collPolicies, and enforced if collCaps says so; if
+// collPolicies is nil, or the endorsement policies of the collections aren't enforced,
+// the writes to the collections are validated against the chaincode-wide endorsement policy
+func NewKeyLevelValidator(policySupport validation.PolicyEvaluator, vpmgr KeyLevelValidationParameterManager, collPolicies CollectionEndorsementPolicyRetriever, collCaps CollectionEndorsementPolicyCapabilities) *KeyLevelValidator {
 	return &KeyLevelValidator{
 		vpmgr:         vpmgr,
+		collPolicies:  collPolicies,
+		collCaps:      collCaps,
 		policySupport: policySupport,
 		blockDep:      blockDependency{},
 	}
@@ -215,9 +268,12 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 	// construct the policy checker object
 	policyChecker := policyChecker{
 		ccEP:          ccEP,
+		collEPChecked: map[string]bool{},
 		policySupport: klv.policySupport,
 		signatureSet:  signatureSet,
 		vpmgr:         klv.vpmgr,
+		collPolicies:  klv.collPolicies,
+		collCaps:      klv.collCaps,
 	}
 
 	// unpack the rwset
@@ -253,7 +309,8 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 		}
 		// writes in collections
 		// we validate writes against key-level validation parameters
-		// if any are present or the chaincode-wide endorsement policy
+		// if any are present or the collection endorsement policy, if
+		// defined, or the chaincode-wide endorsement policy
 		for _, collRWSet := range nsRWSet.CollHashedRwSets {
 			coll := collRWSet.CollectionName
 			for _, hashedWrite := range collRWSet.HashedRwSet.HashedWrites {
@@ -266,7 +323,8 @@ func (klv *KeyLevelValidator) Validate(cc string, blockNum, txNum uint64, rwsetB
 		}
 		// metadata writes in collections
 		// we validate writes against key-level validation parameters
-		// if any are present or the chaincode-wide endorsement policy
+		// if any are present or the collection endorsement policy, if
+		// defined, or the chaincode-wide endorsement policy
 		for _, collRWSet := range nsRWSet.CollHashedRwSets {
 			coll := collRWSet.CollectionName
 			for _, hashedMdWrite := range collRWSet.HashedRwSet.MetadataWrites {
@@ -92,7 +92,7 @@ func TestKeylevelValidation(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -133,7 +133,7 @@ func TestKeylevelValidationPvtData(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToPvtAndHashedWriteSet("cc", "coll", "key", []byte("value"))
@@ -171,7 +171,7 @@ func TestKeylevelValidationMetaUpdate(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToMetadataWriteSet("cc", "key", map[string][]byte{})
@@ -209,7 +209,7 @@ func TestKeylevelValidationPvtMetaUpdate(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToHashedMetadataWriteSet("cc", "coll", "key", map[string][]byte{})
@@ -246,7 +246,7 @@ func TestKeylevelValidationPolicyRetrievalFailure(t *testing.T) {
 	mr := &mockState{GetStateMetadataErr: fmt.Errorf("metadata retrieval failure")}
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, nil, nil)
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -277,7 +277,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: &ledger.CollConfigNotDefinedError{Ns: "mycc"}}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, nil, nil)
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.NoError(t, err)
@@ -287,7 +287,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: &ledger.InvalidCollNameError{Ns: "mycc", Coll: "mycoll"}}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, nil, nil)
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.NoError(t, err)
@@ -297,7 +297,7 @@ func TestKeylevelValidationLedgerFailures(t *testing.T) {
 		mr := &mockState{GetStateMetadataErr: fmt.Errorf("some I/O error")}
 		ms := &mockStateFetcher{FetchStateRv: mr}
 		pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+		validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, nil, nil)
 
 		err := validator.Validate("cc", 1, 0, rwsb, prp, []byte("CCEP"), []*pb.Endorsement{})
 		assert.Error(t, err)
@@ -316,7 +316,7 @@ func TestCCEPValidation(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToWriteSet("cc", "key", []byte("value"))
@@ -345,6 +345,89 @@ func TestCCEPValidation(t *testing.T) {
 	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
 }
 
+type mockCollPolicies struct {
+	policies map[string][]byte
+	err      error
+}
+
+func (m *mockCollPolicies) GetCollectionEndorsementPolicy(cc, coll string) ([]byte, error) {
+	return m.policies[coll], m.err
+}
+
+type mockCollCaps struct {
+	enabled bool
+}
+
+func (m *mockCollCaps) CollectionEndorsementPolicies() bool {
+	return m.enabled
+}
+
+func TestCollectionEPValidation(t *testing.T) {
+	t.Parallel()
+
+	// Scenario: we validate a transaction that writes to
+	// collections without key-level endorsement policies;
+	// we expect to check the collection endorsement policy
+	// for the collections that define one, and the normal
+	// cc-endorsement policy otherwise.
+
+	mr := &mockState{GetStateMetadataRv: map[string][]byte{}, GetPrivateDataMetadataByHashRv: map[string][]byte{}}
+	ms := &mockStateFetcher{FetchStateRv: mr}
+	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
+	pe := &mockPolicyEvaluator{EvaluateResByPolicy: map[string]error{}}
+	collPolicies := &mockCollPolicies{policies: map[string][]byte{"coll": []byte("COLLEP")}}
+	collCaps := &mockCollCaps{enabled: true}
+	validator := NewKeyLevelValidator(pe, pm, collPolicies, collCaps)
+
+	rwsetFor := func(colls ...string) []byte {
+		rwsbu := rwsetutil.NewRWSetBuilder()
+		for _, coll := range colls {
+			rwsbu.AddToPvtAndHashedWriteSet("cc", coll, "key", []byte("value"))
+			rwsbu.AddToPvtAndHashedWriteSet("cc", coll, "key1", []byte("value"))
+		}
+		rwsb, err := rwsbu.GetTxReadWriteSet().ToProtoBytes()
+		assert.NoError(t, err)
+		return rwsb
+	}
+	prp := []byte("barf")
+	block := buildBlockWithTxs(buildTXWithRwset(rwsetFor("coll")))
+	validator.PreValidate(0, block)
+
+	// the collection endorsement policy replaces the cc-endorsement policy
+	pe.EvaluateResByPolicy["CCEP"] = fmt.Errorf("cc policy evaluation error")
+	err := validator.Validate("cc", 1, 0, rwsetFor("coll"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.NoError(t, err)
+
+	// but the cc-endorsement policy applies to the collections without an endorsement policy
+	err = validator.Validate("cc", 1, 0, rwsetFor("coll", "otherColl"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
+	assert.Contains(t, err.Error(), "validation of endorsement policy for chaincode cc in tx 1:0 failed")
+
+	delete(pe.EvaluateResByPolicy, "CCEP")
+	pe.EvaluateResByPolicy["COLLEP"] = fmt.Errorf("coll policy evaluation error")
+	err = validator.Validate("cc", 1, 0, rwsetFor("coll"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCEndorsementPolicyError{}, err)
+	assert.Contains(t, err.Error(), "validation of endorsement policy for collection coll of chaincode cc in tx 1:0 failed")
+
+	// failures to retrieve the collection endorsement policy halt the processing
+	collPolicies.err = fmt.Errorf("ledger error")
+	err = validator.Validate("cc", 1, 0, rwsetFor("coll"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.IsType(t, &errors.VSCCExecutionFailureError{}, err)
+
+	// without the capability, the cc-endorsement policy applies to all the collections
+	collPolicies.err = nil
+	collCaps.enabled = false
+	err = validator.Validate("cc", 1, 0, rwsetFor("coll"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.NoError(t, err)
+	pe.EvaluateResByPolicy["CCEP"] = fmt.Errorf("cc policy evaluation error")
+	err = validator.Validate("cc", 1, 0, rwsetFor("coll"), prp, []byte("CCEP"), []*pb.Endorsement{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "validation of endorsement policy for chaincode cc in tx 1:0 failed")
+}
+
 func TestCCEPValidationReads(t *testing.T) {
 	t.Parallel()
 
@@ -356,7 +439,7 @@ func TestCCEPValidationReads(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToReadSet("cc", "readkey", &version.Height{})
@@ -396,7 +479,7 @@ func TestOnlySBEPChecked(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
@@ -438,7 +521,7 @@ func TestCCEPValidationPvtReads(t *testing.T) {
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
 	pe := &mockPolicyEvaluator{}
-	validator := NewKeyLevelValidator(pe, pm)
+	validator := NewKeyLevelValidator(pe, pm, nil, nil)
 
 	rwsbu := rwsetutil.NewRWSetBuilder()
 	rwsbu.AddToHashedReadSet("cc", "coll", "readpvtkey", &version.Height{})
@@ -477,7 +560,7 @@ func TestKeylevelValidationFailure(t *testing.T) {
 	mr := &mockState{GetStateMetadataRv: map[string][]byte{vpMetadataKey: []byte("EP")}, GetPrivateDataMetadataByHashRv: map[string][]byte{vpMetadataKey: []byte("EP")}}
 	ms := &mockStateFetcher{FetchStateRv: mr}
 	pm := &KeyLevelValidationParameterManagerImpl{StateFetcher: ms}
-	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm)
+	validator := NewKeyLevelValidator(&mockPolicyEvaluator{}, pm, nil, nil)
 
 	rwsb := rwsetBytes(t, "cc")
 	prp := []byte("barf")
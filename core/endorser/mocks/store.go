@@ -33,6 +33,29 @@ func (_m *Store) GetMinTransientBlkHt() (uint64, error) {
 	return r0, r1
 }
 
+// GetPendingEntries provides a mock function with given fields: maxEntries
+func (_m *Store) GetPendingEntries(maxEntries int) (*transientstore.PendingEntries, error) {
+	ret := _m.Called(maxEntries)
+
+	var r0 *transientstore.PendingEntries
+	if rf, ok := ret.Get(0).(func(int) *transientstore.PendingEntries); ok {
+		r0 = rf(maxEntries)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transientstore.PendingEntries)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(maxEntries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxPvtRWSetByTxid provides a mock function with given fields: txid, filter
 func (_m *Store) GetTxPvtRWSetByTxid(txid string, filter ledger.PvtNsCollFilter) (transientstore.RWSetScanner, error) {
 	ret := _m.Called(txid, filter)
@@ -98,6 +121,20 @@ func (_m *Store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return r0
 }
 
+// PurgeKeys provides a mock function with given fields: purgedKeys, maxBlockHeight
+func (_m *Store) PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error {
+	ret := _m.Called(purgedKeys, maxBlockHeight)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*transientstore.PurgedKey, uint64) error); ok {
+		r0 = rf(purgedKeys, maxBlockHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PurgeByTxids provides a mock function with given fields: txids
 func (_m *Store) PurgeByTxids(txids []string) error {
 	ret := _m.Called(txids)
@@ -57,7 +57,7 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 			if err != nil {
 				return nil, errors.WithMessage(err, fmt.Sprintf("error while retrieving collection config for chaincode %#v", namespace))
 			}
-			if cb == nil {
+			if cb == nil && !onlyImplicitCollections(pvtRwset) {
 				return nil, errors.New(fmt.Sprintf("no collection config for chaincode %#v", namespace))
 			}
 
@@ -69,11 +69,49 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 
 			txPvtRwSetWithConfig.CollectionConfigs[namespace] = colCP
 		}
+		addImplicitCollectionConfigs(txPvtRwSetWithConfig.CollectionConfigs[namespace], pvtRwset)
 	}
 	as.trimCollectionConfigs(txPvtRwSetWithConfig)
 	return txPvtRwSetWithConfig, nil
 }
 
+// onlyImplicitCollections returns true if all the collections
+// written in the given private read-write set are implicit collections
+func onlyImplicitCollections(pvtRwset *rwset.NsPvtReadWriteSet) bool {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		if isImplicit, _ := privdata.MSPIDIfImplicitCollection(col.CollectionName); !isImplicit {
+			return false
+		}
+	}
+	return true
+}
+
+// addImplicitCollectionConfigs adds to the given collection config package the
+// configurations of the implicit collections written in the given private read-write set,
+// as they are not part of the collection config package stored in the ledger
+func addImplicitCollectionConfigs(colCP *common.CollectionConfigPackage, pvtRwset *rwset.NsPvtReadWriteSet) {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		isImplicit, mspID := privdata.MSPIDIfImplicitCollection(col.CollectionName)
+		if !isImplicit || hasCollectionConfig(colCP, col.CollectionName) {
+			continue
+		}
+		colCP.Config = append(colCP.Config, &common.CollectionConfig{
+			Payload: &common.CollectionConfig_StaticCollectionConfig{
+				StaticCollectionConfig: privdata.GenerateImplicitCollectionForOrg(mspID),
+			},
+		})
+	}
+}
+
+func hasCollectionConfig(colCP *common.CollectionConfigPackage, collectionName string) bool {
+	for _, conf := range colCP.Config {
+		if colConf := conf.GetStaticCollectionConfig(); colConf != nil && colConf.Name == collectionName {
+			return true
+		}
+	}
+	return false
+}
+
 func (as *rwSetAssembler) trimCollectionConfigs(pvtData *transientstore.TxPvtReadWriteSetWithConfigInfo) {
 	flags := make(map[string]map[string]struct{})
 	for _, pvtRWset := range pvtData.PvtRwset.NsPvtRwset {
@@ -88,3 +88,63 @@ func TestAssemblePvtRWSet(t *testing.T) {
 	assert.Equal(t, 1, len(pvtReadWriteSetWithConfigInfo.PvtRwset.NsPvtRwset))
 
 }
+
+func TestAssemblePvtRWSetWithImplicitCollections(t *testing.T) {
+	collectionsConfigCC1 := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "mycollection-1",
+					},
+				},
+			},
+		},
+	}
+	colB, err := proto.Marshal(collectionsConfigCC1)
+	assert.NoError(t, err)
+
+	configRetriever := &mockCollectionConfigRetriever{}
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("myCC")).Return(colB, nil)
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("noCollectionsCC")).Return([]byte(nil), nil)
+
+	assembler := rwSetAssembler{}
+
+	privData := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "myCC",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{CollectionName: "mycollection-1"},
+					{CollectionName: "_implicit_org_Org1MSP"},
+				},
+			},
+			{
+				Namespace: "noCollectionsCC",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{CollectionName: "_implicit_org_Org2MSP"},
+				},
+			},
+		},
+	}
+
+	pvtReadWriteSetWithConfigInfo, err := assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.NoError(t, err)
+	configPackages := pvtReadWriteSetWithConfigInfo.CollectionConfigs
+
+	configs := configPackages["myCC"]
+	assert.Equal(t, 2, len(configs.Config))
+	assert.Equal(t, "mycollection-1", configs.Config[0].GetStaticCollectionConfig().Name)
+	assert.Equal(t, privdata.GenerateImplicitCollectionForOrg("Org1MSP"), configs.Config[1].GetStaticCollectionConfig())
+
+	configs = configPackages["noCollectionsCC"]
+	assert.Equal(t, 1, len(configs.Config))
+	assert.Equal(t, privdata.GenerateImplicitCollectionForOrg("Org2MSP"), configs.Config[0].GetStaticCollectionConfig())
+
+	// a chaincode without collections cannot write to collections that are not implicit
+	privData.NsPvtRwset[1].CollectionPvtRwset = append(privData.NsPvtRwset[1].CollectionPvtRwset,
+		&rwset.CollectionPvtReadWriteSet{CollectionName: "mycollection-1"})
+	_, err = assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.EqualError(t, err, "no collection config for chaincode \"noCollectionsCC\"")
+}
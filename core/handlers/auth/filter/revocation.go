@@ -0,0 +1,88 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric/core/handlers/auth"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// revocationChecker checks whether an identity has been revoked
+type revocationChecker interface {
+	CheckIdentity(id msp.Identity) error
+}
+
+// NewRevocationCheckFilter creates a new Filter that checks whether
+// the certificate of the creator of a proposal has been revoked
+func NewRevocationCheckFilter() auth.Filter {
+	return &revocationCheckFilter{
+		revocationChecker: func() revocationChecker {
+			if checker := mgmt.GetRevocationChecker(); checker != nil {
+				return checker
+			}
+			return nil
+		},
+		identityDeserializer: mgmt.GetIdentityDeserializer,
+	}
+}
+
+type revocationCheckFilter struct {
+	next                 peer.EndorserServer
+	revocationChecker    func() revocationChecker
+	identityDeserializer func(chainID string) msp.IdentityDeserializer
+}
+
+// Init initializes the Filter with the next EndorserServer
+func (f *revocationCheckFilter) Init(next peer.EndorserServer) {
+	f.next = next
+}
+
+func (f *revocationCheckFilter) checkRevocation(checker revocationChecker, signedProp *peer.SignedProposal) error {
+	prop, err := utils.GetProposal(signedProp.ProposalBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing proposal")
+	}
+
+	hdr, err := utils.GetHeader(prop.Header)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing header")
+	}
+
+	chdr, err := utils.UnmarshalChannelHeader(hdr.ChannelHeader)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing channel header")
+	}
+
+	sh, err := utils.GetSignatureHeader(hdr.SignatureHeader)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing signature header")
+	}
+
+	// Identities that can't be deserialized are rejected
+	// further down the chain, when the proposal is validated
+	identity, err := f.identityDeserializer(chdr.ChannelId).DeserializeIdentity(sh.Creator)
+	if err != nil {
+		return nil
+	}
+	return checker.CheckIdentity(identity)
+}
+
+// ProcessProposal processes a signed proposal
+func (f *revocationCheckFilter) ProcessProposal(ctx context.Context, signedProp *peer.SignedProposal) (*peer.ProposalResponse, error) {
+	if checker := f.revocationChecker(); checker != nil {
+		if err := f.checkRevocation(checker, signedProp); err != nil {
+			return nil, err
+		}
+	}
+	return f.next.ProcessProposal(ctx, signedProp)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package filter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockIdentity struct {
+	msp.Identity
+	creator string
+}
+
+type mockDeserializer struct {
+	msp.IdentityDeserializer
+	channels []string
+}
+
+func (d *mockDeserializer) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	if string(serializedIdentity) == "garbage" {
+		return nil, errors.New("bad identity")
+	}
+	return &mockIdentity{creator: string(serializedIdentity)}, nil
+}
+
+type mockRevocationChecker struct {
+	checked []string
+}
+
+func (c *mockRevocationChecker) CheckIdentity(id msp.Identity) error {
+	creator := id.(*mockIdentity).creator
+	c.checked = append(c.checked, creator)
+	if creator == "revoked" {
+		return errors.New("The certificate has been revoked")
+	}
+	return nil
+}
+
+func TestRevocationCheckFilter(t *testing.T) {
+	nextEndorser := &mockEndorserServer{}
+	auth := NewRevocationCheckFilter()
+	auth.Init(nextEndorser)
+
+	// Scenario I: Revocation checking is disabled
+	sp := createValidSignedProposal(t, []byte("revoked"))
+	_, err := auth.ProcessProposal(context.Background(), sp)
+	assert.NoError(t, err)
+	assert.True(t, nextEndorser.invoked)
+	nextEndorser.invoked = false
+
+	checker := &mockRevocationChecker{}
+	deserializer := &mockDeserializer{}
+	auth.(*revocationCheckFilter).revocationChecker = func() revocationChecker { return checker }
+	auth.(*revocationCheckFilter).identityDeserializer = func(chainID string) msp.IdentityDeserializer {
+		deserializer.channels = append(deserializer.channels, chainID)
+		return deserializer
+	}
+
+	// Scenario II: Revoked identity
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.EqualError(t, err, "The certificate has been revoked")
+	assert.False(t, nextEndorser.invoked)
+
+	// Scenario III: Identity that isn't revoked
+	sp = createValidSignedProposal(t, []byte("alice"))
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.NoError(t, err)
+	assert.True(t, nextEndorser.invoked)
+	nextEndorser.invoked = false
+	assert.Equal(t, []string{"revoked", "alice"}, checker.checked)
+	assert.Equal(t, []string{"", ""}, deserializer.channels)
+
+	// Scenario IV: Identity that can't be deserialized is left to the endorser
+	sp = createValidSignedProposal(t, []byte("garbage"))
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.NoError(t, err)
+	assert.True(t, nextEndorser.invoked)
+	nextEndorser.invoked = false
+	assert.Equal(t, []string{"revoked", "alice"}, checker.checked)
+
+	// Scenario V: Malformed proposal
+	sp = createValidSignedProposal(t, []byte("alice"))
+	sp.ProposalBytes = append(sp.ProposalBytes, 0)
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.Contains(t, err.Error(), "failed parsing proposal")
+	assert.False(t, nextEndorser.invoked)
+
+	// Scenario VI: Malformed signature header
+	sp = createSignedProposalWithInvalidSigHeader(t, []byte("alice"))
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.Contains(t, err.Error(), "failed parsing signature header")
+	assert.False(t, nextEndorser.invoked)
+
+	// Scenario VII: Malformed header
+	sp = createSignedProposalWithInvalidHeader(t, []byte("alice"))
+	_, err = auth.ProcessProposal(context.Background(), sp)
+	assert.Contains(t, err.Error(), "failed parsing header")
+	assert.False(t, nextEndorser.invoked)
+}
@@ -37,6 +37,14 @@ func (r *HandlerLibrary) ExpirationCheck() auth.Filter {
 	return filter.NewExpirationCheckFilter()
 }
 
+// RevocationCheck is an auth filter which blocks requests from
+// identities whose x509 certificates have been revoked, according
+// to the CRL distribution points and the OCSP responders they list.
+// It only checks revocation when peer.revocationCheck is enabled.
+func (r *HandlerLibrary) RevocationCheck() auth.Filter {
+	return filter.NewRevocationCheckFilter()
+}
+
 // DefaultDecorator creates a default decorator
 // that doesn't do anything with the input, simply
 // returns the input as output.
@@ -9,6 +9,7 @@ package v13
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -69,6 +70,12 @@ func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfi
 			return err
 		}
 
+		// implicit collections cannot be defined explicitly
+		if strings.HasPrefix(collectionName, privdata.ImplicitCollectionNamePrefix) {
+			return fmt.Errorf("collection-name: %s -- the prefix %s is reserved for implicit collections",
+				collectionName, privdata.ImplicitCollectionNamePrefix)
+		}
+
 		if _, ok := newCollectionsMap[collectionName]; !ok {
 			newCollectionsMap[collectionName] = true
 		} else {
@@ -94,6 +101,11 @@ func validateNewCollectionConfigs(newCollectionConfigs []*common.CollectionConfi
 		if err != nil {
 			return errors.WithMessage(err, fmt.Sprintf("collection-name: %s -- error in member org policy", collectionName))
 		}
+
+		// make sure that the endorsement policy, if any, carries a signature policy
+		if ep := newCollection.GetEndorsementPolicy(); ep != nil && ep.GetSignaturePolicy() == nil {
+			return fmt.Errorf("collection-name: %s -- endorsement policy must contain a signature policy", collectionName)
+		}
 	}
 	return nil
 }
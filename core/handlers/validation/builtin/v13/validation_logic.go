@@ -10,17 +10,21 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/golang/protobuf/proto"
 	commonerrors "github.com/hyperledger/fabric/common/errors"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/chaincode/platforms/ccmetadata"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	. "github.com/hyperledger/fabric/core/common/validation/statebased"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/capabilities"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/identities"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/policies"
 	. "github.com/hyperledger/fabric/core/handlers/validation/api/state"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 )
 
 var logger = flogging.MustGetLogger("vscc")
@@ -37,7 +41,8 @@ var validCollectionNameRegex = regexp.MustCompile(ccmetadata.AllowedCharsCollect
 // Typically this will only be invoked once per peer
 func New(c Capabilities, s StateFetcher, d IdentityDeserializer, pe PolicyEvaluator) *Validator {
 	vpmgr := &KeyLevelValidationParameterManagerImpl{StateFetcher: s}
-	sbv := NewKeyLevelValidator(pe, vpmgr)
+	collPolicies := &CollectionEndorsementPolicyRetrieverImpl{StateFetcher: s}
+	sbv := NewKeyLevelValidator(pe, vpmgr, collPolicies, c)
 
 	return &Validator{
 		capabilities:        c,
@@ -176,6 +181,14 @@ func (vscc *Validator) Validate(
 		return txverr
 	}
 
+	// check that the creator of the transaction is allowed to
+	// write to the member-only-write collections it modifies
+	if txverr := vscc.checkMemberOnlyWrite(va.chdr.ChannelId, namespace, va); txverr != nil {
+		logger.Errorf("VSCC error: checkMemberOnlyWrite failed, err %s", txverr)
+		vscc.stateBasedValidator.PostValidate(namespace, block.Header.Number, uint64(txPosition), txverr)
+		return txverr
+	}
+
 	// do some extra validation that is specific to lscc
 	if namespace == "lscc" {
 		logger.Debugf("VSCC info: doing special validation for LSCC")
@@ -191,6 +204,85 @@ func (vscc *Validator) Validate(
 	return nil
 }
 
+// checkMemberOnlyWrite evaluates the member orgs policy of every collection
+// of the namespace that is written by the transaction and that is configured
+// as member-only-write against the creator of the transaction, if the channel
+// enforces member-only-write
+func (vscc *Validator) checkMemberOnlyWrite(channel, namespace string, va *validationArtifacts) commonerrors.TxValidationError {
+	if !vscc.capabilities.MemberOnlyWrite() {
+		return nil
+	}
+
+	txRWSet := &rwsetutil.TxRwSet{}
+	if err := txRWSet.FromProtoBytes(va.rwset); err != nil {
+		return policyErr(errors.WithMessage(err, "txRWSet.FromProtoBytes failed"))
+	}
+
+	var writtenCollections []string
+	for _, nsRWSet := range txRWSet.NsRwSets {
+		if nsRWSet.NameSpace != namespace {
+			continue
+		}
+		for _, collRWSet := range nsRWSet.CollHashedRwSets {
+			hashedRWSet := collRWSet.HashedRwSet
+			if len(hashedRWSet.GetHashedWrites()) == 0 && len(hashedRWSet.GetMetadataWrites()) == 0 {
+				continue
+			}
+			writtenCollections = append(writtenCollections, collRWSet.CollectionName)
+		}
+	}
+	if len(writtenCollections) == 0 {
+		return nil
+	}
+
+	channelState, err := vscc.stateFetcher.FetchState()
+	if err != nil {
+		return &commonerrors.VSCCExecutionFailureError{Err: errors.WithMessage(err, "failed obtaining query executor")}
+	}
+	defer channelState.Done()
+
+	ccp, err := privdata.RetrieveCollectionConfigPackageFromState(common.CollectionCriteria{Channel: channel, Namespace: namespace}, &state{channelState})
+	if err != nil {
+		if _, ok := err.(privdata.NoSuchCollectionError); ok {
+			return nil
+		}
+		return &commonerrors.VSCCExecutionFailureError{Err: errors.WithMessage(err, fmt.Sprintf("unable to retrieve collection configuration for chaincode %s", namespace))}
+	}
+
+	configs := make(map[string]*common.StaticCollectionConfig, len(ccp.Config))
+	for _, config := range ccp.Config {
+		if staticConfig := config.GetStaticCollectionConfig(); staticConfig != nil {
+			configs[staticConfig.Name] = staticConfig
+		}
+	}
+
+	shdr, err := utils.GetSignatureHeader(va.payl.Header.SignatureHeader)
+	if err != nil {
+		return policyErr(err)
+	}
+	sd := []*common.SignedData{{
+		Data:      va.env.Payload,
+		Identity:  shdr.Creator,
+		Signature: va.env.Signature,
+	}}
+
+	for _, collection := range writtenCollections {
+		staticConfig, ok := configs[collection]
+		if !ok || !staticConfig.MemberOnlyWrite {
+			continue
+		}
+		policy, err := proto.Marshal(staticConfig.GetMemberOrgsPolicy().GetSignaturePolicy())
+		if err != nil {
+			return policyErr(errors.Wrapf(err, "invalid member orgs policy for collection %s of chaincode %s", collection, namespace))
+		}
+		if err := vscc.policyEvaluator.Evaluate(policy, sd); err != nil {
+			return policyErr(errors.WithMessage(err, fmt.Sprintf("tx creator does not have write access permission on collection %s of chaincode %s", collection, namespace)))
+		}
+	}
+
+	return nil
+}
+
 func policyErr(err error) *commonerrors.VSCCEndorsementPolicyError {
 	return &commonerrors.VSCCEndorsementPolicyError{
 		Err: err,
@@ -52,6 +52,10 @@ import (
 )
 
 func createTx(endorsedByDuplicatedIdentity bool) (*common.Envelope, error) {
+	return createTxWithResults(endorsedByDuplicatedIdentity, nil)
+}
+
+func createTxWithResults(endorsedByDuplicatedIdentity bool, results []byte) (*common.Envelope, error) {
 	ccid := &peer.ChaincodeID{Name: "foo", Version: "v1"}
 	cis := &peer.ChaincodeInvocationSpec{ChaincodeSpec: &peer.ChaincodeSpec{ChaincodeId: ccid}}
 
@@ -60,7 +64,7 @@ func createTx(endorsedByDuplicatedIdentity bool) (*common.Envelope, error) {
 		return nil, err
 	}
 
-	presp, err := utils.CreateProposalResponse(prop.Header, prop.Payload, &peer.Response{Status: 200}, []byte("res"), nil, ccid, nil, id)
+	presp, err := utils.CreateProposalResponse(prop.Header, prop.Payload, &peer.Response{Status: 200}, results, nil, ccid, nil, id)
 	if err != nil {
 		return nil, err
 	}
@@ -435,6 +439,87 @@ func TestInvoke(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMemberOnlyWrite(t *testing.T) {
+	collConfig := func(name, mspID string, memberOnlyWrite bool) *common.CollectionConfig {
+		return &common.CollectionConfig{
+			Payload: &common.CollectionConfig_StaticCollectionConfig{
+				StaticCollectionConfig: &common.StaticCollectionConfig{
+					Name: name,
+					MemberOrgsPolicy: &common.CollectionPolicyConfig{
+						Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+							SignaturePolicy: cauthdsl.SignedByMspMember(mspID),
+						},
+					},
+					MemberOnlyWrite: memberOnlyWrite,
+				},
+			},
+		}
+	}
+	ccp := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			collConfig("member", mspid, true),
+			collConfig("nonmember", "OtherMSP", true),
+			collConfig("unrestricted", "OtherMSP", false),
+		},
+	}
+	state := make(map[string]map[string][]byte)
+	state["lscc"] = map[string][]byte{
+		privdata.BuildCollectionKVSKey("foo"): utils.MarshalOrPanic(ccp),
+	}
+	qec := &mocks2.QueryExecutorCreator{}
+	qec.On("NewQueryExecutor").Return(lm.NewMockQueryExecutor(state), nil)
+	capabilities := &mc.MockApplicationCapabilities{MemberOnlyWriteRv: true}
+	v := newCustomValidationInstance(qec, capabilities)
+
+	policy, err := getSignedByMSPMemberPolicy(mspid)
+	assert.NoError(t, err)
+
+	validate := func(ns, coll string, metadata bool) error {
+		rwsetBuilder := rwsetutil.NewRWSetBuilder()
+		if metadata {
+			rwsetBuilder.AddToHashedMetadataWriteSet(ns, coll, "key", map[string][]byte{"metakey": []byte("value")})
+		} else {
+			rwsetBuilder.AddToPvtAndHashedWriteSet(ns, coll, "key", []byte("value"))
+		}
+		sr, err := rwsetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		srBytes, err := sr.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		tx, err := createTxWithResults(false, srBytes)
+		assert.NoError(t, err)
+		b := &common.Block{Data: &common.BlockData{Data: [][]byte{utils.MarshalOrPanic(tx)}}, Header: &common.BlockHeader{}}
+		return v.Validate(b, "foo", 0, 0, policy)
+	}
+
+	// good path: the creator belongs to the member orgs of the collection
+	assert.NoError(t, validate("foo", "member", false))
+	assert.NoError(t, validate("foo", "member", true))
+
+	// good path: the collection is not member-only-write
+	assert.NoError(t, validate("foo", "unrestricted", false))
+
+	// good path: collections of other namespaces are not checked
+	assert.NoError(t, validate("bar", "nonmember", false))
+
+	// bad path: the creator does not belong to the member orgs of the collection
+	err = validate("foo", "nonmember", false)
+	assert.IsType(t, &commonerrors.VSCCEndorsementPolicyError{}, err)
+	assert.Contains(t, err.Error(), "tx creator does not have write access permission on collection nonmember of chaincode foo")
+
+	err = validate("foo", "nonmember", true)
+	assert.IsType(t, &commonerrors.VSCCEndorsementPolicyError{}, err)
+
+	// good path: the channel does not enforce member-only-write
+	capabilities.MemberOnlyWriteRv = false
+	assert.NoError(t, validate("foo", "nonmember", false))
+	capabilities.MemberOnlyWriteRv = true
+
+	// bad path: the collection configuration cannot be retrieved
+	state["lscc"][privdata.BuildCollectionKVSKey("foo")] = []byte("barf")
+	err = validate("foo", "member", false)
+	assert.IsType(t, &commonerrors.VSCCExecutionFailureError{}, err)
+}
+
 func TestRWSetTooBig(t *testing.T) {
 	state := make(map[string]map[string][]byte)
 	mp := (&scc.MocksccProviderFactory{
@@ -1868,6 +1953,27 @@ func TestValidateRWSetAndCollectionForDeploy(t *testing.T) {
 	err = testValidateCollection(t, v, []*common.CollectionConfig{coll3}, cdRWSet, lsccFunc, ac, chid)
 	assert.EqualError(t, err, "collection-name: mycollection3 -- error in member org policy: signature policy is not an OR concatenation, NOutOf 2")
 
+	// Test 12a: collection endorsement policy without a signature policy -> error
+	policyEnvelope = cauthdsl.Envelope(cauthdsl.Or(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers)
+	coll3 = createCollectionConfig(collName3, policyEnvelope, requiredPeerCount, maximumPeerCount, blockToLive)
+	coll3.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{}
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.EqualError(t, err, "collection-name: mycollection3 -- endorsement policy must contain a signature policy")
+
+	// Test 12b: collection endorsement policy that is an AND concatenation of orgs -> success
+	coll3.GetStaticCollectionConfig().EndorsementPolicy = &common.CollectionPolicyConfig{
+		Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+			SignaturePolicy: cauthdsl.Envelope(cauthdsl.And(cauthdsl.SignedBy(0), cauthdsl.SignedBy(1)), signers),
+		},
+	}
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.NoError(t, err)
+
+	// Test 12c: collection name with the implicit collection prefix -> error
+	coll3 = createCollectionConfig("_implicit_org_SampleOrg", policyEnvelope, requiredPeerCount, maximumPeerCount, blockToLive)
+	err = testValidateCollection(t, v, []*common.CollectionConfig{coll3}, cdRWSet, lsccFunc, ac, chid)
+	assert.EqualError(t, err, "collection-name: _implicit_org_SampleOrg -- the prefix _implicit_org_ is reserved for implicit collections")
+
 	// Test 13: deploy with existing collection config on the ledger -> error
 	ccp := &common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1}}
 	ccpBytes, err := proto.Marshal(ccp)
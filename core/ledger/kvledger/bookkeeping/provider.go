@@ -21,6 +21,8 @@ const (
 	PvtdataExpiry Category = iota
 	// MetadataPresenceIndicator maintains the bookkeeping about whether metadata is ever set for a namespace
 	MetadataPresenceIndicator
+	// StateHash maintains the hash of the state updates applied with each block
+	StateHash
 )
 
 // Provider provides handle to different bookkeepers for the given ledger
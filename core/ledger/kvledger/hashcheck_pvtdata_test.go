@@ -81,7 +81,7 @@ func TestConstructValidInvalidBlocksPvtData(t *testing.T) {
 		Block:          blk1,
 		PvtData:        pvtDataBlk1,
 		MissingPvtData: missingData}
-	assert.NoError(t, lg.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtData1))
+	assert.NoError(t, lg.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtData1, nil))
 
 	// construct pvtData from missing data in tx3, tx6, and tx7
 	blocksPvtData := []*ledger.BlockPvtData{
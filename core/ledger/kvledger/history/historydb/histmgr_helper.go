@@ -54,6 +54,38 @@ func ConstructPartialCompositeHistoryKey(ns string, key string, endkey bool) []b
 	return compositeKey
 }
 
+// PvtHistoryKeyPrefix is the prefix of the History Keys of the private data keys. This keeps
+// the History Keys of the private data apart from the History Keys of the public keys,
+// as a namespace cannot begin with a nil byte
+var PvtHistoryKeyPrefix = []byte{0x00, 0x01}
+
+// ConstructCompositePvtHistoryKey builds the History Key of a private data key in the form
+// prefix~namespace~collection~key~blocknum~trannum using an order preserving encoding so that
+// history query results are ordered by height
+func ConstructCompositePvtHistoryKey(ns, coll, key string, blocknum uint64, trannum uint64) []byte {
+	compositeKey := ConstructPartialCompositePvtHistoryKey(ns, coll, key, false)
+	compositeKey = append(compositeKey, util.EncodeOrderPreservingVarUint64(blocknum)...)
+	compositeKey = append(compositeKey, util.EncodeOrderPreservingVarUint64(trannum)...)
+	return compositeKey
+}
+
+// ConstructPartialCompositePvtHistoryKey builds a partial History Key prefix~namespace~collection~key~
+// of a private data key for use in history key range queries
+func ConstructPartialCompositePvtHistoryKey(ns, coll, key string, endkey bool) []byte {
+	var compositeKey []byte
+	compositeKey = append(compositeKey, PvtHistoryKeyPrefix...)
+	compositeKey = append(compositeKey, []byte(ns)...)
+	compositeKey = append(compositeKey, CompositeKeySep...)
+	compositeKey = append(compositeKey, []byte(coll)...)
+	compositeKey = append(compositeKey, CompositeKeySep...)
+	compositeKey = append(compositeKey, []byte(key)...)
+	compositeKey = append(compositeKey, CompositeKeySep...)
+	if endkey {
+		compositeKey = append(compositeKey, []byte{0xff}...)
+	}
+	return compositeKey
+}
+
 //SplitCompositeHistoryKey splits the key bytes using a separator
 func SplitCompositeHistoryKey(bytesToSplit []byte, separator []byte) ([]byte, []byte) {
 	split := bytes.SplitN(bytesToSplit, separator, 2)
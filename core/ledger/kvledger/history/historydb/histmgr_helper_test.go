@@ -38,6 +38,17 @@ func TestConstructPartialCompositeKey(t *testing.T) {
 	assert.Equal(t, []byte("ns1"+strKeySep+"key1"+strKeySep+string([]byte{0xff})), compositeEndKey)
 }
 
+func TestConstructPartialCompositePvtHistoryKey(t *testing.T) {
+	compositeStartKey := ConstructPartialCompositePvtHistoryKey("ns1", "coll1", "key1", false)
+	compositeEndKey := ConstructPartialCompositePvtHistoryKey("ns1", "coll1", "key1", true)
+
+	prefix := string(PvtHistoryKeyPrefix)
+	assert.Equal(t, []byte(prefix+"ns1"+strKeySep+"coll1"+strKeySep+"key1"+strKeySep), compositeStartKey)
+	assert.Equal(t, []byte(prefix+"ns1"+strKeySep+"coll1"+strKeySep+"key1"+strKeySep+string([]byte{0xff})), compositeEndKey)
+	assert.Equal(t, append(compositeStartKey, []byte{0x01, 0x02, 0x01, 0x03}...),
+		ConstructCompositePvtHistoryKey("ns1", "coll1", "key1", 2, 3))
+}
+
 func TestSplitCompositeKey(t *testing.T) {
 	compositeFullKey := []byte("ns1" + strKeySep + "key1" + strKeySep + "extra bytes to split")
 	compositePartialKey := ConstructPartialCompositeHistoryKey("ns1", "key1", false)
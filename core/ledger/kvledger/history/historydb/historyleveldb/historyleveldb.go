@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package historyleveldb
 
 import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
@@ -15,9 +17,12 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/pvtdatastorage"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
 	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 )
 
 var logger = flogging.MustGetLogger("historyleveldb")
@@ -72,6 +77,69 @@ func (historyDB *historyDB) Close() {
 
 // Commit implements method in HistoryDB interface
 func (historyDB *historyDB) Commit(block *common.Block) error {
+	return historyDB.commit(block, nil)
+}
+
+// CommitWithPvtData implements method in HistoryDB interface
+func (historyDB *historyDB) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error {
+	return historyDB.commit(blockAndPvtdata.Block, blockAndPvtdata.PvtData)
+}
+
+// CommitPvtDataOfOldBlocks implements method in HistoryDB interface. The history records are added
+// for the private data that was missing at the time of the block commit and has been reconciled later.
+// The savepoint is not updated as the blocks have already been committed to the history database
+func (historyDB *historyDB) CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPvtData) error {
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	for blockNo, txsPvtData := range blocksPvtData {
+		for _, txPvtData := range txsPvtData {
+			if err := addPvtHistoryRecords(dbBatch, blockNo, txPvtData, nil, nil); err != nil {
+				return err
+			}
+		}
+	}
+	if err := historyDB.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	logger.Debugf("Channel [%s]: Private data of [%d] old blocks committed to history database", historyDB.dbName, len(blocksPvtData))
+	return nil
+}
+
+// PurgePvtDataHistory implements method in HistoryDB interface. The history records of the purged writes
+// are kept, and marked as purged with the txid and the timestamp of the writing transaction
+func (historyDB *historyDB) PurgePvtDataHistory(purgedWrites []*pvtdatastorage.PurgedWrite, blockStore blkstorage.BlockStore) error {
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	for _, w := range purgedWrites {
+		compositeHistoryKey := historydb.ConstructCompositePvtHistoryKey(w.Namespace, w.Collection, w.Key, w.BlockNum, w.TxNum)
+		val, err := historyDB.db.Get(compositeHistoryKey)
+		if err != nil {
+			return err
+		}
+		if val == nil || len(val) != 0 {
+			// the write was either not recorded or is already marked as purged
+			continue
+		}
+		tranEnvelope, err := blockStore.RetrieveTxByBlockNumTranNum(w.BlockNum, w.TxNum)
+		if err != nil {
+			return err
+		}
+		txID, timestamp, err := getTxIDAndTimestampFromTran(tranEnvelope)
+		if err != nil {
+			return err
+		}
+		purgedMarker, err := encodePurgedMarker(txID, timestamp)
+		if err != nil {
+			return err
+		}
+		dbBatch.Put(compositeHistoryKey, purgedMarker)
+	}
+	if err := historyDB.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	logger.Debugf("Channel [%s]: History of [%d] purged private data writes marked as purged in history database", historyDB.dbName, len(purgedWrites))
+	return nil
+}
+
+func (historyDB *historyDB) commit(block *common.Block, pvtData ledger.TxPvtDataMap) error {
 
 	blockNo := block.Header.Number
 	//Set the starting tranNo to 0
@@ -85,6 +153,12 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 	// Get the invalidation byte array for the block
 	txsFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
 
+	// the private data of the valid transactions along with their purged markers,
+	// and the last transaction of the block that purges each private data key
+	var txsPvtData []*ledger.TxPvtData
+	purgedMarkers := make(map[uint64][]byte)
+	purgingTranNums := make(map[pvtKeyHash]uint64)
+
 	// write each tran's write set to history db
 	for _, envBytes := range block.Data.Data {
 
@@ -141,6 +215,21 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 					// No value is required, write an empty byte array (emptyValue) since Put() of nil is not allowed
 					dbBatch.Put(compositeHistoryKey, emptyValue)
 				}
+
+				for _, collHashedRWSet := range nsRWSet.CollHashedRwSets {
+					for _, hashedWrite := range collHashedRWSet.HashedRwSet.HashedWrites {
+						if hashedWrite.IsPurge {
+							purgingTranNums[pvtKeyHash{ns, collHashedRWSet.CollectionName, string(hashedWrite.KeyHash)}] = tranNo
+						}
+					}
+				}
+			}
+
+			if txPvtData, ok := pvtData[tranNo]; ok {
+				txsPvtData = append(txsPvtData, txPvtData)
+				if purgedMarkers[tranNo], err = encodePurgedMarker(chdr.TxId, chdr.Timestamp); err != nil {
+					return err
+				}
 			}
 
 		} else {
@@ -149,6 +238,19 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 		tranNo++
 	}
 
+	// add a history record for each private data write available on this peer. The records
+	// of the writes of the keys purged by a later transaction of the block are marked as purged
+	for _, txPvtData := range txsPvtData {
+		txNum := txPvtData.SeqInBlock
+		isPurged := func(ns, coll, key string) bool {
+			purgingTranNum, ok := purgingTranNums[pvtKeyHash{ns, coll, string(util.ComputeStringHash(key))}]
+			return ok && txNum < purgingTranNum
+		}
+		if err := addPvtHistoryRecords(dbBatch, blockNo, txPvtData, isPurged, purgedMarkers[txNum]); err != nil {
+			return err
+		}
+	}
+
 	// add savepoint for recovery purpose
 	height := version.NewHeight(blockNo, tranNo)
 	dbBatch.Put(savePointKey, height.ToBytes())
@@ -204,8 +306,56 @@ func (historyDB *historyDB) CommitLostBlock(blockAndPvtdata *ledger.BlockAndPvtD
 		logger.Debugf("Recommitting block [%d] to history database", block.Header.Number)
 	}
 
-	if err := historyDB.Commit(block); err != nil {
+	if err := historyDB.commit(block, blockAndPvtdata.PvtData); err != nil {
 		return err
 	}
 	return nil
 }
+
+// pvtKeyHash identifies a private data key by its hash
+type pvtKeyHash struct {
+	ns, coll, keyHash string
+}
+
+// addPvtHistoryRecords adds a history record for each private data write of a transaction. The records
+// of the writes for which the function `isPurged`, if not nil, returns true hold the given purged marker
+func addPvtHistoryRecords(dbBatch *leveldbhelper.UpdateBatch, blockNo uint64, txPvtData *ledger.TxPvtData,
+	isPurged func(ns, coll, key string) bool, purgedMarker []byte) error {
+	if txPvtData.WriteSet == nil {
+		return nil
+	}
+	txPvtRWSet, err := rwsetutil.TxPvtRwSetFromProtoMsg(txPvtData.WriteSet)
+	if err != nil {
+		return err
+	}
+	for _, nsPvtRWSet := range txPvtRWSet.NsPvtRwSet {
+		for _, collPvtRWSet := range nsPvtRWSet.CollPvtRwSets {
+			for _, kvWrite := range collPvtRWSet.KvRwSet.Writes {
+				//composite key for private data history records is in the form prefix~ns~coll~key~blockNo~tranNo
+				compositeHistoryKey := historydb.ConstructCompositePvtHistoryKey(nsPvtRWSet.NameSpace,
+					collPvtRWSet.CollectionName, kvWrite.Key, blockNo, txPvtData.SeqInBlock)
+				if isPurged != nil && isPurged(nsPvtRWSet.NameSpace, collPvtRWSet.CollectionName, kvWrite.Key) {
+					dbBatch.Put(compositeHistoryKey, purgedMarker)
+					continue
+				}
+				dbBatch.Put(compositeHistoryKey, emptyValue)
+			}
+		}
+	}
+	return nil
+}
+
+// encodePurgedMarker returns the value of the history record of a purged private data write. It holds
+// the txid and the timestamp of the writing transaction, whereas the record of a write that is not
+// purged holds an empty value
+func encodePurgedMarker(txID string, ts *timestamp.Timestamp) ([]byte, error) {
+	return proto.Marshal(&queryresult.KeyModification{TxId: txID, Timestamp: ts, IsPurged: true})
+}
+
+func decodePurgedMarker(purgedMarker []byte) (*queryresult.KeyModification, error) {
+	keyModification := &queryresult.KeyModification{}
+	if err := proto.Unmarshal(purgedMarker, keyModification); err != nil {
+		return nil, errors.Wrap(err, "error while decoding purged marker of history record")
+	}
+	return keyModification, nil
+}
@@ -8,10 +8,14 @@ package historyleveldb
 
 import (
 	"bytes"
+	"fmt"
+	"math"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	commonledger "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
@@ -30,40 +34,236 @@ type LevelHistoryDBQueryExecutor struct {
 
 // GetHistoryForKey implements method in interface `ledger.HistoryQueryExecutor`
 func (q *LevelHistoryDBQueryExecutor) GetHistoryForKey(namespace string, key string) (commonledger.ResultsIterator, error) {
+	return q.GetHistoryForKeyByBlockRange(namespace, key, 0, math.MaxUint64, nil)
+}
+
+// GetHistoryForKeyByBlockRange implements method in interface `ledger.HistoryQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetHistoryForKeyByBlockRange(namespace string, key string,
+	startBlock, endBlock uint64, options *ledger.HistoryQueryOptions) (ledger.QueryResultsIterator, error) {
+	if startBlock > endBlock {
+		return nil, errors.Errorf("start block [%d] is greater than end block [%d]", startBlock, endBlock)
+	}
+	scanner, err := q.newHistoryScanner(namespace, key, startBlock, endBlock, nil, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return scanner, nil
+}
+
+// GetHistoryForKeyByTimeRange implements method in interface `ledger.HistoryQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetHistoryForKeyByTimeRange(namespace string, key string,
+	startTime, endTime *timestamp.Timestamp, options *ledger.HistoryQueryOptions) (ledger.QueryResultsIterator, error) {
+	if startTime != nil && endTime != nil && compareTimestamps(startTime, endTime) > 0 {
+		return nil, errors.Errorf("start time [%s] is after end time [%s]", startTime, endTime)
+	}
+	scanner, err := q.newHistoryScanner(namespace, key, 0, math.MaxUint64, startTime, endTime, options)
+	if err != nil {
+		return nil, err
+	}
+	return scanner, nil
+}
+
+// GetPrivateDataHistoryForKey implements method in interface `ledger.HistoryQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetPrivateDataHistoryForKey(namespace, collection, key string) (commonledger.ResultsIterator, error) {
+	if ledgerconfig.IsHistoryDBEnabled() == false {
+		return nil, errors.New("history database not enabled")
+	}
+	retriever, ok := q.blockStore.(pvtdataRetriever)
+	if !ok {
+		return nil, errors.New("private data history is not supported by the block store")
+	}
+
+	// range scan to find any history records starting with prefix~namespace~collection~key
+	compositePartialKey := historydb.ConstructPartialCompositePvtHistoryKey(namespace, collection, key, false)
+	compositeEndKey := historydb.ConstructPartialCompositePvtHistoryKey(namespace, collection, key, true)
+	dbItr := q.historyDB.db.GetIterator(compositePartialKey, compositeEndKey)
+	return &historyScanner{
+		compositePartialKey: compositePartialKey,
+		namespace:           namespace,
+		collection:          collection,
+		key:                 key,
+		dbItr:               dbItr,
+		blockStore:          q.blockStore,
+		pvtdataRetriever:    retriever,
+	}, nil
+}
+
+// pvtdataRetriever retrieves the private data of a block that is available on this peer
+type pvtdataRetriever interface {
+	GetPvtDataByNum(blockNum uint64, filter ledger.PvtNsCollFilter) ([]*ledger.TxPvtData, error)
+}
+
+func (q *LevelHistoryDBQueryExecutor) newHistoryScanner(namespace, key string, startBlock, endBlock uint64,
+	startTime, endTime *timestamp.Timestamp, options *ledger.HistoryQueryOptions) (*historyScanner, error) {
 
 	if ledgerconfig.IsHistoryDBEnabled() == false {
 		return nil, errors.New("history database not enabled")
 	}
+	if options == nil {
+		options = &ledger.HistoryQueryOptions{}
+	}
 
-	var compositeStartKey []byte
-	var compositeEndKey []byte
-	compositeStartKey = historydb.ConstructPartialCompositeHistoryKey(namespace, key, false)
-	compositeEndKey = historydb.ConstructPartialCompositeHistoryKey(namespace, key, true)
+	compositePartialKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, false)
+	// range scan to find the history records starting with namespace~key and falling in the block range
+	compositeStartKey := historydb.ConstructCompositeHistoryKey(namespace, key, startBlock, 0)
+	compositeEndKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, true)
+	if endBlock != math.MaxUint64 {
+		compositeEndKey = historydb.ConstructCompositeHistoryKey(namespace, key, endBlock+1, 0)
+	}
+
+	// the bookmark is the height of the next record to be returned and it narrows the range
+	// from the start in ascending order and from the end in descending order. A bookmark is
+	// clamped to the range of the query so that it cannot widen the range; the time range,
+	// if any, is enforced on each record by the scanner
+	if options.Bookmark != "" {
+		blockNum, tranNum, err := decodeHistoryBookmark(options.Bookmark)
+		if err != nil {
+			return nil, err
+		}
+		if options.Descending {
+			bookmarkEndKey := historydb.ConstructCompositeHistoryKey(namespace, key, blockNum, tranNum+1)
+			if bytes.Compare(bookmarkEndKey, compositeEndKey) < 0 {
+				compositeEndKey = bookmarkEndKey
+			}
+		} else {
+			bookmarkStartKey := historydb.ConstructCompositeHistoryKey(namespace, key, blockNum, tranNum)
+			if bytes.Compare(bookmarkStartKey, compositeStartKey) > 0 {
+				compositeStartKey = bookmarkStartKey
+			}
+		}
+	}
 
-	// range scan to find any history records starting with namespace~key
 	dbItr := q.historyDB.db.GetIterator(compositeStartKey, compositeEndKey)
-	return newHistoryScanner(compositeStartKey, namespace, key, dbItr, q.blockStore), nil
+	return &historyScanner{
+		compositePartialKey: compositePartialKey,
+		namespace:           namespace,
+		key:                 key,
+		dbItr:               dbItr,
+		blockStore:          q.blockStore,
+		startTime:           startTime,
+		endTime:             endTime,
+		descending:          options.Descending,
+		pageSize:            options.PageSize,
+	}, nil
 }
 
 //historyScanner implements ResultsIterator for iterating through history results
 type historyScanner struct {
 	compositePartialKey []byte //compositePartialKey includes namespace~key
 	namespace           string
+	collection          string
 	key                 string
 	dbItr               iterator.Iterator
 	blockStore          blkstorage.BlockStore
-}
-
-func newHistoryScanner(compositePartialKey []byte, namespace string, key string,
-	dbItr iterator.Iterator, blockStore blkstorage.BlockStore) *historyScanner {
-	return &historyScanner{compositePartialKey, namespace, key, dbItr, blockStore}
+	pvtdataRetriever    pvtdataRetriever
+	startTime           *timestamp.Timestamp
+	endTime             *timestamp.Timestamp
+	descending          bool
+	pageSize            int32
+	numResults          int32
+	started             bool
 }
 
 func (scanner *historyScanner) Next() (commonledger.QueryResult, error) {
+	if scanner.pageSize > 0 && scanner.numResults == scanner.pageSize {
+		return nil, nil
+	}
 	for {
-		if !scanner.dbItr.Next() {
+		blockNum, tranNum, ok := scanner.nextHistoryRecord()
+		if !ok {
 			return nil, nil
 		}
+		logger.Debugf("Found history record for namespace:%s key:%s at blockNumTranNum %v:%v\n",
+			scanner.namespace, scanner.key, blockNum, tranNum)
+
+		// the record of a purged private data write holds the txid and the timestamp of the transaction
+		if purgedMarker := scanner.dbItr.Value(); scanner.collection != "" && len(purgedMarker) != 0 {
+			keyModification, err := decodePurgedMarker(purgedMarker)
+			if err != nil {
+				return nil, err
+			}
+			scanner.numResults++
+			return keyModification, nil
+		}
+
+		// Get the transaction from block storage that is associated with this history record
+		tranEnvelope, err := scanner.blockStore.RetrieveTxByBlockNumTranNum(blockNum, tranNum)
+		if err != nil {
+			return nil, err
+		}
+
+		if scanner.collection != "" {
+			scanner.numResults++
+			return scanner.getPvtKeyModification(tranEnvelope, blockNum, tranNum)
+		}
+
+		// Get the txid, key write value, timestamp, and delete indicator associated with this transaction
+		queryResult, err := getKeyModificationFromTran(tranEnvelope, scanner.namespace, scanner.key)
+		if err != nil {
+			return nil, err
+		}
+		keyModification := queryResult.(*queryresult.KeyModification)
+		if !scanner.isInTimeRange(keyModification.Timestamp) {
+			continue
+		}
+		logger.Debugf("Found historic key value for namespace:%s key:%s from transaction %s\n",
+			scanner.namespace, scanner.key, keyModification.TxId)
+		scanner.numResults++
+		return queryResult, nil
+	}
+}
+
+// getPvtKeyModification returns the modification of the private data key by the given transaction.
+// The value of the key is retrieved from the private data store and, if the private data has been
+// purged, the modification is marked as purged
+func (scanner *historyScanner) getPvtKeyModification(tranEnvelope *common.Envelope, blockNum, tranNum uint64) (commonledger.QueryResult, error) {
+	txID, timestamp, err := getTxIDAndTimestampFromTran(tranEnvelope)
+	if err != nil {
+		return nil, err
+	}
+	filter := ledger.NewPvtNsCollFilter()
+	filter.Add(scanner.namespace, scanner.collection)
+	txsPvtData, err := scanner.pvtdataRetriever.GetPvtDataByNum(blockNum, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, txPvtData := range txsPvtData {
+		if txPvtData.SeqInBlock != tranNum || txPvtData.WriteSet == nil {
+			continue
+		}
+		txPvtRWSet, err := rwsetutil.TxPvtRwSetFromProtoMsg(txPvtData.WriteSet)
+		if err != nil {
+			return nil, err
+		}
+		for _, nsPvtRWSet := range txPvtRWSet.NsPvtRwSet {
+			if nsPvtRWSet.NameSpace != scanner.namespace {
+				continue
+			}
+			for _, collPvtRWSet := range nsPvtRWSet.CollPvtRwSets {
+				if collPvtRWSet.CollectionName != scanner.collection {
+					continue
+				}
+				for _, kvWrite := range collPvtRWSet.KvRwSet.Writes {
+					if kvWrite.Key == scanner.key {
+						return &queryresult.KeyModification{TxId: txID, Value: kvWrite.Value,
+							Timestamp: timestamp, IsDelete: kvWrite.IsDelete}, nil
+					}
+				}
+			}
+		}
+	}
+	logger.Debugf("Private data for namespace:%s collection:%s key:%s at blockNumTranNum %v:%v has been purged",
+		scanner.namespace, scanner.collection, scanner.key, blockNum, tranNum)
+	return &queryresult.KeyModification{TxId: txID, Timestamp: timestamp, IsPurged: true}, nil
+}
+
+// nextHistoryRecord moves the db iterator to the next history record of the key
+// and returns the block number and the transaction number of the record
+func (scanner *historyScanner) nextHistoryRecord() (uint64, uint64, bool) {
+	for {
+		if !scanner.moveDBItr() {
+			return 0, 0, false
+		}
 		historyKey := scanner.dbItr.Key() // history key is in the form namespace~key~blocknum~trannum
 
 		// SplitCompositeKey(namespace~key~blocknum~trannum, namespace~key~) will return the blocknum~trannum in second position
@@ -89,30 +289,86 @@ func (scanner *historyScanner) Next() (commonledger.QueryResult, error) {
 		}
 		blockNum, bytesConsumed := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[0:])
 		tranNum, _ := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes[bytesConsumed:])
-		logger.Debugf("Found history record for namespace:%s key:%s at blockNumTranNum %v:%v\n",
-			scanner.namespace, scanner.key, blockNum, tranNum)
+		return blockNum, tranNum, true
+	}
+}
 
-		// Get the transaction from block storage that is associated with this history record
-		tranEnvelope, err := scanner.blockStore.RetrieveTxByBlockNumTranNum(blockNum, tranNum)
-		if err != nil {
-			return nil, err
-		}
+func (scanner *historyScanner) moveDBItr() bool {
+	if !scanner.descending {
+		return scanner.dbItr.Next()
+	}
+	if !scanner.started {
+		scanner.started = true
+		return scanner.dbItr.Last()
+	}
+	return scanner.dbItr.Prev()
+}
 
-		// Get the txid, key write value, timestamp, and delete indicator associated with this transaction
-		queryResult, err := getKeyModificationFromTran(tranEnvelope, scanner.namespace, scanner.key)
-		if err != nil {
-			return nil, err
-		}
-		logger.Debugf("Found historic key value for namespace:%s key:%s from transaction %s\n",
-			scanner.namespace, scanner.key, queryResult.(*queryresult.KeyModification).TxId)
-		return queryResult, nil
+func (scanner *historyScanner) isInTimeRange(t *timestamp.Timestamp) bool {
+	if scanner.startTime != nil && compareTimestamps(t, scanner.startTime) < 0 {
+		return false
 	}
+	if scanner.endTime != nil && compareTimestamps(t, scanner.endTime) >= 0 {
+		return false
+	}
+	return true
 }
 
 func (scanner *historyScanner) Close() {
 	scanner.dbItr.Release()
 }
 
+// GetBookmarkAndClose implements method in interface `ledger.QueryResultsIterator`.
+// The bookmark is the height of the history record following the last returned result
+func (scanner *historyScanner) GetBookmarkAndClose() string {
+	defer scanner.Close()
+	blockNum, tranNum, ok := scanner.nextHistoryRecord()
+	if !ok {
+		return ""
+	}
+	return encodeHistoryBookmark(blockNum, tranNum)
+}
+
+func encodeHistoryBookmark(blockNum, tranNum uint64) string {
+	return fmt.Sprintf("%d:%d", blockNum, tranNum)
+}
+
+func decodeHistoryBookmark(bookmark string) (uint64, uint64, error) {
+	var blockNum, tranNum uint64
+	if n, err := fmt.Sscanf(bookmark, "%d:%d", &blockNum, &tranNum); err != nil || n != 2 {
+		return 0, 0, errors.Errorf("invalid bookmark [%s] for history query", bookmark)
+	}
+	return blockNum, tranNum, nil
+}
+
+func compareTimestamps(t1, t2 *timestamp.Timestamp) int {
+	switch {
+	case t1.Seconds != t2.Seconds:
+		if t1.Seconds < t2.Seconds {
+			return -1
+		}
+		return 1
+	case t1.Nanos < t2.Nanos:
+		return -1
+	case t1.Nanos > t2.Nanos:
+		return 1
+	}
+	return 0
+}
+
+// getTxIDAndTimestampFromTran returns the txid and the timestamp from the channel header of a transaction
+func getTxIDAndTimestampFromTran(tranEnvelope *common.Envelope) (string, *timestamp.Timestamp, error) {
+	payload, err := putils.GetPayload(tranEnvelope)
+	if err != nil {
+		return "", nil, err
+	}
+	chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, err
+	}
+	return chdr.TxId, chdr.Timestamp, nil
+}
+
 // getTxIDandKeyWriteValueFromTran inspects a transaction for writes to a given key
 func getKeyModificationFromTran(tranEnvelope *common.Envelope, namespace string, key string) (commonledger.QueryResult, error) {
 	logger.Debugf("Entering getKeyModificationFromTran()\n", namespace, key)
@@ -7,15 +7,20 @@ SPDX-License-Identifier: Apache-2.0
 package historyleveldb
 
 import (
+	"math"
 	"os"
 	"strconv"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	util2 "github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/pvtdatastorage"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/queryresult"
@@ -193,6 +198,305 @@ func TestHistory(t *testing.T) {
 	assert.Equal(t, 4, count)
 }
 
+func TestBoundedHistoryQueries(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	provider := env.testBlockStorageEnv.provider
+	ledger1id := "ledger1"
+	store1, err := provider.OpenBlockStore(ledger1id)
+	assert.NoError(t, err, "Error upon provider.OpenBlockStore()")
+	defer store1.Shutdown()
+
+	bg, gb := testutil.NewBlockGenerator(t, ledger1id, false)
+	assert.NoError(t, store1.AddBlock(gb))
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+
+	// blocks 1 to 4 write the values value1 to value5 for the key, block 3 contains two transactions
+	valueNum := 0
+	for blockNum := 1; blockNum <= 4; blockNum++ {
+		numTxs := 1
+		if blockNum == 3 {
+			numTxs = 2
+		}
+		simulationResults := [][]byte{}
+		for i := 0; i < numTxs; i++ {
+			valueNum++
+			simulator, _ := env.txmgr.NewTxSimulator(util2.GenerateUUID())
+			simulator.SetState("ns1", "key7", []byte("value"+strconv.Itoa(valueNum)))
+			simulator.Done()
+			simRes, _ := simulator.GetTxSimulationResults()
+			pubSimResBytes, _ := simRes.GetPubSimulationBytes()
+			simulationResults = append(simulationResults, pubSimResBytes)
+		}
+		block := bg.NextBlock(simulationResults)
+		assert.NoError(t, store1.AddBlock(block))
+		assert.NoError(t, env.testHistoryDB.Commit(block))
+	}
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err, "Error upon NewHistoryQueryExecutor")
+
+	t.Run("block-range", func(t *testing.T) {
+		itr, err := qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 2, 3, nil)
+		assert.NoError(t, err)
+		values, bookmark := retrieveHistory(t, itr)
+		assert.Equal(t, []string{"value2", "value3", "value4"}, values)
+		assert.Equal(t, "", bookmark)
+
+		itr, err = qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 3, math.MaxUint64,
+			&ledger.HistoryQueryOptions{Descending: true})
+		assert.NoError(t, err)
+		values, _ = retrieveHistory(t, itr)
+		assert.Equal(t, []string{"value5", "value4", "value3"}, values)
+
+		_, err = qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 3, 2, nil)
+		assert.EqualError(t, err, "start block [3] is greater than end block [2]")
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		for _, descending := range []bool{false, true} {
+			var pages [][]string
+			bookmark := ""
+			for {
+				itr, err := qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 0, math.MaxUint64,
+					&ledger.HistoryQueryOptions{Descending: descending, PageSize: 2, Bookmark: bookmark})
+				assert.NoError(t, err)
+				var values []string
+				values, bookmark = retrieveHistory(t, itr)
+				pages = append(pages, values)
+				if bookmark == "" {
+					break
+				}
+			}
+			if descending {
+				assert.Equal(t, [][]string{{"value5", "value4"}, {"value3", "value2"}, {"value1"}}, pages)
+			} else {
+				assert.Equal(t, [][]string{{"value1", "value2"}, {"value3", "value4"}, {"value5"}}, pages)
+			}
+		}
+
+		// a bookmark outside the block range of the query does not widen the range
+		itr, err := qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 2, 3,
+			&ledger.HistoryQueryOptions{Bookmark: encodeHistoryBookmark(1, 0)})
+		assert.NoError(t, err)
+		values, _ := retrieveHistory(t, itr)
+		assert.Equal(t, []string{"value2", "value3", "value4"}, values)
+		itr, err = qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 2, 3,
+			&ledger.HistoryQueryOptions{Descending: true, Bookmark: encodeHistoryBookmark(4, 0)})
+		assert.NoError(t, err)
+		values, _ = retrieveHistory(t, itr)
+		assert.Equal(t, []string{"value4", "value3", "value2"}, values)
+		itr, err = qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 2, 3,
+			&ledger.HistoryQueryOptions{Bookmark: encodeHistoryBookmark(4, 0)})
+		assert.NoError(t, err)
+		values, bookmark := retrieveHistory(t, itr)
+		assert.Nil(t, values)
+		assert.Equal(t, "", bookmark)
+
+		_, err = qhistory.GetHistoryForKeyByBlockRange("ns1", "key7", 0, math.MaxUint64,
+			&ledger.HistoryQueryOptions{Bookmark: "invalid"})
+		assert.EqualError(t, err, "invalid bookmark [invalid] for history query")
+	})
+
+	t.Run("time-range", func(t *testing.T) {
+		itr, err := qhistory.GetHistoryForKey("ns1", "key7")
+		assert.NoError(t, err)
+		var timestamps []*timestamp.Timestamp
+		for {
+			kmod, err := itr.Next()
+			assert.NoError(t, err)
+			if kmod == nil {
+				break
+			}
+			timestamps = append(timestamps, kmod.(*queryresult.KeyModification).Timestamp)
+		}
+		itr.Close()
+		assert.Len(t, timestamps, 5)
+
+		startTime, endTime := timestamps[1], timestamps[3]
+		var expectedValues []string
+		for i, ts := range timestamps {
+			if compareTimestamps(ts, startTime) >= 0 && compareTimestamps(ts, endTime) < 0 {
+				expectedValues = append(expectedValues, "value"+strconv.Itoa(i+1))
+			}
+		}
+		itr2, err := qhistory.GetHistoryForKeyByTimeRange("ns1", "key7", startTime, endTime, nil)
+		assert.NoError(t, err)
+		values, _ := retrieveHistory(t, itr2)
+		assert.Equal(t, expectedValues, values)
+
+		itr2, err = qhistory.GetHistoryForKeyByTimeRange("ns1", "key7", nil, nil, &ledger.HistoryQueryOptions{Descending: true})
+		assert.NoError(t, err)
+		values, _ = retrieveHistory(t, itr2)
+		assert.Equal(t, []string{"value5", "value4", "value3", "value2", "value1"}, values)
+
+		_, err = qhistory.GetHistoryForKeyByTimeRange("ns1", "key7", endTime, &timestamp.Timestamp{}, nil)
+		assert.Contains(t, err.Error(), "is after end time")
+	})
+}
+
+func retrieveHistory(t *testing.T, itr ledger.QueryResultsIterator) ([]string, string) {
+	var values []string
+	for {
+		kmod, err := itr.Next()
+		assert.NoError(t, err)
+		if kmod == nil {
+			break
+		}
+		values = append(values, string(kmod.(*queryresult.KeyModification).Value))
+	}
+	return values, itr.GetBookmarkAndClose()
+}
+
+func TestPrivateDataHistory(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	provider := env.testBlockStorageEnv.provider
+	ledger1id := "ledger1"
+	store1, err := provider.OpenBlockStore(ledger1id)
+	assert.NoError(t, err, "Error upon provider.OpenBlockStore()")
+	defer store1.Shutdown()
+	pvtStore := &testPvtdataStore{BlockStore: store1, pvtData: map[uint64][]*ledger.TxPvtData{}}
+
+	bg, gb := testutil.NewBlockGenerator(t, ledger1id, false)
+	assert.NoError(t, store1.AddBlock(gb))
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+
+	constructSimRes := func(value []byte) *ledger.TxSimulationResults {
+		builder := rwsetutil.NewRWSetBuilder()
+		builder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", value)
+		builder.AddToPvtAndHashedWriteSet("ns1", "coll2", "key1", []byte("coll2-value"))
+		simRes, err := builder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		return simRes
+	}
+	commitBlock := func(simRes *ledger.TxSimulationResults, pvtDataAvailable bool) *ledger.TxPvtData {
+		pubSimResBytes, err := simRes.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		block := bg.NextBlock([][]byte{pubSimResBytes})
+		assert.NoError(t, store1.AddBlock(block))
+		txPvtData := &ledger.TxPvtData{SeqInBlock: 0, WriteSet: simRes.PvtSimulationResults}
+		blockAndPvtData := &ledger.BlockAndPvtData{Block: block, PvtData: ledger.TxPvtDataMap{}}
+		if pvtDataAvailable {
+			blockAndPvtData.PvtData[0] = txPvtData
+			pvtStore.pvtData[block.Header.Number] = []*ledger.TxPvtData{txPvtData}
+		}
+		assert.NoError(t, env.testHistoryDB.CommitWithPvtData(blockAndPvtData))
+		return txPvtData
+	}
+
+	// block1 writes value1 with the private data available at the time of commit
+	blk1TxPvtData := commitBlock(constructSimRes([]byte("value1")), true)
+	// block2 writes value2 with the private data missing at the time of commit
+	txPvtData := commitBlock(constructSimRes([]byte("value2")), false)
+	// block3 deletes the key
+	commitBlock(constructSimRes(nil), true)
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(pvtStore)
+	assert.NoError(t, err)
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1", []string{"value1", "<deleted>"})
+
+	// the private data of block2 is reconciled later
+	pvtStore.pvtData[2] = []*ledger.TxPvtData{txPvtData}
+	assert.NoError(t, env.testHistoryDB.CommitPvtDataOfOldBlocks(map[uint64][]*ledger.TxPvtData{2: {txPvtData}}))
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1", []string{"value1", "value2", "<deleted>"})
+	testutilVerifyPvtResults(t, qhistory, "coll2", "key1", []string{"coll2-value", "coll2-value", "coll2-value"})
+
+	// the private data of block1 for coll1 is purged but its history record is not yet marked as purged
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSet("ns1", "coll2", "key1", []byte("coll2-value"))
+	coll2SimRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pvtStore.pvtData[1] = []*ledger.TxPvtData{{SeqInBlock: 0, WriteSet: coll2SimRes.PvtSimulationResults}}
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1", []string{"<purged>", "value2", "<deleted>"})
+	testutilVerifyPvtResults(t, qhistory, "coll2", "key1", []string{"coll2-value", "coll2-value", "coll2-value"})
+
+	// the history record of the purged private data is kept and marked as purged, so the
+	// private data is no longer reported for it even if it were available
+	assert.NoError(t, env.testHistoryDB.PurgePvtDataHistory([]*pvtdatastorage.PurgedWrite{
+		{Namespace: "ns1", Collection: "coll1", Key: "key1", BlockNum: 1, TxNum: 0},
+	}, store1))
+	pvtStore.pvtData[1] = []*ledger.TxPvtData{blk1TxPvtData}
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1", []string{"<purged>", "value2", "<deleted>"})
+	testutilVerifyPvtResults(t, qhistory, "coll2", "key1", []string{"coll2-value", "coll2-value", "coll2-value"})
+
+	// marking a purged write again, or a write without a history record, has no effect
+	assert.NoError(t, env.testHistoryDB.PurgePvtDataHistory([]*pvtdatastorage.PurgedWrite{
+		{Namespace: "ns1", Collection: "coll1", Key: "key1", BlockNum: 1, TxNum: 0},
+		{Namespace: "ns1", Collection: "coll1", Key: "key2", BlockNum: 1, TxNum: 0},
+	}, store1))
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1", []string{"<purged>", "value2", "<deleted>"})
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key2", nil)
+
+	// block4 writes the key in tx0 and purges it in tx1, and the key is written again in tx2
+	simRes := constructSimRes([]byte("value4"))
+	builder = rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key1")
+	purgeSimRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	rewriteSimRes := constructSimRes([]byte("value5"))
+	var pubSimResBytes [][]byte
+	blockPvtData := ledger.TxPvtDataMap{}
+	for i, res := range []*ledger.TxSimulationResults{simRes, purgeSimRes, rewriteSimRes} {
+		bytes, err := res.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		pubSimResBytes = append(pubSimResBytes, bytes)
+		blockPvtData[uint64(i)] = &ledger.TxPvtData{SeqInBlock: uint64(i), WriteSet: res.PvtSimulationResults}
+	}
+	block4 := bg.NextBlock(pubSimResBytes)
+	assert.NoError(t, store1.AddBlock(block4))
+	assert.NoError(t, env.testHistoryDB.CommitWithPvtData(&ledger.BlockAndPvtData{Block: block4, PvtData: blockPvtData}))
+	pvtStore.pvtData[4] = []*ledger.TxPvtData{blockPvtData[1], blockPvtData[2]}
+	// the history record of the write of tx0 is marked as purged
+	testutilVerifyPvtResults(t, qhistory, "coll1", "key1",
+		[]string{"<purged>", "value2", "<deleted>", "<purged>", "<deleted>", "value5"})
+
+	// private data is not reported in the history of the public keys and vice versa
+	testutilVerifyResults(t, qhistory, "ns1", "key1", []string{})
+	testutilVerifyPvtResults(t, qhistory, "coll3", "key1", nil)
+
+	// a block store that does not provide the private data cannot serve the private data history
+	qhistory, err = env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err)
+	_, err = qhistory.GetPrivateDataHistoryForKey("ns1", "coll1", "key1")
+	assert.EqualError(t, err, "private data history is not supported by the block store")
+}
+
+type testPvtdataStore struct {
+	blkstorage.BlockStore
+	pvtData map[uint64][]*ledger.TxPvtData
+}
+
+func (s *testPvtdataStore) GetPvtDataByNum(blockNum uint64, filter ledger.PvtNsCollFilter) ([]*ledger.TxPvtData, error) {
+	return s.pvtData[blockNum], nil
+}
+
+func testutilVerifyPvtResults(t *testing.T, hqe ledger.HistoryQueryExecutor, coll, key string, expectedVals []string) {
+	itr, err := hqe.GetPrivateDataHistoryForKey("ns1", coll, key)
+	assert.NoError(t, err)
+	defer itr.Close()
+	var retrievedVals []string
+	for {
+		kmod, err := itr.Next()
+		assert.NoError(t, err)
+		if kmod == nil {
+			break
+		}
+		keyModification := kmod.(*queryresult.KeyModification)
+		assert.NotEmpty(t, keyModification.TxId)
+		assert.NotNil(t, keyModification.Timestamp)
+		switch {
+		case keyModification.IsPurged:
+			retrievedVals = append(retrievedVals, "<purged>")
+		case keyModification.IsDelete:
+			retrievedVals = append(retrievedVals, "<deleted>")
+		default:
+			retrievedVals = append(retrievedVals, string(keyModification.Value))
+		}
+	}
+	assert.Equal(t, expectedVals, retrievedVals)
+}
+
 func TestHistoryForInvalidTran(t *testing.T) {
 	env := newTestHistoryEnv(t)
 	defer env.cleanup()
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
+	"bytes"
 	"sync"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/pvtdatastorage"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -98,6 +100,19 @@ func (l *kvLedger) initTxMgr(versionedDB privacyenabledstate.DB, stateListeners
 
 func (l *kvLedger) initBlockStore(btlPolicy pvtdatapolicy.BTLPolicy) {
 	l.blockStore.Init(btlPolicy)
+	if ledgerconfig.IsHistoryDBEnabled() {
+		l.blockStore.SetPvtdataPurgeListener(l.purgePvtDataHistory)
+	}
+}
+
+// purgePvtDataHistory marks as purged, in the history database, the records of the private data writes
+// purged from the pvtdata store. A failure is not fatal as the history queries report a write
+// whose private data is no longer available as purged
+func (l *kvLedger) purgePvtDataHistory(purgedWrites []*pvtdatastorage.PurgedWrite) {
+	if err := l.historyDB.PurgePvtDataHistory(purgedWrites, l.blockStore); err != nil {
+		logger.Warningf("[%s:] Failed to mark the history of [%d] purged private data writes as purged: %s",
+			l.ledgerID, len(purgedWrites), err)
+	}
 }
 
 //Recover the state database and history database (if exist)
@@ -172,6 +187,11 @@ func (l *kvLedger) syncStateDBWithPvtdatastore() error {
 	if err := l.txtmgmt.RemoveStaleAndCommitPvtDataOfOldBlocks(blocksPvtData); err != nil {
 		return err
 	}
+	if ledgerconfig.IsHistoryDBEnabled() {
+		if err := l.historyDB.CommitPvtDataOfOldBlocks(blocksPvtData); err != nil {
+			return err
+		}
+	}
 	if err := l.blockStore.ResetLastUpdatedOldBlocksList(); err != nil {
 		return err
 	}
@@ -309,7 +329,7 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 	logger.Debugf("[%s] Committing block [%d] to storage", l.ledgerID, blockNo)
 	l.blockAPIsRWLock.Lock()
 	defer l.blockAPIsRWLock.Unlock()
-	if err = l.blockStore.CommitWithPvtData(pvtdataAndBlock); err != nil {
+	if err = l.blockStore.CommitWithPvtData(pvtdataAndBlock, validTxPurgeMarkers(txstatsInfo)); err != nil {
 		return err
 	}
 	elapsedCommitBlockStorage := time.Since(startCommitBlockStorage)
@@ -325,7 +345,7 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 	// although it has not been a bottleneck...no need to clutter the log with elapsed duration.
 	if ledgerconfig.IsHistoryDBEnabled() {
 		logger.Debugf("[%s] Committing block [%d] transactions to history database", l.ledgerID, blockNo)
-		if err := l.historyDB.Commit(block); err != nil {
+		if err := l.historyDB.CommitWithPvtData(pvtdataAndBlock); err != nil {
 			panic(errors.WithMessage(err, "Error during commit to history db"))
 		}
 	}
@@ -348,6 +368,33 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData) er
 	return nil
 }
 
+// validTxPurgeMarkers returns the keys purged by the valid transactions of a block,
+// from the read-write sets parsed during the validation of the block
+func validTxPurgeMarkers(txstatsInfo []*txmgr.TxStatInfo) []*pvtdatastorage.PurgeMarker {
+	var purgeMarkers []*pvtdatastorage.PurgeMarker
+	for txNum, txStatInfo := range txstatsInfo {
+		if txStatInfo.ValidationCode != peer.TxValidationCode_VALID || txStatInfo.TxRWSet == nil {
+			continue
+		}
+		for _, nsRWSet := range txStatInfo.TxRWSet.NsRwSets {
+			for _, collHashedRWSet := range nsRWSet.CollHashedRwSets {
+				for _, hashedWrite := range collHashedRWSet.HashedRwSet.HashedWrites {
+					if !hashedWrite.IsPurge {
+						continue
+					}
+					purgeMarkers = append(purgeMarkers, &pvtdatastorage.PurgeMarker{
+						Namespace:  nsRWSet.NameSpace,
+						Collection: collHashedRWSet.CollectionName,
+						KeyHash:    hashedWrite.KeyHash,
+						TxNum:      uint64(txNum),
+					})
+				}
+			}
+		}
+	}
+	return purgeMarkers
+}
+
 func (l *kvLedger) updateBlockStats(
 	blockNum uint64,
 	blockProcessingTime time.Duration,
@@ -368,6 +415,12 @@ func (l *kvLedger) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledge
 	return l.blockStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// GetMissingPvtDataInfoForBlockRange returns the missing private data information of eligible
+// collections for the blocks in the range [startBlock, endBlock]
+func (l *kvLedger) GetMissingPvtDataInfoForBlockRange(startBlock, endBlock uint64) (ledger.MissingPvtDataInfo, error) {
+	return l.blockStore.GetMissingPvtDataInfoForBlockRange(startBlock, endBlock)
+}
+
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 // The pvt data is filtered by the list of 'collections' supplied
 func (l *kvLedger) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
@@ -422,6 +475,20 @@ func (l *kvLedger) CommitPvtDataOfOldBlocks(pvtData []*ledger.BlockPvtData) ([]*
 		return nil, err
 	}
 
+	if ledgerconfig.IsHistoryDBEnabled() {
+		// the history records are added only for the pvtData stored in the pvtdatastore, i.e., excluding
+		// the data that was purged or has expired. As for the stateDB, a crash before the bookkeeping
+		// information is cleared is recovered by syncStateDBWithPvtdatastore()
+		storedPvtData, err := l.blockStore.GetLastUpdatedOldBlocksPvtData()
+		if err != nil {
+			return nil, err
+		}
+		logger.Debugf("[%s:] Committing pvtData of [%d] old blocks to the history database", l.ledgerID, len(storedPvtData))
+		if err := l.historyDB.CommitPvtDataOfOldBlocks(storedPvtData); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Debugf("[%s:] Clearing the bookkeeping information from pvtdatastore", l.ledgerID)
 	if err := l.blockStore.ResetLastUpdatedOldBlocksList(); err != nil {
 		return nil, err
@@ -434,6 +501,30 @@ func (l *kvLedger) GetMissingPvtDataTracker() (ledger.MissingPvtDataTracker, err
 	return l, nil
 }
 
+// GetStateHash implements method in interface `ledger.PeerLedger`
+func (l *kvLedger) GetStateHash(blockNum uint64) ([]byte, error) {
+	return l.txtmgmt.GetStateHash(blockNum)
+}
+
+// VerifyStateHash implements method in interface `ledger.PeerLedger`
+func (l *kvLedger) VerifyStateHash(blockNum uint64, expectedStateHash []byte) (bool, error) {
+	stateHash, err := l.txtmgmt.GetStateHash(blockNum)
+	if err != nil {
+		return false, err
+	}
+	if stateHash == nil {
+		return false, errors.Errorf("state hash is not available for block [%d]", blockNum)
+	}
+	if !bytes.Equal(stateHash, expectedStateHash) {
+		logger.Errorf("[%s] State hash mismatch for block [%d]: computed state hash = [%x], expected state hash = [%x]",
+			l.ledgerID, blockNum, stateHash, expectedStateHash)
+		l.stats.updateStateHashMismatches()
+		return false, nil
+	}
+	logger.Debugf("[%s] State hash verified for block [%d]", l.ledgerID, blockNum)
+	return true, nil
+}
+
 // Close closes `KVLedger`
 func (l *kvLedger) Close() {
 	l.blockStore.Shutdown()
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -15,9 +16,12 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/common/util"
 	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/mock"
 	ledgertestutil "github.com/hyperledger/fabric/core/ledger/testutil"
+	lutil "github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/queryresult"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -231,7 +235,7 @@ func testSyncStateAndHistoryDBWithBlockstore(t *testing.T) {
 
 	_, err := ledger.(*kvLedger).txtmgmt.ValidateAndPrepare(blockAndPvtdata2, true)
 	assert.NoError(t, err)
-	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata2))
+	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata2, nil))
 
 	// block storage should be as of block-2 but the state and history db should be as of block-1
 	checkBCSummaryForTest(t, ledger,
@@ -281,7 +285,7 @@ func testSyncStateAndHistoryDBWithBlockstore(t *testing.T) {
 	)
 	_, err = ledger.(*kvLedger).txtmgmt.ValidateAndPrepare(blockAndPvtdata3, true)
 	assert.NoError(t, err)
-	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata3))
+	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata3, nil))
 	// committing the transaction to state DB
 	assert.NoError(t, ledger.(*kvLedger).txtmgmt.Commit())
 
@@ -334,7 +338,7 @@ func testSyncStateAndHistoryDBWithBlockstore(t *testing.T) {
 	)
 	_, err = ledger.(*kvLedger).txtmgmt.ValidateAndPrepare(blockAndPvtdata4, true)
 	assert.NoError(t, err)
-	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata4))
+	assert.NoError(t, ledger.(*kvLedger).blockStore.CommitWithPvtData(blockAndPvtdata4, nil))
 	assert.NoError(t, ledger.(*kvLedger).historyDB.Commit(blockAndPvtdata4.Block))
 
 	checkBCSummaryForTest(t, ledger,
@@ -435,6 +439,119 @@ func testSyncStateDBWithPvtdatastore(t *testing.T) {
 	assert.Equal(t, value, []byte("pvtValue1.2"))
 }
 
+func TestKVLedgerStateHash(t *testing.T) {
+	viper.Set("ledger.state.enableStateHash", true)
+	defer viper.Set("ledger.state.enableStateHash", false)
+	env := newTestEnv(t)
+	defer env.cleanup()
+	testMetricProvider := testutilConstructMetricProvider()
+	provider, err := NewProvider()
+	assert.NoError(t, err)
+	provider.Initialize(&lgr.Initializer{
+		DeployedChaincodeInfoProvider: &mock.DeployedChaincodeInfoProvider{},
+		MetricsProvider:               testMetricProvider.fakeProvider,
+	})
+	defer provider.Close()
+
+	// commit the same updates to two different ledgers
+	var ledgers []lgr.PeerLedger
+	for _, ledgerid := range []string{"ledger1", "ledger2"} {
+		bg, gb := testutil.NewBlockGenerator(t, ledgerid, false)
+		l, err := provider.Create(gb)
+		assert.NoError(t, err)
+		defer l.Close()
+		for i := 0; i < 2; i++ {
+			simulator, _ := l.NewTxSimulator(util.GenerateUUID())
+			simulator.SetState("ns1", "key1", []byte(fmt.Sprintf("value1_%d", i)))
+			simulator.SetState("ns1", "key2", []byte(fmt.Sprintf("value2_%d", i)))
+			simulator.Done()
+			simRes, _ := simulator.GetTxSimulationResults()
+			pubSimBytes, _ := simRes.GetPubSimulationBytes()
+			assert.NoError(t, l.CommitWithPvtData(&lgr.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimBytes})}))
+		}
+		ledgers = append(ledgers, l)
+	}
+
+	var stateHashes [][]byte
+	for blkNum := uint64(0); blkNum < 3; blkNum++ {
+		stateHash1, err := ledgers[0].GetStateHash(blkNum)
+		assert.NoError(t, err)
+		assert.NotNil(t, stateHash1)
+		stateHash2, err := ledgers[1].GetStateHash(blkNum)
+		assert.NoError(t, err)
+		assert.Equal(t, stateHash1, stateHash2)
+		assert.NotContains(t, stateHashes, stateHash1)
+		stateHashes = append(stateHashes, stateHash1)
+	}
+	stateHash, err := ledgers[0].GetStateHash(3)
+	assert.NoError(t, err)
+	assert.Nil(t, stateHash)
+
+	match, err := ledgers[0].VerifyStateHash(2, stateHashes[2])
+	assert.NoError(t, err)
+	assert.True(t, match)
+	assert.Equal(t, 0, testMetricProvider.fakeStateHashMismatches.AddCallCount())
+
+	match, err = ledgers[0].VerifyStateHash(2, stateHashes[1])
+	assert.NoError(t, err)
+	assert.False(t, match)
+	assert.Equal(t, 1, testMetricProvider.fakeStateHashMismatches.AddCallCount())
+	assert.Equal(t, []string{"channel", "ledger1"}, testMetricProvider.fakeStateHashMismatches.WithArgsForCall(0))
+
+	_, err = ledgers[0].VerifyStateHash(3, stateHashes[2])
+	assert.EqualError(t, err, "state hash is not available for block [3]")
+}
+
+func TestKVLedgerStateHashDisabled(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+	_, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	assert.NoError(t, err)
+	defer ledger.Close()
+
+	_, err = ledger.GetStateHash(0)
+	assert.EqualError(t, err, "state hash computation is not enabled")
+	_, err = ledger.VerifyStateHash(0, []byte("state-hash"))
+	assert.EqualError(t, err, "state hash computation is not enabled")
+}
+
+func TestValidTxPurgeMarkers(t *testing.T) {
+	txRWSet := func(purge bool, ns, coll, key string) *rwsetutil.TxRwSet {
+		rwsetBuilder := rwsetutil.NewRWSetBuilder()
+		if purge {
+			rwsetBuilder.AddToPvtAndHashedWriteSetForPurge(ns, coll, key)
+		} else {
+			rwsetBuilder.AddToPvtAndHashedWriteSet(ns, coll, key, []byte("value"))
+		}
+		simRes, err := rwsetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		pubSimResBytes, err := simRes.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		txRWSet := &rwsetutil.TxRwSet{}
+		assert.NoError(t, txRWSet.FromProtoBytes(pubSimResBytes))
+		return txRWSet
+	}
+
+	// txNum 0 and 2 purge keys, txNum 1 only writes a key, txNum 2 is invalid and txNum 3 carries no rwset
+	txStatsInfo := []*txmgr.TxStatInfo{
+		{ValidationCode: peer.TxValidationCode_VALID, TxRWSet: txRWSet(true, "ns-1", "coll-1", "key-1")},
+		{ValidationCode: peer.TxValidationCode_VALID, TxRWSet: txRWSet(false, "ns-1", "coll-1", "key-2")},
+		{ValidationCode: peer.TxValidationCode_MVCC_READ_CONFLICT, TxRWSet: txRWSet(true, "ns-1", "coll-2", "key-3")},
+		{ValidationCode: peer.TxValidationCode_VALID},
+	}
+	purgeMarkers := validTxPurgeMarkers(txStatsInfo)
+	assert.Len(t, purgeMarkers, 1)
+	assert.Equal(t, "ns-1", purgeMarkers[0].Namespace)
+	assert.Equal(t, "coll-1", purgeMarkers[0].Collection)
+	assert.Equal(t, lutil.ComputeStringHash("key-1"), purgeMarkers[0].KeyHash)
+	assert.Equal(t, uint64(0), purgeMarkers[0].TxNum)
+
+	assert.Empty(t, validTxPurgeMarkers(nil))
+}
+
 func TestLedgerWithCouchDbEnabledWithBinaryAndJSONData(t *testing.T) {
 
 	//call a helper method to load the core.yaml
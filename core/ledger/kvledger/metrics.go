@@ -18,6 +18,7 @@ type stats struct {
 	blockstorageCommitTime metrics.Histogram
 	statedbCommitTime      metrics.Histogram
 	transactionsCount      metrics.Counter
+	stateHashMismatches    metrics.Counter
 }
 
 func newStats(metricsProvider metrics.Provider) *stats {
@@ -27,6 +28,7 @@ func newStats(metricsProvider metrics.Provider) *stats {
 	stats.blockstorageCommitTime = metricsProvider.NewHistogram(blockstorageCommitTimeOpts)
 	stats.statedbCommitTime = metricsProvider.NewHistogram(statedbCommitTimeOpts)
 	stats.transactionsCount = metricsProvider.NewCounter(transactionCountOpts)
+	stats.stateHashMismatches = metricsProvider.NewCounter(stateHashMismatchesOpts)
 	return stats
 }
 
@@ -59,6 +61,10 @@ func (s *ledgerStats) updateStatedbCommitTime(timeTaken time.Duration) {
 	s.stats.statedbCommitTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
 }
 
+func (s *ledgerStats) updateStateHashMismatches() {
+	s.stats.stateHashMismatches.With("channel", s.ledgerid).Add(1)
+}
+
 func (s *ledgerStats) updateTransactionsStats(
 	txstatsInfo []*txmgr.TxStatInfo,
 ) {
@@ -130,4 +136,13 @@ var (
 		LabelNames:   []string{"channel", "transaction_type", "chaincode", "validation_code"},
 		StatsdFormat: "%{#fqname}.%{channel}.%{transaction_type}.%{chaincode}.%{validation_code}",
 	}
+
+	stateHashMismatchesOpts = metrics.CounterOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "state_hash_mismatches",
+		Help:         "Number of state hash verifications that detected a divergence of the state.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
@@ -182,6 +182,7 @@ type testMetricProvider struct {
 	fakeBlockstorageCommitTimeHist *metricsfakes.Histogram
 	fakeStatedbCommitTimeHist      *metricsfakes.Histogram
 	fakeTransactionsCount          *metricsfakes.Counter
+	fakeStateHashMismatches        *metricsfakes.Counter
 }
 
 func testutilConstructMetricProvider() *testMetricProvider {
@@ -191,6 +192,7 @@ func testutilConstructMetricProvider() *testMetricProvider {
 	fakeBlockstorageCommitTimeHist := testutilConstructHist()
 	fakeStatedbCommitTimeHist := testutilConstructHist()
 	fakeTransactionsCount := testutilConstructCounter()
+	fakeStateHashMismatches := testutilConstructCounter()
 	fakeProvider.NewGaugeStub = func(opts metrics.GaugeOpts) metrics.Gauge {
 		switch opts.Name {
 		case blockchainHeightOpts.Name:
@@ -214,6 +216,8 @@ func testutilConstructMetricProvider() *testMetricProvider {
 		switch opts.Name {
 		case transactionCountOpts.Name:
 			return fakeTransactionsCount
+		case stateHashMismatchesOpts.Name:
+			return fakeStateHashMismatches
 		}
 		return nil
 	}
@@ -224,6 +228,7 @@ func testutilConstructMetricProvider() *testMetricProvider {
 		fakeBlockstorageCommitTimeHist,
 		fakeStatedbCommitTimeHist,
 		fakeTransactionsCount,
+		fakeStateHashMismatches,
 	}
 }
 
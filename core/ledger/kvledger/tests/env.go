@@ -48,7 +48,7 @@ type env struct {
 	assert *assert.Assertions
 }
 
-func newEnv(conf config, t *testing.T) *env {
+func newEnv(conf config, t testing.TB) *env {
 	setupConfigs(conf)
 	env := &env{assert.New(t)}
 	initLedgerMgmt()
@@ -137,7 +137,7 @@ func initLedgerMgmt() {
 	identityDeserializerFactory := func(chainID string) msp.IdentityDeserializer {
 		return mgmt.GetManagerForChain(chainID)
 	}
-	membershipInfoProvider := privdata.NewMembershipInfoProvider(createSelfSignedData(), identityDeserializerFactory)
+	membershipInfoProvider := privdata.NewMembershipInfoProvider(createSelfSignedData, identityDeserializerFactory)
 
 	ledgermgmt.InitializeExistingTestEnvWithInitializer(
 		&ledgermgmt.Initializer{
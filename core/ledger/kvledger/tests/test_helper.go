@@ -30,7 +30,7 @@ type testhelper struct {
 }
 
 // newTestHelperCreateLgr creates a new ledger and retruns a 'testhelper' for the ledger
-func newTestHelperCreateLgr(id string, t *testing.T) *testhelper {
+func newTestHelperCreateLgr(id string, t testing.TB) *testhelper {
 	genesisBlk, err := constructTestGenesisBlock(id)
 	assert.NoError(t, err)
 	lgr, err := ledgermgmt.CreateLedger(genesisBlk)
@@ -40,7 +40,7 @@ func newTestHelperCreateLgr(id string, t *testing.T) *testhelper {
 }
 
 // newTestHelperOpenLgr opens an existing ledger and retruns a 'testhelper' for the ledger
-func newTestHelperOpenLgr(id string, t *testing.T) *testhelper {
+func newTestHelperOpenLgr(id string, t testing.TB) *testhelper {
 	lgr, err := ledgermgmt.OpenLedger(id)
 	assert.NoError(t, err)
 	client, committer, verifier := newClient(lgr, t), newCommitter(lgr, t), newVerifier(lgr, t)
@@ -0,0 +1,62 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/flogging"
+)
+
+func BenchmarkMVCCValidation(b *testing.B) {
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers-%d", workers), func(b *testing.B) {
+			benchmarkMVCCValidation(b, workers, 500, 10)
+		})
+	}
+}
+
+// benchmarkMVCCValidation commits blocks of 'numTxsPerBlock' transactions where each transaction reads and
+// updates its own key and, in addition, every 'conflictEvery'th transaction reads the key updated
+// by its preceding transaction so as to exercise the sequential validation path as well
+func benchmarkMVCCValidation(b *testing.B, workers, numTxsPerBlock, conflictEvery int) {
+	defer flogging.ActivateSpec(flogging.Global.Spec())
+	flogging.ActivateSpec("error")
+
+	conf := config{"ledger.state.mvccValidationWorkers": workers}
+	for k, v := range defaultConfig {
+		conf[k] = v
+	}
+	env := newEnv(conf, b)
+	defer env.cleanup()
+	h := newTestHelperCreateLgr("ledger1", b)
+
+	h.simulateDataTx("", func(s *simulator) {
+		for i := 0; i < numTxsPerBlock; i++ {
+			s.setState("cc1", fmt.Sprintf("key%d", i), "value")
+		}
+	})
+	h.cutBlockAndCommitWithPvtdata()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		for i := 0; i < numTxsPerBlock; i++ {
+			i := i
+			h.simulateDataTx("", func(s *simulator) {
+				if i > 0 && i%conflictEvery == 0 {
+					s.getState("cc1", fmt.Sprintf("key%d", i-1))
+				}
+				s.getState("cc1", fmt.Sprintf("key%d", i))
+				s.setState("cc1", fmt.Sprintf("key%d", i), fmt.Sprintf("value-%d", n))
+			})
+		}
+		b.StartTimer()
+		h.cutBlockAndCommitWithPvtdata()
+	}
+}
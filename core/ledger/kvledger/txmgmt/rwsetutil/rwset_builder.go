@@ -116,6 +116,16 @@ func (b *RWSetBuilder) AddToPvtAndHashedWriteSet(ns string, coll string, key str
 	b.getOrCreateCollHashedRwBuilder(ns, coll).writeMap[key] = kvWriteHash
 }
 
+// AddToPvtAndHashedWriteSetForPurge adds a delete of the key to the private write-set and
+// a purge marker for the key to the hashed write-set. On commit, the purge marker causes
+// all the historical versions of the key to be removed from the private data store
+func (b *RWSetBuilder) AddToPvtAndHashedWriteSetForPurge(ns, coll, key string) {
+	kvWrite, kvWriteHash := newPvtKVWriteAndHash(key, nil)
+	kvWriteHash.IsPurge = true
+	b.getOrCreateCollPvtRwBuilder(ns, coll).writeMap[key] = kvWrite
+	b.getOrCreateCollHashedRwBuilder(ns, coll).writeMap[key] = kvWriteHash
+}
+
 // AddToHashedMetadataWriteSet adds a metadata to a key in the hashed write-set
 func (b *RWSetBuilder) AddToHashedMetadataWriteSet(ns, coll, key string, metadata map[string][]byte) {
 	// pvt write set just need the key; not the entire metadata. The metadata is stored only
@@ -357,3 +357,48 @@ func serializeTestProtoMsg(t *testing.T, protoMsg proto.Message) []byte {
 	assert.NoError(t, err)
 	return msgBytes
 }
+
+func TestTxSimulationResultWithPurge(t *testing.T) {
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key1")
+
+	actualSimRes, err := rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+
+	pvtNs1Coll1 := &kvrwset.KVRWSet{
+		Writes: []*kvrwset.KVWrite{{Key: "key1", IsDelete: true}},
+	}
+	expectedPvtRWSet := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "ns1",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{
+						CollectionName: "coll1",
+						Rwset:          serializeTestProtoMsg(t, pvtNs1Coll1),
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedPvtRWSet, actualSimRes.PvtSimulationResults)
+
+	hashedNs1Coll1 := &kvrwset.HashedRWSet{
+		HashedWrites: []*kvrwset.KVWriteHash{
+			{KeyHash: util.ComputeStringHash("key1"), IsDelete: true, IsPurge: true},
+		},
+	}
+	expectedNs1 := &rwset.NsReadWriteSet{
+		Namespace: "ns1",
+		Rwset:     serializeTestProtoMsg(t, &kvrwset.KVRWSet{}),
+		CollectionHashedRwset: []*rwset.CollectionHashedReadWriteSet{
+			{
+				CollectionName: "coll1",
+				HashedRwset:    serializeTestProtoMsg(t, hashedNs1Coll1),
+				PvtRwsetHash:   util.ComputeHash(serializeTestProtoMsg(t, pvtNs1Coll1)),
+			},
+		},
+	}
+	assert.Equal(t, expectedNs1, actualSimRes.PubSimulationResults.NsRwset[0])
+}
@@ -0,0 +1,273 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statehash
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	lutil "github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/pkg/errors"
+)
+
+var logger = flogging.MustGetLogger("statehash")
+
+const (
+	stateHashKeyPrefix = 's'
+	anchorBlockKey     = "a"
+
+	// runningStateHashNs is the namespace in the state database that holds the running state hash. The names
+	// of the chaincodes cannot start with an underscore and hence, this namespace does not clash with a chaincode
+	runningStateHashNs  = "_statehash"
+	runningStateHashKey = "running"
+)
+
+// Mgr maintains an incremental hash over the updates that are applied to the state database
+// with each block. The state hash at block 'n' is computed as hash(stateHash(n-1) || hash(updates(n)))
+// where the updates consist of the public updates and the hashed updates of the private data. The private
+// data itself is not included, as a peer may not hold the private data for all the collections.
+// As a result, all the peers of a channel are expected to compute the same state hash at a given height,
+// irrespective of the collections they are member of and the type of the state database they use.
+//
+// The state hash chain starts with the genesis block, i.e., the state hash at block 0 is hash(hash(updates(0))).
+// If the computation is enabled on a ledger whose state database already holds committed blocks, the chain
+// starts with the first block committed afterwards. This block is recorded as the anchor block of the chain
+// and the state hashes are comparable only with the peers that have the same anchor block.
+//
+// The running state hash (i.e., the state hash of the last committed block) is added to the update batch of
+// the block and hence, it is persisted along with the savepoint of the state database. The state hashes of
+// the individual blocks are additionally persisted in the bookkeeper for serving the queries
+type Mgr struct {
+	ledgerid string
+	stateDB  statedb.VersionedDB
+	db       *leveldbhelper.DBHandle
+}
+
+// NewMgr constructs a state hash manager for the given ledger
+func NewMgr(ledgerid string, stateDB statedb.VersionedDB, bookkeepingProvider bookkeeping.Provider) *Mgr {
+	return &Mgr{ledgerid, stateDB, bookkeepingProvider.GetDBHandle(ledgerid, bookkeeping.StateHash)}
+}
+
+// Update computes the state hash for the given block from the running state hash and the updates that
+// the block applies to the state database, and adds the new running state hash to the batch at the given
+// commit height. The computed state hash is returned. This function is expected to be invoked with the final
+// batch, just before it is committed to the state database. In the case of a crash before the savepoint of the
+// block is recorded, the block is recommitted during recovery and the same state hash is recomputed
+func (m *Mgr) Update(blockNum uint64, batch *privacyenabledstate.UpdateBatch, commitHeight *version.Height) ([]byte, error) {
+	running, err := m.getRunningStateHash()
+	if err != nil {
+		return nil, err
+	}
+	var anchorBlockNum uint64
+	var prevStateHash []byte
+	switch {
+	case running == nil && blockNum == 0:
+		// the chain starts with the genesis block
+	case running == nil:
+		anchorBlockNum = blockNum
+		logger.Warningf("[%s] State hash chain starts with block [%d] as the state database holds the blocks "+
+			"committed before enabling the state hash computation. The state hashes are comparable only with the peers "+
+			"whose state hash chain starts with the same block. Rebuild the state database to start the chain with the genesis block",
+			m.ledgerid, blockNum)
+	case running.BlockNum+1 == blockNum:
+		anchorBlockNum, prevStateHash = running.AnchorBlockNum, running.StateHash
+	case running.BlockNum == blockNum:
+		// the block is recommitted as the state database was partially committed (e.g., in the case
+		// of CouchDB) when the peer crashed
+		anchorBlockNum, prevStateHash = running.AnchorBlockNum, running.PrevStateHash
+	default:
+		return nil, errors.Errorf("running state hash is at block [%d], cannot compute state hash for block [%d]",
+			running.BlockNum, blockNum)
+	}
+
+	h := sha256.New()
+	h.Write(prevStateHash)
+	h.Write(computeUpdatesHash(batch))
+	stateHash := h.Sum(nil)
+
+	runningBytes, err := encodeRunningStateHash(&runningStateHash{
+		AnchorBlockNum: anchorBlockNum,
+		BlockNum:       blockNum,
+		PrevStateHash:  prevStateHash,
+		StateHash:      stateHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	batch.PubUpdates.Put(runningStateHashNs, runningStateHashKey, runningBytes, commitHeight)
+
+	bookkeepingBatch := leveldbhelper.NewUpdateBatch()
+	bookkeepingBatch.Put(encodeStateHashKey(blockNum), stateHash)
+	bookkeepingBatch.Put([]byte(anchorBlockKey), util.EncodeOrderPreservingVarUint64(anchorBlockNum))
+	if err := m.db.WriteBatch(bookkeepingBatch, true); err != nil {
+		return nil, errors.Wrapf(err, "error while persisting state hash for block [%d]", blockNum)
+	}
+	logger.Debugf("[%s] State hash for block [%d] = %x", m.ledgerid, blockNum, stateHash)
+	return stateHash, nil
+}
+
+// GetAnchorBlockNum returns the block with which the state hash chain starts. The returned boolean
+// is false if no state hash has been computed yet
+func (m *Mgr) GetAnchorBlockNum() (uint64, bool, error) {
+	anchorBytes, err := m.db.Get([]byte(anchorBlockKey))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "error while retrieving anchor block of state hash chain")
+	}
+	if anchorBytes == nil {
+		return 0, false, nil
+	}
+	anchorBlockNum, _ := util.DecodeOrderPreservingVarUint64(anchorBytes)
+	return anchorBlockNum, true, nil
+}
+
+// GetStateHash returns the state hash computed for the given block. A nil value is returned if
+// no state hash is available for the block - i.e., the block has not been committed yet or it was
+// committed before the anchor block of the state hash chain
+func (m *Mgr) GetStateHash(blockNum uint64) ([]byte, error) {
+	anchorBlockNum, ok, err := m.GetAnchorBlockNum()
+	if err != nil || !ok || blockNum < anchorBlockNum {
+		return nil, err
+	}
+	stateHash, err := m.db.Get(encodeStateHashKey(blockNum))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while retrieving state hash for block [%d]", blockNum)
+	}
+	return stateHash, nil
+}
+
+// runningStateHash is the value persisted in the state database for the running state hash
+type runningStateHash struct {
+	AnchorBlockNum uint64
+	BlockNum       uint64
+	PrevStateHash  []byte
+	StateHash      []byte
+}
+
+func (m *Mgr) getRunningStateHash() (*runningStateHash, error) {
+	vv, err := m.stateDB.GetState(runningStateHashNs, runningStateHashKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while retrieving running state hash")
+	}
+	if vv == nil {
+		return nil, nil
+	}
+	return decodeRunningStateHash(vv.Value)
+}
+
+func encodeRunningStateHash(r *runningStateHash) ([]byte, error) {
+	buf := proto.NewBuffer(nil)
+	if err := buf.EncodeVarint(r.AnchorBlockNum); err != nil {
+		return nil, errors.Wrap(err, "error while encoding running state hash")
+	}
+	if err := buf.EncodeVarint(r.BlockNum); err != nil {
+		return nil, errors.Wrap(err, "error while encoding running state hash")
+	}
+	if err := buf.EncodeRawBytes(r.PrevStateHash); err != nil {
+		return nil, errors.Wrap(err, "error while encoding running state hash")
+	}
+	if err := buf.EncodeRawBytes(r.StateHash); err != nil {
+		return nil, errors.Wrap(err, "error while encoding running state hash")
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeRunningStateHash(b []byte) (*runningStateHash, error) {
+	buf := proto.NewBuffer(b)
+	r := &runningStateHash{}
+	var err error
+	if r.AnchorBlockNum, err = buf.DecodeVarint(); err != nil {
+		return nil, errors.Wrap(err, "error while decoding running state hash")
+	}
+	if r.BlockNum, err = buf.DecodeVarint(); err != nil {
+		return nil, errors.Wrap(err, "error while decoding running state hash")
+	}
+	if r.PrevStateHash, err = buf.DecodeRawBytes(true); err != nil {
+		return nil, errors.Wrap(err, "error while decoding running state hash")
+	}
+	if r.StateHash, err = buf.DecodeRawBytes(true); err != nil {
+		return nil, errors.Wrap(err, "error while decoding running state hash")
+	}
+	return r, nil
+}
+
+// computeUpdatesHash computes a deterministic hash of the public and hashed updates present in the batch,
+// excluding the running state hash
+func computeUpdatesHash(batch *privacyenabledstate.UpdateBatch) []byte {
+	h := sha256.New()
+	pubUpdates := batch.PubUpdates
+	namespaces := pubUpdates.GetUpdatedNamespaces()
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		if ns == runningStateHashNs {
+			continue
+		}
+		updates := pubUpdates.GetUpdates(ns)
+		for _, key := range lutil.GetSortedKeys(updates) {
+			writeUpdate(h, updates[key], ns, "", key)
+		}
+	}
+
+	hashedUpdates := batch.HashUpdates.ToCompositeKeyMap()
+	hashedKeys := make([]privacyenabledstate.HashedCompositeKey, 0, len(hashedUpdates))
+	for k := range hashedUpdates {
+		hashedKeys = append(hashedKeys, k)
+	}
+	sort.Slice(hashedKeys, func(i, j int) bool {
+		ki, kj := hashedKeys[i], hashedKeys[j]
+		if ki.Namespace != kj.Namespace {
+			return ki.Namespace < kj.Namespace
+		}
+		if ki.CollectionName != kj.CollectionName {
+			return ki.CollectionName < kj.CollectionName
+		}
+		return ki.KeyHash < kj.KeyHash
+	})
+	for _, k := range hashedKeys {
+		writeUpdate(h, hashedUpdates[k], k.Namespace, k.CollectionName, k.KeyHash)
+	}
+	return h.Sum(nil)
+}
+
+func writeUpdate(h hash.Hash, vv *statedb.VersionedValue, ns, coll, key string) {
+	buf := &bytes.Buffer{}
+	writeLengthPrefixed(buf, []byte(ns))
+	writeLengthPrefixed(buf, []byte(coll))
+	writeLengthPrefixed(buf, []byte(key))
+	if vv.IsDelete() {
+		buf.WriteByte(0)
+	} else {
+		buf.WriteByte(1)
+		writeLengthPrefixed(buf, vv.Value)
+		writeLengthPrefixed(buf, vv.Metadata)
+	}
+	if vv.Version != nil {
+		buf.Write(vv.Version.ToBytes())
+	}
+	h.Write(buf.Bytes())
+}
+
+func writeLengthPrefixed(buf *bytes.Buffer, b []byte) {
+	lenBytes := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(lenBytes, uint64(len(b)))
+	buf.Write(lenBytes[:n])
+	buf.Write(b)
+}
+
+func encodeStateHashKey(blockNum uint64) []byte {
+	return append([]byte{stateHashKeyPrefix}, util.EncodeOrderPreservingVarUint64(blockNum)...)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statehash
+
+import (
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	flogging.ActivateSpec("statehash=debug")
+	viper.Set("peer.fileSystemPath", "/tmp/fabric/ledgertests/kvledger/txmgmt/statehash")
+	os.Exit(m.Run())
+}
+
+func TestStateHash(t *testing.T) {
+	testenv := bookkeeping.NewTestEnv(t)
+	defer testenv.Cleanup()
+	vdbEnv := stateleveldb.NewTestVDBEnv(t)
+	defer vdbEnv.Cleanup()
+	vdb, err := vdbEnv.DBProvider.GetDBHandle("testledger")
+	assert.NoError(t, err)
+	mgr := NewMgr("testledger", vdb, testenv.TestProvider)
+
+	stateHash, err := mgr.GetStateHash(0)
+	assert.NoError(t, err)
+	assert.Nil(t, stateHash)
+
+	constructBatch0 := func() *privacyenabledstate.UpdateBatch {
+		batch := privacyenabledstate.NewUpdateBatch()
+		batch.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(0, 1))
+		batch.HashUpdates.Put("ns1", "coll1", []byte("keyHash1"), []byte("valueHash1"), version.NewHeight(0, 1))
+		return batch
+	}
+	constructBatch1 := func() *privacyenabledstate.UpdateBatch {
+		batch := privacyenabledstate.NewUpdateBatch()
+		batch.PubUpdates.Delete("ns1", "key1", version.NewHeight(1, 1))
+		return batch
+	}
+	commit := func(blockNum uint64, batch *privacyenabledstate.UpdateBatch) []byte {
+		height := version.NewHeight(blockNum, 1)
+		stateHash, err := mgr.Update(blockNum, batch, height)
+		assert.NoError(t, err)
+		assert.NoError(t, vdb.ApplyUpdates(batch.PubUpdates.UpdateBatch, height))
+		return stateHash
+	}
+
+	// the state hash chain starts with the genesis block
+	stateHash0 := commit(0, constructBatch0())
+	expectedStateHash0 := sha256.Sum256(computeUpdatesHash(constructBatch0()))
+	assert.Equal(t, expectedStateHash0[:], stateHash0)
+
+	stateHash1 := commit(1, constructBatch1())
+	expectedStateHash1 := sha256.Sum256(append(stateHash0, computeUpdatesHash(constructBatch1())...))
+	assert.Equal(t, expectedStateHash1[:], stateHash1)
+
+	stateHash, err = mgr.GetStateHash(0)
+	assert.NoError(t, err)
+	assert.Equal(t, stateHash0, stateHash)
+	stateHash, err = mgr.GetStateHash(1)
+	assert.NoError(t, err)
+	assert.Equal(t, stateHash1, stateHash)
+	anchorBlockNum, ok, err := mgr.GetAnchorBlockNum()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0), anchorBlockNum)
+
+	// the running state hash is committed along with the updates, and excluded from the updates hash
+	vv, err := vdb.GetState(runningStateHashNs, runningStateHashKey)
+	assert.NoError(t, err)
+	running, err := decodeRunningStateHash(vv.Value)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), running.BlockNum)
+	assert.Equal(t, stateHash1, running.StateHash)
+	batch1 := constructBatch1()
+	_, err = mgr.Update(1, batch1, version.NewHeight(1, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, computeUpdatesHash(constructBatch1()), computeUpdatesHash(batch1))
+
+	// recomputing the state hash for a committed block (e.g., during recovery) yields the same value
+	stateHash, err = mgr.Update(1, constructBatch1(), version.NewHeight(1, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, stateHash1, stateHash)
+
+	// the state hash cannot be computed for a block that does not follow the running state hash
+	_, err = mgr.Update(3, constructBatch1(), version.NewHeight(3, 1))
+	assert.EqualError(t, err, "running state hash is at block [1], cannot compute state hash for block [3]")
+}
+
+func TestStateHashAnchorBlock(t *testing.T) {
+	testenv := bookkeeping.NewTestEnv(t)
+	defer testenv.Cleanup()
+	vdbEnv := stateleveldb.NewTestVDBEnv(t)
+	defer vdbEnv.Cleanup()
+	vdb, err := vdbEnv.DBProvider.GetDBHandle("testledger")
+	assert.NoError(t, err)
+	mgr := NewMgr("testledger", vdb, testenv.TestProvider)
+
+	_, ok, err := mgr.GetAnchorBlockNum()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// the state database holds the blocks committed before enabling the state hash computation
+	batch := privacyenabledstate.NewUpdateBatch()
+	batch.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(5, 1))
+	stateHash5, err := mgr.Update(5, batch, version.NewHeight(5, 1))
+	assert.NoError(t, err)
+	expectedStateHash5 := sha256.Sum256(computeUpdatesHash(batch))
+	assert.Equal(t, expectedStateHash5[:], stateHash5)
+
+	anchorBlockNum, ok, err := mgr.GetAnchorBlockNum()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(5), anchorBlockNum)
+	stateHash, err := mgr.GetStateHash(4)
+	assert.NoError(t, err)
+	assert.Nil(t, stateHash)
+	stateHash, err = mgr.GetStateHash(5)
+	assert.NoError(t, err)
+	assert.Equal(t, stateHash5, stateHash)
+}
+
+func TestComputeUpdatesHash(t *testing.T) {
+	batch1 := privacyenabledstate.NewUpdateBatch()
+	batch1.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+	batch1.PubUpdates.Put("ns2", "key2", []byte("value2"), version.NewHeight(1, 2))
+	batch1.HashUpdates.Put("ns1", "coll1", []byte("keyHash1"), []byte("valueHash1"), version.NewHeight(1, 1))
+	batch1.HashUpdates.Put("ns1", "coll2", []byte("keyHash2"), []byte("valueHash2"), version.NewHeight(1, 2))
+	batch1.PvtUpdates.Put("ns1", "coll1", "key1", []byte("pvtValue1"), version.NewHeight(1, 1))
+
+	// same updates added in a different order and without the private data
+	batch2 := privacyenabledstate.NewUpdateBatch()
+	batch2.HashUpdates.Put("ns1", "coll2", []byte("keyHash2"), []byte("valueHash2"), version.NewHeight(1, 2))
+	batch2.HashUpdates.Put("ns1", "coll1", []byte("keyHash1"), []byte("valueHash1"), version.NewHeight(1, 1))
+	batch2.PubUpdates.Put("ns2", "key2", []byte("value2"), version.NewHeight(1, 2))
+	batch2.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+	assert.Equal(t, computeUpdatesHash(batch1), computeUpdatesHash(batch2))
+
+	// a different value
+	batch3 := privacyenabledstate.NewUpdateBatch()
+	batch3.PubUpdates.Put("ns1", "key1", []byte("value1_new"), version.NewHeight(1, 1))
+	batch3.PubUpdates.Put("ns2", "key2", []byte("value2"), version.NewHeight(1, 2))
+	batch3.HashUpdates.Put("ns1", "coll1", []byte("keyHash1"), []byte("valueHash1"), version.NewHeight(1, 1))
+	batch3.HashUpdates.Put("ns1", "coll2", []byte("keyHash2"), []byte("valueHash2"), version.NewHeight(1, 2))
+	assert.NotEqual(t, computeUpdatesHash(batch1), computeUpdatesHash(batch3))
+
+	// a delete instead of a write of an empty value
+	batch4 := privacyenabledstate.NewUpdateBatch()
+	batch4.PubUpdates.Put("ns1", "key1", []byte{}, version.NewHeight(1, 1))
+	batch5 := privacyenabledstate.NewUpdateBatch()
+	batch5.PubUpdates.Delete("ns1", "key1", version.NewHeight(1, 1))
+	assert.NotEqual(t, computeUpdatesHash(batch4), computeUpdatesHash(batch5))
+
+	// the boundaries between the namespace and the key are preserved
+	batch6 := privacyenabledstate.NewUpdateBatch()
+	batch6.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+	batch7 := privacyenabledstate.NewUpdateBatch()
+	batch7.PubUpdates.Put("ns1k", "ey1", []byte("value1"), version.NewHeight(1, 1))
+	assert.NotEqual(t, computeUpdatesHash(batch6), computeUpdatesHash(batch7))
+}
@@ -16,15 +16,18 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/pvtstatepurgemgmt"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/queryutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statehash"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/valimpl"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/pkg/errors"
 )
 
 var logger = flogging.MustGetLogger("lockbasedtxmgr")
@@ -35,6 +38,7 @@ type LockBasedTxMgr struct {
 	ledgerid        string
 	db              privacyenabledstate.DB
 	pvtdataPurgeMgr *pvtdataPurgeMgr
+	stateHashMgr    *statehash.Mgr
 	validator       validator.Validator
 	stateListeners  []ledger.StateListener
 	ccInfoProvider  ledger.DeployedChaincodeInfoProvider
@@ -72,6 +76,9 @@ func NewLockBasedTxMgr(ledgerid string, db privacyenabledstate.DB, stateListener
 		return nil, err
 	}
 	txmgr.pvtdataPurgeMgr = &pvtdataPurgeMgr{pvtstatePurgeMgr, false}
+	if ledgerconfig.IsStateHashEnabled() {
+		txmgr.stateHashMgr = statehash.NewMgr(ledgerid, db, bookkeepingProvider)
+	}
 	txmgr.validator = valimpl.NewStatebasedValidator(txmgr, db)
 	return txmgr, nil
 }
@@ -82,6 +89,14 @@ func (txmgr *LockBasedTxMgr) GetLastSavepoint() (*version.Height, error) {
 	return txmgr.db.GetLatestSavePoint()
 }
 
+// GetStateHash implements method in interface `txmgmt.TxMgr`
+func (txmgr *LockBasedTxMgr) GetStateHash(blockNum uint64) ([]byte, error) {
+	if txmgr.stateHashMgr == nil {
+		return nil, errors.New("state hash computation is not enabled")
+	}
+	return txmgr.stateHashMgr.GetStateHash(blockNum)
+}
+
 // NewQueryExecutor implements method in interface `txmgmt.TxMgr`
 func (txmgr *LockBasedTxMgr) NewQueryExecutor(txid string) (ledger.QueryExecutor, error) {
 	qe := newQueryExecutor(txmgr, txid)
@@ -475,6 +490,15 @@ func (txmgr *LockBasedTxMgr) Commit() error {
 	}
 
 	commitHeight := version.NewHeight(txmgr.current.blockNum(), txmgr.current.maxTxNumber())
+
+	// the state hash is computed from the final batch (i.e., after including the expired keys) and the running
+	// state hash is committed along with the batch. See the comments on function 'statehash.Mgr.Update'
+	if txmgr.stateHashMgr != nil {
+		if _, err := txmgr.stateHashMgr.Update(txmgr.current.blockNum(), txmgr.current.batch, commitHeight); err != nil {
+			return err
+		}
+	}
+
 	txmgr.commitRWLock.Lock()
 	logger.Debugf("Write lock acquired for committing updates to state database")
 	if err := txmgr.db.ApplyPrivacyAwareUpdates(txmgr.current.batch, commitHeight); err != nil {
@@ -18,6 +18,7 @@ package txmgr
 
 import (
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -30,6 +31,7 @@ type TxMgr interface {
 	ValidateAndPrepare(blockAndPvtdata *ledger.BlockAndPvtData, doMVCCValidation bool) ([]*TxStatInfo, error)
 	RemoveStaleAndCommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPvtData) error
 	GetLastSavepoint() (*version.Height, error)
+	GetStateHash(blockNum uint64) ([]byte, error)
 	ShouldRecover(lastAvailableBlock uint64) (bool, uint64, error)
 	CommitLostBlock(blockAndPvtdata *ledger.BlockAndPvtData) error
 	Commit() error
@@ -43,6 +45,9 @@ type TxStatInfo struct {
 	TxType         common.HeaderType
 	ChaincodeID    *peer.ChaincodeID
 	NumCollections int
+	// TxRWSet is the read-write set of the transaction, as parsed during the validation,
+	// so that it does not need to be parsed again while committing the block
+	TxRWSet *rwsetutil.TxRwSet
 }
 
 // ErrUnsupportedTransaction is expected to be thrown if a unsupported query is performed in an update transaction
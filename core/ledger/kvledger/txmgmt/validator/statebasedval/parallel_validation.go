@@ -0,0 +1,156 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebasedval
+
+import (
+	"sync"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/internal"
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+// txDependencies captures, for each transaction in a block, the indexes of the preceding transactions
+// in the same block whose writes (public or hashed) overlap with either the reads or the ranges
+// queried by the transaction. The outcome of the mvcc validation of a transaction can only be influenced by
+// the preceding transactions that it depends on
+type txDependencies [][]int
+
+// buildTxDependencies constructs the dependency graph of the transactions in the block
+// based on the read-sets, range queries, and write-sets of the transactions
+func buildTxDependencies(block *internal.Block) txDependencies {
+	deps := make(txDependencies, len(block.Txs))
+	pubWriters := make(map[compositeKey][]int)
+	hashedWriters := make(map[compositeKey][]int)
+	// pubWrittenKeys maintains the public keys written by the preceding transactions, per namespace,
+	// so as to evaluate the overlap with the range queries
+	pubWrittenKeys := make(map[string]map[string][]int)
+
+	for i, tx := range block.Txs {
+		dependsOn := make(map[int]struct{})
+		for _, nsRWSet := range tx.RWSet.NsRwSets {
+			ns := nsRWSet.NameSpace
+			for _, kvRead := range nsRWSet.KvRwSet.Reads {
+				addDependencies(dependsOn, pubWriters[compositeKey{ns, "", kvRead.Key}])
+			}
+			for _, rqi := range nsRWSet.KvRwSet.RangeQueriesInfo {
+				for key, writers := range pubWrittenKeys[ns] {
+					if key >= rqi.StartKey && (rqi.EndKey == "" || key <= rqi.EndKey) {
+						addDependencies(dependsOn, writers)
+					}
+				}
+			}
+			for _, collHashedRWSet := range nsRWSet.CollHashedRwSets {
+				coll := collHashedRWSet.CollectionName
+				for _, kvReadHash := range collHashedRWSet.HashedRwSet.HashedReads {
+					addDependencies(dependsOn, hashedWriters[compositeKey{ns, coll, string(kvReadHash.KeyHash)}])
+				}
+			}
+		}
+		for d := range dependsOn {
+			deps[i] = append(deps[i], d)
+		}
+
+		for _, nsRWSet := range tx.RWSet.NsRwSets {
+			ns := nsRWSet.NameSpace
+			nsWrittenKeys, ok := pubWrittenKeys[ns]
+			if !ok {
+				nsWrittenKeys = make(map[string][]int)
+				pubWrittenKeys[ns] = nsWrittenKeys
+			}
+			for _, kvWrite := range nsRWSet.KvRwSet.Writes {
+				key := compositeKey{ns, "", kvWrite.Key}
+				pubWriters[key] = append(pubWriters[key], i)
+				nsWrittenKeys[kvWrite.Key] = append(nsWrittenKeys[kvWrite.Key], i)
+			}
+			for _, kvMetadataWrite := range nsRWSet.KvRwSet.MetadataWrites {
+				key := compositeKey{ns, "", kvMetadataWrite.Key}
+				pubWriters[key] = append(pubWriters[key], i)
+				nsWrittenKeys[kvMetadataWrite.Key] = append(nsWrittenKeys[kvMetadataWrite.Key], i)
+			}
+			for _, collHashedRWSet := range nsRWSet.CollHashedRwSets {
+				coll := collHashedRWSet.CollectionName
+				for _, kvWriteHash := range collHashedRWSet.HashedRwSet.HashedWrites {
+					key := compositeKey{ns, coll, string(kvWriteHash.KeyHash)}
+					hashedWriters[key] = append(hashedWriters[key], i)
+				}
+				for _, kvMetadataWriteHash := range collHashedRWSet.HashedRwSet.MetadataWrites {
+					key := compositeKey{ns, coll, string(kvMetadataWriteHash.KeyHash)}
+					hashedWriters[key] = append(hashedWriters[key], i)
+				}
+			}
+		}
+	}
+	return deps
+}
+
+// isIndependent returns true if the transaction at the given index does not depend on any preceding transaction
+func (deps txDependencies) isIndependent(txIndex int) bool {
+	return len(deps[txIndex]) == 0
+}
+
+// validateIndependentTxs performs the mvcc validation, against the committed state, of the transactions that
+// do not depend on any preceding transaction in the block. The validation is performed concurrently by 'v.workers'
+// goroutines. The returned map contains the validation code for each of the independent transactions, keyed
+// by the index of the transaction in the block. Because the updates from the preceding valid transactions cannot
+// overlap with the reads of an independent transaction, the validation codes are the same as the ones that
+// a sequential validation would produce
+func (v *Validator) validateIndependentTxs(block *internal.Block, deps txDependencies) (map[int]peer.TxValidationCode, error) {
+	var independentTxs []int
+	for i := range block.Txs {
+		if deps.isIndependent(i) {
+			independentTxs = append(independentTxs, i)
+		}
+	}
+	logger.Debugf("Block [%d]: validating [%d] out of [%d] transactions in parallel using [%d] workers",
+		block.Num, len(independentTxs), len(block.Txs), v.workers)
+
+	validationCodes := make([]peer.TxValidationCode, len(independentTxs))
+	txIndexChan := make(chan int, len(independentTxs))
+	for i := range independentTxs {
+		txIndexChan <- i
+	}
+	close(txIndexChan)
+
+	var wg sync.WaitGroup
+	errsChan := make(chan error, v.workers)
+	for w := 0; w < v.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// updates is kept empty as no preceding transaction writes the keys read by an independent transaction
+			emptyUpdates := internal.NewPubAndHashUpdates()
+			for i := range txIndexChan {
+				validationCode, err := v.validateTx(block.Txs[independentTxs[i]].RWSet, emptyUpdates)
+				if err != nil {
+					errsChan <- err
+					return
+				}
+				validationCodes[i] = validationCode
+			}
+		}()
+	}
+	wg.Wait()
+	close(errsChan)
+	if err := <-errsChan; err != nil {
+		return nil, err
+	}
+
+	results := make(map[int]peer.TxValidationCode, len(independentTxs))
+	for i, txIndex := range independentTxs {
+		results[txIndex] = validationCodes[i]
+	}
+	return results, nil
+}
+
+type compositeKey struct {
+	ns, coll, key string
+}
+
+func addDependencies(dependsOn map[int]struct{}, writers []int) {
+	for _, w := range writers {
+		dependsOn[w] = struct{}{}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statebasedval
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/internal"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTxDependencies(t *testing.T) {
+	// tx0 writes key1 and a hashed key in coll1
+	rwsetBuilder0 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder0.AddToWriteSet("ns1", "key1", []byte("value1"))
+	rwsetBuilder0.AddToPvtAndHashedWriteSet("ns1", "coll1", "pvtKey1", []byte("pvtValue1"))
+	// tx1 reads key1
+	rwsetBuilder1 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder1.AddToReadSet("ns1", "key1", version.NewHeight(1, 0))
+	// tx2 reads key2 and key1 in a different namespace
+	rwsetBuilder2 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder2.AddToReadSet("ns1", "key2", version.NewHeight(1, 1))
+	rwsetBuilder2.AddToReadSet("ns2", "key1", version.NewHeight(1, 1))
+	// tx3 performs a range query that covers key1
+	rwsetBuilder3 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder3.AddToRangeQuerySet("ns1", &kvrwset.RangeQueryInfo{StartKey: "key0", EndKey: "key3", ItrExhausted: true})
+	// tx4 reads the hashed key written by tx0 and writes key2
+	rwsetBuilder4 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder4.AddToHashedReadSet("ns1", "coll1", "pvtKey1", version.NewHeight(1, 0))
+	rwsetBuilder4.AddToWriteSet("ns1", "key2", []byte("value2"))
+	// tx5 reads key1 and key2
+	rwsetBuilder5 := rwsetutil.NewRWSetBuilder()
+	rwsetBuilder5.AddToReadSet("ns1", "key1", version.NewHeight(1, 0))
+	rwsetBuilder5.AddToReadSet("ns1", "key2", version.NewHeight(1, 1))
+
+	block := testBlock(getTestPubSimulationRWSet(t,
+		rwsetBuilder0, rwsetBuilder1, rwsetBuilder2, rwsetBuilder3, rwsetBuilder4, rwsetBuilder5))
+	deps := buildTxDependencies(block)
+	assert.Len(t, deps, 6)
+	assert.Empty(t, deps[0])
+	assert.ElementsMatch(t, []int{0}, deps[1])
+	assert.Empty(t, deps[2])
+	assert.ElementsMatch(t, []int{0}, deps[3])
+	assert.ElementsMatch(t, []int{0}, deps[4])
+	assert.ElementsMatch(t, []int{0, 4}, deps[5])
+
+	assert.True(t, deps.isIndependent(0))
+	assert.False(t, deps.isIndependent(1))
+	assert.True(t, deps.isIndependent(2))
+}
+
+func TestParallelValidationMatchesSequential(t *testing.T) {
+	testDBEnv := privacyenabledstate.LevelDBCommonStorageTestEnv{}
+	testDBEnv.Init(t)
+	defer testDBEnv.Cleanup()
+	db := testDBEnv.GetDBHandle("TestDB")
+
+	//populate db with initial data
+	batch := privacyenabledstate.NewUpdateBatch()
+	for i := 0; i < 10; i++ {
+		batch.PubUpdates.Put("ns1", fmt.Sprintf("key%d", i), []byte(fmt.Sprintf("value%d", i)), version.NewHeight(1, uint64(i)))
+	}
+	db.ApplyPrivacyAwareUpdates(batch, version.NewHeight(1, 9))
+
+	var builders []*rwsetutil.RWSetBuilder
+	// tx0 is valid and writes key0
+	b := rwsetutil.NewRWSetBuilder()
+	b.AddToReadSet("ns1", "key0", version.NewHeight(1, 0))
+	b.AddToWriteSet("ns1", "key0", []byte("value0_new"))
+	builders = append(builders, b)
+	// tx1 is invalid (stale read of key1) and writes key2
+	b = rwsetutil.NewRWSetBuilder()
+	b.AddToReadSet("ns1", "key1", version.NewHeight(1, 0))
+	b.AddToWriteSet("ns1", "key2", []byte("value2_new"))
+	builders = append(builders, b)
+	// tx2 reads key0 written by the valid tx0 - invalid
+	b = rwsetutil.NewRWSetBuilder()
+	b.AddToReadSet("ns1", "key0", version.NewHeight(1, 0))
+	builders = append(builders, b)
+	// tx3 reads key2 written only by the invalid tx1 - valid
+	b = rwsetutil.NewRWSetBuilder()
+	b.AddToReadSet("ns1", "key2", version.NewHeight(1, 2))
+	b.AddToWriteSet("ns1", "key3", []byte("value3_new"))
+	builders = append(builders, b)
+	// tx4 range query covers key3 written by the valid tx3 - invalid
+	b = rwsetutil.NewRWSetBuilder()
+	rqi := &kvrwset.RangeQueryInfo{StartKey: "key3", EndKey: "key5", ItrExhausted: true}
+	rqi.SetRawReads([]*kvrwset.KVRead{
+		rwsetutil.NewKVRead("key3", version.NewHeight(1, 3)),
+		rwsetutil.NewKVRead("key4", version.NewHeight(1, 4))})
+	b.AddToRangeQuerySet("ns1", rqi)
+	builders = append(builders, b)
+	// tx5 to tx9 are independent and valid
+	for i := 5; i < 10; i++ {
+		b = rwsetutil.NewRWSetBuilder()
+		b.AddToReadSet("ns1", fmt.Sprintf("key%d", i), version.NewHeight(1, uint64(i)))
+		b.AddToWriteSet("ns1", fmt.Sprintf("key%d", i), []byte("new_value"))
+		builders = append(builders, b)
+	}
+	rwsets := getTestPubSimulationRWSet(t, builders...)
+
+	sequentialBlock := testBlock(rwsets)
+	sequentialUpdates, err := (&Validator{db, 1}).ValidateAndPrepareBatch(sequentialBlock, true)
+	assert.NoError(t, err)
+
+	parallelBlock := testBlock(rwsets)
+	parallelUpdates, err := (&Validator{db, 4}).ValidateAndPrepareBatch(parallelBlock, true)
+	assert.NoError(t, err)
+
+	expectedCodes := []peer.TxValidationCode{
+		peer.TxValidationCode_VALID,
+		peer.TxValidationCode_MVCC_READ_CONFLICT,
+		peer.TxValidationCode_MVCC_READ_CONFLICT,
+		peer.TxValidationCode_VALID,
+		peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+	}
+	for i := 5; i < 10; i++ {
+		expectedCodes = append(expectedCodes, peer.TxValidationCode_VALID)
+	}
+	for i := range rwsets {
+		assert.Equal(t, expectedCodes[i], sequentialBlock.Txs[i].ValidationCode, "tx index %d", i)
+		assert.Equal(t, expectedCodes[i], parallelBlock.Txs[i].ValidationCode, "tx index %d", i)
+	}
+	assert.Equal(t, sequentialUpdates, parallelUpdates)
+}
+
+func testBlock(rwsets []*rwsetutil.TxRwSet) *internal.Block {
+	var trans []*internal.Transaction
+	for i, rwset := range rwsets {
+		trans = append(trans, &internal.Transaction{
+			ID:             fmt.Sprintf("txid-%d", i),
+			IndexInBlock:   i,
+			ValidationCode: peer.TxValidationCode_VALID,
+			RWSet:          rwset,
+		})
+	}
+	return &internal.Block{Num: 2, Txs: trans}
+}
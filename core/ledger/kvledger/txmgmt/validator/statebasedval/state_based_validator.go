@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/validator/internal"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/peer"
 )
@@ -21,12 +22,13 @@ var logger = flogging.MustGetLogger("statebasedval")
 // Validator validates a tx against the latest committed state
 // and preceding valid transactions with in the same block
 type Validator struct {
-	db privacyenabledstate.DB
+	db      privacyenabledstate.DB
+	workers int
 }
 
 // NewValidator constructs StateValidator
 func NewValidator(db privacyenabledstate.DB) *Validator {
-	return &Validator{db}
+	return &Validator{db, ledgerconfig.GetMVCCValidationWorkers()}
 }
 
 // preLoadCommittedVersionOfRSet loads committed version of all keys in each
@@ -97,11 +99,24 @@ func (v *Validator) ValidateAndPrepareBatch(block *internal.Block, doMVCCValidat
 		}
 	}
 
+	// When more than one worker is configured, the transactions that do not depend on any preceding
+	// transaction in the block are validated concurrently upfront. The remaining transactions are
+	// validated in the order of their appearance in the block, as before
+	var independentTxsValidationCodes map[int]peer.TxValidationCode
+	if doMVCCValidation && v.workers > 1 && len(block.Txs) > 1 {
+		var err error
+		if independentTxsValidationCodes, err = v.validateIndependentTxs(block, buildTxDependencies(block)); err != nil {
+			return nil, err
+		}
+	}
+
 	updates := internal.NewPubAndHashUpdates()
-	for _, tx := range block.Txs {
+	for i, tx := range block.Txs {
 		var validationCode peer.TxValidationCode
 		var err error
-		if validationCode, err = v.validateEndorserTX(tx.RWSet, doMVCCValidation, updates); err != nil {
+		if code, ok := independentTxsValidationCodes[i]; ok {
+			validationCode = code
+		} else if validationCode, err = v.validateEndorserTX(tx.RWSet, doMVCCValidation, updates); err != nil {
 			return nil, err
 		}
 
@@ -420,6 +420,15 @@ func TestTxStatsInfo(t *testing.T) {
 			NumCollections: 2,
 		},
 	}
+	// the parsed rwsets are passed along for the txs that carry one
+	for i, txStatInfo := range txStatsInfo {
+		if i == 2 {
+			assert.Nil(t, txStatInfo.TxRWSet)
+			continue
+		}
+		assert.NotNil(t, txStatInfo.TxRWSet)
+		txStatInfo.TxRWSet = nil
+	}
 	t.Logf("txStatsInfo=%s\n", spew.Sdump(txStatsInfo))
 	assert.Equal(t, expectedTxStatInfo, txStatsInfo)
 }
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric-lib-go/healthz"
 	commonledger "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/metrics"
@@ -96,6 +97,13 @@ type PeerLedger interface {
 	CommitPvtDataOfOldBlocks(blockPvtData []*BlockPvtData) ([]*PvtdataHashMismatch, error)
 	// GetMissingPvtDataTracker return the MissingPvtDataTracker
 	GetMissingPvtDataTracker() (MissingPvtDataTracker, error)
+	// GetStateHash returns the state hash (an incremental hash over the public and hashed private data updates
+	// applied to the state database) as of the given block. All the peers of a channel are expected to compute
+	// the same state hash for a given block. A nil value is returned if the state hash is not available for the block
+	GetStateHash(blockNum uint64) ([]byte, error)
+	// VerifyStateHash compares the state hash as of the given block with the expected state hash (e.g., the one
+	// computed by a peer of another organization) and returns false in the case of a mismatch
+	VerifyStateHash(blockNum uint64, expectedStateHash []byte) (bool, error)
 }
 
 // ValidatedLedger represents the 'final ledger' after filtering out invalid transactions from PeerLedger.
@@ -173,6 +181,33 @@ type HistoryQueryExecutor interface {
 	// GetHistoryForKey retrieves the history of values for a key.
 	// The returned ResultsIterator contains results of type *KeyModification which is defined in protos/ledger/queryresult.
 	GetHistoryForKey(namespace string, key string) (commonledger.ResultsIterator, error)
+	// GetHistoryForKeyByBlockRange retrieves the history of values for a key that were committed in the blocks
+	// in the range [startBlock, endBlock]. A math.MaxUint64 endBlock refers to the last committed block.
+	// The returned QueryResultsIterator contains results of type *KeyModification which is defined in protos/ledger/queryresult.
+	GetHistoryForKeyByBlockRange(namespace string, key string, startBlock, endBlock uint64, options *HistoryQueryOptions) (QueryResultsIterator, error)
+	// GetHistoryForKeyByTimeRange retrieves the history of values for a key that were written by the transactions
+	// with a timestamp in the range [startTime, endTime). A nil startTime or endTime denotes an unbounded range on that end.
+	// The timestamp of a transaction is the one supplied by the client in the proposal header and hence, the whole history
+	// of the key is scanned for evaluating this query.
+	// The returned QueryResultsIterator contains results of type *KeyModification which is defined in protos/ledger/queryresult.
+	GetHistoryForKeyByTimeRange(namespace string, key string, startTime, endTime *timestamp.Timestamp, options *HistoryQueryOptions) (QueryResultsIterator, error)
+	// GetPrivateDataHistoryForKey retrieves the history of values for a private data key of a collection.
+	// The history is maintained only for the private data that is available on this peer, i.e., the peer is
+	// a member of the collection. The returned ResultsIterator contains results of type *KeyModification
+	// which is defined in protos/ledger/queryresult. A modification whose value is no longer available
+	// because of the purge of the private data has the field 'IsPurged' set and carries no value.
+	GetPrivateDataHistoryForKey(namespace, collection, key string) (commonledger.ResultsIterator, error)
+}
+
+// HistoryQueryOptions specifies the order and the pagination of the results of a history query
+type HistoryQueryOptions struct {
+	// Descending causes the most recent modifications to be returned first
+	Descending bool
+	// PageSize limits the number of results returned by the iterator. A zero value denotes no limit
+	PageSize int32
+	// Bookmark resumes the query from the position returned by the function `GetBookmarkAndClose`
+	// of the iterator of a previous page of the same query
+	Bookmark string
 }
 
 // TxSimulator simulates a transaction on a consistent snapshot of the 'as recent state as possible'
@@ -197,6 +232,9 @@ type TxSimulator interface {
 	SetPrivateDataMultipleKeys(namespace, collection string, kvs map[string][]byte) error
 	// DeletePrivateData deletes the given tuple <namespace, collection, key> from private data
 	DeletePrivateData(namespace, collection, key string) error
+	// PurgePrivateData purges all the historical versions of the given tuple <namespace, collection, key>
+	// from the private data, on all the member peers, when the transaction commits
+	PurgePrivateData(namespace, collection, key string) error
 	// SetPrivateDataMetadata sets the metadata associated with an existing key-tuple <namespace, collection, key>
 	SetPrivateDataMetadata(namespace, collection, key string, metadata map[string][]byte) error
 	// DeletePrivateDataMetadata deletes the metadata associated with an existing key-tuple <namespace, collection, key>
@@ -374,6 +412,7 @@ type ConfigHistoryRetriever interface {
 // MissingPvtDataTracker allows getting information about the private data that is not missing on the peer
 type MissingPvtDataTracker interface {
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlocks int) (MissingPvtDataInfo, error)
+	GetMissingPvtDataInfoForBlockRange(startBlock, endBlock uint64) (MissingPvtDataInfo, error)
 }
 
 // MissingPvtDataInfo is a map of block number to MissingBlockPvtdataInfo
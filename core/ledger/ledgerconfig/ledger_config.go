@@ -8,6 +8,7 @@ package ledgerconfig
 
 import (
 	"path/filepath"
+	"runtime"
 
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/spf13/viper"
@@ -37,6 +38,11 @@ const confEnableHistoryDatabase = "ledger.history.enableHistoryDatabase"
 const confMaxBatchSize = "ledger.state.couchDBConfig.maxBatchUpdateSize"
 const confAutoWarmIndexes = "ledger.state.couchDBConfig.autoWarmIndexes"
 const confWarmIndexesAfterNBlocks = "ledger.state.couchDBConfig.warmIndexesAfterNBlocks"
+const confEnableStateHash = "ledger.state.enableStateHash"
+const confMVCCValidationWorkers = "ledger.state.mvccValidationWorkers"
+const confCompressSealedBlockfiles = "ledger.blockchain.compressSealedBlockfiles"
+const confBlockfilesArchiveDir = "ledger.blockchain.archiveDir"
+const confArchiveAfterBlocks = "ledger.blockchain.archiveAfterBlocks"
 
 var confCollElgProcMaxDbBatchSize = &conf{"ledger.pvtdataStore.collElgProcMaxDbBatchSize", 5000}
 var confCollElgProcDbBatchesInterval = &conf{"ledger.pvtdataStore.collElgProcDbBatchesInterval", 1000}
@@ -88,6 +94,30 @@ func GetMaxBlockfileSize() int {
 	return 64 * 1024 * 1024
 }
 
+// IsSealedBlockfilesCompressionEnabled exposes the compressSealedBlockfiles variable
+func IsSealedBlockfilesCompressionEnabled() bool {
+	return viper.GetBool(confCompressSealedBlockfiles)
+}
+
+// GetBlockfilesArchivePath returns the filesystem path to which the sealed block files are moved.
+// An empty value is returned if the archival of the sealed block files is not enabled
+func GetBlockfilesArchivePath() string {
+	if viper.GetString(confBlockfilesArchiveDir) == "" {
+		return ""
+	}
+	return config.GetPath(confBlockfilesArchiveDir)
+}
+
+// GetArchiveAfterBlocks returns the number of blocks that are to be committed after the
+// last block of a sealed block file before the block file is moved to the archive path
+func GetArchiveAfterBlocks() uint64 {
+	archiveAfterBlocks := viper.GetInt(confArchiveAfterBlocks)
+	if archiveAfterBlocks < 0 {
+		return 0
+	}
+	return uint64(archiveAfterBlocks)
+}
+
 // GetTotalQueryLimit exposes the totalLimit variable
 func GetTotalQueryLimit() int {
 	totalQueryLimit := viper.GetInt(confTotalQueryLimit)
@@ -153,6 +183,11 @@ func IsHistoryDBEnabled() bool {
 	return viper.GetBool(confEnableHistoryDatabase)
 }
 
+// IsStateHashEnabled exposes the enableStateHash variable
+func IsStateHashEnabled() bool {
+	return viper.GetBool(confEnableStateHash)
+}
+
 // IsQueryReadsHashingEnabled enables or disables computing of hash
 // of range query results for phantom item validation
 func IsQueryReadsHashingEnabled() bool {
@@ -186,6 +221,18 @@ func GetWarmIndexesAfterNBlocks() int {
 	return warmAfterNBlocks
 }
 
+// GetMVCCValidationWorkers returns the number of goroutines used for validating concurrently
+// the read-sets of the transactions in a block that do not conflict with each other.
+// A value of 1 results in the transactions being validated sequentially
+func GetMVCCValidationWorkers() int {
+	workers := viper.GetInt(confMVCCValidationWorkers)
+	// if mvccValidationWorkers was unset or non-positive, default to the number of CPUs
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return workers
+}
+
 type conf struct {
 	Name       string
 	DefaultVal int
@@ -17,6 +17,7 @@ limitations under the License.
 package ledgerconfig
 
 import (
+	"runtime"
 	"testing"
 
 	ledgertestutil "github.com/hyperledger/fabric/core/ledger/testutil"
@@ -196,6 +197,20 @@ func TestIsHistoryDBEnabledFalse(t *testing.T) {
 	assert.False(t, updatedValue) //test config returns false
 }
 
+func TestIsStateHashEnabledDefault(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defaultValue := IsStateHashEnabled()
+	assert.False(t, defaultValue) //test default config is false
+}
+
+func TestIsStateHashEnabledTrue(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defer ledgertestutil.ResetConfigToDefaultValues()
+	viper.Set("ledger.state.enableStateHash", true)
+	updatedValue := IsStateHashEnabled()
+	assert.True(t, updatedValue) //test config returns true
+}
+
 func TestIsAutoWarmIndexesEnabledDefault(t *testing.T) {
 	setUpCoreYAMLConfig()
 	defaultValue := IsAutoWarmIndexesEnabled()
@@ -244,10 +259,43 @@ func TestGetWarmIndexesAfterNBlocks(t *testing.T) {
 	assert.Equal(t, 10, updatedValue)
 }
 
+func TestGetMVCCValidationWorkersUnset(t *testing.T) {
+	viper.Reset()
+	assert.Equal(t, runtime.NumCPU(), GetMVCCValidationWorkers())
+}
+
+func TestGetMVCCValidationWorkers(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defer ledgertestutil.ResetConfigToDefaultValues()
+	viper.Set("ledger.state.mvccValidationWorkers", 4)
+	assert.Equal(t, 4, GetMVCCValidationWorkers())
+}
+
 func TestGetMaxBlockfileSize(t *testing.T) {
 	assert.Equal(t, 67108864, GetMaxBlockfileSize())
 }
 
+func TestSealedBlockfilesConfigDefault(t *testing.T) {
+	setUpCoreYAMLConfig()
+	assert.False(t, IsSealedBlockfilesCompressionEnabled())
+	assert.Equal(t, "", GetBlockfilesArchivePath())
+	assert.Equal(t, uint64(10000), GetArchiveAfterBlocks())
+}
+
+func TestSealedBlockfilesConfig(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defer ledgertestutil.ResetConfigToDefaultValues()
+	viper.Set("ledger.blockchain.compressSealedBlockfiles", true)
+	viper.Set("ledger.blockchain.archiveDir", "/tmp/hyperledger/archive")
+	viper.Set("ledger.blockchain.archiveAfterBlocks", 100)
+	assert.True(t, IsSealedBlockfilesCompressionEnabled())
+	assert.Equal(t, "/tmp/hyperledger/archive", GetBlockfilesArchivePath())
+	assert.Equal(t, uint64(100), GetArchiveAfterBlocks())
+
+	viper.Set("ledger.blockchain.archiveAfterBlocks", -1)
+	assert.Equal(t, uint64(0), GetArchiveAfterBlocks())
+}
+
 func setUpCoreYAMLConfig() {
 	//call a helper method to load the core.yaml
 	ledgertestutil.SetupCoreYAMLConfig()
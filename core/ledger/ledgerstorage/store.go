@@ -48,8 +48,13 @@ func NewProvider() *Provider {
 		blkstorage.IndexableAttrTxValidationCode,
 	}
 	indexConfig := &blkstorage.IndexConfig{AttrsToIndex: attrsToIndex}
+	sealedBlockfilesConf := fsblkstorage.SealedBlockfilesConf{
+		Compress:           ledgerconfig.IsSealedBlockfilesCompressionEnabled(),
+		ArchiveDir:         ledgerconfig.GetBlockfilesArchivePath(),
+		ArchiveAfterBlocks: ledgerconfig.GetArchiveAfterBlocks(),
+	}
 	blockStoreProvider := fsblkstorage.NewProvider(
-		fsblkstorage.NewConf(ledgerconfig.GetBlockStorePath(), ledgerconfig.GetMaxBlockfileSize()),
+		fsblkstorage.NewConfWithSealedBlockfiles(ledgerconfig.GetBlockStorePath(), ledgerconfig.GetMaxBlockfileSize(), sealedBlockfilesConf),
 		indexConfig)
 
 	pvtStoreProvider := pvtdatastorage.NewProvider()
@@ -86,8 +91,9 @@ func (s *Store) Init(btlPolicy pvtdatapolicy.BTLPolicy) {
 	s.pvtdataStore.Init(btlPolicy)
 }
 
-// CommitWithPvtData commits the block and the corresponding pvt data in an atomic operation
-func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error {
+// CommitWithPvtData commits the block and the corresponding pvt data in an atomic operation.
+// The `purgeMarkers` denote the keys purged by the valid transactions of the block
+func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData, purgeMarkers []*pvtdatastorage.PurgeMarker) error {
 	blockNum := blockAndPvtdata.Block.Header.Number
 	s.rwlock.Lock()
 	defer s.rwlock.Unlock()
@@ -121,7 +127,7 @@ func (s *Store) CommitWithPvtData(blockAndPvtdata *ledger.BlockAndPvtData) error
 		// valid transactions' pvtdata. Hence, it is necessary to rebuild pvtdatastore
 		// along with the blockstore to keep only valid tx data in the pvtdatastore.
 		validTxPvtData, validTxMissingPvtData := constructValidTxPvtDataAndMissingData(blockAndPvtdata)
-		if err := s.pvtdataStore.Prepare(blockAndPvtdata.Block.Header.Number, validTxPvtData, validTxMissingPvtData); err != nil {
+		if err := s.pvtdataStore.Prepare(blockAndPvtdata.Block.Header.Number, validTxPvtData, validTxMissingPvtData, purgeMarkers); err != nil {
 			return err
 		}
 		writtenToPvtStore = true
@@ -178,6 +184,12 @@ func (s *Store) CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPv
 	return nil
 }
 
+// SetPvtdataPurgeListener sets the listener that is notified of the private data writes
+// purged from the underlying pvtdata store
+func (s *Store) SetPvtdataPurgeListener(listener pvtdatastorage.PurgeListener) {
+	s.pvtdataStore.SetPurgeListener(listener)
+}
+
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 // The pvt data is filtered by the list of 'collections' supplied
 func (s *Store) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
@@ -226,6 +238,11 @@ func (s *Store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 	return s.pvtdataStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// GetMissingPvtDataInfoForBlockRange invokes the function on underlying pvtdata store
+func (s *Store) GetMissingPvtDataInfoForBlockRange(startBlock, endBlock uint64) (ledger.MissingPvtDataInfo, error) {
+	return s.pvtdataStore.GetMissingPvtDataInfoForBlockRange(startBlock, endBlock)
+}
+
 // ProcessCollsEligibilityEnabled invokes the function on underlying pvtdata store
 func (s *Store) ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap map[string][]string) error {
 	return s.pvtdataStore.ProcessCollsEligibilityEnabled(committingBlk, nsCollMap)
@@ -23,6 +23,7 @@ import (
 	lutil "github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -46,7 +47,7 @@ func TestStore(t *testing.T) {
 	assert.NoError(t, err)
 	sampleData := sampleDataWithPvtdataForSelectiveTx(t)
 	for _, sampleDatum := range sampleData {
-		assert.NoError(t, store.CommitWithPvtData(sampleDatum))
+		assert.NoError(t, store.CommitWithPvtData(sampleDatum, nil))
 	}
 
 	// block 1 has no pvt data
@@ -154,7 +155,7 @@ func TestStoreWithExistingBlockchain(t *testing.T) {
 
 	// Add one more block with ovtdata associated with one of the trans and commit in the normal course
 	pvtdata := samplePvtData(t, []uint64{0})
-	assert.NoError(t, store.CommitWithPvtData(&ledger.BlockAndPvtData{Block: blockToAdd, PvtData: pvtdata}))
+	assert.NoError(t, store.CommitWithPvtData(&ledger.BlockAndPvtData{Block: blockToAdd, PvtData: pvtdata}, nil))
 	pvtdataBlockHt, err = store.pvtdataStore.LastCommittedBlockHeight()
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(10), pvtdataBlockHt)
@@ -175,7 +176,7 @@ func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 	dataAtCrash := sampleData[3]
 
 	for _, sampleDatum := range dataBeforeCrash {
-		assert.NoError(t, store.CommitWithPvtData(sampleDatum))
+		assert.NoError(t, store.CommitWithPvtData(sampleDatum, nil))
 	}
 	blokNumAtCrash := dataAtCrash.Block.Header.Number
 	var pvtdataAtCrash []*ledger.TxPvtData
@@ -183,7 +184,7 @@ func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 		pvtdataAtCrash = append(pvtdataAtCrash, p)
 	}
 	// Only call Prepare on pvt data store and mimic a crash
-	store.pvtdataStore.Prepare(blokNumAtCrash, pvtdataAtCrash, nil)
+	store.pvtdataStore.Prepare(blokNumAtCrash, pvtdataAtCrash, nil, nil)
 	store.Shutdown()
 	provider.Close()
 	provider = NewProvider()
@@ -197,7 +198,7 @@ func TestCrashAfterPvtdataStorePreparation(t *testing.T) {
 	assert.True(t, ok)
 
 	//we should be able to write the last block again
-	assert.NoError(t, store.CommitWithPvtData(dataAtCrash))
+	assert.NoError(t, store.CommitWithPvtData(dataAtCrash, nil))
 	pvtdata, err := store.GetPvtDataByNum(blokNumAtCrash, nil)
 	assert.NoError(t, err)
 	constructed := constructPvtdataMap(pvtdata)
@@ -230,7 +231,7 @@ func TestCrashBeforePvtdataStoreCommit(t *testing.T) {
 	dataAtCrash := sampleData[3]
 
 	for _, sampleDatum := range dataBeforeCrash {
-		assert.NoError(t, store.CommitWithPvtData(sampleDatum))
+		assert.NoError(t, store.CommitWithPvtData(sampleDatum, nil))
 	}
 	blokNumAtCrash := dataAtCrash.Block.Header.Number
 	var pvtdataAtCrash []*ledger.TxPvtData
@@ -240,7 +241,7 @@ func TestCrashBeforePvtdataStoreCommit(t *testing.T) {
 
 	// Mimic a crash just short of calling the final commit on pvtdata store
 	// After starting the store again, the block and the pvtdata should be available
-	store.pvtdataStore.Prepare(blokNumAtCrash, pvtdataAtCrash, nil)
+	store.pvtdataStore.Prepare(blokNumAtCrash, pvtdataAtCrash, nil, nil)
 	store.BlockStore.AddBlock(dataAtCrash.Block)
 	store.Shutdown()
 	provider.Close()
@@ -266,11 +267,11 @@ func TestAddAfterPvtdataStoreError(t *testing.T) {
 
 	sampleData := sampleDataWithPvtdataForAllTxs(t)
 	for _, d := range sampleData[0:9] {
-		assert.NoError(t, store.CommitWithPvtData(d))
+		assert.NoError(t, store.CommitWithPvtData(d, nil))
 	}
 	// try to write the last block again. The function should skip adding block to the private store
 	// as the pvt store but the block storage should return error
-	assert.Error(t, store.CommitWithPvtData(sampleData[8]))
+	assert.Error(t, store.CommitWithPvtData(sampleData[8], nil))
 
 	// At the end, the pvt store status should not have changed
 	pvtStoreCommitHt, err := store.pvtdataStore.LastCommittedBlockHeight()
@@ -281,7 +282,7 @@ func TestAddAfterPvtdataStoreError(t *testing.T) {
 	assert.False(t, pvtStorePndingBatch)
 
 	// commit the rightful next block
-	assert.NoError(t, store.CommitWithPvtData(sampleData[9]))
+	assert.NoError(t, store.CommitWithPvtData(sampleData[9], nil))
 	pvtStoreCommitHt, err = store.pvtdataStore.LastCommittedBlockHeight()
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(10), pvtStoreCommitHt)
@@ -303,13 +304,13 @@ func TestAddAfterBlkStoreError(t *testing.T) {
 
 	sampleData := sampleDataWithPvtdataForAllTxs(t)
 	for _, d := range sampleData[0:9] {
-		assert.NoError(t, store.CommitWithPvtData(d))
+		assert.NoError(t, store.CommitWithPvtData(d, nil))
 	}
 	lastBlkAndPvtData := sampleData[9]
 	// Add the block directly to blockstore
 	store.BlockStore.AddBlock(lastBlkAndPvtData.Block)
 	// Adding the same block should cause passing on the error caused by the block storgae
-	assert.Error(t, store.CommitWithPvtData(lastBlkAndPvtData))
+	assert.Error(t, store.CommitWithPvtData(lastBlkAndPvtData, nil))
 	// At the end, the pvt store status should not have changed
 	pvtStoreCommitHt, err := store.pvtdataStore.LastCommittedBlockHeight()
 	assert.NoError(t, err)
@@ -367,6 +368,13 @@ func sampleDataWithPvtdataForAllTxs(t *testing.T) []*ledger.BlockAndPvtData {
 }
 
 func samplePvtData(t *testing.T, txNums []uint64) map[uint64]*ledger.TxPvtData {
+	sampleRWSet := func(key string) []byte {
+		rwsetBytes, err := proto.Marshal(&kvrwset.KVRWSet{
+			Writes: []*kvrwset.KVWrite{{Key: key, Value: []byte("value-" + key)}},
+		})
+		assert.NoError(t, err)
+		return rwsetBytes
+	}
 	pvtWriteSet := &rwset.TxPvtReadWriteSet{DataModel: rwset.TxReadWriteSet_KV}
 	pvtWriteSet.NsPvtRwset = []*rwset.NsPvtReadWriteSet{
 		{
@@ -374,11 +382,11 @@ func samplePvtData(t *testing.T, txNums []uint64) map[uint64]*ledger.TxPvtData {
 			CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
 				{
 					CollectionName: "coll-1",
-					Rwset:          []byte("RandomBytes-PvtRWSet-ns1-coll1"),
+					Rwset:          sampleRWSet("key-ns1-coll1"),
 				},
 				{
 					CollectionName: "coll-2",
-					Rwset:          []byte("RandomBytes-PvtRWSet-ns1-coll2"),
+					Rwset:          sampleRWSet("key-ns1-coll2"),
 				},
 			},
 		},
@@ -9,9 +9,14 @@ package pvtdatastorage
 import (
 	"math"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/pkg/errors"
 	"github.com/willf/bitset"
 )
 
@@ -158,6 +163,171 @@ func deriveKeys(expiryEntry *expiryEntry) (dataKeys []*dataKey, missingDataKeys
 	return
 }
 
+type nsColl struct {
+	ns, coll string
+}
+
+type purgedKey struct {
+	ns, coll, keyHash string
+}
+
+// indexPurgeMarkers maps each key purged in a block to the highest
+// transaction number, within the block, that purges the key
+func indexPurgeMarkers(purgeMarkers []*PurgeMarker) map[purgedKey]uint64 {
+	purgedKeys := make(map[purgedKey]uint64)
+	for _, m := range purgeMarkers {
+		k := purgedKey{m.Namespace, m.Collection, string(m.KeyHash)}
+		if txNum, ok := purgedKeys[k]; !ok || txNum < m.TxNum {
+			purgedKeys[k] = m.TxNum
+		}
+	}
+	return purgedKeys
+}
+
+// newPurgedWrite returns the write of the given key by the given data entry
+func newPurgedWrite(key *dataKey, k string) *PurgedWrite {
+	return &PurgedWrite{
+		Namespace:  key.ns,
+		Collection: key.coll,
+		Key:        k,
+		BlockNum:   key.blkNum,
+		TxNum:      key.txNum,
+	}
+}
+
+// writesOfDataEntry returns the writes of the keys in the given data entry
+func writesOfDataEntry(key *dataKey, collPvtWset *rwset.CollectionPvtReadWriteSet) ([]*PurgedWrite, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtWset.Rwset, kvRWSet); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var writes []*PurgedWrite
+	for _, w := range kvRWSet.Writes {
+		writes = append(writes, newPurgedWrite(key, w.Key))
+	}
+	return writes, nil
+}
+
+// removeWritesPurgedInBlock removes, from the data entries of a block, the writes
+// of the keys that are purged by a later transaction of the same block
+func removeWritesPurgedInBlock(dataEntries []*dataEntry, purgedKeys map[purgedKey]uint64) error {
+	if len(purgedKeys) == 0 {
+		return nil
+	}
+	for _, dataEntry := range dataEntries {
+		key := dataEntry.key
+		filtered, _, err := removePurgedWrites(dataEntry.value, func(k string) (bool, error) {
+			purgingTxNum, ok := purgedKeys[purgedKey{key.ns, key.coll, string(util.ComputeStringHash(k))}]
+			return ok && key.txNum < purgingTxNum, nil
+		})
+		if err != nil {
+			return err
+		}
+		if filtered != nil {
+			dataEntry.value = filtered
+		}
+	}
+	return nil
+}
+
+// removePurgedWrites returns a copy of the given collection pvt write set that does not contain the
+// writes (and metadata writes) of the keys for which the function `isPurged` returns true. A nil
+// write set is returned if no write is removed. The returned boolean indicates whether
+// the returned write set is left with no write at all
+func removePurgedWrites(collPvtWset *rwset.CollectionPvtReadWriteSet,
+	isPurged func(key string) (bool, error)) (*rwset.CollectionPvtReadWriteSet, bool, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtWset.Rwset, kvRWSet); err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+
+	removed := false
+	var writes []*kvrwset.KVWrite
+	for _, w := range kvRWSet.Writes {
+		purged, err := isPurged(w.Key)
+		if err != nil {
+			return nil, false, err
+		}
+		if purged {
+			removed = true
+			continue
+		}
+		writes = append(writes, w)
+	}
+	var metadataWrites []*kvrwset.KVMetadataWrite
+	for _, w := range kvRWSet.MetadataWrites {
+		purged, err := isPurged(w.Key)
+		if err != nil {
+			return nil, false, err
+		}
+		if purged {
+			removed = true
+			continue
+		}
+		metadataWrites = append(metadataWrites, w)
+	}
+	if !removed {
+		return nil, false, nil
+	}
+
+	kvRWSet.Writes = writes
+	kvRWSet.MetadataWrites = metadataWrites
+	rwsetBytes, err := proto.Marshal(kvRWSet)
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	filtered := &rwset.CollectionPvtReadWriteSet{
+		CollectionName: collPvtWset.CollectionName,
+		Rwset:          rwsetBytes,
+	}
+	return filtered, len(writes) == 0 && len(metadataWrites) == 0, nil
+}
+
+// keyHashIndexKeys returns the keys of the entries of the key hash index for
+// the keys written, or whose metadata is written, in the given data entry
+func keyHashIndexKeys(key *dataKey, collPvtWset *rwset.CollectionPvtReadWriteSet) ([][]byte, error) {
+	kvRWSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtWset.Rwset, kvRWSet); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var indexKeys [][]byte
+	for _, w := range kvRWSet.Writes {
+		indexKeys = append(indexKeys,
+			encodeKeyHashIndexKey(key.ns, key.coll, util.ComputeStringHash(w.Key), key.blkNum, key.txNum))
+	}
+	for _, w := range kvRWSet.MetadataWrites {
+		indexKeys = append(indexKeys,
+			encodeKeyHashIndexKey(key.ns, key.coll, util.ComputeStringHash(w.Key), key.blkNum, key.txNum))
+	}
+	return indexKeys, nil
+}
+
+// addKeyHashIndexEntriesToBatch adds to the batch the entries of
+// the key hash index for the keys written in the given data entry
+func addKeyHashIndexEntriesToBatch(batch *leveldbhelper.UpdateBatch, key *dataKey, collPvtWset *rwset.CollectionPvtReadWriteSet) error {
+	indexKeys, err := keyHashIndexKeys(key, collPvtWset)
+	if err != nil {
+		return err
+	}
+	for _, indexKey := range indexKeys {
+		batch.Put(indexKey, emptyValue)
+	}
+	return nil
+}
+
+// removeKeyHashIndexEntriesFromBatch adds to the batch the removal of the
+// entries of the key hash index for the keys written in the given data entry
+func removeKeyHashIndexEntriesFromBatch(batch *leveldbhelper.UpdateBatch, key *dataKey, collPvtWset *rwset.CollectionPvtReadWriteSet) error {
+	indexKeys, err := keyHashIndexKeys(key, collPvtWset)
+	if err != nil {
+		return err
+	}
+	for _, indexKey := range indexKeys {
+		batch.Delete(indexKey)
+	}
+	return nil
+}
+
 func passesFilter(dataKey *dataKey, filter ledger.PvtNsCollFilter) bool {
 	return filter == nil || filter.Has(dataKey.ns, dataKey.coll)
 }
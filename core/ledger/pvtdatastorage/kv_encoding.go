@@ -27,6 +27,10 @@ var (
 	ineligibleMissingDataKeyPrefix = []byte{5}
 	collElgKeyPrefix               = []byte{6}
 	lastUpdatedOldBlocksKey        = []byte{7}
+	pendingPurgeMarkersKey         = []byte{8}
+	purgeMarkerKeyPrefix           = []byte{9}
+	keyHashIndexKeyPrefix          = []byte{10}
+	keyHashIndexBuiltKey           = []byte{11}
 
 	nilByte    = byte(0)
 	emptyValue = []byte{}
@@ -101,6 +105,12 @@ func decodeDataValue(datavalueBytes []byte) (*rwset.CollectionPvtReadWriteSet, e
 	return collPvtdata, err
 }
 
+func getDataKeysForRangeScanBeforeBlockNum(blockNum uint64) (startKey, endKey []byte) {
+	startKey = append(pvtDataKeyPrefix, version.NewHeight(0, 0).ToBytes()...)
+	endKey = append(pvtDataKeyPrefix, version.NewHeight(blockNum, 0).ToBytes()...)
+	return
+}
+
 func encodeMissingDataKey(key *missingDataKey) []byte {
 	if key.isEligible {
 		keyBytes := append(eligibleMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(key.blkNum)...)
@@ -177,6 +187,18 @@ func createRangeScanKeysForEligibleMissingDataEntries(blkNum uint64) (startKey,
 	return startKey, endKey
 }
 
+func createRangeScanKeysForEligibleMissingDataEntriesInRange(startBlkNum, endBlkNum uint64) (startKey, endKey []byte) {
+	// the entries are ordered by descending block number and the genesis block never
+	// has private data, hence the scan stops at the key of the block preceding startBlkNum
+	if startBlkNum == 0 {
+		startBlkNum = 1
+	}
+	startKey = append(eligibleMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(endBlkNum)...)
+	endKey = append(eligibleMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(startBlkNum-1)...)
+
+	return startKey, endKey
+}
+
 func createRangeScanKeysForIneligibleMissingData(maxBlkNum uint64, ns, coll string) (startKey, endKey []byte) {
 	startKey = encodeMissingDataKey(
 		&missingDataKey{
@@ -209,3 +231,58 @@ func eligibleMissingdatakeyRange(blkNum uint64) (startKey, endKey []byte) {
 	endKey = append(eligibleMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(blkNum-1)...)
 	return
 }
+
+func encodePurgeMarkersValue(purgeMarkers []*PurgeMarker) ([]byte, error) {
+	return proto.Marshal(&PurgeMarkers{List: purgeMarkers})
+}
+
+func decodePurgeMarkersValue(b []byte) ([]*PurgeMarker, error) {
+	m := &PurgeMarkers{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return m.List, nil
+}
+
+func encodePurgeMarkerKey(ns, coll string, keyHash []byte) []byte {
+	keyBytes := append(purgeMarkerKeyPrefix, []byte(ns)...)
+	keyBytes = append(keyBytes, nilByte)
+	keyBytes = append(keyBytes, []byte(coll)...)
+	keyBytes = append(keyBytes, nilByte)
+	return append(keyBytes, keyHash...)
+}
+
+func encodePurgeMarkerValue(purgeHeight *version.Height) []byte {
+	return purgeHeight.ToBytes()
+}
+
+func decodePurgeMarkerValue(b []byte) *version.Height {
+	purgeHeight, _ := version.NewHeightFromBytes(b)
+	return purgeHeight
+}
+
+// encodeKeyHashIndexKey encodes the key of the entry of the key hash index that records
+// that the data entry <blkNum, txNum, ns, coll> contains a write of the key with the given hash
+func encodeKeyHashIndexKey(ns, coll string, keyHash []byte, blkNum, txNum uint64) []byte {
+	keyBytes := append([]byte{}, keyHashIndexKeyPrefix...)
+	keyBytes = append(keyBytes, []byte(ns)...)
+	keyBytes = append(keyBytes, nilByte)
+	keyBytes = append(keyBytes, []byte(coll)...)
+	keyBytes = append(keyBytes, nilByte)
+	keyBytes = append(keyBytes, keyHash...)
+	return append(keyBytes, version.NewHeight(blkNum, txNum).ToBytes()...)
+}
+
+// decodeKeyHashIndexKey returns the <blkNum, txNum> of the data entry referred
+// to by the given key of the key hash index of the given key hash
+func decodeKeyHashIndexKey(ns, coll string, keyHash []byte, keyBytes []byte) (blkNum, txNum uint64) {
+	prefixLen := len(keyHashIndexKeyPrefix) + len(ns) + len(coll) + len(keyHash) + 2
+	height, _ := version.NewHeightFromBytes(keyBytes[prefixLen:])
+	return height.BlockNum, height.TxNum
+}
+
+func getKeyHashIndexKeysForRangeScanBeforeBlockNum(ns, coll string, keyHash []byte, blockNum uint64) (startKey, endKey []byte) {
+	startKey = encodeKeyHashIndexKey(ns, coll, keyHash, 0, 0)
+	endKey = encodeKeyHashIndexKey(ns, coll, keyHash, blockNum, 0)
+	return
+}
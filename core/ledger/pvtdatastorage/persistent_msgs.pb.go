@@ -29,7 +29,7 @@ func (m *ExpiryData) Reset()         { *m = ExpiryData{} }
 func (m *ExpiryData) String() string { return proto.CompactTextString(m) }
 func (*ExpiryData) ProtoMessage()    {}
 func (*ExpiryData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_persistent_msgs_f79691cf3913ef09, []int{0}
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{0}
 }
 func (m *ExpiryData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpiryData.Unmarshal(m, b)
@@ -72,7 +72,7 @@ func (m *Collections) Reset()         { *m = Collections{} }
 func (m *Collections) String() string { return proto.CompactTextString(m) }
 func (*Collections) ProtoMessage()    {}
 func (*Collections) Descriptor() ([]byte, []int) {
-	return fileDescriptor_persistent_msgs_f79691cf3913ef09, []int{1}
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{1}
 }
 func (m *Collections) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Collections.Unmarshal(m, b)
@@ -117,7 +117,7 @@ func (m *TxNums) Reset()         { *m = TxNums{} }
 func (m *TxNums) String() string { return proto.CompactTextString(m) }
 func (*TxNums) ProtoMessage()    {}
 func (*TxNums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_persistent_msgs_f79691cf3913ef09, []int{2}
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{2}
 }
 func (m *TxNums) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TxNums.Unmarshal(m, b)
@@ -155,7 +155,7 @@ func (m *CollElgInfo) Reset()         { *m = CollElgInfo{} }
 func (m *CollElgInfo) String() string { return proto.CompactTextString(m) }
 func (*CollElgInfo) ProtoMessage()    {}
 func (*CollElgInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_persistent_msgs_f79691cf3913ef09, []int{3}
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{3}
 }
 func (m *CollElgInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollElgInfo.Unmarshal(m, b)
@@ -193,7 +193,7 @@ func (m *CollNames) Reset()         { *m = CollNames{} }
 func (m *CollNames) String() string { return proto.CompactTextString(m) }
 func (*CollNames) ProtoMessage()    {}
 func (*CollNames) Descriptor() ([]byte, []int) {
-	return fileDescriptor_persistent_msgs_f79691cf3913ef09, []int{4}
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{4}
 }
 func (m *CollNames) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CollNames.Unmarshal(m, b)
@@ -220,6 +220,108 @@ func (m *CollNames) GetEntries() []string {
 	return nil
 }
 
+type PurgeMarkers struct {
+	List                 []*PurgeMarker `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PurgeMarkers) Reset()         { *m = PurgeMarkers{} }
+func (m *PurgeMarkers) String() string { return proto.CompactTextString(m) }
+func (*PurgeMarkers) ProtoMessage()    {}
+func (*PurgeMarkers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{5}
+}
+func (m *PurgeMarkers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMarkers.Unmarshal(m, b)
+}
+func (m *PurgeMarkers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMarkers.Marshal(b, m, deterministic)
+}
+func (dst *PurgeMarkers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMarkers.Merge(dst, src)
+}
+func (m *PurgeMarkers) XXX_Size() int {
+	return xxx_messageInfo_PurgeMarkers.Size(m)
+}
+func (m *PurgeMarkers) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMarkers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMarkers proto.InternalMessageInfo
+
+func (m *PurgeMarkers) GetList() []*PurgeMarker {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+// PurgeMarker denotes that the transaction `tx_num` purges all the versions of the
+// key, identified by `key_hash`, committed before it in the collection <namespace, collection>
+type PurgeMarker struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	KeyHash              []byte   `protobuf:"bytes,3,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	TxNum                uint64   `protobuf:"varint,4,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeMarker) Reset()         { *m = PurgeMarker{} }
+func (m *PurgeMarker) String() string { return proto.CompactTextString(m) }
+func (*PurgeMarker) ProtoMessage()    {}
+func (*PurgeMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_persistent_msgs_d125f03fd3a9f94c, []int{6}
+}
+func (m *PurgeMarker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeMarker.Unmarshal(m, b)
+}
+func (m *PurgeMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeMarker.Marshal(b, m, deterministic)
+}
+func (dst *PurgeMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeMarker.Merge(dst, src)
+}
+func (m *PurgeMarker) XXX_Size() int {
+	return xxx_messageInfo_PurgeMarker.Size(m)
+}
+func (m *PurgeMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeMarker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeMarker proto.InternalMessageInfo
+
+func (m *PurgeMarker) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PurgeMarker) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *PurgeMarker) GetKeyHash() []byte {
+	if m != nil {
+		return m.KeyHash
+	}
+	return nil
+}
+
+func (m *PurgeMarker) GetTxNum() uint64 {
+	if m != nil {
+		return m.TxNum
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ExpiryData)(nil), "pvtdatastorage.ExpiryData")
 	proto.RegisterMapType((map[string]*Collections)(nil), "pvtdatastorage.ExpiryData.MapEntry")
@@ -230,37 +332,44 @@ func init() {
 	proto.RegisterType((*CollElgInfo)(nil), "pvtdatastorage.CollElgInfo")
 	proto.RegisterMapType((map[string]*CollNames)(nil), "pvtdatastorage.CollElgInfo.NsCollMapEntry")
 	proto.RegisterType((*CollNames)(nil), "pvtdatastorage.CollNames")
+	proto.RegisterType((*PurgeMarkers)(nil), "pvtdatastorage.PurgeMarkers")
+	proto.RegisterType((*PurgeMarker)(nil), "pvtdatastorage.PurgeMarker")
 }
 
 func init() {
-	proto.RegisterFile("persistent_msgs.proto", fileDescriptor_persistent_msgs_f79691cf3913ef09)
-}
-
-var fileDescriptor_persistent_msgs_f79691cf3913ef09 = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xdf, 0x6a, 0xdb, 0x30,
-	0x14, 0xc6, 0xb1, 0x93, 0x65, 0xf1, 0x09, 0x84, 0xa1, 0xfd, 0xc1, 0xf3, 0x76, 0x11, 0xb2, 0x0d,
-	0xc2, 0x18, 0x36, 0xcb, 0xd8, 0x08, 0xb9, 0x5b, 0xdb, 0x40, 0x7b, 0x11, 0x5f, 0xb8, 0x85, 0x40,
-	0x6f, 0x8a, 0xe2, 0x28, 0x8e, 0xa8, 0x6d, 0x09, 0x49, 0x09, 0xf1, 0x9b, 0xf4, 0x31, 0xda, 0x37,
-	0x2c, 0xb6, 0xf3, 0xc7, 0x0a, 0x26, 0x77, 0xf2, 0xd1, 0x77, 0x7e, 0xe7, 0x3b, 0x1f, 0x16, 0x7c,
-	0xe4, 0x44, 0x48, 0x2a, 0x15, 0x49, 0xd5, 0x43, 0x22, 0x23, 0xe9, 0x72, 0xc1, 0x14, 0x43, 0x5d,
-	0xbe, 0x51, 0x0b, 0xac, 0xb0, 0x54, 0x4c, 0xe0, 0x88, 0xf4, 0x9f, 0x0c, 0x80, 0xc9, 0x96, 0x53,
-	0x91, 0x5d, 0x61, 0x85, 0xd1, 0x5f, 0x68, 0x24, 0x98, 0xdb, 0x46, 0xaf, 0x31, 0xe8, 0x0c, 0xbf,
-	0xb9, 0xba, 0xd8, 0x3d, 0x0a, 0xdd, 0x29, 0xe6, 0x93, 0x54, 0x89, 0x2c, 0xc8, 0xf5, 0xce, 0x2d,
-	0xb4, 0xf7, 0x05, 0xf4, 0x0e, 0x1a, 0x8f, 0x24, 0xb3, 0x8d, 0x9e, 0x31, 0xb0, 0x82, 0xfc, 0x88,
-	0x7e, 0xc3, 0x9b, 0x0d, 0x8e, 0xd7, 0xc4, 0x36, 0x7b, 0xc6, 0xa0, 0x33, 0xfc, 0x72, 0x8a, 0xbd,
-	0x64, 0x71, 0x4c, 0x42, 0x45, 0x59, 0x2a, 0x83, 0x52, 0x39, 0x36, 0x47, 0x46, 0xff, 0xc5, 0x84,
-	0x4e, 0xe5, 0x0a, 0xfd, 0xab, 0x7a, 0xfb, 0x7e, 0x06, 0xa2, 0x9b, 0x43, 0x33, 0xe8, 0x26, 0x54,
-	0x4a, 0x9a, 0x46, 0xb9, 0xf3, 0x29, 0xe6, 0xb6, 0x59, 0x20, 0xbc, 0xb3, 0x08, 0xad, 0xa3, 0xa4,
-	0x9d, 0x60, 0x1c, 0xff, 0xec, 0xd6, 0xbf, 0xf4, 0xad, 0x3f, 0x9d, 0x4e, 0xbb, 0xdb, 0xfa, 0xeb,
-	0xa4, 0xba, 0xb0, 0xf3, 0x1f, 0xde, 0xd7, 0x8c, 0xad, 0x41, 0x7f, 0xa8, 0xa2, 0xdb, 0xd5, 0xcc,
-	0xbe, 0x42, 0xab, 0xe4, 0x22, 0x04, 0xcd, 0x98, 0x4a, 0x55, 0xc4, 0xd5, 0x0c, 0x8a, 0x73, 0xff,
-	0xd9, 0x28, 0x13, 0x9d, 0xc4, 0xd1, 0x4d, 0xba, 0x64, 0xe8, 0x1a, 0xac, 0x54, 0xe6, 0x85, 0xe9,
-	0x21, 0xd7, 0x9f, 0x75, 0xa1, 0xec, 0xf4, 0xae, 0xbf, 0x17, 0x97, 0x79, 0x1c, 0x9b, 0x9d, 0x19,
-	0x74, 0xf5, 0xcb, 0x1a, 0xd7, 0x9e, 0x1e, 0xc8, 0xe7, 0xba, 0x49, 0x3e, 0x4e, 0x88, 0xf6, 0x13,
-	0xfc, 0x00, 0xeb, 0x50, 0x47, 0x36, 0xbc, 0x25, 0xa9, 0x12, 0x94, 0xc8, 0xc2, 0xad, 0x15, 0xec,
-	0x3f, 0x2f, 0xc6, 0xf7, 0xa3, 0x88, 0xaa, 0xd5, 0x7a, 0xee, 0x86, 0x2c, 0xf1, 0x56, 0x19, 0x27,
-	0x22, 0x26, 0x8b, 0x88, 0x08, 0x6f, 0x89, 0xe7, 0x82, 0x86, 0x5e, 0xc8, 0x04, 0xf1, 0x76, 0x25,
-	0x7d, 0xee, 0xbc, 0x55, 0xbc, 0x8c, 0x3f, 0xaf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x3e, 0x75,
-	0xee, 0x32, 0x03, 0x00, 0x00,
+	proto.RegisterFile("persistent_msgs.proto", fileDescriptor_persistent_msgs_d125f03fd3a9f94c)
+}
+
+var fileDescriptor_persistent_msgs_d125f03fd3a9f94c = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x5d, 0x8b, 0xd3, 0x40,
+	0x14, 0x25, 0x6d, 0x77, 0xb7, 0xb9, 0x5d, 0x8a, 0x8c, 0xae, 0x64, 0xeb, 0x22, 0xa1, 0x2a, 0x04,
+	0x91, 0x04, 0x57, 0x94, 0x65, 0x5f, 0xc4, 0x8f, 0xc2, 0xfa, 0xd0, 0x22, 0x51, 0x58, 0xf0, 0xa5,
+	0x4c, 0xb3, 0x77, 0x93, 0xd0, 0x64, 0x32, 0xcc, 0x4c, 0x96, 0xe6, 0xc1, 0xff, 0xe1, 0xcf, 0xd0,
+	0x7f, 0x28, 0x49, 0xfa, 0x31, 0x29, 0xa1, 0x6f, 0xd3, 0x33, 0x67, 0xce, 0x3d, 0xe7, 0xf4, 0x06,
+	0xce, 0x38, 0x0a, 0x19, 0x4b, 0x85, 0x4c, 0xcd, 0x53, 0x19, 0x4a, 0x97, 0x8b, 0x4c, 0x65, 0x64,
+	0xc8, 0x1f, 0xd4, 0x1d, 0x55, 0x54, 0xaa, 0x4c, 0xd0, 0x10, 0xc7, 0x7f, 0x0c, 0x80, 0xc9, 0x8a,
+	0xc7, 0xa2, 0xf8, 0x4a, 0x15, 0x25, 0xef, 0xa1, 0x9b, 0x52, 0x6e, 0x19, 0x76, 0xd7, 0x19, 0x5c,
+	0xbe, 0x70, 0x9b, 0x64, 0x77, 0x47, 0x74, 0xa7, 0x94, 0x4f, 0x98, 0x12, 0x85, 0x5f, 0xf2, 0x47,
+	0x3f, 0xa0, 0xbf, 0x01, 0xc8, 0x23, 0xe8, 0x2e, 0xb1, 0xb0, 0x0c, 0xdb, 0x70, 0x4c, 0xbf, 0x3c,
+	0x92, 0xb7, 0x70, 0xf4, 0x40, 0x93, 0x1c, 0xad, 0x8e, 0x6d, 0x38, 0x83, 0xcb, 0x67, 0xfb, 0xb2,
+	0x5f, 0xb2, 0x24, 0xc1, 0x40, 0xc5, 0x19, 0x93, 0x7e, 0xcd, 0xbc, 0xee, 0x5c, 0x19, 0xe3, 0x7f,
+	0x1d, 0x18, 0x68, 0x57, 0xe4, 0x83, 0xee, 0xed, 0xe5, 0x01, 0x91, 0xa6, 0x39, 0x72, 0x0b, 0xc3,
+	0x34, 0x96, 0x32, 0x66, 0x61, 0xe9, 0x7c, 0x4a, 0xb9, 0xd5, 0xa9, 0x24, 0xbc, 0x83, 0x12, 0x8d,
+	0x17, 0xb5, 0xda, 0x9e, 0xcc, 0x68, 0x76, 0x30, 0xf5, 0x9b, 0x66, 0xea, 0xa7, 0xfb, 0xd3, 0x7e,
+	0xae, 0x66, 0x79, 0xaa, 0x07, 0x1e, 0x7d, 0x82, 0xc7, 0x2d, 0x63, 0x5b, 0xa4, 0x9f, 0xe8, 0xd2,
+	0x7d, 0xbd, 0xb3, 0x0b, 0x38, 0xae, 0x75, 0x09, 0x81, 0x5e, 0x12, 0x4b, 0x55, 0xd5, 0xd5, 0xf3,
+	0xab, 0xf3, 0xf8, 0xaf, 0x51, 0x37, 0x3a, 0x49, 0xc2, 0x6f, 0xec, 0x3e, 0x23, 0x37, 0x60, 0x32,
+	0x59, 0x02, 0xd3, 0x6d, 0xaf, 0xaf, 0xdb, 0x4a, 0x59, 0xf3, 0xdd, 0xd9, 0x86, 0x5c, 0xf7, 0xb1,
+	0x7b, 0x3c, 0xba, 0x85, 0x61, 0xf3, 0xb2, 0xc5, 0xb5, 0xd7, 0x2c, 0xe4, 0xbc, 0x6d, 0xd2, 0x8c,
+	0xa6, 0xd8, 0x58, 0x82, 0x57, 0x60, 0x6e, 0x71, 0x62, 0xc1, 0x09, 0x32, 0x25, 0x62, 0x94, 0x95,
+	0x5b, 0xd3, 0xdf, 0xfc, 0x1c, 0x7f, 0x84, 0xd3, 0xef, 0xb9, 0x08, 0x71, 0x4a, 0xc5, 0x12, 0x85,
+	0x24, 0x9e, 0x96, 0xbe, 0x65, 0xe3, 0x34, 0xee, 0xba, 0x9a, 0xdf, 0x30, 0xd0, 0x40, 0x72, 0x01,
+	0x26, 0x2b, 0x47, 0x72, 0x1a, 0xe0, 0x3a, 0xc3, 0x0e, 0x20, 0xcf, 0x01, 0x82, 0xed, 0xae, 0x54,
+	0x71, 0x4c, 0x5f, 0x43, 0xc8, 0x39, 0xf4, 0x97, 0x58, 0xcc, 0x23, 0x2a, 0x23, 0xab, 0x6b, 0x1b,
+	0xce, 0xa9, 0x7f, 0xb2, 0xc4, 0xe2, 0x86, 0xca, 0x88, 0x9c, 0xc1, 0xb1, 0x5a, 0xcd, 0x59, 0x9e,
+	0x5a, 0x3d, 0xdb, 0x70, 0x7a, 0xfe, 0x91, 0x2a, 0xff, 0xae, 0xcf, 0xd7, 0xbf, 0xae, 0xc2, 0x58,
+	0x45, 0xf9, 0xc2, 0x0d, 0xb2, 0xd4, 0x8b, 0x0a, 0x8e, 0x22, 0xc1, 0xbb, 0x10, 0x85, 0x77, 0x4f,
+	0x17, 0x22, 0x0e, 0xbc, 0x20, 0x13, 0xe8, 0xad, 0xa1, 0x66, 0x98, 0xc5, 0x71, 0xf5, 0x65, 0xbf,
+	0xfb, 0x3f, 0x00, 0x2d, 0xf0, 0xa5, 0x05, 0xf2, 0x03, 0x00, 0x00,
 }
@@ -31,6 +31,10 @@ type Provider interface {
 type Store interface {
 	// Init initializes the store. This function is expected to be invoked before using the store
 	Init(btlPolicy pvtdatapolicy.BTLPolicy)
+	// SetPurgeListener sets the listener that is notified of the writes removed from the store, either
+	// because their data expired or because their keys were purged, once the removal is committed.
+	// This function is expected to be invoked before committing any block
+	SetPurgeListener(listener PurgeListener)
 	// InitLastCommittedBlockHeight sets the last commited block height into the pvt data store
 	// This function is used in a special case where the peer is started up with the blockchain
 	// from an earlier version of a peer when the pvt data feature (and hence this store) was not
@@ -46,6 +50,9 @@ type Store interface {
 	// GetMissingPvtDataInfoForMostRecentBlocks returns the missing private data information for the
 	// most recent `maxBlock` blocks which miss at least a private data of a eligible collection.
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.MissingPvtDataInfo, error)
+	// GetMissingPvtDataInfoForBlockRange returns the missing private data information of eligible
+	// collections for the blocks in the range [startBlock, endBlock]
+	GetMissingPvtDataInfoForBlockRange(startBlock, endBlock uint64) (ledger.MissingPvtDataInfo, error)
 	// Prepare prepares the Store for commiting the pvt data and storing both eligible and ineligible
 	// missing private data --- `eligible` denotes that the missing private data belongs to a collection
 	// for which this peer is a member; `ineligible` denotes that the missing private data belong to a
@@ -54,8 +61,11 @@ type Store interface {
 	// is expected to call either `Commit` or `Rollback` function. Return from this should ensure
 	// that enough preparation is done such that `Commit` function invoked afterwards can commit the
 	// data and the store is capable of surviving a crash between this function call and the next
-	// invoke to the `Commit`
-	Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvtData ledger.TxMissingPvtDataMap) error
+	// invoke to the `Commit`.
+	// The `purgeMarkers` denote the keys purged by the valid transactions of the block. On `Commit`, all
+	// the writes of these keys, committed prior to the purging transaction, are removed from the store
+	Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvtData ledger.TxMissingPvtDataMap,
+		purgeMarkers []*PurgeMarker) error
 	// Commit commits the pvt data passed in the previous invoke to the `Prepare` function
 	Commit() error
 	// Rollback rolls back the pvt data passed in the previous invoke to the `Prepare` function
@@ -86,6 +96,19 @@ type Store interface {
 	Shutdown()
 }
 
+// PurgedWrite identifies a write of a private data key that has been removed
+// from the store, either because its data expired or because the key was purged
+type PurgedWrite struct {
+	Namespace  string
+	Collection string
+	Key        string
+	BlockNum   uint64
+	TxNum      uint64
+}
+
+// PurgeListener is notified of the writes removed from the store
+type PurgeListener func(purgedWrites []*PurgedWrite)
+
 // ErrIllegalCall is to be thrown by a store impl if the store does not expect a call to Prepare/Commit/Rollback/InitLastCommittedBlock
 type ErrIllegalCall struct {
 	msg string
@@ -17,8 +17,10 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/willf/bitset"
 )
@@ -39,6 +41,7 @@ type store struct {
 	batchPending       bool
 	purgerLock         sync.Mutex
 	collElgProcSync    *collElgProcSync
+	purgeListener      PurgeListener
 	// After committing the pvtdata of old blocks,
 	// the `isLastUpdatedOldBlocksSet` is set to true.
 	// Once the stateDB is updated with these pvtdata,
@@ -124,6 +127,9 @@ func (p *provider) OpenStore(ledgerid string) (Store, error) {
 	if err := s.initState(); err != nil {
 		return nil, err
 	}
+	if err := s.buildKeyHashIndex(); err != nil {
+		return nil, err
+	}
 	s.launchCollElgProc()
 	logger.Debugf("Pvtdata store opened. Initial state: isEmpty [%t], lastCommittedBlock [%d], batchPending [%t]",
 		s.isEmpty, s.lastCommittedBlock, s.batchPending)
@@ -157,12 +163,75 @@ func (s *store) initState() error {
 	return nil
 }
 
+// buildKeyHashIndex indexes the writes of the data entries committed before the store
+// maintained the key hash index. This is done only the first time the store is opened
+func (s *store) buildKeyHashIndex() error {
+	built, err := s.db.Get(keyHashIndexBuiltKey)
+	if err != nil || built != nil {
+		return err
+	}
+	maxBatchSize := ledgerconfig.GetPvtdataStoreCollElgProcMaxDbBatchSize()
+	batch := leveldbhelper.NewUpdateBatch()
+	itr := s.db.GetIterator(pvtDataKeyPrefix, expiryKeyPrefix)
+	defer itr.Release()
+	numEntries := 0
+	for itr.Next() {
+		var dataKeys []*dataKey
+		var collPvtWsets []*rwset.CollectionPvtReadWriteSet
+		if v11Format(itr.Key()) {
+			pvtWSet, err := v11DecodePvtRwSet(itr.Value())
+			if err != nil {
+				return err
+			}
+			dataKeys, collPvtWsets = v11DataKeys(itr.Key(), pvtWSet)
+		} else {
+			collPvtWset, err := decodeDataValue(itr.Value())
+			if err != nil {
+				return err
+			}
+			dataKeys, collPvtWsets = []*dataKey{decodeDatakey(itr.Key())}, []*rwset.CollectionPvtReadWriteSet{collPvtWset}
+		}
+		for i, dataKey := range dataKeys {
+			if err := addKeyHashIndexEntriesToBatch(batch, dataKey, collPvtWsets[i]); err != nil {
+				return err
+			}
+		}
+		numEntries++
+		if batch.Len() >= maxBatchSize {
+			if err := s.db.WriteBatch(batch, false); err != nil {
+				return err
+			}
+			batch = leveldbhelper.NewUpdateBatch()
+		}
+	}
+	batch.Put(keyHashIndexBuiltKey, emptyValue)
+	if err := s.db.WriteBatch(batch, true); err != nil {
+		return err
+	}
+	logger.Debugf("[%s] Built the key hash index of [%d] private data entries", s.ledgerid, numEntries)
+	return nil
+}
+
 func (s *store) Init(btlPolicy pvtdatapolicy.BTLPolicy) {
 	s.btlPolicy = btlPolicy
 }
 
+// SetPurgeListener implements the function in the interface `Store`
+func (s *store) SetPurgeListener(listener PurgeListener) {
+	s.purgeListener = listener
+}
+
+// notifyPurgeListener notifies the purge listener, if any, of the given removed writes
+func (s *store) notifyPurgeListener(purgedWrites []*PurgedWrite) {
+	if s.purgeListener == nil || len(purgedWrites) == 0 {
+		return
+	}
+	s.purgeListener(purgedWrites)
+}
+
 // Prepare implements the function in the interface `Store`
-func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvtData ledger.TxMissingPvtDataMap) error {
+func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvtData ledger.TxMissingPvtDataMap,
+	purgeMarkers []*PurgeMarker) error {
 	if s.batchPending {
 		return &ErrIllegalCall{`A pending batch exists as as result of last invoke to "Prepare" call.
 			 Invoke "Commit" or "Rollback" on the pending batch before invoking "Prepare" function`}
@@ -181,12 +250,22 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvt
 		return err
 	}
 
+	// the writes purged by a later transaction in the same block are removed right away.
+	// The purge of the writes present in the previous blocks is deferred to the `Commit`
+	// function so that a rollback does not leave the store with the data purged
+	if err := removeWritesPurgedInBlock(storeEntries.dataEntries, indexPurgeMarkers(purgeMarkers)); err != nil {
+		return err
+	}
+
 	for _, dataEntry := range storeEntries.dataEntries {
 		keyBytes = encodeDataKey(dataEntry.key)
 		if valBytes, err = encodeDataValue(dataEntry.value); err != nil {
 			return err
 		}
 		batch.Put(keyBytes, valBytes)
+		if err := addKeyHashIndexEntriesToBatch(batch, dataEntry.key, dataEntry.value); err != nil {
+			return err
+		}
 	}
 
 	for _, expiryEntry := range storeEntries.expiryEntries {
@@ -205,6 +284,13 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvt
 		batch.Put(keyBytes, valBytes)
 	}
 
+	if len(purgeMarkers) > 0 {
+		if valBytes, err = encodePurgeMarkersValue(purgeMarkers); err != nil {
+			return err
+		}
+		batch.Put(pendingPurgeMarkersKey, valBytes)
+	}
+
 	batch.Put(pendingCommitKey, emptyValue)
 	if err := s.db.WriteBatch(batch, true); err != nil {
 		return err
@@ -222,6 +308,10 @@ func (s *store) Commit() error {
 	committingBlockNum := s.nextBlockNum()
 	logger.Debugf("Committing private data for block [%d]", committingBlockNum)
 	batch := leveldbhelper.NewUpdateBatch()
+	purgedWrites, err := s.addPendingPurgesToBatch(committingBlockNum, batch)
+	if err != nil {
+		return err
+	}
 	batch.Delete(pendingCommitKey)
 	batch.Put(lastCommittedBlkkey, encodeLastCommittedBlockVal(committingBlockNum))
 	if err := s.db.WriteBatch(batch, true); err != nil {
@@ -231,6 +321,7 @@ func (s *store) Commit() error {
 	s.isEmpty = false
 	s.lastCommittedBlock = committingBlockNum
 	logger.Debugf("Committed private data for block [%d]", committingBlockNum)
+	s.notifyPurgeListener(purgedWrites)
 	s.performPurgeIfScheduled(committingBlockNum)
 	return nil
 }
@@ -251,6 +342,15 @@ func (s *store) Rollback() error {
 	batch := leveldbhelper.NewUpdateBatch()
 	itr := s.db.GetIterator(datakeyRange(blkNum))
 	for itr.Next() {
+		collPvtWset, err := decodeDataValue(itr.Value())
+		if err != nil {
+			itr.Release()
+			return err
+		}
+		if err := removeKeyHashIndexEntriesFromBatch(batch, decodeDatakey(itr.Key()), collPvtWset); err != nil {
+			itr.Release()
+			return err
+		}
 		batch.Delete(itr.Key())
 	}
 	itr.Release()
@@ -259,6 +359,7 @@ func (s *store) Rollback() error {
 		batch.Delete(itr.Key())
 	}
 	itr.Release()
+	batch.Delete(pendingPurgeMarkersKey)
 	batch.Delete(pendingCommitKey)
 	if err := s.db.WriteBatch(batch, true); err != nil {
 		return err
@@ -267,11 +368,116 @@ func (s *store) Rollback() error {
 	return nil
 }
 
+// addPendingPurgesToBatch adds to the batch the removal of all the writes, committed in the blocks prior to
+// the committing block, of the keys purged by the committing block, and returns the removed writes. The purge
+// markers are also persisted so that the purged writes are not added back later via `CommitPvtDataOfOldBlocks`
+func (s *store) addPendingPurgesToBatch(committingBlockNum uint64, batch *leveldbhelper.UpdateBatch) ([]*PurgedWrite, error) {
+	purgeMarkersBytes, err := s.db.Get(pendingPurgeMarkersKey)
+	if err != nil || purgeMarkersBytes == nil {
+		return nil, err
+	}
+	purgeMarkers, err := decodePurgeMarkersValue(purgeMarkersBytes)
+	if err != nil {
+		return nil, err
+	}
+	purgedKeys := indexPurgeMarkers(purgeMarkers)
+
+	// the key hash index gives the data entries that write the purged keys,
+	// so that only these entries are visited rather than the whole store
+	dataKeys := make(map[dataKey]struct{})
+	for k := range purgedKeys {
+		keyHash := []byte(k.keyHash)
+		itr := s.db.GetIterator(getKeyHashIndexKeysForRangeScanBeforeBlockNum(k.ns, k.coll, keyHash, committingBlockNum))
+		for itr.Next() {
+			blkNum, txNum := decodeKeyHashIndexKey(k.ns, k.coll, keyHash, itr.Key())
+			dataKeys[dataKey{nsCollBlk{k.ns, k.coll, blkNum}, txNum}] = struct{}{}
+			batch.Delete(itr.Key())
+		}
+		itr.Release()
+	}
+
+	var purgedWrites []*PurgedWrite
+	v11Keys := make(map[string]struct{})
+	for key := range dataKeys {
+		keyBytes := encodeDataKey(&key)
+		valBytes, err := s.db.Get(keyBytes)
+		if err != nil {
+			return nil, err
+		}
+		if valBytes == nil {
+			// the data entry has either expired or been committed in the v11 format
+			v11Keys[string(v11EncodePK(key.blkNum, key.txNum))] = struct{}{}
+			continue
+		}
+		collPvtWset, err := decodeDataValue(valBytes)
+		if err != nil {
+			return nil, err
+		}
+		filtered, empty, err := removePurgedWrites(collPvtWset, func(k string) (bool, error) {
+			_, ok := purgedKeys[purgedKey{key.ns, key.coll, string(util.ComputeStringHash(k))}]
+			if ok {
+				purgedWrites = append(purgedWrites, newPurgedWrite(&key, k))
+			}
+			return ok, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case filtered == nil:
+			continue
+		case empty:
+			batch.Delete(keyBytes)
+		default:
+			valBytes, err := encodeDataValue(filtered)
+			if err != nil {
+				return nil, err
+			}
+			batch.Put(keyBytes, valBytes)
+		}
+	}
+
+	for key := range v11Keys {
+		valBytes, err := s.db.Get([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		if valBytes == nil {
+			continue
+		}
+		pvtWSet, err := v11DecodePvtRwSet(valBytes)
+		if err != nil {
+			return nil, err
+		}
+		v11PurgedWrites, err := v11RemovePurgedWrites(blkTranNumKey(key), pvtWSet, purgedKeys)
+		if err != nil {
+			return nil, err
+		}
+		if len(v11PurgedWrites) == 0 {
+			continue
+		}
+		purgedWrites = append(purgedWrites, v11PurgedWrites...)
+		if valBytes, err = proto.Marshal(pvtWSet); err != nil {
+			return nil, err
+		}
+		batch.Put([]byte(key), valBytes)
+	}
+
+	for k, txNum := range purgedKeys {
+		batch.Put(encodePurgeMarkerKey(k.ns, k.coll, []byte(k.keyHash)),
+			encodePurgeMarkerValue(version.NewHeight(committingBlockNum, txNum)))
+	}
+	batch.Delete(pendingPurgeMarkersKey)
+	logger.Debugf("Purged [%d] private data keys from [%d] entries committed prior to block [%d]",
+		len(purgedKeys), len(dataKeys), committingBlockNum)
+	return purgedWrites, nil
+}
+
 // CommitPvtDataOfOldBlocks commits the pvtData (i.e., previously missing data) of old blocks.
 // The parameter `blocksPvtData` refers a list of old block's pvtdata which are missing in the pvtstore.
 // Given a list of old block's pvtData, `CommitPvtDataOfOldBlocks` performs the following four
 // operations
-// (1) construct dataEntries for all pvtData
+// (1) construct dataEntries for all pvtData, excluding the writes of the keys purged after the data was committed
 // (2) construct update entries (i.e., dataEntries, expiryEntries, missingDataEntries, and
 //     lastUpdatedOldBlocksList) from the above created data entries
 // (3) create a db update batch from the update entries
@@ -284,6 +490,9 @@ func (s *store) CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPv
 
 	// (1) construct dataEntries for all pvtData
 	dataEntries := constructDataEntriesFromBlocksPvtData(blocksPvtData)
+	if err := s.removePurgedWritesFromDataEntries(dataEntries); err != nil {
+		return err
+	}
 
 	// (2) construct update entries (i.e., dataEntries, expiryEntries, missingDataEntries) from the above created data entries
 	logger.Debugf("Constructing pvtdatastore entries for pvtData of [%d] old blocks", len(blocksPvtData))
@@ -319,6 +528,31 @@ func constructDataEntriesFromBlocksPvtData(blocksPvtData map[uint64][]*ledger.Tx
 	return dataEntries
 }
 
+// removePurgedWritesFromDataEntries removes, from the data entries of old blocks, the writes of
+// the keys that have been purged by a transaction committed after the data entry
+func (s *store) removePurgedWritesFromDataEntries(dataEntries []*dataEntry) error {
+	for _, dataEntry := range dataEntries {
+		key := dataEntry.key
+		entryHeight := version.NewHeight(key.blkNum, key.txNum)
+		filtered, _, err := removePurgedWrites(dataEntry.value, func(k string) (bool, error) {
+			purgeHeightBytes, err := s.db.Get(encodePurgeMarkerKey(key.ns, key.coll, util.ComputeStringHash(k)))
+			if err != nil || purgeHeightBytes == nil {
+				return false, err
+			}
+			return entryHeight.Compare(decodePurgeMarkerValue(purgeHeightBytes)) < 0, nil
+		})
+		if err != nil {
+			return err
+		}
+		if filtered != nil {
+			// the entry is retained even if it is left with no writes so that
+			// the data is no longer reported as missing
+			dataEntry.value = filtered
+		}
+	}
+	return nil
+}
+
 func (s *store) constructUpdateEntriesFromDataEntries(dataEntries []*dataEntry) (*entriesForPvtDataOfOldBlocks, error) {
 	updateEntries := &entriesForPvtDataOfOldBlocks{
 		dataEntries:        make(map[dataKey]*rwset.CollectionPvtReadWriteSet),
@@ -471,6 +705,9 @@ func addNewDataEntriesToUpdateBatch(batch *leveldbhelper.UpdateBatch, entries *e
 			return err
 		}
 		batch.Put(keyBytes, valBytes)
+		if err := addKeyHashIndexEntriesToBatch(batch, &dataKey, pvtData); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -738,22 +975,60 @@ func (s *store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 			}
 		}
 
-		valueBytes := dbItr.Value()
-		bitmap, err := decodeMissingDataValue(valueBytes)
-		if err != nil {
+		if err := addMissingDataEntry(missingPvtDataInfo, missingDataKey, dbItr.Value()); err != nil {
 			return nil, err
 		}
+	}
+
+	return missingPvtDataInfo, nil
+}
+
+// GetMissingPvtDataInfoForBlockRange implements the function in the interface `Store`
+func (s *store) GetMissingPvtDataInfoForBlockRange(startBlock, endBlock uint64) (ledger.MissingPvtDataInfo, error) {
+	lastCommittedBlock := atomic.LoadUint64(&s.lastCommittedBlock)
+	if endBlock > lastCommittedBlock {
+		endBlock = lastCommittedBlock
+	}
+	if startBlock > endBlock {
+		return nil, nil
+	}
+
+	missingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	startKey, endKey := createRangeScanKeysForEligibleMissingDataEntriesInRange(startBlock, endBlock)
+	dbItr := s.db.GetIterator(startKey, endKey)
+	defer dbItr.Release()
 
-		// for each transaction which misses private data, make an entry in missingBlockPvtDataInfo
-		for index, isSet := bitmap.NextSet(0); isSet; index, isSet = bitmap.NextSet(index + 1) {
-			txNum := uint64(index)
-			missingPvtDataInfo.Add(missingDataKey.blkNum, txNum, missingDataKey.ns, missingDataKey.coll)
+	for dbItr.Next() {
+		missingDataKey := decodeMissingDataKey(dbItr.Key())
+		expired, err := isExpired(missingDataKey.nsCollBlk, s.btlPolicy, atomic.LoadUint64(&s.lastCommittedBlock))
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			continue
+		}
+		if err := addMissingDataEntry(missingPvtDataInfo, missingDataKey, dbItr.Value()); err != nil {
+			return nil, err
 		}
 	}
 
 	return missingPvtDataInfo, nil
 }
 
+// addMissingDataEntry adds an entry in the missingPvtDataInfo for each transaction
+// which misses private data, as recorded in the bitmap of the missing data entry
+func addMissingDataEntry(missingPvtDataInfo ledger.MissingPvtDataInfo, missingDataKey *missingDataKey, valueBytes []byte) error {
+	bitmap, err := decodeMissingDataValue(valueBytes)
+	if err != nil {
+		return err
+	}
+	for index, isSet := bitmap.NextSet(0); isSet; index, isSet = bitmap.NextSet(index + 1) {
+		txNum := uint64(index)
+		missingPvtDataInfo.Add(missingDataKey.blkNum, txNum, missingDataKey.ns, missingDataKey.coll)
+	}
+	return nil
+}
+
 // ProcessCollsEligibilityEnabled implements the function in the interface `Store`
 func (s *store) ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap map[string][]string) error {
 	key := encodeCollElgKey(committingBlk)
@@ -793,12 +1068,18 @@ func (s *store) purgeExpiredData(minBlkNum, maxBlkNum uint64) error {
 	if err != nil || len(expiryEntries) == 0 {
 		return err
 	}
+	var purgedWrites []*PurgedWrite
 	for _, expiryEntry := range expiryEntries {
 		// this encoding could have been saved if the function retrieveExpiryEntries also returns the encoded expiry keys.
 		// However, keeping it for better readability
 		batch.Delete(encodeExpiryKey(expiryEntry.key))
 		dataKeys, missingDataKeys := deriveKeys(expiryEntry)
 		for _, dataKey := range dataKeys {
+			expiredWrites, err := s.removeKeyHashIndexEntries(batch, dataKey)
+			if err != nil {
+				return err
+			}
+			purgedWrites = append(purgedWrites, expiredWrites...)
 			batch.Delete(encodeDataKey(dataKey))
 		}
 		for _, missingDataKey := range missingDataKeys {
@@ -807,9 +1088,27 @@ func (s *store) purgeExpiredData(minBlkNum, maxBlkNum uint64) error {
 		s.db.WriteBatch(batch, false)
 	}
 	logger.Infof("[%s] - [%d] Entries purged from private data storage till block number [%d]", s.ledgerid, len(expiryEntries), maxBlkNum)
+	s.notifyPurgeListener(purgedWrites)
 	return nil
 }
 
+// removeKeyHashIndexEntries adds to the batch the removal of the entries of the key hash
+// index for the keys written in the given stored data entry, and returns these writes
+func (s *store) removeKeyHashIndexEntries(batch *leveldbhelper.UpdateBatch, key *dataKey) ([]*PurgedWrite, error) {
+	valBytes, err := s.db.Get(encodeDataKey(key))
+	if err != nil || valBytes == nil {
+		return nil, err
+	}
+	collPvtWset, err := decodeDataValue(valBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := removeKeyHashIndexEntriesFromBatch(batch, key, collPvtWset); err != nil {
+		return nil, err
+	}
+	return writesOfDataEntry(key, collPvtWset)
+}
+
 func (s *store) retrieveExpiryEntries(minBlkNum, maxBlkNum uint64) ([]*expiryEntry, error) {
 	startKey, endKey := getExpiryKeysForRangeScan(minBlkNum, maxBlkNum)
 	logger.Debugf("retrieveExpiryEntries(): startKey=%#v, endKey=%#v", startKey, endKey)
@@ -8,6 +8,7 @@ package pvtdatastorage
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"strings"
 	"testing"
@@ -15,10 +16,13 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	btltestutil "github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -83,15 +87,15 @@ func TestStoreBasicCommitAndRetrieval(t *testing.T) {
 	blk2MissingData.Add(3, "ns-1", "coll-1", true)
 
 	// no pvt data with block 0
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// pvt data with block 1 - commit
-	assert.NoError(store.Prepare(1, testData, blk1MissingData))
+	assert.NoError(store.Prepare(1, testData, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// pvt data with block 2 - rollback
-	assert.NoError(store.Prepare(2, testData, nil))
+	assert.NoError(store.Prepare(2, testData, nil, nil))
 	assert.NoError(store.Rollback())
 
 	// pvt data retrieval for block 0 should return nil
@@ -129,7 +133,7 @@ func TestStoreBasicCommitAndRetrieval(t *testing.T) {
 	assert.Nil(retrievedData)
 
 	// pvt data with block 2 - commit
-	assert.NoError(store.Prepare(2, testData, blk2MissingData))
+	assert.NoError(store.Prepare(2, testData, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// retrieve the stored missing entries using GetMissingPvtDataInfoForMostRecentBlocks
@@ -162,6 +166,27 @@ func TestStoreBasicCommitAndRetrieval(t *testing.T) {
 	missingPvtDataInfo, err = store.GetMissingPvtDataInfoForMostRecentBlocks(10)
 	assert.NoError(err)
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
+
+	// retrieve the stored missing entries using GetMissingPvtDataInfoForBlockRange
+	missingPvtDataInfo, err = store.GetMissingPvtDataInfoForBlockRange(0, math.MaxUint64)
+	assert.NoError(err)
+	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
+
+	expectedBlk1MissingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	expectedBlk1MissingPvtDataInfo[1] = expectedMissingPvtDataInfo[1]
+	missingPvtDataInfo, err = store.GetMissingPvtDataInfoForBlockRange(1, 1)
+	assert.NoError(err)
+	assert.Equal(expectedBlk1MissingPvtDataInfo, missingPvtDataInfo)
+
+	expectedBlk2MissingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	expectedBlk2MissingPvtDataInfo[2] = expectedMissingPvtDataInfo[2]
+	missingPvtDataInfo, err = store.GetMissingPvtDataInfoForBlockRange(2, 5)
+	assert.NoError(err)
+	assert.Equal(expectedBlk2MissingPvtDataInfo, missingPvtDataInfo)
+
+	missingPvtDataInfo, err = store.GetMissingPvtDataInfoForBlockRange(3, 5)
+	assert.NoError(err)
+	assert.Nil(missingPvtDataInfo)
 }
 
 func TestCommitPvtDataOfOldBlocks(t *testing.T) {
@@ -211,15 +236,15 @@ func TestCommitPvtDataOfOldBlocks(t *testing.T) {
 	blk2MissingData.Add(3, "ns-1", "coll-1", true)
 
 	// COMMIT BLOCK 0 WITH NO DATA
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// COMMIT BLOCK 1 WITH PVTDATA AND MISSINGDATA
-	assert.NoError(store.Prepare(1, testData, blk1MissingData))
+	assert.NoError(store.Prepare(1, testData, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// COMMIT BLOCK 2 WITH PVTDATA AND MISSINGDATA
-	assert.NoError(store.Prepare(2, nil, blk2MissingData))
+	assert.NoError(store.Prepare(2, nil, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// CHECK MISSINGDATA ENTRIES ARE CORRECTLY STORED
@@ -322,7 +347,7 @@ func TestCommitPvtDataOfOldBlocks(t *testing.T) {
 	assert.Nil(blksPvtData)
 
 	// COMMIT BLOCK 3 WITH NO PVTDATA
-	assert.NoError(store.Prepare(3, nil, nil))
+	assert.NoError(store.Prepare(3, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// IN BLOCK 1, NS-1:COLL-2 AND NS-2:COLL-2 SHOULD HAVE EXPIRED BUT NOT PURGED
@@ -354,7 +379,7 @@ func TestCommitPvtDataOfOldBlocks(t *testing.T) {
 	assert.NoError(err)
 
 	// COMMIT BLOCK 4 WITH NO PVTDATA
-	assert.NoError(store.Prepare(4, nil, nil))
+	assert.NoError(store.Prepare(4, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	testWaitForPurgerRoutineToFinish(store)
@@ -416,7 +441,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	blk2MissingData.Add(1, "ns-1", "coll-2", true)
 
 	// no pvt data with block 0
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// write pvt data for block 1
@@ -424,7 +449,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 4, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData))
+	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// write pvt data for block 2
@@ -432,7 +457,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 		produceSamplePvtdata(t, 3, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 5, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData))
+	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	retrievedData, _ := store.GetPvtDataByBlockNum(1, nil)
@@ -457,7 +482,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
 
 	// Commit block 3 with no pvtdata
-	assert.NoError(store.Prepare(3, nil, nil))
+	assert.NoError(store.Prepare(3, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// After committing block 3, the data for "ns-1:coll1" of block 1 should have expired and should not be returned by the store
@@ -481,7 +506,7 @@ func TestExpiryDataNotIncluded(t *testing.T) {
 	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
 
 	// Commit block 4 with no pvtdata
-	assert.NoError(store.Prepare(4, nil, nil))
+	assert.NoError(store.Prepare(4, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// After committing block 4, the data for "ns-2:coll2" of block 1 should also have expired and should not be returned by the store
@@ -533,7 +558,7 @@ func TestStorePurge(t *testing.T) {
 	s := env.TestStore
 
 	// no pvt data with block 0
-	assert.NoError(s.Prepare(0, nil, nil))
+	assert.NoError(s.Prepare(0, nil, nil, nil))
 	assert.NoError(s.Commit())
 
 	// construct missing data for block 1
@@ -550,11 +575,11 @@ func TestStorePurge(t *testing.T) {
 		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 		produceSamplePvtdata(t, 4, []string{"ns-1:coll-1", "ns-1:coll-2", "ns-2:coll-1", "ns-2:coll-2"}),
 	}
-	assert.NoError(s.Prepare(1, testDataForBlk1, blk1MissingData))
+	assert.NoError(s.Prepare(1, testDataForBlk1, blk1MissingData, nil))
 	assert.NoError(s.Commit())
 
 	// write pvt data for block 2
-	assert.NoError(s.Prepare(2, nil, nil))
+	assert.NoError(s.Prepare(2, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 and ns-2:coll-2 should exist in store
 	ns1Coll1 := &dataKey{nsCollBlk: nsCollBlk{ns: "ns-1", coll: "coll-1", blkNum: 1}, txNum: 2}
@@ -579,7 +604,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3Coll2inelgMD))
 
 	// write pvt data for block 3
-	assert.NoError(s.Prepare(3, nil, nil))
+	assert.NoError(s.Prepare(3, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 and ns-2:coll-2 should exist in store (because purger should not be launched at block 3)
 	testWaitForPurgerRoutineToFinish(s)
@@ -593,7 +618,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3Coll2inelgMD))
 
 	// write pvt data for block 4
-	assert.NoError(s.Prepare(4, nil, nil))
+	assert.NoError(s.Prepare(4, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// data for ns-1:coll-1 should not exist in store (because purger should be launched at block 4)
 	// but ns-2:coll-2 should exist because it expires at block 5
@@ -608,7 +633,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testMissingDataKeyExists(t, s, ns3Coll2inelgMD))
 
 	// write pvt data for block 5
-	assert.NoError(s.Prepare(5, nil, nil))
+	assert.NoError(s.Prepare(5, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// ns-2:coll-2 should exist because though the data expires at block 5 but purger is launched every second block
 	testWaitForPurgerRoutineToFinish(s)
@@ -616,7 +641,7 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testDataKeyExists(t, s, ns2Coll2))
 
 	// write pvt data for block 6
-	assert.NoError(s.Prepare(6, nil, nil))
+	assert.NoError(s.Prepare(6, nil, nil, nil))
 	assert.NoError(s.Commit())
 	// ns-2:coll-2 should not exists now (because purger should be launched at block 6)
 	testWaitForPurgerRoutineToFinish(s)
@@ -641,14 +666,14 @@ func TestStoreState(t *testing.T) {
 	testData := []*ledger.TxPvtData{
 		produceSamplePvtdata(t, 0, []string{"ns-1:coll-1", "ns-1:coll-2"}),
 	}
-	_, ok := store.Prepare(1, testData, nil).(*ErrIllegalArgs)
+	_, ok := store.Prepare(1, testData, nil, nil).(*ErrIllegalArgs)
 	assert.True(ok)
 
-	assert.Nil(store.Prepare(0, testData, nil))
+	assert.Nil(store.Prepare(0, testData, nil, nil))
 	assert.NoError(store.Commit())
 
-	assert.Nil(store.Prepare(1, testData, nil))
-	_, ok = store.Prepare(2, testData, nil).(*ErrIllegalCall)
+	assert.Nil(store.Prepare(1, testData, nil, nil))
+	_, ok = store.Prepare(2, testData, nil, nil).(*ErrIllegalCall)
 	assert.True(ok)
 }
 
@@ -705,7 +730,7 @@ func testCollElgEnabled(t *testing.T) {
 	// Initial state: eligible for {ns-1:coll-1 and ns-2:coll-1 }
 
 	// no pvt data with block 0
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	// construct and commit block 1
@@ -717,7 +742,7 @@ func testCollElgEnabled(t *testing.T) {
 	testDataForBlk1 := []*ledger.TxPvtData{
 		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1"}),
 	}
-	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData))
+	assert.NoError(store.Prepare(1, testDataForBlk1, blk1MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// construct and commit block 2
@@ -728,7 +753,7 @@ func testCollElgEnabled(t *testing.T) {
 	testDataForBlk2 := []*ledger.TxPvtData{
 		produceSamplePvtdata(t, 3, []string{"ns-1:coll-1"}),
 	}
-	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData))
+	assert.NoError(store.Prepare(2, testDataForBlk2, blk2MissingData, nil))
 	assert.NoError(store.Commit())
 
 	// Retrieve and verify missing data reported
@@ -784,7 +809,7 @@ func TestRollBack(t *testing.T) {
 	defer env.Cleanup()
 	assert := assert.New(t)
 	store := env.TestStore
-	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Prepare(0, nil, nil, nil))
 	assert.NoError(store.Commit())
 
 	pvtdata := []*ledger.TxPvtData{
@@ -797,7 +822,7 @@ func TestRollBack(t *testing.T) {
 	missingData.Add(5, "ns-2", "coll-2", false)
 
 	for i := 1; i <= 9; i++ {
-		assert.NoError(store.Prepare(uint64(i), pvtdata, missingData))
+		assert.NoError(store.Prepare(uint64(i), pvtdata, missingData, nil))
 		assert.NoError(store.Commit())
 	}
 
@@ -819,7 +844,7 @@ func TestRollBack(t *testing.T) {
 	testLastCommittedBlockHeight(10, assert, store)
 
 	// prepare for block 10 and test store for presence of datakeys and eligibile missingdatakeys
-	assert.NoError(store.Prepare(10, pvtdata, missingData))
+	assert.NoError(store.Prepare(10, pvtdata, missingData, nil))
 	testPendingBatch(true, assert, store)
 	testLastCommittedBlockHeight(10, assert, store)
 
@@ -849,6 +874,154 @@ func TestRollBack(t *testing.T) {
 	}
 }
 
+func TestPurgeMarkers(t *testing.T) {
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns-1", "coll-1"}: 0,
+			{"ns-1", "coll-2"}: 0,
+		},
+	)
+	env := NewTestStoreEnv(t, "TestPurgeMarkers", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	store := env.TestStore
+
+	purgedKey := "key-ns-1-coll-1"
+	purgeMarker := &PurgeMarker{
+		Namespace:  "ns-1",
+		Collection: "coll-1",
+		KeyHash:    util.ComputeStringHash(purgedKey),
+		TxNum:      2,
+	}
+
+	assert.NoError(store.Prepare(0, nil, nil, nil))
+	assert.NoError(store.Commit())
+
+	// block 1: the purged key is written by tx 0 and tx 1 is missing the pvt data of coll-1
+	blk1MissingData := make(ledger.TxMissingPvtDataMap)
+	blk1MissingData.Add(1, "ns-1", "coll-1", true)
+	assert.NoError(store.Prepare(1, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 0, []string{"ns-1:coll-1", "ns-1:coll-2"}),
+	}, blk1MissingData, nil))
+	assert.NoError(store.Commit())
+
+	// block 2: the key is written by tx 0, purged by tx 2 and written again by tx 3
+	assert.NoError(store.Prepare(2, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 0, []string{"ns-1:coll-1", "ns-1:coll-2"}),
+		produceSamplePurgePvtdata(t, 2, "ns-1", "coll-1", purgedKey),
+		produceSamplePvtdata(t, 3, []string{"ns-1:coll-1"}),
+	}, nil, []*PurgeMarker{purgeMarker}))
+
+	// the purge of the data in the previous blocks is deferred till commit
+	assert.Equal([]string{purgedKey}, testWrittenKeys(t, store, 1, 0, "ns-1", "coll-1"))
+	assert.NoError(store.Commit())
+
+	blk1Tx0Coll1 := &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 0}
+	blk1Tx0Coll2 := &dataKey{nsCollBlk{"ns-1", "coll-2", 1}, 0}
+	assert.False(testDataKeyExists(t, store, blk1Tx0Coll1))
+	assert.True(testDataKeyExists(t, store, blk1Tx0Coll2))
+	assert.Nil(testWrittenKeys(t, store, 2, 0, "ns-1", "coll-1"))
+	assert.Equal([]string{"key-ns-1-coll-2"}, testWrittenKeys(t, store, 2, 0, "ns-1", "coll-2"))
+	assert.Equal([]string{purgedKey}, testWrittenKeys(t, store, 2, 2, "ns-1", "coll-1"))
+	assert.Equal([]string{purgedKey}, testWrittenKeys(t, store, 2, 3, "ns-1", "coll-1"))
+
+	// the purged key should not be added back by the pvt data of old blocks
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", purgedKey, []byte("value"))
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "other-key", []byte("value"))
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(err)
+	oldBlocksPvtData := map[uint64][]*ledger.TxPvtData{
+		1: {{SeqInBlock: 1, WriteSet: simRes.PvtSimulationResults}},
+	}
+	assert.NoError(store.CommitPvtDataOfOldBlocks(oldBlocksPvtData))
+	assert.NoError(store.ResetLastUpdatedOldBlocksList())
+	assert.Equal([]string{"other-key"}, testWrittenKeys(t, store, 1, 1, "ns-1", "coll-1"))
+	assert.False(testMissingDataKeyExists(t, store, &missingDataKey{nsCollBlk{"ns-1", "coll-1", 1}, true}))
+
+	// a rolled back purge should not remove any data
+	assert.NoError(store.Prepare(3, nil, nil, []*PurgeMarker{
+		{Namespace: "ns-1", Collection: "coll-2", KeyHash: util.ComputeStringHash("key-ns-1-coll-2")},
+	}))
+	assert.NoError(store.Rollback())
+	assert.NoError(store.Prepare(3, nil, nil, nil))
+	assert.NoError(store.Commit())
+	assert.True(testDataKeyExists(t, store, blk1Tx0Coll2))
+}
+
+func TestKeyHashIndex(t *testing.T) {
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns-1", "coll-1"}: 0,
+			{"ns-1", "coll-2"}: 1,
+		},
+	)
+	env := NewTestStoreEnv(t, "TestKeyHashIndex", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	s := env.TestStore.(*store)
+
+	indexed := func(ns, coll, key string, blkNum, txNum uint64) bool {
+		val, err := s.db.Get(encodeKeyHashIndexKey(ns, coll, util.ComputeStringHash(key), blkNum, txNum))
+		assert.NoError(err)
+		return val != nil
+	}
+
+	assert.NoError(s.Prepare(0, nil, nil, nil))
+	assert.NoError(s.Commit())
+	assert.NoError(s.Prepare(1, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2"}),
+	}, nil, nil))
+	assert.NoError(s.Commit())
+	assert.True(indexed("ns-1", "coll-1", "key-ns-1-coll-1", 1, 2))
+	assert.True(indexed("ns-1", "coll-2", "key-ns-1-coll-2", 1, 2))
+
+	// a rolled back block is removed from the index
+	assert.NoError(s.Prepare(2, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 0, []string{"ns-1:coll-1"}),
+	}, nil, nil))
+	assert.True(indexed("ns-1", "coll-1", "key-ns-1-coll-1", 2, 0))
+	assert.NoError(s.Rollback())
+	assert.False(indexed("ns-1", "coll-1", "key-ns-1-coll-1", 2, 0))
+
+	// the data committed before the index is indexed when the store is reopened
+	batch := leveldbhelper.NewUpdateBatch()
+	batch.Delete(encodeKeyHashIndexKey("ns-1", "coll-1", util.ComputeStringHash("key-ns-1-coll-1"), 1, 2))
+	batch.Delete(keyHashIndexBuiltKey)
+	assert.NoError(s.db.WriteBatch(batch, true))
+	env.CloseAndReopen()
+	s = env.TestStore.(*store)
+	assert.True(indexed("ns-1", "coll-1", "key-ns-1-coll-1", 1, 2))
+
+	var notifiedWrites []*PurgedWrite
+	s.SetPurgeListener(func(purgedWrites []*PurgedWrite) {
+		notifiedWrites = append(notifiedWrites, purgedWrites...)
+	})
+
+	// the purge of a key removes its index entries and notifies the purge listener
+	assert.NoError(s.Prepare(2, nil, nil, []*PurgeMarker{
+		{Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-ns-1-coll-1")},
+	}))
+	assert.Nil(notifiedWrites)
+	assert.NoError(s.Commit())
+	assert.False(indexed("ns-1", "coll-1", "key-ns-1-coll-1", 1, 2))
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 2}))
+	assert.Equal([]*PurgedWrite{
+		{Namespace: "ns-1", Collection: "coll-1", Key: "key-ns-1-coll-1", BlockNum: 1, TxNum: 2},
+	}, notifiedWrites)
+
+	// the expiry of a data entry removes its index entries and notifies the purge listener
+	notifiedWrites = nil
+	assert.NoError(s.Prepare(3, nil, nil, nil))
+	assert.NoError(s.Commit())
+	assert.NoError(s.purgeExpiredData(0, 3))
+	assert.False(testDataKeyExists(t, s, &dataKey{nsCollBlk{"ns-1", "coll-2", 1}, 2}))
+	assert.False(indexed("ns-1", "coll-2", "key-ns-1-coll-2", 1, 2))
+	assert.Equal([]*PurgedWrite{
+		{Namespace: "ns-1", Collection: "coll-2", Key: "key-ns-1-coll-2", BlockNum: 1, TxNum: 2},
+	}, notifiedWrites)
+}
+
 // TODO Add tests for simulating a crash between calls `Prepare` and `Commit`/`Rollback` - [FAB-13099]
 
 func testEmpty(expectedEmpty bool, assert *assert.Assertions, store Store) {
@@ -905,3 +1078,30 @@ func produceSamplePvtdata(t *testing.T, txNum uint64, nsColls []string) *ledger.
 	assert.NoError(t, err)
 	return &ledger.TxPvtData{SeqInBlock: txNum, WriteSet: simRes.PvtSimulationResults}
 }
+
+func produceSamplePurgePvtdata(t *testing.T, txNum uint64, ns, coll, key string) *ledger.TxPvtData {
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSetForPurge(ns, coll, key)
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	return &ledger.TxPvtData{SeqInBlock: txNum, WriteSet: simRes.PvtSimulationResults}
+}
+
+func testWrittenKeys(t *testing.T, s Store, blkNum, txNum uint64, ns, coll string) []string {
+	val, err := s.(*store).db.Get(encodeDataKey(&dataKey{nsCollBlk{ns, coll, blkNum}, txNum}))
+	assert.NoError(t, err)
+	assert.NotNil(t, val)
+	collPvtWset, err := decodeDataValue(val)
+	assert.NoError(t, err)
+	return testKeysOf(t, collPvtWset.Rwset)
+}
+
+func testKeysOf(t *testing.T, rwsetBytes []byte) []string {
+	kvRWSet := &kvrwset.KVRWSet{}
+	assert.NoError(t, proto.Unmarshal(rwsetBytes, kvRWSet))
+	var keys []string
+	for _, w := range kvRWSet.Writes {
+		keys = append(keys, w.Key)
+	}
+	return keys
+}
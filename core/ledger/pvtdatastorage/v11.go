@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 )
 
@@ -90,3 +91,47 @@ func v11TrimPvtWSet(pvtWSet *rwset.TxPvtReadWriteSet, filter ledger.PvtNsCollFil
 	}
 	return filteredTxPvtRwSet
 }
+
+func v11EncodePK(blockNum uint64, tranNum uint64) blkTranNumKey {
+	return append(pvtDataKeyPrefix, version.NewHeight(blockNum, tranNum).ToBytes()...)
+}
+
+// v11DataKeys returns the data keys, one per collection, of the given v11 data entry
+func v11DataKeys(key blkTranNumKey, pvtWSet *rwset.TxPvtReadWriteSet) ([]*dataKey, []*rwset.CollectionPvtReadWriteSet) {
+	blkNum, txNum := v11DecodePK(key)
+	var dataKeys []*dataKey
+	var collPvtWsets []*rwset.CollectionPvtReadWriteSet
+	for _, ns := range pvtWSet.NsPvtRwset {
+		for _, coll := range ns.CollectionPvtRwset {
+			dataKeys = append(dataKeys, &dataKey{nsCollBlk{ns.Namespace, coll.CollectionName, blkNum}, txNum})
+			collPvtWsets = append(collPvtWsets, coll)
+		}
+	}
+	return dataKeys, collPvtWsets
+}
+
+// v11RemovePurgedWrites removes, from the given v11 data entry, the writes of the purged
+// keys, and returns the removed writes
+func v11RemovePurgedWrites(key blkTranNumKey, pvtWSet *rwset.TxPvtReadWriteSet, purgedKeys map[purgedKey]uint64) ([]*PurgedWrite, error) {
+	blkNum, txNum := v11DecodePK(key)
+	var purgedWrites []*PurgedWrite
+	for _, ns := range pvtWSet.NsPvtRwset {
+		for i, coll := range ns.CollectionPvtRwset {
+			dataKey := &dataKey{nsCollBlk{ns.Namespace, coll.CollectionName, blkNum}, txNum}
+			filtered, _, err := removePurgedWrites(coll, func(k string) (bool, error) {
+				_, ok := purgedKeys[purgedKey{ns.Namespace, coll.CollectionName, string(util.ComputeStringHash(k))}]
+				if ok {
+					purgedWrites = append(purgedWrites, newPurgedWrite(dataKey, k))
+				}
+				return ok, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			if filtered != nil {
+				ns.CollectionPvtRwset[i] = filtered
+			}
+		}
+	}
+	return purgedWrites, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	btltestutil "github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,3 +70,43 @@ func checkDataExists(t *testing.T, s Store, blkNum int) {
 	assert.NotNil(t, data)
 	t.Logf("pvtdata = %s\n", spew.Sdump(data))
 }
+
+func TestV11PurgeMarkers(t *testing.T) {
+	testWorkingDir := "test-working-dir"
+	testutil.CopyDir("testdata/v11_v12/ledgersData", testWorkingDir)
+	defer os.RemoveAll(testWorkingDir)
+
+	viper.Set("peer.fileSystemPath", testWorkingDir)
+	defer viper.Reset()
+
+	p := NewProvider()
+	defer p.Close()
+	s, err := p.OpenStore("ch1")
+	assert.NoError(t, err)
+	s.Init(btltestutil.SampleBTLPolicy(map[[2]string]uint64{}))
+
+	// the key written in the v11 entry of block 10 is purged by block 15
+	blk10Data, err := s.GetPvtDataByBlockNum(10, nil)
+	assert.NoError(t, err)
+	assert.Len(t, blk10Data, 1)
+	txNum := blk10Data[0].SeqInBlock
+	nsPvtRwset := blk10Data[0].WriteSet.NsPvtRwset[0]
+	collPvtRwset := nsPvtRwset.CollectionPvtRwset[0]
+	keys := testKeysOf(t, collPvtRwset.Rwset)
+	assert.NotEmpty(t, keys)
+
+	assert.NoError(t, s.Prepare(15, nil, nil, []*PurgeMarker{{
+		Namespace:  nsPvtRwset.Namespace,
+		Collection: collPvtRwset.CollectionName,
+		KeyHash:    util.ComputeStringHash(keys[0]),
+	}}))
+	assert.NoError(t, s.Commit())
+
+	blk10Data, err = s.GetPvtDataByBlockNum(10, nil)
+	assert.NoError(t, err)
+	assert.Len(t, blk10Data, 1)
+	assert.Equal(t, txNum, blk10Data[0].SeqInBlock)
+	remainingKeys := testKeysOf(t, blk10Data[0].WriteSet.NsPvtRwset[0].CollectionPvtRwset[0].Rwset)
+	assert.Len(t, remainingKeys, len(keys)-1)
+	assert.NotContains(t, remainingKeys, keys[0])
+}
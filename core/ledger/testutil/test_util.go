@@ -80,6 +80,10 @@ func ResetConfigToDefaultValues() {
 	viper.Set("ledger.history.enableHistoryDatabase", false)
 	viper.Set("ledger.state.couchDBConfig.autoWarmIndexes", true)
 	viper.Set("ledger.state.couchDBConfig.warmIndexesAfterNBlocks", 1)
+	viper.Set("ledger.state.enableStateHash", false)
+	viper.Set("ledger.blockchain.compressSealedBlockfiles", false)
+	viper.Set("ledger.blockchain.archiveDir", "")
+	viper.Set("ledger.blockchain.archiveAfterBlocks", 10000)
 	viper.Set("peer.fileSystemPath", "/var/hyperledger/production")
 }
 
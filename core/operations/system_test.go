@@ -93,6 +93,24 @@ var _ = Describe("System", func() {
 		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
 	})
 
+	It("hosts secure endpoints for registered handlers", func() {
+		system.RegisterHandler("/credentials/reload", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		err := system.Start()
+		Expect(err).NotTo(HaveOccurred())
+
+		reloadURL := fmt.Sprintf("https://%s/credentials/reload", system.Addr())
+		resp, err := client.Get(reloadURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+		resp.Body.Close()
+
+		resp, err = unauthClient.Get(reloadURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
 	Context("when TLS is disabled", func() {
 		BeforeEach(func() {
 			options.TLS.Enabled = false
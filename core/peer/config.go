@@ -174,6 +174,24 @@ func GetServerConfig() (comm.ServerConfig, error) {
 	return serverConfig, nil
 }
 
+// GetServerCertificate returns the TLS certificate of the peer's gRPC server,
+// as currently found on the file system
+func GetServerCertificate() (tls.Certificate, error) {
+	serverKey, err := ioutil.ReadFile(config.GetPath("peer.tls.key.file"))
+	if err != nil {
+		return tls.Certificate{}, errors.WithMessage(err, "error loading TLS key")
+	}
+	serverCert, err := ioutil.ReadFile(config.GetPath("peer.tls.cert.file"))
+	if err != nil {
+		return tls.Certificate{}, errors.WithMessage(err, "error loading TLS certificate")
+	}
+	cert, err := tls.X509KeyPair(serverCert, serverKey)
+	if err != nil {
+		return tls.Certificate{}, errors.WithMessage(err, "error parsing TLS key pair")
+	}
+	return cert, nil
+}
+
 // GetClientCertificate returns the TLS certificate to use for gRPC client
 // connections
 func GetClientCertificate() (tls.Certificate, error) {
@@ -178,6 +178,26 @@ func TestGetServerConfig(t *testing.T) {
 
 }
 
+func TestGetServerCertificate(t *testing.T) {
+	viper.Set("peer.tls.key.file", filepath.Join("testdata", "Org1-server1-key.pem"))
+	viper.Set("peer.tls.cert.file", filepath.Join("testdata", "nonexistent.pem"))
+	_, err := GetServerCertificate()
+	assert.Contains(t, err.Error(), "error loading TLS certificate")
+
+	viper.Set("peer.tls.cert.file", filepath.Join("testdata", "Org2-server1-cert.pem"))
+	_, err = GetServerCertificate()
+	assert.Contains(t, err.Error(), "error parsing TLS key pair")
+
+	viper.Set("peer.tls.cert.file", filepath.Join("testdata", "Org1-server1-cert.pem"))
+	expected, err := tls.LoadX509KeyPair(
+		filepath.Join("testdata", "Org1-server1-cert.pem"),
+		filepath.Join("testdata", "Org1-server1-key.pem"))
+	assert.NoError(t, err)
+	cert, err := GetServerCertificate()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, cert)
+}
+
 func TestGetClientCertificate(t *testing.T) {
 	viper.Set("peer.tls.key.file", "")
 	viper.Set("peer.tls.cert.file", "")
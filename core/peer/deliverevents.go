@@ -15,8 +15,11 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/aclmgmt/resources"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/pkg/errors"
@@ -29,10 +32,22 @@ var logger = flogging.MustGetLogger("common.deliverevents")
 // given resource name
 type PolicyCheckerProvider func(resourceName string) deliver.PolicyCheckerFunc
 
+// PvtDataSupport provides the private data the peer holds for the blocks
+// of a channel, along with the access policies of the collections
+type PvtDataSupport interface {
+	// GetPvtDataByNum returns the private data the peer holds for the
+	// given block of the given channel
+	GetPvtDataByNum(channelID string, blockNum uint64) ([]*ledger.TxPvtData, error)
+
+	// RetrieveCollectionAccessPolicy retrieves a collection's access policy
+	RetrieveCollectionAccessPolicy(cc common.CollectionCriteria) (privdata.CollectionAccessPolicy, error)
+}
+
 // server holds the dependencies necessary to create a deliver server
 type server struct {
 	dh                    *deliver.Handler
 	policyCheckerProvider PolicyCheckerProvider
+	pvtDataSupport        PvtDataSupport
 }
 
 // blockResponseSender structure used to send block responses
@@ -90,6 +105,140 @@ func (fbrs *filteredBlockResponseSender) SendBlockResponse(block *common.Block)
 	return fbrs.Send(response)
 }
 
+// blockAndPrivateDataResponseSender structure used to send block responses
+// along with the private data the requesting client is authorized to read
+type blockAndPrivateDataResponseSender struct {
+	peer.Deliver_DeliverWithPrivateDataServer
+	pvtDataSupport PvtDataSupport
+	// seekRequest is the last seek request received, whose signer
+	// is evaluated against the member policies of the collections
+	seekRequest *common.Envelope
+}
+
+// Recv receives the next seek request, and records it in order
+// to authorize the access to the private data of the blocks
+func (bprs *blockAndPrivateDataResponseSender) Recv() (*common.Envelope, error) {
+	envelope, err := bprs.Deliver_DeliverWithPrivateDataServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	bprs.seekRequest = envelope
+	return envelope, nil
+}
+
+// SendStatusResponse generates status reply proto message
+func (bprs *blockAndPrivateDataResponseSender) SendStatusResponse(status common.Status) error {
+	reply := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_Status{Status: status},
+	}
+	return bprs.Send(reply)
+}
+
+// SendBlockResponse generates deliver response with the block along with the
+// private data of the collections the requesting client's org is a member of
+func (bprs *blockAndPrivateDataResponseSender) SendBlockResponse(block *common.Block) error {
+	channelID, signedData, err := seekRequestSigner(bprs.seekRequest)
+	if err != nil {
+		return err
+	}
+
+	txPvtData, err := bprs.pvtDataSupport.GetPvtDataByNum(channelID, block.Header.Number)
+	if err != nil {
+		return errors.Wrapf(err, "failed retrieving private data of block [%d]", block.Header.Number)
+	}
+
+	// membership is evaluated once per collection of the block
+	membership := make(map[string]bool)
+	isMember := func(cc common.CollectionCriteria) bool {
+		key := cc.Namespace + "/" + cc.Collection
+		if member, exists := membership[key]; exists {
+			return member
+		}
+		member := bprs.isCollectionMember(cc, signedData)
+		membership[key] = member
+		return member
+	}
+
+	privateDataMap := make(map[uint64]*rwset.TxPvtReadWriteSet)
+	for _, pvtData := range txPvtData {
+		if pvtData.WriteSet == nil {
+			continue
+		}
+		writeSet := &rwset.TxPvtReadWriteSet{DataModel: pvtData.WriteSet.DataModel}
+		for _, nsPvtRWSet := range pvtData.WriteSet.NsPvtRwset {
+			nsRWSet := &rwset.NsPvtReadWriteSet{Namespace: nsPvtRWSet.Namespace}
+			for _, collPvtRWSet := range nsPvtRWSet.CollectionPvtRwset {
+				cc := common.CollectionCriteria{
+					Channel:    channelID,
+					Namespace:  nsPvtRWSet.Namespace,
+					Collection: collPvtRWSet.CollectionName,
+				}
+				if !isMember(cc) {
+					continue
+				}
+				nsRWSet.CollectionPvtRwset = append(nsRWSet.CollectionPvtRwset, collPvtRWSet)
+			}
+			if len(nsRWSet.CollectionPvtRwset) > 0 {
+				writeSet.NsPvtRwset = append(writeSet.NsPvtRwset, nsRWSet)
+			}
+		}
+		if len(writeSet.NsPvtRwset) > 0 {
+			privateDataMap[pvtData.SeqInBlock] = writeSet
+		}
+	}
+
+	response := &peer.DeliverResponse{
+		Type: &peer.DeliverResponse_BlockAndPrivateData{
+			BlockAndPrivateData: &peer.BlockAndPrivateData{
+				Block:          block,
+				PrivateDataMap: privateDataMap,
+			},
+		},
+	}
+	return bprs.Send(response)
+}
+
+// isCollectionMember returns whether the given signed data satisfies
+// the member policy of the given collection
+func (bprs *blockAndPrivateDataResponseSender) isCollectionMember(cc common.CollectionCriteria, signedData common.SignedData) bool {
+	accessPolicy, err := bprs.pvtDataSupport.RetrieveCollectionAccessPolicy(cc)
+	if err != nil {
+		logger.Warningf("Failed retrieving access policy of collection %s/%s/%s: %s", cc.Channel, cc.Namespace, cc.Collection, err)
+		return false
+	}
+	if accessPolicy == nil {
+		logger.Warningf("No access policy found for collection %s/%s/%s", cc.Channel, cc.Namespace, cc.Collection)
+		return false
+	}
+	filter := accessPolicy.AccessFilter()
+	if filter == nil {
+		logger.Warningf("No access filter found for collection %s/%s/%s", cc.Channel, cc.Namespace, cc.Collection)
+		return false
+	}
+	return filter(signedData)
+}
+
+// seekRequestSigner returns the channel of the given seek request,
+// along with the data signed by the requesting client
+func seekRequestSigner(envelope *common.Envelope) (string, common.SignedData, error) {
+	payload, err := utils.UnmarshalPayload(envelope.GetPayload())
+	if err != nil {
+		return "", common.SignedData{}, err
+	}
+	if payload.Header == nil {
+		return "", common.SignedData{}, errors.New("seek request has no header")
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", common.SignedData{}, err
+	}
+	signedData, err := envelope.AsSignedData()
+	if err != nil {
+		return "", common.SignedData{}, err
+	}
+	return chdr.ChannelId, *signedData[0], nil
+}
+
 // transactionActions aliasing for peer.TransactionAction pointers slice
 type transactionActions []*peer.TransactionAction
 
@@ -127,9 +276,27 @@ func (s *server) Deliver(srv peer.Deliver_DeliverServer) (err error) {
 	return s.dh.Handle(srv.Context(), deliverServer)
 }
 
-// NewDeliverEventsServer creates a peer.Deliver server to deliver block and
-// filtered block events
-func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, metricsProvider metrics.Provider) peer.DeliverServer {
+// DeliverWithPrivateData sends a stream of blocks to a client after commitment,
+// along with the private data of the collections the client's org is a member of
+func (s *server) DeliverWithPrivateData(srv peer.Deliver_DeliverWithPrivateDataServer) error {
+	logger.Debugf("Starting new DeliverWithPrivateData handler")
+	defer dumpStacktraceOnPanic()
+	sender := &blockAndPrivateDataResponseSender{
+		Deliver_DeliverWithPrivateDataServer: srv,
+		pvtDataSupport:                       s.pvtDataSupport,
+	}
+	// getting policy checker based on resources.Event_Block resource name
+	deliverServer := &deliver.Server{
+		PolicyChecker:  s.policyCheckerProvider(resources.Event_Block),
+		Receiver:       sender,
+		ResponseSender: sender,
+	}
+	return s.dh.Handle(srv.Context(), deliverServer)
+}
+
+// NewDeliverEventsServer creates a peer.Deliver server to deliver block,
+// filtered block and block with private data events
+func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerProvider, chainManager deliver.ChainManager, pvtDataSupport PvtDataSupport, metricsProvider metrics.Provider) peer.DeliverServer {
 	timeWindow := viper.GetDuration("peer.authentication.timewindow")
 	if timeWindow == 0 {
 		defaultTimeWindow := 15 * time.Minute
@@ -140,6 +307,7 @@ func NewDeliverEventsServer(mutualTLS bool, policyCheckerProvider PolicyCheckerP
 	return &server{
 		dh:                    deliver.NewHandler(chainManager, timeWindow, mutualTLS, metrics),
 		policyCheckerProvider: policyCheckerProvider,
+		pvtDataSupport:        pvtDataSupport,
 	}
 }
 
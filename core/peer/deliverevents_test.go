@@ -18,10 +18,14 @@ import (
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -146,6 +150,38 @@ func (*mockDeliverServer) SetTrailer(metadata.MD) {
 	panic("implement me")
 }
 
+// mockPvtDataSupport mock implementation of the PvtDataSupport interface
+type mockPvtDataSupport struct {
+	mock.Mock
+}
+
+func (m *mockPvtDataSupport) GetPvtDataByNum(channelID string, blockNum uint64) ([]*ledger.TxPvtData, error) {
+	args := m.Called(channelID, blockNum)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ledger.TxPvtData), args.Error(1)
+}
+
+func (m *mockPvtDataSupport) RetrieveCollectionAccessPolicy(cc common.CollectionCriteria) (privdata.CollectionAccessPolicy, error) {
+	args := m.Called(cc.Channel, cc.Namespace, cc.Collection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(privdata.CollectionAccessPolicy), args.Error(1)
+}
+
+// mockCollectionAccessPolicy mock implementation of the
+// privdata.CollectionAccessPolicy interface
+type mockCollectionAccessPolicy struct {
+	privdata.CollectionAccessPolicy
+	filter privdata.Filter
+}
+
+func (m *mockCollectionAccessPolicy) AccessFilter() privdata.Filter {
+	return m.filter
+}
+
 type testConfig struct {
 	channelID     string
 	eventName     string
@@ -383,6 +419,7 @@ func TestEventsServer_DeliverFiltered(t *testing.T) {
 				false,
 				defaultPolicyCheckerProvider,
 				chainManager,
+				&mockPvtDataSupport{},
 				&disabled.Provider{},
 			)
 			err := server.DeliverFiltered(deliverServer)
@@ -523,3 +560,142 @@ func createTestBlock(data []*common.Envelope) (*common.Block, error) {
 	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(data))
 	return block, nil
 }
+
+func TestEventsServer_DeliverWithPrivateData(t *testing.T) {
+	viper.Set("peer.authentication.timewindow", "1s")
+	creator := []byte("Org1MSP client")
+	seekRequest := &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					ChannelId: "testChainID",
+					Timestamp: util.CreateUtcTimestamp(),
+				}),
+				SignatureHeader: utils.MarshalOrPanic(&common.SignatureHeader{
+					Creator: creator,
+				}),
+			},
+			Data: utils.MarshalOrPanic(&orderer.SeekInfo{
+				Start:    &orderer.SeekPosition{Type: &orderer.SeekPosition_Specified{Specified: &orderer.SeekSpecified{Number: 0}}},
+				Stop:     &orderer.SeekPosition{Type: &orderer.SeekPosition_Newest{Newest: &orderer.SeekNewest{}}},
+				Behavior: orderer.SeekInfo_BLOCK_UNTIL_READY,
+			}),
+		}),
+		Signature: []byte("signature"),
+	}
+
+	collPvtRWSet := func(collection string) *rwset.CollectionPvtReadWriteSet {
+		return &rwset.CollectionPvtReadWriteSet{
+			CollectionName: collection,
+			Rwset:          []byte("rwset of " + collection),
+		}
+	}
+	txPvtData := []*ledger.TxPvtData{
+		{
+			SeqInBlock: 0,
+			WriteSet: &rwset.TxPvtReadWriteSet{
+				NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+					{
+						Namespace:          "mycc",
+						CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{collPvtRWSet("members"), collPvtRWSet("others")},
+					},
+					{
+						Namespace:          "othercc",
+						CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{collPvtRWSet("unknown")},
+					},
+				},
+			},
+		},
+		{
+			SeqInBlock: 1,
+			WriteSet: &rwset.TxPvtReadWriteSet{
+				NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+					{
+						Namespace:          "mycc",
+						CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{collPvtRWSet("others")},
+					},
+				},
+			},
+		},
+	}
+
+	isCreator := func(sd common.SignedData) bool {
+		return string(sd.Identity) == string(creator) && string(sd.Signature) == "signature"
+	}
+	isNotCreator := func(sd common.SignedData) bool {
+		return !isCreator(sd)
+	}
+
+	t.Run("Only collections the client is a member of", func(t *testing.T) {
+		config := testConfig{
+			channelID:  "testChainID",
+			txID:       "testID",
+			Assertions: assert.New(t),
+		}
+		chainManager := createDefaultSupportMamangerMock(config, nil)
+
+		pvtDataSupport := &mockPvtDataSupport{}
+		pvtDataSupport.On("GetPvtDataByNum", "testChainID", uint64(0)).Return(txPvtData, nil)
+		pvtDataSupport.On("RetrieveCollectionAccessPolicy", "testChainID", "mycc", "members").Return(&mockCollectionAccessPolicy{filter: isCreator}, nil)
+		pvtDataSupport.On("RetrieveCollectionAccessPolicy", "testChainID", "mycc", "others").Return(&mockCollectionAccessPolicy{filter: isNotCreator}, nil)
+		pvtDataSupport.On("RetrieveCollectionAccessPolicy", "testChainID", "othercc", "unknown").Return(nil, errors.New("collection not found"))
+
+		var responses []*peer.DeliverResponse
+		deliverServer := &mockDeliverServer{}
+		deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), &peer2.Peer{}))
+		deliverServer.On("Recv").Return(seekRequest, nil).Once()
+		deliverServer.On("Recv").Return(nil, io.EOF)
+		deliverServer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			responses = append(responses, args.Get(0).(*peer.DeliverResponse))
+		}).Return(nil)
+
+		server := NewDeliverEventsServer(false, defaultPolicyCheckerProvider, chainManager, pvtDataSupport, &disabled.Provider{})
+		err := server.DeliverWithPrivateData(deliverServer)
+		assert.NoError(t, err)
+
+		assert.Len(t, responses, 2)
+		blockAndPvtData := responses[0].GetBlockAndPrivateData()
+		assert.NotNil(t, blockAndPvtData)
+		assert.Equal(t, uint64(0), blockAndPvtData.Block.Header.Number)
+		assert.Equal(t, map[uint64]*rwset.TxPvtReadWriteSet{
+			0: {
+				NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+					{
+						Namespace:          "mycc",
+						CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{collPvtRWSet("members")},
+					},
+				},
+			},
+		}, blockAndPvtData.PrivateDataMap)
+		assert.Equal(t, common.Status_SUCCESS, responses[1].GetStatus())
+		// the access policy of each collection is retrieved once per block
+		pvtDataSupport.AssertNumberOfCalls(t, "RetrieveCollectionAccessPolicy", 3)
+	})
+
+	t.Run("Private data retrieval failure", func(t *testing.T) {
+		config := testConfig{
+			channelID:  "testChainID",
+			txID:       "testID",
+			Assertions: assert.New(t),
+		}
+		chainManager := createDefaultSupportMamangerMock(config, nil)
+
+		pvtDataSupport := &mockPvtDataSupport{}
+		pvtDataSupport.On("GetPvtDataByNum", "testChainID", uint64(0)).Return(nil, errors.New("pvtdata store unavailable"))
+
+		var responses []*peer.DeliverResponse
+		deliverServer := &mockDeliverServer{}
+		deliverServer.On("Context").Return(peer2.NewContext(context.TODO(), &peer2.Peer{}))
+		deliverServer.On("Recv").Return(seekRequest, nil).Once()
+		deliverServer.On("Recv").Return(nil, io.EOF)
+		deliverServer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			responses = append(responses, args.Get(0).(*peer.DeliverResponse))
+		}).Return(nil)
+
+		server := NewDeliverEventsServer(false, defaultPolicyCheckerProvider, chainManager, pvtDataSupport, &disabled.Provider{})
+		err := server.DeliverWithPrivateData(deliverServer)
+		// the stream is aborted rather than delivering the block without its private data
+		assert.EqualError(t, err, "failed retrieving private data of block [0]: pvtdata store unavailable")
+		assert.Empty(t, responses)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net"
 	"runtime"
+	"sort"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -21,6 +22,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	fileledger "github.com/hyperledger/fabric/common/ledger/blockledger/file"
 	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/policies"
 	"github.com/hyperledger/fabric/core/chaincode/platforms"
 	"github.com/hyperledger/fabric/core/comm"
@@ -76,25 +78,49 @@ type chainSupport struct {
 	ledger ledger.PeerLedger
 }
 
-var TransientStoreFactory = &storeProvider{stores: make(map[string]transientstore.Store)}
+var TransientStoreFactory = &storeProvider{
+	stores:          make(map[string]transientstore.Store),
+	metricsProvider: &disabled.Provider{},
+}
 
 type storeProvider struct {
 	stores map[string]transientstore.Store
 	transientstore.StoreProvider
+	metricsProvider metrics.Provider
 	sync.RWMutex
 }
 
+// setMetricsProvider sets the metrics provider of the transient stores
+// which are opened thereafter
+func (sp *storeProvider) setMetricsProvider(metricsProvider metrics.Provider) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.metricsProvider = metricsProvider
+}
+
 func (sp *storeProvider) StoreForChannel(channel string) transientstore.Store {
 	sp.RLock()
 	defer sp.RUnlock()
 	return sp.stores[channel]
 }
 
+// Channels returns the channels that have a transient store
+func (sp *storeProvider) Channels() []string {
+	sp.RLock()
+	defer sp.RUnlock()
+	var channels []string
+	for channel := range sp.stores {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
 func (sp *storeProvider) OpenStore(ledgerID string) (transientstore.Store, error) {
 	sp.Lock()
 	defer sp.Unlock()
 	if sp.StoreProvider == nil {
-		sp.StoreProvider = transientstore.NewStoreProvider()
+		sp.StoreProvider = transientstore.NewStoreProvider(sp.metricsProvider)
 	}
 	store, err := sp.StoreProvider.OpenStore(ledgerID)
 	if err == nil {
@@ -213,6 +239,7 @@ func Initialize(init func(string), ccp ccprovider.ChaincodeProvider, sccp sysccp
 
 	pluginMapper = pm
 	chainInitializer = init
+	TransientStoreFactory.setMetricsProvider(metricsProvider)
 
 	var cb *common.Block
 	var ledger ledger.PeerLedger
@@ -412,6 +439,9 @@ func createChain(cid string, ledger ledger.PeerLedger, cb *common.Block, ccp ccp
 		Store:                store,
 		Cs:                   simpleCollectionStore,
 		IdDeserializeFactory: csStoreSupport,
+		ChannelMSPIDs: func() []string {
+			return GetMSPIDs(cid)
+		},
 	})
 
 	chains.Lock()
@@ -724,6 +754,10 @@ func (*CollectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDe
 	return mspmgmt.GetManagerForChain(chainID)
 }
 
+func (*CollectionSupport) GetMSPIDs(cid string) []string {
+	return GetMSPIDs(cid)
+}
+
 //
 //  Deliver service support structs for the peer
 //
@@ -740,6 +774,27 @@ func (DeliverChainManager) GetChain(chainID string) deliver.Chain {
 	return channel.cs
 }
 
+// DeliverPvtDataSupport provides access to the private data of a channel
+// and to the access policies of its collections for performing deliver
+type DeliverPvtDataSupport struct {
+}
+
+func (DeliverPvtDataSupport) GetPvtDataByNum(channelID string, blockNum uint64) ([]*ledger.TxPvtData, error) {
+	l := GetLedger(channelID)
+	if l == nil {
+		return nil, errors.Errorf("channel %s not found", channelID)
+	}
+	return l.GetPvtDataByNum(blockNum, nil)
+}
+
+func (DeliverPvtDataSupport) RetrieveCollectionAccessPolicy(cc common.CollectionCriteria) (privdata.CollectionAccessPolicy, error) {
+	l := GetLedger(cc.Channel)
+	if l == nil {
+		return nil, errors.Errorf("channel %s not found", cc.Channel)
+	}
+	return privdata.NewSimpleCollectionStore(&CollectionSupport{PeerLedger: l}).RetrieveCollectionAccessPolicy(cc)
+}
+
 // fileLedgerBlockStore implements the interface expected by
 // common/ledger/blockledger/file to interact with a file ledger for deliver
 type fileLedgerBlockStore struct {
@@ -118,7 +118,7 @@ func TestCreateChainFromBlock(t *testing.T) {
 		return dialOpts
 	}
 	err = service.InitGossipServiceCustomDeliveryFactory(
-		identity, socket.Addr().String(), grpcServer, nil,
+		identity, &disabled.Provider{}, socket.Addr().String(), grpcServer, nil,
 		&mockDeliveryClientFactory{},
 		messageCryptoService, secAdv, defaultSecureDialOpts)
 
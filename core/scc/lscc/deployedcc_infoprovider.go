@@ -81,6 +81,12 @@ func (p *DeployedCCInfoProvider) ChaincodeInfo(chaincodeName string, qe ledger.S
 
 // CollectionInfo implements function in interface ledger.DeployedChaincodeInfoProvider
 func (p *DeployedCCInfoProvider) CollectionInfo(chaincodeName, collectionName string, qe ledger.SimpleQueryExecutor) (*common.StaticCollectionConfig, error) {
+	if isImplicit, mspID := privdata.MSPIDIfImplicitCollection(collectionName); isImplicit {
+		// The config of an implicit collection does not depend on the current orgs of the channel,
+		// so that its private data is committed and purged alike by all the peers, including when
+		// blocks are recommitted during recovery or an org left the channel since the block was cut
+		return privdata.GenerateImplicitCollectionForOrg(mspID), nil
+	}
 	collConfigPkg, err := fetchCollConfigPkg(chaincodeName, qe)
 	if err != nil || collConfigPkg == nil {
 		return nil, err
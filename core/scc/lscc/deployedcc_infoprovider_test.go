@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/core/scc/lscc"
 	"github.com/hyperledger/fabric/core/scc/lscc/mock"
 	"github.com/hyperledger/fabric/protos/common"
@@ -85,6 +86,20 @@ func TestCollectionInfo(t *testing.T) {
 	collInfo3, err := ccInfoProvdier.CollectionInfo("cc2", "non-existing-coll-in-cc2", mockQE)
 	assert.NoError(t, err)
 	assert.Nil(t, collInfo3)
+
+	collInfo4, err := ccInfoProvdier.CollectionInfo("cc1", "_implicit_org_Org1MSP", mockQE)
+	assert.NoError(t, err)
+	assert.Equal(t, privdata.GenerateImplicitCollectionForOrg("Org1MSP"), collInfo4)
+
+	// the config of implicit collections does not depend on the current orgs of the channel
+	peer.MockSetMSPIDGetter(func(channelName string) []string {
+		return nil
+	})
+	defer peer.MockSetMSPIDGetter(nil)
+	collInfo5, err := ccInfoProvdier.CollectionInfo("cc1", "_implicit_org_Org3MSP", mockQE)
+	assert.NoError(t, err)
+	assert.Equal(t, privdata.GenerateImplicitCollectionForOrg("Org3MSP"), collInfo5)
+	assert.Equal(t, uint64(0), collInfo5.BlockToLive)
 }
 
 func prepareMockQE(t *testing.T, deployedChaincodes []*ledger.DeployedChaincodeInfo) *mock.QueryExecutor {
@@ -445,7 +445,7 @@ func (lscc *LifeCycleSysCC) getChaincodes(stub shim.ChaincodeStubInterface) pb.R
 		}
 
 		// add this specific chaincode's metadata to the array of all chaincodes
-		ccInfo := &pb.ChaincodeInfo{Name: ccdata.Name, Version: ccdata.Version, Path: path, Input: input, Escc: ccdata.Escc, Vscc: ccdata.Vscc}
+		ccInfo := &pb.ChaincodeInfo{Name: ccdata.Name, Version: ccdata.Version, Path: path, Input: input, Escc: ccdata.Escc, Vscc: ccdata.Vscc, Policy: ccdata.Policy}
 		ccInfoArray = append(ccInfoArray, ccInfo)
 	}
 	// add array with info about all instantiated chaincodes to the query
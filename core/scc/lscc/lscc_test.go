@@ -712,7 +712,7 @@ func TestGetChaincodesFilter(t *testing.T) {
 	results := []*queryresult.KV{
 		{Key: "one", Value: utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "name-one", Version: "1.0", Escc: "escc", Vscc: "vscc"})},
 		{Key: "something~collections", Value: []byte("completely-ignored")},
-		{Key: "two", Value: utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "name-two", Version: "2.0", Escc: "escc-2", Vscc: "vscc-2"})},
+		{Key: "two", Value: utils.MarshalOrPanic(&ccprovider.ChaincodeData{Name: "name-two", Version: "2.0", Escc: "escc-2", Vscc: "vscc-2", Policy: []byte("policy-2")})},
 	}
 	for i, r := range results {
 		sqi.NextReturnsOnCall(i, r, nil)
@@ -731,7 +731,7 @@ func TestGetChaincodesFilter(t *testing.T) {
 
 	assert.Equal(t, cqr.Chaincodes, []*pb.ChaincodeInfo{
 		{Name: "name-one", Version: "1.0", Escc: "escc", Vscc: "vscc"},
-		{Name: "name-two", Version: "2.0", Escc: "escc-2", Vscc: "vscc-2"},
+		{Name: "name-two", Version: "2.0", Escc: "escc-2", Vscc: "vscc-2", Policy: []byte("policy-2")},
 	})
 }
 
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package qscc
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -40,6 +41,8 @@ func (e *LedgerQuerier) Enabled() bool             { return true }
 // - GetBlockByNumber returns a block
 // - GetBlockByHash returns a block
 // - GetTransactionByID returns a transaction
+// - GetStateHash returns the state hash at a block
+// - VerifyStateHash verifies the state hash at a block
 type LedgerQuerier struct {
 	aclProvider aclmgmt.ACLProvider
 }
@@ -53,6 +56,8 @@ const (
 	GetBlockByHash     string = "GetBlockByHash"
 	GetTransactionByID string = "GetTransactionByID"
 	GetBlockByTxID     string = "GetBlockByTxID"
+	GetStateHash       string = "GetStateHash"
+	VerifyStateHash    string = "VerifyStateHash"
 )
 
 // Init is called once per chain when the chain is created.
@@ -71,6 +76,9 @@ func (e *LedgerQuerier) Init(stub shim.ChaincodeStubInterface) pb.Response {
 // # GetBlockByNumber: Return the block specified by block number in args[2]
 // # GetBlockByHash: Return the block specified by block hash in args[2]
 // # GetTransactionByID: Return the transaction specified by ID in args[2]
+// # GetStateHash: Return the state hash at the block specified by block number in args[2]
+// # VerifyStateHash: Verify that the state hash at the block specified by block number in args[2]
+//   matches the hex encoded state hash in args[3]
 func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 	args := stub.GetArgs()
 
@@ -115,6 +123,13 @@ func (e *LedgerQuerier) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return getChainInfo(targetLedger)
 	case GetBlockByTxID:
 		return getBlockByTxID(targetLedger, args[2])
+	case GetStateHash:
+		return getStateHash(targetLedger, args[2])
+	case VerifyStateHash:
+		if len(args) < 4 {
+			return shim.Error(fmt.Sprintf("missing 4th argument for %s", fname))
+		}
+		return verifyStateHash(targetLedger, args[2], args[3])
 	}
 
 	return shim.Error(fmt.Sprintf("Requested function %s not found.", fname))
@@ -214,6 +229,41 @@ func getBlockByTxID(vledger ledger.PeerLedger, rawTxID []byte) pb.Response {
 	return shim.Success(bytes)
 }
 
+func getStateHash(vledger ledger.PeerLedger, number []byte) pb.Response {
+	bnum, err := strconv.ParseUint(string(number), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse block number with error %s", err))
+	}
+	stateHash, err := vledger.GetStateHash(bnum)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to get state hash for block number %d, error %s", bnum, err))
+	}
+	if stateHash == nil {
+		return shim.Error(fmt.Sprintf("State hash is not available for block number %d", bnum))
+	}
+	return shim.Success(stateHash)
+}
+
+func verifyStateHash(vledger ledger.PeerLedger, number []byte, expectedStateHash []byte) pb.Response {
+	bnum, err := strconv.ParseUint(string(number), 10, 64)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to parse block number with error %s", err))
+	}
+	expected, err := hex.DecodeString(string(expectedStateHash))
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to decode expected state hash with error %s", err))
+	}
+	match, err := vledger.VerifyStateHash(bnum, expected)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("Failed to verify state hash for block number %d, error %s", bnum, err))
+	}
+	if !match {
+		stateHash, _ := vledger.GetStateHash(bnum)
+		return shim.Error(fmt.Sprintf("State hash mismatch for block number %d, expected %x, found %x", bnum, expected, stateHash))
+	}
+	return shim.Success(expected)
+}
+
 func getACLResource(fname string) string {
 	return "qscc/" + fname
 }
@@ -16,6 +16,7 @@ limitations under the License.
 package qscc
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -179,6 +180,50 @@ func TestQueryGetBlockByTxID(t *testing.T) {
 	assert.Equal(t, int32(shim.ERROR), res.Status, "GetBlockByTxID should have failed with blank txId.")
 }
 
+func TestQueryStateHash(t *testing.T) {
+	chainid := "mytestchainid9"
+	path := tempDir(t, "test9")
+	defer os.RemoveAll(path)
+
+	viper.Set("ledger.state.enableStateHash", true)
+	defer viper.Set("ledger.state.enableStateHash", false)
+	stub, err := setupTestLedger(chainid, path)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// state hash for block number 0 (genesis block) would already be present in the ledger
+	args := [][]byte{[]byte(GetStateHash), []byte(chainid), []byte("0")}
+	prop := resetProvider(resources.Qscc_GetStateHash, chainid, &peer2.SignedProposal{}, nil)
+	res := stub.MockInvokeWithSignedProposal("1", args, prop)
+	assert.Equal(t, int32(shim.OK), res.Status, "GetStateHash should have succeeded for block number: 0")
+	assert.NotEmpty(t, res.Payload)
+	stateHash := res.Payload
+
+	// state hash for block number 1 should not be present in the ledger
+	args = [][]byte{[]byte(GetStateHash), []byte(chainid), []byte("1")}
+	res = stub.MockInvoke("2", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "GetStateHash should have failed with invalid number: 1")
+
+	args = [][]byte{[]byte(VerifyStateHash), []byte(chainid), []byte("0"), []byte(hex.EncodeToString(stateHash))}
+	prop = resetProvider(resources.Qscc_VerifyStateHash, chainid, &peer2.SignedProposal{}, nil)
+	res = stub.MockInvokeWithSignedProposal("3", args, prop)
+	assert.Equal(t, int32(shim.OK), res.Status, "VerifyStateHash should have succeeded for block number: 0")
+
+	args = [][]byte{[]byte(VerifyStateHash), []byte(chainid), []byte("0"), []byte(hex.EncodeToString([]byte("wrong-hash")))}
+	res = stub.MockInvoke("4", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyStateHash should have failed with a wrong state hash")
+	assert.Contains(t, res.Message, "State hash mismatch for block number 0")
+
+	args = [][]byte{[]byte(VerifyStateHash), []byte(chainid), []byte("0"), []byte("not-hex")}
+	res = stub.MockInvoke("5", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyStateHash should have failed with a non hex state hash")
+
+	args = [][]byte{[]byte(VerifyStateHash), []byte(chainid), []byte("0")}
+	res = stub.MockInvoke("6", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status, "VerifyStateHash should have failed due to incorrect number of arguments")
+}
+
 func TestFailingAccessControl(t *testing.T) {
 	chainid := "mytestchainid6"
 	path := tempDir(t, "test6")
@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transientstore
+
+import (
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+const (
+	evictionReasonAge  = "age"
+	evictionReasonSize = "size"
+)
+
+type stats struct {
+	entries   metrics.Gauge
+	size      metrics.Gauge
+	evictions metrics.Counter
+}
+
+func newStats(metricsProvider metrics.Provider) *stats {
+	return &stats{
+		entries:   metricsProvider.NewGauge(entriesOpts),
+		size:      metricsProvider.NewGauge(sizeOpts),
+		evictions: metricsProvider.NewCounter(evictionsOpts),
+	}
+}
+
+type ledgerStats struct {
+	stats    *stats
+	ledgerID string
+}
+
+func (s *stats) ledgerStats(ledgerID string) *ledgerStats {
+	return &ledgerStats{
+		s, ledgerID,
+	}
+}
+
+func (s *ledgerStats) updateEntries(numEntries, size uint64) {
+	s.stats.entries.With("channel", s.ledgerID).Set(float64(numEntries))
+	s.stats.size.With("channel", s.ledgerID).Set(float64(size))
+}
+
+func (s *ledgerStats) addEvictions(reason string, numEvicted int) {
+	if numEvicted == 0 {
+		return
+	}
+	s.stats.evictions.With("channel", s.ledgerID, "reason", reason).Add(float64(numEvicted))
+}
+
+var (
+	entriesOpts = metrics.GaugeOpts{
+		Namespace:    "transientstore",
+		Subsystem:    "",
+		Name:         "entries",
+		Help:         "Number of private write sets in the transient store.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	sizeOpts = metrics.GaugeOpts{
+		Namespace:    "transientstore",
+		Subsystem:    "",
+		Name:         "size_bytes",
+		Help:         "Total size in bytes of the private write sets in the transient store.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	evictionsOpts = metrics.CounterOpts{
+		Namespace:    "transientstore",
+		Subsystem:    "",
+		Name:         "evictions",
+		Help:         "Number of private write sets evicted from the transient store due to their age or the size of the store.",
+		LabelNames:   []string{"channel", "reason"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{reason}",
+	}
+)
@@ -0,0 +1,59 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transientstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+
+	fakeProvider := &metricsfakes.Provider{}
+	fakeEntriesGauge := &metricsfakes.Gauge{}
+	fakeEntriesGauge.WithReturns(fakeEntriesGauge)
+	fakeSizeGauge := &metricsfakes.Gauge{}
+	fakeSizeGauge.WithReturns(fakeSizeGauge)
+	fakeEvictionsCounter := &metricsfakes.Counter{}
+	fakeEvictionsCounter.WithReturns(fakeEvictionsCounter)
+	fakeProvider.NewGaugeStub = func(opts metrics.GaugeOpts) metrics.Gauge {
+		switch opts.Name {
+		case entriesOpts.Name:
+			return fakeEntriesGauge
+		case sizeOpts.Name:
+			return fakeSizeGauge
+		}
+		return nil
+	}
+	fakeProvider.NewCounterReturns(fakeEvictionsCounter)
+
+	s := env.TestStore.(*store)
+	s.stats = newStats(fakeProvider).ledgerStats("TestStore")
+	s.evictionConfig = &EvictionConfig{MaxAge: time.Minute}
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	assert.NoError(t, s.PersistWithConfig("txid-1", 10, samplePvtDataWithConfigInfo(t)))
+	assert.Equal(t, []string{"channel", "TestStore"}, fakeEntriesGauge.WithArgsForCall(0))
+	assert.Equal(t, float64(1), fakeEntriesGauge.SetArgsForCall(0))
+	assert.Equal(t, []string{"channel", "TestStore"}, fakeSizeGauge.WithArgsForCall(0))
+	assert.Equal(t, float64(s.size), fakeSizeGauge.SetArgsForCall(0))
+	assert.Equal(t, 0, fakeEvictionsCounter.AddCallCount())
+
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, s.PersistWithConfig("txid-2", 10, samplePvtDataWithConfigInfo(t)))
+	assert.Equal(t, 1, fakeEvictionsCounter.AddCallCount())
+	assert.Equal(t, []string{"channel", "TestStore", "reason", "age"}, fakeEvictionsCounter.WithArgsForCall(0))
+	assert.Equal(t, float64(1), fakeEvictionsCounter.AddArgsForCall(0))
+	assert.Equal(t, float64(1), fakeEntriesGauge.SetArgsForCall(1))
+}
@@ -8,10 +8,13 @@ package transientstore
 
 import (
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
@@ -21,7 +24,6 @@ import (
 
 var logger = flogging.MustGetLogger("transientstore")
 
-var emptyValue = []byte{}
 var nilByte = byte('\x00')
 
 // ErrStoreEmpty is used to indicate that there are no entries in transient store
@@ -78,11 +80,55 @@ type Store interface {
 	// after successful block commit, PurgeByHeight() is still required to remove orphan entries (as
 	// transaction that gets endorsed may not be submitted by the client for commit)
 	PurgeByHeight(maxBlockNumToRetain uint64) error
+	// PurgeKeys removes the private write sets that were persisted at block height of maxBlockHeight
+	// or lower, i.e., that were simulated before the keys were purged, and that hold a write of any
+	// of the given purged keys
+	PurgeKeys(purgedKeys []*PurgedKey, maxBlockHeight uint64) error
 	// GetMinTransientBlkHt returns the lowest block height remaining in transient store
 	GetMinTransientBlkHt() (uint64, error)
+	// GetPendingEntries returns the number and the total size of the private write sets
+	// residing in the transient store, along with up to maxEntries of the oldest of them
+	GetPendingEntries(maxEntries int) (*PendingEntries, error)
 	Shutdown()
 }
 
+// EvictionConfig holds the limits beyond which private write sets are evicted from a
+// transient store, irrespective of the block height they were received at
+type EvictionConfig struct {
+	// MaxAge is the maximum wall-clock age of a private write set. A zero MaxAge
+	// disables the eviction based on age
+	MaxAge time.Duration
+	// MaxSize is the maximum total size in bytes of the private write sets of a store.
+	// When exceeded, the oldest private write sets are evicted first. A zero MaxSize
+	// disables the eviction based on size
+	MaxSize uint64
+}
+
+// PendingEntries describes the private write sets residing in a transient store
+type PendingEntries struct {
+	// TotalEntries is the number of private write sets in the store
+	TotalEntries uint64
+	// TotalSize is the total size in bytes of the private write sets in the store
+	TotalSize uint64
+	// Oldest holds the oldest private write sets in the store, ordered by the time they were received at
+	Oldest []*PendingEntry
+}
+
+// PendingEntry describes a private write set residing in a transient store
+type PendingEntry struct {
+	TxID                  string
+	ReceivedAt            time.Time
+	ReceivedAtBlockHeight uint64
+	Size                  uint64
+}
+
+// PurgedKey identifies, by its hash, a key that is purged from a private data collection
+type PurgedKey struct {
+	Namespace  string
+	Collection string
+	KeyHash    []byte
+}
+
 // EndorserPvtSimulationResults captures the details of the simulation results specific to an endorser
 // TODO: Once the related gossip changes are made as per FAB-5096, remove this struct
 type EndorserPvtSimulationResults struct {
@@ -104,13 +150,24 @@ type EndorserPvtSimulationResultsWithConfig struct {
 // private write sets of simulated transactions, and implements TransientStoreProvider
 // interface.
 type storeProvider struct {
-	dbProvider *leveldbhelper.Provider
+	dbProvider     *leveldbhelper.Provider
+	evictionConfig *EvictionConfig
+	stats          *stats
 }
 
 // store holds an instance of a levelDB.
 type store struct {
-	db       *leveldbhelper.DBHandle
-	ledgerID string
+	db             *leveldbhelper.DBHandle
+	ledgerID       string
+	evictionConfig *EvictionConfig
+	stats          *ledgerStats
+	now            func() time.Time
+
+	// mutex serializes the updates to the store such that the number of private
+	// write sets and their total size are kept in sync with the content of the store
+	mutex      sync.Mutex
+	numEntries uint64
+	size       uint64
 }
 
 type RwsetScanner struct {
@@ -120,15 +177,27 @@ type RwsetScanner struct {
 }
 
 // NewStoreProvider instantiates TransientStoreProvider
-func NewStoreProvider() StoreProvider {
+func NewStoreProvider(metricsProvider metrics.Provider) StoreProvider {
 	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: GetTransientStorePath()})
-	return &storeProvider{dbProvider: dbProvider}
+	return &storeProvider{
+		dbProvider:     dbProvider,
+		evictionConfig: GetEvictionConfig(),
+		stats:          newStats(metricsProvider),
+	}
 }
 
 // OpenStore returns a handle to a ledgerId in Store
 func (provider *storeProvider) OpenStore(ledgerID string) (Store, error) {
 	dbHandle := provider.dbProvider.GetDBHandle(ledgerID)
-	return &store{db: dbHandle, ledgerID: ledgerID}, nil
+	s := &store{
+		db:             dbHandle,
+		ledgerID:       ledgerID,
+		evictionConfig: provider.evictionConfig,
+		stats:          provider.stats.ledgerStats(ledgerID),
+		now:            time.Now,
+	}
+	s.loadEntriesInfo()
+	return s, nil
 }
 
 // Close closes the TransientStoreProvider
@@ -144,43 +213,11 @@ func (s *store) Persist(txid string, blockHeight uint64,
 
 	logger.Debugf("Persisting private data to transient store for txid [%s] at block height [%d]", txid, blockHeight)
 
-	dbBatch := leveldbhelper.NewUpdateBatch()
-
-	// Create compositeKey with appropriate prefix, txid, uuid and blockHeight
-	// Due to the fact that the txid may have multiple private write sets persisted from different
-	// endorsers (via Gossip), we postfix an uuid with the txid to avoid collision.
-	uuid := util.GenerateUUID()
-	compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
 	privateSimulationResultsBytes, err := proto.Marshal(privateSimulationResults)
 	if err != nil {
 		return err
 	}
-	dbBatch.Put(compositeKeyPvtRWSet, privateSimulationResultsBytes)
-
-	// Create two index: (i) by txid, and (ii) by height
-
-	// Create compositeKey for purge index by height with appropriate prefix, blockHeight,
-	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that
-	// the purge index is used to remove orphan entries in the transient store (which are not removed
-	// by PurgeTxids()) using BTL policy by PurgeByHeight(). Note that orphan entries are due to transaction
-	// that gets endorsed but not submitted by the client for commit)
-	compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
-	dbBatch.Put(compositeKeyPurgeIndexByHeight, emptyValue)
-
-	// Create compositeKey for purge index by txid with appropriate prefix, txid, uuid,
-	// blockHeight and store the compositeKey (purge index) with a nil byte as value.
-	// Though compositeKeyPvtRWSet itself can be used to purge private write set by txid,
-	// we create a separate composite key with a nil byte as value. The reason is that
-	// if we use compositeKeyPvtRWSet, we unnecessarily read (potentially large) private write
-	// set associated with the key from db. Note that this purge index is used to remove non-orphan
-	// entries in the transient store and is used by PurgeTxids()
-	// Note: We can create compositeKeyPurgeIndexByTxid by just replacing the prefix of compositeKeyPvtRWSet
-	// with purgeIndexByTxidPrefix. For code readability and to be expressive, we use a
-	// createCompositeKeyForPurgeIndexByTxid() instead.
-	compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
-	dbBatch.Put(compositeKeyPurgeIndexByTxid, emptyValue)
-
-	return s.db.WriteBatch(dbBatch, true)
+	return s.persist(txid, blockHeight, privateSimulationResultsBytes)
 }
 
 // PersistWithConfig stores the private write set of a transaction along with the collection config
@@ -192,13 +229,6 @@ func (s *store) PersistWithConfig(txid string, blockHeight uint64,
 
 	logger.Debugf("Persisting private data to transient store for txid [%s] at block height [%d]", txid, blockHeight)
 
-	dbBatch := leveldbhelper.NewUpdateBatch()
-
-	// Create compositeKey with appropriate prefix, txid, uuid and blockHeight
-	// Due to the fact that the txid may have multiple private write sets persisted from different
-	// endorsers (via Gossip), we postfix an uuid with the txid to avoid collision.
-	uuid := util.GenerateUUID()
-	compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
 	privateSimulationResultsWithConfigBytes, err := proto.Marshal(privateSimulationResultsWithConfig)
 	if err != nil {
 		return err
@@ -210,22 +240,45 @@ func (s *store) PersistWithConfig(txid string, blockHeight uint64,
 	// as a marshaled message can never start with a nil byte. In v1.3, we can avoid prepending the
 	// nil byte.
 	value := append([]byte{nilByte}, privateSimulationResultsWithConfigBytes...)
+	return s.persist(txid, blockHeight, value)
+}
+
+// persist stores the given value, i.e., the marshaled private write set of a transaction,
+// along with the indexes on it and evicts the private write sets that exceed the limits
+// of the eviction config
+func (s *store) persist(txid string, blockHeight uint64, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dbBatch := leveldbhelper.NewUpdateBatch()
+
+	// Create compositeKey with appropriate prefix, txid, uuid and blockHeight
+	// Due to the fact that the txid may have multiple private write sets persisted from different
+	// endorsers (via Gossip), we postfix an uuid with the txid to avoid collision.
+	uuid := util.GenerateUUID()
+	compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
 	dbBatch.Put(compositeKeyPvtRWSet, value)
 
-	// Create two index: (i) by txid, and (ii) by height
+	// The purge indexes hold the wall-clock time the private write set is received at and its
+	// size such that the private write set can be evicted, and the total size of the store can be
+	// maintained, while purging the private write set using any of the indexes
+	info := &entryInfo{receivedAt: uint64(s.now().UnixNano()), size: uint64(len(value))}
+	indexVal := encodeEntryInfo(info)
+
+	// Create three index: (i) by txid, (ii) by height, and (iii) by received at wall-clock time
 
 	// Create compositeKey for purge index by height with appropriate prefix, blockHeight,
-	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that
+	// txid, uuid and store the compositeKey (purge index) with the entry info as value. Note that
 	// the purge index is used to remove orphan entries in the transient store (which are not removed
 	// by PurgeTxids()) using BTL policy by PurgeByHeight(). Note that orphan entries are due to transaction
 	// that gets endorsed but not submitted by the client for commit)
 	compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
-	dbBatch.Put(compositeKeyPurgeIndexByHeight, emptyValue)
+	dbBatch.Put(compositeKeyPurgeIndexByHeight, indexVal)
 
 	// Create compositeKey for purge index by txid with appropriate prefix, txid, uuid,
-	// blockHeight and store the compositeKey (purge index) with a nil byte as value.
+	// blockHeight and store the compositeKey (purge index) with the entry info as value.
 	// Though compositeKeyPvtRWSet itself can be used to purge private write set by txid,
-	// we create a separate composite key with a nil byte as value. The reason is that
+	// we create a separate composite key with a small value. The reason is that
 	// if we use compositeKeyPvtRWSet, we unnecessarily read (potentially large) private write
 	// set associated with the key from db. Note that this purge index is used to remove non-orphan
 	// entries in the transient store and is used by PurgeTxids()
@@ -233,9 +286,22 @@ func (s *store) PersistWithConfig(txid string, blockHeight uint64,
 	// with purgeIndexByTxidPrefix. For code readability and to be expressive, we use a
 	// createCompositeKeyForPurgeIndexByTxid() instead.
 	compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
-	dbBatch.Put(compositeKeyPurgeIndexByTxid, emptyValue)
+	dbBatch.Put(compositeKeyPurgeIndexByTxid, indexVal)
 
-	return s.db.WriteBatch(dbBatch, true)
+	// Create compositeKey for purge index by received at wall-clock time with appropriate prefix,
+	// receivedAt, txid, uuid, blockHeight and store the compositeKey (purge index) with the entry info
+	// as value. Note that this purge index is used to evict the entries that are older than the maximum
+	// age, or the oldest entries when the store exceeds the maximum size, by evict()
+	compositeKeyPurgeIndexByTime := createCompositeKeyForPurgeIndexByTime(info.receivedAt, txid, uuid, blockHeight)
+	dbBatch.Put(compositeKeyPurgeIndexByTime, indexVal)
+
+	if err := s.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	s.numEntries++
+	s.size += info.size
+	s.evictAndUpdateStats()
+	return nil
 }
 
 // GetTxPvtRWSetByTxid returns an iterator due to the fact that the txid may have multiple private
@@ -259,7 +325,10 @@ func (s *store) PurgeByTxids(txids []string) error {
 
 	logger.Debug("Purging private data from transient store for committed txids")
 
-	dbBatch := leveldbhelper.NewUpdateBatch()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	batch := newPurgeBatch()
 
 	for _, txid := range txids {
 		// Construct startKey and endKey to do an range query
@@ -272,27 +341,20 @@ func (s *store) PurgeByTxids(txids []string) error {
 		// write set and the corresponding indexes.
 		for iter.Next() {
 			// For each entry, remove the private read-write set and correponding indexes
-
-			// Remove private write set
-			compositeKeyPurgeIndexByTxid := iter.Key()
 			// Note: We can create compositeKeyPvtRWSet by just replacing the prefix of compositeKeyPurgeIndexByTxid
 			// with  prwsetPrefix. For code readability and to be expressive, we split and create again.
-			uuid, blockHeight := splitCompositeKeyOfPurgeIndexByTxid(compositeKeyPurgeIndexByTxid)
-			compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
-			dbBatch.Delete(compositeKeyPvtRWSet)
-
-			// Remove purge index -- purgeIndexByHeight
-			compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
-			dbBatch.Delete(compositeKeyPurgeIndexByHeight)
-
-			// Remove purge index -- purgeIndexByTxid
-			dbBatch.Delete(compositeKeyPurgeIndexByTxid)
+			uuid, blockHeight := splitCompositeKeyOfPurgeIndexByTxid(iter.Key())
+			batch.remove(txid, uuid, blockHeight, decodeEntryInfo(iter.Value()))
 		}
 		iter.Release()
 	}
 	// If peer fails before/while writing the batch to golevelDB, these entries will be
 	// removed as per BTL policy later by PurgeByHeight()
-	return s.db.WriteBatch(dbBatch, true)
+	if err := s.writePurgeBatch(batch); err != nil {
+		return err
+	}
+	s.evictAndUpdateStats()
+	return nil
 }
 
 // PurgeByHeight removes private write sets at block height lesser than
@@ -305,36 +367,81 @@ func (s *store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 
 	logger.Debugf("Purging orphaned private data from transient store received prior to block [%d]", maxBlockNumToRetain)
 
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	// Do a range query with 0 as startKey and maxBlockNumToRetain-1 as endKey
 	startKey := createPurgeIndexByHeightRangeStartKey(0)
 	endKey := createPurgeIndexByHeightRangeEndKey(maxBlockNumToRetain - 1)
 	iter := s.db.GetIterator(startKey, endKey)
 
-	dbBatch := leveldbhelper.NewUpdateBatch()
+	batch := newPurgeBatch()
 
 	// Get all txid and uuid from above result and remove it from transient store (both
 	// write set and the corresponding index.
 	for iter.Next() {
 		// For each entry, remove the private read-write set and correponding indexes
-
-		// Remove private write set
-		compositeKeyPurgeIndexByHeight := iter.Key()
-		txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByHeight(compositeKeyPurgeIndexByHeight)
+		txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByHeight(iter.Key())
 		logger.Debugf("Purging from transient store private data simulated at block [%d]: txid [%s] uuid [%s]", blockHeight, txid, uuid)
+		batch.remove(txid, uuid, blockHeight, decodeEntryInfo(iter.Value()))
+	}
+	iter.Release()
 
-		compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
-		dbBatch.Delete(compositeKeyPvtRWSet)
+	if err := s.writePurgeBatch(batch); err != nil {
+		return err
+	}
+	s.evictAndUpdateStats()
+	return nil
+}
+
+// PurgeKeys removes the private write sets that were persisted at block height of maxBlockHeight
+// or lower and that hold a write of any of the given purged keys. A private write set is removed as
+// a whole, as it would no longer match the hash in the block if its writes were altered. PurgeKeys()
+// is expected to be called by coordinator after committing a block that purges private data
+func (s *store) PurgeKeys(purgedKeys []*PurgedKey, maxBlockHeight uint64) error {
+	if len(purgedKeys) == 0 {
+		return nil
+	}
+	logger.Debugf("Purging private data of [%d] purged keys from transient store received up to block [%d]", len(purgedKeys), maxBlockHeight)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-		// Remove purge index -- purgeIndexByTxid
-		compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
-		dbBatch.Delete(compositeKeyPurgeIndexByTxid)
+	keyHashes := newPurgedKeyHashes(purgedKeys)
 
-		// Remove purge index -- purgeIndexByHeight
-		dbBatch.Delete(compositeKeyPurgeIndexByHeight)
+	startKey := createPurgeIndexByHeightRangeStartKey(0)
+	endKey := createPurgeIndexByHeightRangeEndKey(maxBlockHeight)
+	iter := s.db.GetIterator(startKey, endKey)
+
+	batch := newPurgeBatch()
+	for iter.Next() {
+		txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByHeight(iter.Key())
+		dbVal, err := s.db.Get(createCompositeKeyForPvtRWSet(txid, uuid, blockHeight))
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		if len(dbVal) == 0 {
+			continue
+		}
+		containsPurgedKeys, err := storedValueContainsPurgedKeys(dbVal, keyHashes)
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		if !containsPurgedKeys {
+			continue
+		}
+		logger.Debugf("Purging from transient store private data of purged keys simulated at block [%d]: txid [%s] uuid [%s]", blockHeight, txid, uuid)
+		batch.remove(txid, uuid, blockHeight, decodeEntryInfo(iter.Value()))
 	}
 	iter.Release()
 
-	return s.db.WriteBatch(dbBatch, true)
+	if err := s.writePurgeBatch(batch); err != nil {
+		return err
+	}
+	s.evictAndUpdateStats()
+	return nil
 }
 
 // GetMinTransientBlkHt returns the lowest block height remaining in transient store
@@ -358,6 +465,125 @@ func (s *store) GetMinTransientBlkHt() (uint64, error) {
 	return 0, ErrStoreEmpty
 }
 
+// GetPendingEntries returns the number and the total size of the private write sets
+// residing in the transient store, along with up to maxEntries of the oldest of them.
+// Private write sets persisted by an older version of the transient store do not carry
+// the time they were received at and are hence not listed among the oldest ones
+func (s *store) GetPendingEntries(maxEntries int) (*PendingEntries, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	pendingEntries := &PendingEntries{
+		TotalEntries: s.numEntries,
+		TotalSize:    s.size,
+	}
+	if maxEntries <= 0 {
+		return pendingEntries, nil
+	}
+
+	iter := s.db.GetIterator(createPurgeIndexByTimeRangeStartKey(0), []byte{purgeIndexByTimePrefix, compositeKeySep, 0xff})
+	defer iter.Release()
+	for len(pendingEntries.Oldest) < maxEntries && iter.Next() {
+		receivedAt, txid, _, blockHeight := splitCompositeKeyOfPurgeIndexByTime(iter.Key())
+		pendingEntry := &PendingEntry{
+			TxID:                  txid,
+			ReceivedAt:            time.Unix(0, int64(receivedAt)),
+			ReceivedAtBlockHeight: blockHeight,
+		}
+		if info := decodeEntryInfo(iter.Value()); info != nil {
+			pendingEntry.Size = info.size
+		}
+		pendingEntries.Oldest = append(pendingEntries.Oldest, pendingEntry)
+	}
+	return pendingEntries, iter.Error()
+}
+
+// evict removes the private write sets that are older than the maximum age of the eviction
+// config and then, if the store exceeds the maximum size of the eviction config, removes the
+// oldest private write sets until the store falls within the maximum size. It is expected to
+// be called with the mutex of the store held
+func (s *store) evict() error {
+	if s.evictionConfig.MaxAge > 0 {
+		cutoff := s.now().Add(-s.evictionConfig.MaxAge).UnixNano()
+		if cutoff > 0 {
+			startKey := createPurgeIndexByTimeRangeStartKey(0)
+			endKey := createPurgeIndexByTimeRangeEndKey(uint64(cutoff))
+			iter := s.db.GetIterator(startKey, endKey)
+			batch := newPurgeBatch()
+			for iter.Next() {
+				receivedAt, txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByTime(iter.Key())
+				logger.Debugf("Evicting from transient store private data received at [%s]: txid [%s] uuid [%s]", time.Unix(0, int64(receivedAt)), txid, uuid)
+				batch.remove(txid, uuid, blockHeight, decodeEntryInfo(iter.Value()))
+			}
+			iter.Release()
+			if err := s.writePurgeBatch(batch); err != nil {
+				return err
+			}
+			s.stats.addEvictions(evictionReasonAge, len(batch.removed))
+		}
+	}
+
+	if s.evictionConfig.MaxSize > 0 && s.size > s.evictionConfig.MaxSize {
+		startKey := createPurgeIndexByTimeRangeStartKey(0)
+		endKey := []byte{purgeIndexByTimePrefix, compositeKeySep, 0xff}
+		iter := s.db.GetIterator(startKey, endKey)
+		batch := newPurgeBatch()
+		sizeToEvict := s.size - s.evictionConfig.MaxSize
+		for batch.removedSize < sizeToEvict && iter.Next() {
+			receivedAt, txid, uuid, blockHeight := splitCompositeKeyOfPurgeIndexByTime(iter.Key())
+			logger.Debugf("Evicting from transient store private data received at [%s]: txid [%s] uuid [%s]", time.Unix(0, int64(receivedAt)), txid, uuid)
+			batch.remove(txid, uuid, blockHeight, decodeEntryInfo(iter.Value()))
+		}
+		iter.Release()
+		if err := s.writePurgeBatch(batch); err != nil {
+			return err
+		}
+		s.stats.addEvictions(evictionReasonSize, len(batch.removed))
+	}
+	return nil
+}
+
+// evictAndUpdateStats evicts the private write sets that exceed the limits of the eviction
+// config and updates the stats of the store. A failure to evict is not fatal as the eviction is
+// attempted again upon the next update of the store. It is expected to be called with the mutex
+// of the store held
+func (s *store) evictAndUpdateStats() {
+	if err := s.evict(); err != nil {
+		logger.Warningf("Failed evicting private data from transient store for ledger [%s]: %s", s.ledgerID, err)
+	}
+	s.stats.updateEntries(s.numEntries, s.size)
+}
+
+// writePurgeBatch writes the given purgeBatch to the db and updates the number
+// of private write sets and their total size accordingly
+func (s *store) writePurgeBatch(batch *purgeBatch) error {
+	if len(batch.removed) == 0 {
+		return nil
+	}
+	if err := s.db.WriteBatch(batch.dbBatch, true); err != nil {
+		return err
+	}
+	s.numEntries -= minUint64(s.numEntries, uint64(len(batch.removed)))
+	s.size -= minUint64(s.size, batch.removedSize)
+	return nil
+}
+
+// loadEntriesInfo loads the number of private write sets residing in the store
+// and their total size
+func (s *store) loadEntriesInfo() {
+	startKey := createPurgeIndexByHeightRangeStartKey(0)
+	endKey := []byte{purgeIndexByHeightPrefix, compositeKeySep, 0xff}
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+	for iter.Next() {
+		s.numEntries++
+		if info := decodeEntryInfo(iter.Value()); info != nil {
+			s.size += info.size
+		}
+	}
+	s.stats.updateEntries(s.numEntries, s.size)
+}
+
 func (s *store) Shutdown() {
 	// do nothing because shared db is used
 }
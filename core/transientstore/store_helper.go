@@ -11,17 +11,29 @@ import (
 	"errors"
 	"path/filepath"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/core/ledger"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric/protos/transientstore"
+	"github.com/spf13/viper"
+)
+
+const (
+	maxAgeConfigKey  = "peer.gossip.pvtData.transientstoreMaxAge"
+	maxSizeConfigKey = "peer.gossip.pvtData.transientstoreMaxSize"
 )
 
 var (
 	prwsetPrefix             = []byte("P")[0] // key prefix for storing private write set in transient store.
 	purgeIndexByHeightPrefix = []byte("H")[0] // key prefix for storing index on private write set using received at block height.
 	purgeIndexByTxidPrefix   = []byte("T")[0] // key prefix for storing index on private write set using txid
+	purgeIndexByTimePrefix   = []byte("A")[0] // key prefix for storing index on private write set using received at wall-clock time.
 	compositeKeySep          = byte(0x00)
 )
 
@@ -76,6 +88,21 @@ func createCompositeKeyForPurgeIndexByHeight(blockHeight uint64, txid string, uu
 	return compositeKey
 }
 
+// createCompositeKeyForPurgeIndexByTime creates a key to index private write set based on
+// the wall-clock time (in nanoseconds since epoch) it was received at such that eviction based
+// on age and oldest-first eviction based on size can be achieved. The structure of the key
+// is <purgeIndexByTimePrefix>~receivedAt~txid~uuid~blockHeight.
+func createCompositeKeyForPurgeIndexByTime(receivedAt uint64, txid string, uuid string, blockHeight uint64) []byte {
+	var compositeKey []byte
+	compositeKey = append(compositeKey, purgeIndexByTimePrefix)
+	compositeKey = append(compositeKey, compositeKeySep)
+	compositeKey = append(compositeKey, util.EncodeOrderPreservingVarUint64(receivedAt)...)
+	compositeKey = append(compositeKey, compositeKeySep)
+	compositeKey = append(compositeKey, createCompositeKeyWithoutPrefixForTxid(txid, uuid, blockHeight)...)
+
+	return compositeKey
+}
+
 // splitCompositeKeyOfPvtRWSet splits the compositeKey (<prwsetPrefix>~txid~uuid~blockHeight)
 // into uuid and blockHeight.
 func splitCompositeKeyOfPvtRWSet(compositeKey []byte) (uuid string, blockHeight uint64) {
@@ -99,6 +126,17 @@ func splitCompositeKeyOfPurgeIndexByHeight(compositeKey []byte) (txid string, uu
 	return
 }
 
+// splitCompositeKeyOfPurgeIndexByTime splits the compositeKey (<purgeIndexByTimePrefix>~receivedAt~txid~uuid~blockHeight)
+// into receivedAt, txid, uuid and blockHeight.
+func splitCompositeKeyOfPurgeIndexByTime(compositeKey []byte) (receivedAt uint64, txid string, uuid string, blockHeight uint64) {
+	var n int
+	receivedAt, n = util.DecodeOrderPreservingVarUint64(compositeKey[2:])
+	keyWithoutPrefixForTxid := compositeKey[n+3:]
+	txid = string(keyWithoutPrefixForTxid[:bytes.IndexByte(keyWithoutPrefixForTxid, compositeKeySep)])
+	uuid, blockHeight = splitCompositeKeyWithoutPrefixForTxid(keyWithoutPrefixForTxid)
+	return
+}
+
 // splitCompositeKeyWithoutPrefixForTxid splits the composite key txid~uuid~blockHeight into
 // uuid and blockHeight
 func splitCompositeKeyWithoutPrefixForTxid(compositeKey []byte) (uuid string, blockHeight uint64) {
@@ -178,12 +216,114 @@ func createPurgeIndexByTxidRangeEndKey(txid string) []byte {
 	return endKey
 }
 
+// createPurgeIndexByTimeRangeStartKey returns a startKey to do a range query on index stored in transient store
+// using received at wall-clock time
+func createPurgeIndexByTimeRangeStartKey(receivedAt uint64) []byte {
+	var startKey []byte
+	startKey = append(startKey, purgeIndexByTimePrefix)
+	startKey = append(startKey, compositeKeySep)
+	startKey = append(startKey, util.EncodeOrderPreservingVarUint64(receivedAt)...)
+	startKey = append(startKey, compositeKeySep)
+	return startKey
+}
+
+// createPurgeIndexByTimeRangeEndKey returns a endKey to do a range query on index stored in transient store
+// using received at wall-clock time
+func createPurgeIndexByTimeRangeEndKey(receivedAt uint64) []byte {
+	var endKey []byte
+	endKey = append(endKey, purgeIndexByTimePrefix)
+	endKey = append(endKey, compositeKeySep)
+	endKey = append(endKey, util.EncodeOrderPreservingVarUint64(receivedAt)...)
+	endKey = append(endKey, byte(0xff))
+	return endKey
+}
+
+// entryInfo holds the wall-clock time (in nanoseconds since epoch) a private write set
+// was received at and its size in bytes. It is stored as the value of the purge indexes
+// of the private write set. Private write sets persisted by an older version of the
+// transient store have indexes with an empty value and hence no entryInfo
+type entryInfo struct {
+	receivedAt uint64
+	size       uint64
+}
+
+func encodeEntryInfo(info *entryInfo) []byte {
+	encoded := proto.EncodeVarint(info.receivedAt)
+	return append(encoded, proto.EncodeVarint(info.size)...)
+}
+
+// decodeEntryInfo decodes the value of a purge index into an entryInfo. It returns nil
+// if the index does not hold an entryInfo
+func decodeEntryInfo(indexVal []byte) *entryInfo {
+	if len(indexVal) == 0 {
+		return nil
+	}
+	receivedAt, n := proto.DecodeVarint(indexVal)
+	size, _ := proto.DecodeVarint(indexVal[n:])
+	return &entryInfo{receivedAt: receivedAt, size: size}
+}
+
 // GetTransientStorePath returns the filesystem path for temporarily storing the private rwset
 func GetTransientStorePath() string {
 	sysPath := config.GetPath("peer.fileSystemPath")
 	return filepath.Join(sysPath, "transientStore")
 }
 
+// GetEvictionConfig returns the limits beyond which private write sets are evicted
+// from the transient stores of the peer
+func GetEvictionConfig() *EvictionConfig {
+	return &EvictionConfig{
+		MaxAge:  viper.GetDuration(maxAgeConfigKey),
+		MaxSize: uint64(viper.GetSizeInBytes(maxSizeConfigKey)),
+	}
+}
+
+// purgeBatch accumulates the removal of private write sets, along with their indexes,
+// from the transient store
+type purgeBatch struct {
+	dbBatch     *leveldbhelper.UpdateBatch
+	removed     map[string]struct{}
+	removedSize uint64
+}
+
+func newPurgeBatch() *purgeBatch {
+	return &purgeBatch{
+		dbBatch: leveldbhelper.NewUpdateBatch(),
+		removed: make(map[string]struct{}),
+	}
+}
+
+// remove adds to the batch the removal of the given private write set and its indexes.
+// The given entryInfo is nil for the private write sets persisted by an older version
+// of the transient store, which do not have an index by received at wall-clock time
+func (b *purgeBatch) remove(txid string, uuid string, blockHeight uint64, info *entryInfo) {
+	compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
+	if _, ok := b.removed[string(compositeKeyPvtRWSet)]; ok {
+		return
+	}
+	b.removed[string(compositeKeyPvtRWSet)] = struct{}{}
+
+	// Remove private write set
+	b.dbBatch.Delete(compositeKeyPvtRWSet)
+	// Remove purge index -- purgeIndexByTxid
+	b.dbBatch.Delete(createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight))
+	// Remove purge index -- purgeIndexByHeight
+	b.dbBatch.Delete(createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid))
+	if info == nil {
+		return
+	}
+	// Remove purge index -- purgeIndexByTime
+	b.dbBatch.Delete(createCompositeKeyForPurgeIndexByTime(info.receivedAt, txid, uuid, blockHeight))
+	b.removedSize += info.size
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // trimPvtWSet returns a `TxPvtReadWriteSet` that retains only list of 'ns/collections' supplied in the filter
 // A nil filter does not filter any results and returns the original `pvtWSet` as is
 func trimPvtWSet(pvtWSet *rwset.TxPvtReadWriteSet, filter ledger.PvtNsCollFilter) *rwset.TxPvtReadWriteSet {
@@ -218,6 +358,67 @@ func trimPvtWSet(pvtWSet *rwset.TxPvtReadWriteSet, filter ledger.PvtNsCollFilter
 	return filteredTxPvtRwSet
 }
 
+type nsColl struct {
+	ns, coll string
+}
+
+// purgedKeyHashes holds, for each namespace and collection, the set of the hashes of the purged keys
+type purgedKeyHashes map[nsColl]map[string]struct{}
+
+func newPurgedKeyHashes(purgedKeys []*PurgedKey) purgedKeyHashes {
+	p := make(purgedKeyHashes)
+	for _, k := range purgedKeys {
+		nc := nsColl{k.Namespace, k.Collection}
+		if _, ok := p[nc]; !ok {
+			p[nc] = make(map[string]struct{})
+		}
+		p[nc][string(k.KeyHash)] = struct{}{}
+	}
+	return p
+}
+
+// containsPurgedKeys returns true if the given `pvtWSet` holds a write of any of the purged keys
+func containsPurgedKeys(pvtWSet *rwset.TxPvtReadWriteSet, purgedKeys purgedKeyHashes) (bool, error) {
+	for _, ns := range pvtWSet.GetNsPvtRwset() {
+		for _, coll := range ns.CollectionPvtRwset {
+			keyHashes, ok := purgedKeys[nsColl{ns.Namespace, coll.CollectionName}]
+			if !ok {
+				continue
+			}
+			kvRWSet := &kvrwset.KVRWSet{}
+			if err := proto.Unmarshal(coll.Rwset, kvRWSet); err != nil {
+				return false, err
+			}
+			for _, w := range kvRWSet.Writes {
+				if _, purged := keyHashes[string(ledgerutil.ComputeStringHash(w.Key))]; purged {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// storedValueContainsPurgedKeys returns true if the private write set stored, in either the old
+// or the new proto format, as the given value holds a write of any of the purged keys
+func storedValueContainsPurgedKeys(dbVal []byte, purgedKeys purgedKeyHashes) (bool, error) {
+	if dbVal[0] != nilByte {
+		// old proto, i.e., TxPvtReadWriteSet
+		txPvtRWSet := &rwset.TxPvtReadWriteSet{}
+		if err := proto.Unmarshal(dbVal, txPvtRWSet); err != nil {
+			return false, err
+		}
+		return containsPurgedKeys(txPvtRWSet, purgedKeys)
+	}
+
+	// new proto, i.e., TxPvtReadWriteSetWithConfigInfo
+	txPvtRWSetWithConfig := &transientstore.TxPvtReadWriteSetWithConfigInfo{}
+	if err := proto.Unmarshal(dbVal[1:], txPvtRWSetWithConfig); err != nil {
+		return false, err
+	}
+	return containsPurgedKeys(txPvtRWSetWithConfig.PvtRwset, purgedKeys)
+}
+
 func trimPvtCollectionConfigs(configs map[string]*common.CollectionConfigPackage,
 	filter ledger.PvtNsCollFilter) (map[string]*common.CollectionConfigPackage, error) {
 	if filter == nil {
@@ -11,13 +11,16 @@ import (
 	"os"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -50,6 +53,32 @@ func TestPurgeIndexKeyCodingEncoding(t *testing.T) {
 	}
 }
 
+func TestPurgeIndexByTimeKeyCodingEncoding(t *testing.T) {
+	assert := assert.New(t)
+	receivedAts := []uint64{0, 10, uint64(time.Now().UnixNano())}
+	blkHts := []uint64{0, 10, 20000}
+	txids := []string{"txid", ""}
+	uuids := []string{"uuid", ""}
+	for _, receivedAt := range receivedAts {
+		for _, blkHt := range blkHts {
+			for _, txid := range txids {
+				for _, uuid := range uuids {
+					purgeIndexKey := createCompositeKeyForPurgeIndexByTime(receivedAt, txid, uuid, blkHt)
+					receivedAt1, txid1, uuid1, blkHt1 := splitCompositeKeyOfPurgeIndexByTime(purgeIndexKey)
+					assert.Equal(receivedAt, receivedAt1)
+					assert.Equal(txid, txid1)
+					assert.Equal(uuid, uuid1)
+					assert.Equal(blkHt, blkHt1)
+				}
+			}
+		}
+	}
+
+	info := &entryInfo{receivedAt: uint64(time.Now().UnixNano()), size: 1024}
+	assert.Equal(info, decodeEntryInfo(encodeEntryInfo(info)))
+	assert.Nil(decodeEntryInfo([]byte{}))
+}
+
 func TestRWSetKeyCodingEncoding(t *testing.T) {
 	assert := assert.New(t)
 	blkHts := []uint64{0, 10, 20000}
@@ -455,6 +484,235 @@ func TestTransientStorePurgeByHeight(t *testing.T) {
 	env.Cleanup()
 }
 
+func TestTransientStorePurgeKeys(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	kvRWSetBytes := func(keys ...string) []byte {
+		kvRWSet := &kvrwset.KVRWSet{}
+		for _, k := range keys {
+			kvRWSet.Writes = append(kvRWSet.Writes, &kvrwset.KVWrite{Key: k, Value: []byte("value-" + k)})
+		}
+		b, err := proto.Marshal(kvRWSet)
+		assert.NoError(err)
+		return b
+	}
+	samplePvtRWSet := func() *rwset.TxPvtReadWriteSet {
+		return &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				{
+					Namespace: "ns-1",
+					CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+						{CollectionName: "coll-1", Rwset: kvRWSetBytes("key-1", "key-2")},
+						{CollectionName: "coll-2", Rwset: kvRWSetBytes("key-1")},
+					},
+				},
+			},
+		}
+	}
+
+	otherPvtRWSet := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "ns-1",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{CollectionName: "coll-1", Rwset: kvRWSetBytes("key-2")},
+				},
+			},
+		},
+	}
+
+	// private data simulated before (height 10 and 11) and after (height 12) the purge
+	assert.NoError(env.TestStore.PersistWithConfig("txid-1", 10, &transientstore.TxPvtReadWriteSetWithConfigInfo{PvtRwset: samplePvtRWSet()}))
+	assert.NoError(env.TestStore.Persist("txid-2", 11, samplePvtRWSet()))
+	assert.NoError(env.TestStore.PersistWithConfig("txid-3", 12, &transientstore.TxPvtReadWriteSetWithConfigInfo{PvtRwset: samplePvtRWSet()}))
+	assert.NoError(env.TestStore.PersistWithConfig("txid-4", 11, &transientstore.TxPvtReadWriteSetWithConfigInfo{PvtRwset: otherPvtRWSet}))
+
+	purgedKeys := []*PurgedKey{
+		{Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-1")},
+	}
+	assert.NoError(env.TestStore.PurgeKeys(purgedKeys, 11))
+
+	// the private write sets of txid-1 and txid-2 are removed as a whole, whereas
+	// txid-3 is simulated after the purge and txid-4 does not write a purged key
+	pendingEntries, err := env.TestStore.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(2), pendingEntries.TotalEntries)
+	var txids []string
+	for _, entry := range pendingEntries.Oldest {
+		txids = append(txids, entry.TxID)
+	}
+	assert.Equal([]string{"txid-3", "txid-4"}, txids)
+
+	for txid, expected := range map[string]*rwset.TxPvtReadWriteSet{
+		"txid-1": nil,
+		"txid-2": nil,
+		"txid-3": samplePvtRWSet(),
+		"txid-4": otherPvtRWSet,
+	} {
+		iter, err := env.TestStore.GetTxPvtRWSetByTxid(txid, nil)
+		assert.NoError(err)
+		result, err := iter.NextWithConfig()
+		assert.NoError(err)
+		if expected == nil {
+			assert.Nil(result, txid)
+		} else {
+			assert.True(proto.Equal(expected, result.PvtSimulationResultsWithConfig.PvtRwset), txid)
+		}
+		iter.Close()
+	}
+
+	// purging keys that were never written should not fail
+	assert.NoError(env.TestStore.PurgeKeys([]*PurgedKey{
+		{Namespace: "ns-2", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-1")},
+	}, 12))
+}
+
+func TestTransientStoreEvictionByAge(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	s := env.TestStore.(*store)
+	s.evictionConfig = &EvictionConfig{MaxAge: time.Hour}
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	receivedAt := now
+	assert.NoError(s.PersistWithConfig("txid-1", 10, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(s.Persist("txid-2", 10, samplePvtData(t)))
+	now = now.Add(30 * time.Minute)
+	assert.NoError(s.PersistWithConfig("txid-3", 11, samplePvtDataWithConfigInfo(t)))
+
+	// No private write set has exceeded the maximum age yet
+	pendingEntries, err := s.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(3), pendingEntries.TotalEntries)
+	assert.Len(pendingEntries.Oldest, 3)
+	assert.Equal("txid-1", pendingEntries.Oldest[0].TxID)
+	assert.True(receivedAt.Equal(pendingEntries.Oldest[0].ReceivedAt))
+	assert.Equal(uint64(10), pendingEntries.Oldest[0].ReceivedAtBlockHeight)
+
+	// Persisting a private write set after txid-1 and txid-2 exceed the maximum age evicts them
+	now = now.Add(31 * time.Minute)
+	assert.NoError(s.PersistWithConfig("txid-4", 12, samplePvtDataWithConfigInfo(t)))
+	pendingEntries, err = s.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(2), pendingEntries.TotalEntries)
+	var txids []string
+	for _, entry := range pendingEntries.Oldest {
+		txids = append(txids, entry.TxID)
+	}
+	assert.Equal([]string{"txid-3", "txid-4"}, txids)
+
+	for _, txid := range []string{"txid-1", "txid-2"} {
+		iter, err := s.GetTxPvtRWSetByTxid(txid, nil)
+		assert.NoError(err)
+		result, err := iter.NextWithConfig()
+		assert.NoError(err)
+		assert.Nil(result)
+		iter.Close()
+	}
+	_, err = s.GetMinTransientBlkHt()
+	assert.NoError(err)
+
+	// Purging by txids evicts the private write sets that exceeded the maximum age in the meantime
+	now = now.Add(time.Hour)
+	assert.NoError(s.PurgeByTxids(nil))
+	pendingEntries, err = s.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(0), pendingEntries.TotalEntries)
+	assert.Equal(uint64(0), pendingEntries.TotalSize)
+	assert.Empty(pendingEntries.Oldest)
+	_, err = s.GetMinTransientBlkHt()
+	assert.Equal(ErrStoreEmpty, err)
+}
+
+func TestTransientStoreEvictionBySize(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	s := env.TestStore.(*store)
+	now := time.Now()
+	s.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	assert.NoError(s.PersistWithConfig("txid-1", 10, samplePvtDataWithConfigInfo(t)))
+	pendingEntries, err := s.GetPendingEntries(0)
+	assert.NoError(err)
+	assert.Empty(pendingEntries.Oldest)
+	entrySize := pendingEntries.TotalSize
+	assert.NotZero(entrySize)
+
+	s.evictionConfig = &EvictionConfig{MaxSize: 2*entrySize + entrySize/2}
+	assert.NoError(s.PersistWithConfig("txid-2", 10, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(s.PersistWithConfig("txid-3", 11, samplePvtDataWithConfigInfo(t)))
+
+	// The oldest private write set is evicted once the store exceeds the maximum size
+	pendingEntries, err = s.GetPendingEntries(1)
+	assert.NoError(err)
+	assert.Equal(uint64(2), pendingEntries.TotalEntries)
+	assert.Equal(2*entrySize, pendingEntries.TotalSize)
+	assert.Len(pendingEntries.Oldest, 1)
+	assert.Equal("txid-2", pendingEntries.Oldest[0].TxID)
+	assert.Equal(entrySize, pendingEntries.Oldest[0].Size)
+
+	// Purging keeps track of the size of the store
+	assert.NoError(s.PurgeByTxids([]string{"txid-2", "txid-2"}))
+	pendingEntries, err = s.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(1), pendingEntries.TotalEntries)
+	assert.Equal(entrySize, pendingEntries.TotalSize)
+
+	assert.NoError(s.PurgeByHeight(12))
+	pendingEntries, err = s.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(0), pendingEntries.TotalEntries)
+	assert.Equal(uint64(0), pendingEntries.TotalSize)
+}
+
+func TestTransientStoreEntriesInfoOnReopen(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	assert.NoError(env.TestStore.PersistWithConfig("txid-1", 10, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(env.TestStore.Persist("txid-2", 11, samplePvtData(t)))
+
+	// Persist a private write set as an older version of the transient store would,
+	// i.e., without the entry info and the index by received at wall-clock time
+	s := env.TestStore.(*store)
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	dbBatch.Put(createCompositeKeyForPvtRWSet("txid-3", "uuid", 12), []byte("private write set"))
+	dbBatch.Put(createCompositeKeyForPurgeIndexByHeight(12, "txid-3", "uuid"), []byte{})
+	dbBatch.Put(createCompositeKeyForPurgeIndexByTxid("txid-3", "uuid", 12), []byte{})
+	assert.NoError(s.db.WriteBatch(dbBatch, true))
+
+	expectedEntries, err := env.TestStore.GetPendingEntries(10)
+	assert.NoError(err)
+
+	reopenedStore, err := env.TestStoreProvider.OpenStore("TestStore")
+	assert.NoError(err)
+	pendingEntries, err := reopenedStore.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(expectedEntries.TotalSize, pendingEntries.TotalSize)
+	assert.Equal(uint64(3), pendingEntries.TotalEntries)
+	assert.Equal(expectedEntries.Oldest, pendingEntries.Oldest)
+	assert.Len(pendingEntries.Oldest, 2)
+
+	assert.NoError(reopenedStore.PurgeByTxids([]string{"txid-3"}))
+	pendingEntries, err = reopenedStore.GetPendingEntries(10)
+	assert.NoError(err)
+	assert.Equal(uint64(2), pendingEntries.TotalEntries)
+	assert.Equal(expectedEntries.TotalSize, pendingEntries.TotalSize)
+}
+
 func TestTransientStoreRetrievalWithFilter(t *testing.T) {
 	env := NewTestStoreEnv(t)
 	store := env.TestStore
@@ -20,6 +20,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,7 +35,7 @@ type StoreEnv struct {
 func NewTestStoreEnv(t *testing.T) *StoreEnv {
 	removeStorePath(t)
 	assert := assert.New(t)
-	testStoreProvider := NewStoreProvider()
+	testStoreProvider := NewStoreProvider(&disabled.Provider{})
 	testStore, err := testStoreProvider.OpenStore("TestStore")
 	assert.NoError(err)
 	return &StoreEnv{t, testStoreProvider, testStore}
@@ -14,6 +14,16 @@ import (
 )
 
 type Gossip struct {
+	DeadPeersStub        func() []discovery.NetworkMember
+	deadPeersMutex       sync.RWMutex
+	deadPeersArgsForCall []struct {
+	}
+	deadPeersReturns struct {
+		result1 []discovery.NetworkMember
+	}
+	deadPeersReturnsOnCall map[int]struct {
+		result1 []discovery.NetworkMember
+	}
 	SelfMembershipInfoStub        func() discovery.NetworkMember
 	selfMembershipInfoMutex       sync.RWMutex
 	selfMembershipInfoArgsForCall []struct{}
@@ -77,6 +87,17 @@ type Gossip struct {
 	updateMetadataArgsForCall []struct {
 		metadata []byte
 	}
+	UpdateIdentityStub        func(identity api.PeerIdentityType) error
+	updateIdentityMutex       sync.RWMutex
+	updateIdentityArgsForCall []struct {
+		identity api.PeerIdentityType
+	}
+	updateIdentityReturns struct {
+		result1 error
+	}
+	updateIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateLedgerHeightStub        func(height uint64, chainID common.ChainID)
 	updateLedgerHeightMutex       sync.RWMutex
 	updateLedgerHeightArgsForCall []struct {
@@ -154,6 +175,58 @@ type Gossip struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *Gossip) DeadPeers() []discovery.NetworkMember {
+	fake.deadPeersMutex.Lock()
+	ret, specificReturn := fake.deadPeersReturnsOnCall[len(fake.deadPeersArgsForCall)]
+	fake.deadPeersArgsForCall = append(fake.deadPeersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DeadPeers", []interface{}{})
+	fake.deadPeersMutex.Unlock()
+	if fake.DeadPeersStub != nil {
+		return fake.DeadPeersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deadPeersReturns
+	return fakeReturns.result1
+}
+
+func (fake *Gossip) DeadPeersCallCount() int {
+	fake.deadPeersMutex.RLock()
+	defer fake.deadPeersMutex.RUnlock()
+	return len(fake.deadPeersArgsForCall)
+}
+
+func (fake *Gossip) DeadPeersCalls(stub func() []discovery.NetworkMember) {
+	fake.deadPeersMutex.Lock()
+	defer fake.deadPeersMutex.Unlock()
+	fake.DeadPeersStub = stub
+}
+
+func (fake *Gossip) DeadPeersReturns(result1 []discovery.NetworkMember) {
+	fake.deadPeersMutex.Lock()
+	defer fake.deadPeersMutex.Unlock()
+	fake.DeadPeersStub = nil
+	fake.deadPeersReturns = struct {
+		result1 []discovery.NetworkMember
+	}{result1}
+}
+
+func (fake *Gossip) DeadPeersReturnsOnCall(i int, result1 []discovery.NetworkMember) {
+	fake.deadPeersMutex.Lock()
+	defer fake.deadPeersMutex.Unlock()
+	fake.DeadPeersStub = nil
+	if fake.deadPeersReturnsOnCall == nil {
+		fake.deadPeersReturnsOnCall = make(map[int]struct {
+			result1 []discovery.NetworkMember
+		})
+	}
+	fake.deadPeersReturnsOnCall[i] = struct {
+		result1 []discovery.NetworkMember
+	}{result1}
+}
+
 func (fake *Gossip) SelfMembershipInfo() discovery.NetworkMember {
 	fake.selfMembershipInfoMutex.Lock()
 	ret, specificReturn := fake.selfMembershipInfoReturnsOnCall[len(fake.selfMembershipInfoArgsForCall)]
@@ -433,6 +506,54 @@ func (fake *Gossip) UpdateMetadataArgsForCall(i int) []byte {
 	return fake.updateMetadataArgsForCall[i].metadata
 }
 
+func (fake *Gossip) UpdateIdentity(identity api.PeerIdentityType) error {
+	fake.updateIdentityMutex.Lock()
+	ret, specificReturn := fake.updateIdentityReturnsOnCall[len(fake.updateIdentityArgsForCall)]
+	fake.updateIdentityArgsForCall = append(fake.updateIdentityArgsForCall, struct {
+		identity api.PeerIdentityType
+	}{identity})
+	fake.recordInvocation("UpdateIdentity", []interface{}{identity})
+	fake.updateIdentityMutex.Unlock()
+	if fake.UpdateIdentityStub != nil {
+		return fake.UpdateIdentityStub(identity)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateIdentityReturns.result1
+}
+
+func (fake *Gossip) UpdateIdentityCallCount() int {
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
+	return len(fake.updateIdentityArgsForCall)
+}
+
+func (fake *Gossip) UpdateIdentityArgsForCall(i int) api.PeerIdentityType {
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
+	return fake.updateIdentityArgsForCall[i].identity
+}
+
+func (fake *Gossip) UpdateIdentityReturns(result1 error) {
+	fake.UpdateIdentityStub = nil
+	fake.updateIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Gossip) UpdateIdentityReturnsOnCall(i int, result1 error) {
+	fake.UpdateIdentityStub = nil
+	if fake.updateIdentityReturnsOnCall == nil {
+		fake.updateIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Gossip) UpdateLedgerHeight(height uint64, chainID common.ChainID) {
 	fake.updateLedgerHeightMutex.Lock()
 	fake.updateLedgerHeightArgsForCall = append(fake.updateLedgerHeightArgsForCall, struct {
@@ -748,6 +869,8 @@ func (fake *Gossip) StopCallCount() int {
 func (fake *Gossip) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deadPeersMutex.RLock()
+	defer fake.deadPeersMutex.RUnlock()
 	fake.selfMembershipInfoMutex.RLock()
 	defer fake.selfMembershipInfoMutex.RUnlock()
 	fake.selfChannelInfoMutex.RLock()
@@ -762,6 +885,8 @@ func (fake *Gossip) Invocations() map[string][][]interface{} {
 	defer fake.peersOfChannelMutex.RUnlock()
 	fake.updateMetadataMutex.RLock()
 	defer fake.updateMetadataMutex.RUnlock()
+	fake.updateIdentityMutex.RLock()
+	defer fake.updateIdentityMutex.RUnlock()
 	fake.updateLedgerHeightMutex.RLock()
 	defer fake.updateLedgerHeightMutex.RUnlock()
 	fake.updateChaincodesMutex.RLock()
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package comm
 
 import (
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -47,10 +48,24 @@ type Comm interface {
 	// CloseConn closes a connection to a certain endpoint
 	CloseConn(peer *RemotePeer)
 
+	// UpdateIdentity replaces the identity of this instance, and closes all
+	// connections, so that remote peers authenticate it with its new identity
+	UpdateIdentity(identity api.PeerIdentityType)
+
+	// SetConnectionFilter restricts the connections established with remote peers,
+	// both incoming and outgoing, to the peers permitted by the given filter.
+	// Handshakes aren't affected by the filter.
+	SetConnectionFilter(filter ConnectionFilter)
+
 	// Stop stops the module
 	Stop()
 }
 
+// ConnectionFilter determines whether connections with the remote peer of the
+// given connection info are permitted. tlsCert is the TLS certificate the remote
+// peer authenticated with, and is nil if TLS isn't used
+type ConnectionFilter func(connInfo *proto.ConnectionInfo, tlsCert *x509.Certificate) bool
+
 // RemotePeer defines a peer's endpoint and its PKIid
 type RemotePeer struct {
 	Endpoint string
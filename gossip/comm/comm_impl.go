@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"fmt"
 	"net"
@@ -60,7 +61,8 @@ func (c *commImpl) SetDialOpts(opts ...grpc.DialOption) {
 
 // NewCommInstanceWithServer creates a comm instance that creates an underlying gRPC server
 func NewCommInstanceWithServer(port int, idMapper identity.Mapper, peerIdentity api.PeerIdentityType,
-	secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor, dialOpts ...grpc.DialOption) (Comm, error) {
+	secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor, commMetrics *CommMetrics,
+	dialOpts ...grpc.DialOption) (Comm, error) {
 
 	var ll net.Listener
 	var s *grpc.Server
@@ -90,6 +92,9 @@ func NewCommInstanceWithServer(port int, idMapper identity.Mapper, peerIdentity
 		subscriptions:  make([]chan proto.ReceivedMessage, 0),
 		dialTimeout:    util.GetDurationOrDefault("peer.gossip.dialTimeout", defDialTimeout),
 		tlsCerts:       certs,
+		metrics:        commMetrics,
+		peerSendRate:   util.GetIntOrDefault("peer.gossip.sendRateLimit.perPeer", 0),
+		sendLimiter:    newRateLimiter(util.GetIntOrDefault("peer.gossip.sendRateLimit.total", 0)),
 	}
 	commInst.connStore = newConnStore(commInst, commInst.logger)
 
@@ -108,9 +113,9 @@ func NewCommInstanceWithServer(port int, idMapper identity.Mapper, peerIdentity
 // NewCommInstance creates a new comm instance that binds itself to the given gRPC server
 func NewCommInstance(s *grpc.Server, certs *common.TLSCertificates, idStore identity.Mapper,
 	peerIdentity api.PeerIdentityType, secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor,
-	dialOpts ...grpc.DialOption) (Comm, error) {
+	commMetrics *CommMetrics, dialOpts ...grpc.DialOption) (Comm, error) {
 
-	commInst, err := NewCommInstanceWithServer(-1, idStore, peerIdentity, secureDialOpts, sa, dialOpts...)
+	commInst, err := NewCommInstanceWithServer(-1, idStore, peerIdentity, secureDialOpts, sa, commMetrics, dialOpts...)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -133,6 +138,7 @@ type commImpl struct {
 	secureDialOpts func() []grpc.DialOption
 	connStore      *connectionStore
 	PKIID          []byte
+	identityLock   sync.RWMutex
 	deadEndpoints  chan common.PKIidType
 	msgPublisher   *ChannelDeMultiplexer
 	lock           *sync.Mutex
@@ -144,6 +150,10 @@ type commImpl struct {
 	port           int
 	stopping       int32
 	dialTimeout    time.Duration
+	connFilter     atomic.Value
+	metrics        *CommMetrics
+	peerSendRate   int          // limit of the rate of bytes sent to each remote peer
+	sendLimiter    *rateLimiter // limiter of the total rate of bytes sent to all remote peers
 }
 
 func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidType) (*connection, error) {
@@ -199,11 +209,18 @@ func (c *commImpl) createConnection(endpoint string, expectedPKIID common.PKIidT
 					return nil, errors.New("authentication failure")
 				}
 			}
+			if !c.isPermitted(connInfo, extractCertificateFromContext(stream.Context())) {
+				c.logger.Warning("Connection to", endpoint, "with PKI-ID", pkiID, "isn't permitted")
+				cc.Close()
+				cancel()
+				return nil, errors.New("connection not permitted")
+			}
 			conn := newConnection(cl, cc, stream, nil)
 			conn.pkiID = pkiID
 			conn.info = connInfo
 			conn.logger = c.logger
 			conn.cancel = cancel
+			c.instrument(conn)
 
 			h := func(m *proto.SignedGossipMessage) {
 				c.logger.Debug("Got message:", m)
@@ -375,6 +392,15 @@ func (c *commImpl) CloseConn(peer *RemotePeer) {
 	c.connStore.closeConn(peer)
 }
 
+func (c *commImpl) SetConnectionFilter(filter ConnectionFilter) {
+	c.connFilter.Store(filter)
+}
+
+func (c *commImpl) isPermitted(connInfo *proto.ConnectionInfo, tlsCert *x509.Certificate) bool {
+	filter, isSet := c.connFilter.Load().(ConnectionFilter)
+	return !isSet || filter == nil || filter(connInfo, tlsCert)
+}
+
 func (c *commImpl) closeSubscriptions() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -404,9 +430,23 @@ func (c *commImpl) Stop() {
 }
 
 func (c *commImpl) GetPKIid() common.PKIidType {
+	c.identityLock.RLock()
+	defer c.identityLock.RUnlock()
 	return c.PKIID
 }
 
+// UpdateIdentity replaces the identity of this instance, and closes all
+// connections, so that remote peers authenticate it with its new identity
+func (c *commImpl) UpdateIdentity(identity api.PeerIdentityType) {
+	c.identityLock.Lock()
+	c.peerIdentity = identity
+	c.PKIID = c.idMapper.GetPKIidOfCert(identity)
+	c.identityLock.Unlock()
+
+	c.logger.Info("Updated identity, closing all connections")
+	c.connStore.closeAll()
+}
+
 func extractRemoteAddress(stream stream) string {
 	var remoteAddress string
 	p, ok := peer.FromContext(stream.Context())
@@ -445,7 +485,11 @@ func (c *commImpl) authenticateRemotePeer(stream stream, initiator bool) (*proto
 		return nil, fmt.Errorf("No TLS certificate")
 	}
 
-	cMsg, err = c.createConnectionMsg(c.PKIID, selfCertHash, c.peerIdentity, signer)
+	c.identityLock.RLock()
+	pkiID, peerIdentity := c.PKIID, c.peerIdentity
+	c.identityLock.RUnlock()
+
+	cMsg, err = c.createConnectionMsg(pkiID, selfCertHash, peerIdentity, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -575,9 +619,14 @@ func (c *commImpl) GossipStream(stream proto.Gossip_GossipStreamServer) error {
 		c.logger.Errorf("Authentication failed: %v", err)
 		return err
 	}
+	if !c.isPermitted(connInfo, extractCertificateFromContext(stream.Context())) {
+		c.logger.Warning("Connection from", extractRemoteAddress(stream), "with PKI-ID", connInfo.ID, "isn't permitted")
+		return errors.New("connection not permitted")
+	}
 	c.logger.Debug("Servicing", extractRemoteAddress(stream))
 
 	conn := c.connStore.onConnected(stream, connInfo)
+	c.instrument(conn)
 
 	h := func(m *proto.SignedGossipMessage) {
 		c.msgPublisher.DeMultiplex(&ReceivedMessageImpl{
@@ -599,6 +648,12 @@ func (c *commImpl) GossipStream(stream proto.Gossip_GossipStreamServer) error {
 	return conn.serviceConnection()
 }
 
+// instrument sets the send rate limiters and the metrics of the given connection
+func (c *commImpl) instrument(conn *connection) {
+	conn.limiters = []*rateLimiter{newRateLimiter(c.peerSendRate), c.sendLimiter}
+	conn.metrics = c.metrics
+}
+
 func (c *commImpl) Ping(context.Context, *proto.Empty) (*proto.Empty, error) {
 	return &proto.Empty{}, nil
 }
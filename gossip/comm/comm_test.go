@@ -12,6 +12,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"math/rand"
 	"net"
@@ -23,6 +24,8 @@ import (
 	"time"
 
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
@@ -111,10 +114,12 @@ func (*naiveSecProvider) VerifyByChannel(_ common.ChainID, _ api.PeerIdentityTyp
 	return nil
 }
 
+var disabledMetrics = NewCommMetrics(&disabled.Provider{})
+
 func newCommInstance(port int, sec *naiveSecProvider) (Comm, error) {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	id := []byte(endpoint)
-	inst, err := NewCommInstanceWithServer(port, identity.NewIdentityMapper(sec, id, noopPurgeIdentity, sec), id, nil, sec)
+	inst, err := NewCommInstanceWithServer(port, identity.NewIdentityMapper(sec, id, noopPurgeIdentity, sec), id, nil, sec, disabledMetrics)
 	return inst, err
 }
 
@@ -278,7 +283,7 @@ func TestHandshake(t *testing.T) {
 	idMapper := identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec)
 	inst, err := NewCommInstance(s, nil, idMapper, api.PeerIdentityType("localhost:9611"), func() []grpc.DialOption {
 		return []grpc.DialOption{grpc.WithInsecure()}
-	}, naiveSec)
+	}, naiveSec, disabledMetrics)
 	go s.Serve(ll)
 	assert.NoError(t, err)
 	var msg proto.ReceivedMessage
@@ -474,14 +479,14 @@ func TestProdConstructor(t *testing.T) {
 	defer srv.Stop()
 	defer lsnr.Close()
 	id := []byte("localhost:20000")
-	comm1, _ := NewCommInstance(srv, certs, identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec), id, dialOpts, naiveSec)
+	comm1, _ := NewCommInstance(srv, certs, identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec), id, dialOpts, naiveSec, disabledMetrics)
 	go srv.Serve(lsnr)
 
 	srv, lsnr, dialOpts, certs = createGRPCLayer(30000)
 	defer srv.Stop()
 	defer lsnr.Close()
 	id = []byte("localhost:30000")
-	comm2, _ := NewCommInstance(srv, certs, identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec), id, dialOpts, naiveSec)
+	comm2, _ := NewCommInstance(srv, certs, identity.NewIdentityMapper(naiveSec, id, noopPurgeIdentity, naiveSec), id, dialOpts, naiveSec, disabledMetrics)
 	go srv.Serve(lsnr)
 	defer comm1.Stop()
 	defer comm2.Stop()
@@ -841,6 +846,121 @@ func TestProbe(t *testing.T) {
 	assert.Equal(t, api.PeerIdentityType("localhost:6612"), id)
 }
 
+func TestConnectionFilter(t *testing.T) {
+	t.Parallel()
+	comm1, _ := newCommInstance(6621, naiveSec)
+	defer comm1.Stop()
+	comm2, _ := newCommInstance(6622, naiveSec)
+	defer comm2.Stop()
+	comm3, _ := newCommInstance(6623, naiveSec)
+	defer comm3.Stop()
+	comm1.SetConnectionFilter(func(connInfo *proto.ConnectionInfo, tlsCert *x509.Certificate) bool {
+		assert.NotNil(t, tlsCert)
+		return bytes.Equal(connInfo.ID, remotePeer(6622).PKIID)
+	})
+
+	messagesForComm1 := comm1.Accept(acceptAll)
+	messagesForComm3 := comm3.Accept(acceptAll)
+
+	// A permitted peer can connect
+	comm2.Send(createGossipMsg(), remotePeer(6621))
+	select {
+	case <-messagesForComm1:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "Didn't receive a message from a permitted peer within a timely manner")
+	}
+
+	// A peer which isn't permitted can't connect
+	comm3.Send(createGossipMsg(), remotePeer(6621))
+	select {
+	case <-messagesForComm1:
+		assert.Fail(t, "Message from a peer which isn't permitted shouldn't have been received")
+	case <-time.After(time.Second * 3):
+	}
+
+	// Connections to peers which aren't permitted aren't created
+	comm1.Send(createGossipMsg(), remotePeer(6623))
+	select {
+	case <-messagesForComm3:
+		assert.Fail(t, "Message to a peer which isn't permitted shouldn't have been sent")
+	case <-time.After(time.Second * 3):
+	}
+
+	// Handshakes aren't affected by the filter
+	id, err := comm3.Handshake(&RemotePeer{Endpoint: "localhost:6621"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.PeerIdentityType("localhost:6621"), id)
+	id, err = comm1.Handshake(&RemotePeer{Endpoint: "localhost:6623"})
+	assert.NoError(t, err)
+	assert.Equal(t, api.PeerIdentityType("localhost:6623"), id)
+}
+
+func TestSendRateLimit(t *testing.T) {
+	// Scenario: comm1 limits the rate of bytes sent to each remote peer to 10KB per second,
+	// and sends 20 data messages of about 1.5KB each to comm2, followed by an alive message.
+	// Ensure that the messages which exceed the rate are delayed, that the alive message
+	// isn't held back by them, and that the bytes sent and received are recorded.
+	newFakeMetrics := func() (*CommMetrics, *metricsfakes.Counter, *metricsfakes.Counter, *metricsfakes.Counter) {
+		sent, received, rateLimited := &metricsfakes.Counter{}, &metricsfakes.Counter{}, &metricsfakes.Counter{}
+		sent.WithReturns(sent)
+		received.WithReturns(received)
+		rateLimited.WithReturns(rateLimited)
+		return &CommMetrics{SentBytes: sent, ReceivedBytes: received, RateLimitedMessages: rateLimited}, sent, received, rateLimited
+	}
+
+	viper.Set("peer.gossip.sendRateLimit.perPeer", 10000)
+	metrics1, sent, _, rateLimited := newFakeMetrics()
+	id1 := []byte("localhost:6631")
+	comm1, _ := NewCommInstanceWithServer(6631, identity.NewIdentityMapper(naiveSec, id1, noopPurgeIdentity, naiveSec), id1, nil, naiveSec, metrics1)
+	defer comm1.Stop()
+	viper.Set("peer.gossip.sendRateLimit.perPeer", 0)
+	metrics2, _, received, _ := newFakeMetrics()
+	id2 := []byte("localhost:6632")
+	comm2, _ := NewCommInstanceWithServer(6632, identity.NewIdentityMapper(naiveSec, id2, noopPurgeIdentity, naiveSec), id2, nil, naiveSec, metrics2)
+	defer comm2.Stop()
+
+	messages := comm2.Accept(acceptAll)
+	// Establish the connection beforehand, so that the messages don't overflow the send buffer
+	comm1.Send(createGossipMsg(), remotePeer(6632))
+	<-messages
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		msg := createGossipMsg()
+		msg.GetDataMsg().Payload = &proto.Payload{SeqNum: uint64(i), Data: make([]byte, 1500)}
+		msg, _ = msg.NoopSign()
+		comm1.Send(msg, remotePeer(6632))
+	}
+	aliveMsg, _ := (&proto.GossipMessage{
+		Tag:     proto.GossipMessage_EMPTY,
+		Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}},
+	}).NoopSign()
+	comm1.Send(aliveMsg, remotePeer(6632))
+
+	dataMsgsBeforeAlive := -1
+	for i := 0; i < 21; i++ {
+		select {
+		case m := <-messages:
+			if m.GetGossipMessage().IsAliveMsg() {
+				dataMsgsBeforeAlive = i
+			}
+		case <-time.After(time.Second * 10):
+			assert.Fail(t, "Didn't receive all messages within a timely manner")
+			return
+		}
+	}
+	assert.True(t, time.Since(start) > time.Second, "Messages weren't delayed by the rate limit")
+	assert.NotZero(t, rateLimited.AddCallCount())
+	assert.True(t, dataMsgsBeforeAlive >= 0 && dataMsgsBeforeAlive < 20, "Alive message was held back by the rate limit")
+
+	assert.Equal(t, 22, sent.AddCallCount())
+	assert.Equal(t, []string{"message_type", "data_msg"}, sent.WithArgsForCall(21))
+	assert.True(t, sent.AddArgsForCall(21) > 1500)
+	assert.Equal(t, 22, received.AddCallCount())
+	assert.Equal(t, []string{"message_type", "data_msg"}, received.WithArgsForCall(21))
+	assert.Equal(t, sent.AddArgsForCall(21), received.AddArgsForCall(21))
+}
+
 func TestPresumedDead(t *testing.T) {
 	t.Parallel()
 	comm1, _ := newCommInstance(4611, naiveSec)
@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	pb "github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/util"
 	proto "github.com/hyperledger/fabric/protos/gossip"
@@ -142,6 +144,14 @@ func (cs *connectionStore) closeConn(peer *RemotePeer) {
 func (cs *connectionStore) shutdown() {
 	cs.Lock()
 	cs.isClosing = true
+	cs.Unlock()
+
+	cs.closeAll()
+}
+
+// closeAll closes all the connections of the store
+func (cs *connectionStore) closeAll() {
+	cs.Lock()
 	pkiIds2conn := cs.pki2Conn
 
 	var connections2Close []*connection
@@ -193,6 +203,7 @@ func (cs *connectionStore) closeByPKIid(pkiID common.PKIidType) {
 func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_GossipStreamClient, ss proto.Gossip_GossipStreamServer) *connection {
 	connection := &connection{
 		outBuff:      make(chan *msgSending, util.GetIntOrDefault("peer.gossip.sendBuffSize", defSendBuffSize)),
+		ctrlBuff:     make(chan *msgSending, util.GetIntOrDefault("peer.gossip.sendBuffSize", defSendBuffSize)),
 		cl:           cl,
 		conn:         c,
 		clientStream: cs,
@@ -206,7 +217,8 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 type connection struct {
 	cancel       context.CancelFunc
 	info         *proto.ConnectionInfo
-	outBuff      chan *msgSending
+	outBuff      chan *msgSending                // buffer of the messages subject to the send rate limits
+	ctrlBuff     chan *msgSending                // buffer of the control messages, which are sent ahead of the others
 	logger       util.Logger                     // logger
 	pkiID        common.PKIidType                // pkiID of the remote endpoint
 	handler      handler                         // function to invoke upon a message reception
@@ -216,6 +228,8 @@ type connection struct {
 	serverStream proto.Gossip_GossipStreamServer // server-side stream to remote endpoint
 	stopFlag     int32                           // indicates whether this connection is in process of stopping
 	stopChan     chan struct{}                   // a method to stop the server-side gRPC call from a different go-routine
+	limiters     []*rateLimiter                  // limiters of the rate of bytes sent to the remote endpoint
+	metrics      *CommMetrics                    // metrics of the bytes sent and received
 	sync.RWMutex                                 // synchronizes access to shared variables
 }
 
@@ -259,10 +273,16 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 
 	m := &msgSending{
 		envelope: msg.Envelope,
+		msgType:  messageType(msg.GossipMessage),
 		onErr:    onErr,
 	}
 
-	if len(conn.outBuff) == cap(conn.outBuff) {
+	buff := conn.ctrlBuff
+	if isThrottled(msg.GossipMessage) {
+		buff = conn.outBuff
+	}
+
+	if len(buff) == cap(buff) {
 		if conn.logger.IsEnabledFor(zapcore.DebugLevel) {
 			conn.logger.Debug("Buffer to", conn.info.Endpoint, "overflowed, dropping message", msg.String())
 		}
@@ -271,7 +291,7 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 		}
 	}
 
-	conn.outBuff <- m
+	buff <- m
 }
 
 func (conn *connection) serviceConnection() error {
@@ -309,11 +329,46 @@ func (conn *connection) writeToStream() {
 			conn.logger.Error(conn.pkiID, "Stream is nil, aborting!")
 			return
 		}
+		// Control messages are sent ahead of the messages waiting in the output buffer
+		select {
+		case m := <-conn.ctrlBuff:
+			if !conn.sendToStream(stream, m, pb.Size(m.envelope)) {
+				return
+			}
+			continue
+		default:
+		}
+
 		select {
+		case m := <-conn.ctrlBuff:
+			if !conn.sendToStream(stream, m, pb.Size(m.envelope)) {
+				return
+			}
 		case m := <-conn.outBuff:
-			err := stream.Send(m.envelope)
-			if err != nil {
-				go m.onErr(err)
+			size := pb.Size(m.envelope)
+			if delay := conn.throttle(size); delay > 0 {
+				conn.metrics.RateLimitedMessages.With("message_type", m.msgType).Add(1)
+				// Keep sending the control messages while the message is delayed
+				timer := time.NewTimer(delay)
+			waitForDelay:
+				for {
+					select {
+					case <-timer.C:
+						break waitForDelay
+					case ctrl := <-conn.ctrlBuff:
+						if !conn.sendToStream(stream, ctrl, pb.Size(ctrl.envelope)) {
+							timer.Stop()
+							return
+						}
+					case stop := <-conn.stopChan:
+						timer.Stop()
+						conn.logger.Debug("Closing writing to stream")
+						conn.stopChan <- stop
+						return
+					}
+				}
+			}
+			if !conn.sendToStream(stream, m, size) {
 				return
 			}
 		case stop := <-conn.stopChan:
@@ -324,11 +379,37 @@ func (conn *connection) writeToStream() {
 	}
 }
 
+// sendToStream sends the given message of the given size to the stream,
+// and returns false if sending failed
+func (conn *connection) sendToStream(stream stream, m *msgSending, size int) bool {
+	if err := stream.Send(m.envelope); err != nil {
+		go m.onErr(err)
+		return false
+	}
+	conn.metrics.SentBytes.With("message_type", m.msgType).Add(float64(size))
+	return true
+}
+
+// throttle reserves the given number of bytes to be sent from
+// the rate limiters, and returns how long to wait before sending them
+func (conn *connection) throttle(size int) time.Duration {
+	var delay time.Duration
+	for _, limiter := range conn.limiters {
+		if d := limiter.reserve(size); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
 func (conn *connection) drainOutputBuffer() {
 	// Drain the output buffer
 	for len(conn.outBuff) > 0 {
 		<-conn.outBuff
 	}
+	for len(conn.ctrlBuff) > 0 {
+		<-conn.ctrlBuff
+	}
 }
 
 func (conn *connection) readFromStream(errChan chan error, quit chan struct{}, msgChan chan *proto.SignedGossipMessage) {
@@ -353,6 +434,8 @@ func (conn *connection) readFromStream(errChan chan error, quit chan struct{}, m
 		if err != nil {
 			errChan <- err
 			conn.logger.Warningf("Got error, aborting: %v", err)
+		} else {
+			conn.metrics.ReceivedBytes.With("message_type", messageType(msg.GossipMessage)).Add(float64(pb.Size(envelope)))
 		}
 		select {
 		case msgChan <- msg:
@@ -384,5 +467,6 @@ func (conn *connection) getStream() stream {
 
 type msgSending struct {
 	envelope *proto.Envelope
+	msgType  string
 	onErr    func(error)
 }
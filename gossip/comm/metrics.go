@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+)
+
+var (
+	sentBytesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "sent_bytes",
+		Help:         "Number of bytes sent to remote peers.",
+		LabelNames:   []string{"message_type"},
+		StatsdFormat: "%{#fqname}.%{message_type}",
+	}
+
+	receivedBytesOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "received_bytes",
+		Help:         "Number of bytes received from remote peers.",
+		LabelNames:   []string{"message_type"},
+		StatsdFormat: "%{#fqname}.%{message_type}",
+	}
+
+	rateLimitedOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "rate_limited_messages",
+		Help:         "Number of messages whose sending was delayed by the send rate limits.",
+		LabelNames:   []string{"message_type"},
+		StatsdFormat: "%{#fqname}.%{message_type}",
+	}
+)
+
+// CommMetrics are the metrics of the gossip communication layer
+type CommMetrics struct {
+	SentBytes           metrics.Counter
+	ReceivedBytes       metrics.Counter
+	RateLimitedMessages metrics.Counter
+}
+
+// NewCommMetrics creates the metrics of the gossip communication layer
+func NewCommMetrics(p metrics.Provider) *CommMetrics {
+	return &CommMetrics{
+		SentBytes:           p.NewCounter(sentBytesOpts),
+		ReceivedBytes:       p.NewCounter(receivedBytesOpts),
+		RateLimitedMessages: p.NewCounter(rateLimitedOpts),
+	}
+}
+
+// messageType returns the type of the content of the given message,
+// such as data_msg or state_response
+func messageType(msg *proto.GossipMessage) string {
+	if msg == nil || msg.Content == nil {
+		return "unknown"
+	}
+	name := strings.TrimPrefix(reflect.TypeOf(msg.Content).Elem().Name(), "GossipMessage_")
+	var typ []rune
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			typ = append(typ, '_')
+		}
+		typ = append(typ, r)
+	}
+	return strings.ToLower(string(typ))
+}
@@ -139,6 +139,10 @@ func (mock *commMock) GetPKIid() common.PKIidType {
 	return common.PKIidType(mock.id)
 }
 
+// UpdateIdentity replaces the identity of this instance
+func (mock *commMock) UpdateIdentity(identity api.PeerIdentityType) {
+}
+
 // Send sends a message to remote peers
 func (mock *commMock) Send(msg *proto.SignedGossipMessage, peers ...*comm.RemotePeer) {
 	for _, peer := range peers {
@@ -185,6 +189,11 @@ func (mock *commMock) CloseConn(peer *comm.RemotePeer) {
 	// NOOP
 }
 
+// SetConnectionFilter restricts the connections established with remote peers
+func (mock *commMock) SetConnectionFilter(filter comm.ConnectionFilter) {
+	// NOOP
+}
+
 // Stop stops the module
 func (mock *commMock) Stop() {
 	logger.Debug("Stopping communication module, closing all accepting channels.")
@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"sync"
+	"time"
+
+	proto "github.com/hyperledger/fabric/protos/gossip"
+)
+
+// rateLimiter limits the rate of bytes sent by means of a token bucket,
+// which is refilled at the configured rate and holds up to a second
+// worth of bytes, in order to allow short bursts
+type rateLimiter struct {
+	sync.Mutex
+	bytesPerSecond float64
+	tokens         float64
+	last           time.Time
+	now            func() time.Time
+}
+
+// newRateLimiter creates a rate limiter of the given number of bytes
+// per second, or returns nil if the rate isn't limited
+func newRateLimiter(bytesPerSecond int) *rateLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		bytesPerSecond: float64(bytesPerSecond),
+		tokens:         float64(bytesPerSecond),
+		last:           time.Now(),
+		now:            time.Now,
+	}
+}
+
+// isThrottled returns whether the given message carries ledger data, and is
+// therefore subject to the send rate limits. Control messages, such as alive,
+// leadership and state info messages, aren't throttled, so that the membership
+// and the leader election remain stable while data saturates the limits
+func isThrottled(msg *proto.GossipMessage) bool {
+	switch {
+	case msg.IsDataMsg(), msg.GetStateResponse() != nil, msg.GetPrivateData() != nil, msg.GetPrivateRes() != nil:
+		return true
+	case msg.GetDataUpdate() != nil:
+		return msg.GetDataUpdate().MsgType == proto.PullMsgType_BLOCK_MSG
+	default:
+		return false
+	}
+}
+
+// reserve reserves the given number of bytes to be sent,
+// and returns how long to wait before sending them
+func (rl *rateLimiter) reserve(size int) time.Duration {
+	if rl == nil {
+		return 0
+	}
+	rl.Lock()
+	defer rl.Unlock()
+
+	now := rl.now()
+	rl.tokens += now.Sub(rl.last).Seconds() * rl.bytesPerSecond
+	if rl.tokens > rl.bytesPerSecond {
+		rl.tokens = rl.bytesPerSecond
+	}
+	rl.last = now
+
+	rl.tokens -= float64(size)
+	if rl.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-rl.tokens / rl.bytesPerSecond * float64(time.Second))
+}
@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	assert.Nil(t, newRateLimiter(0))
+	var unlimited *rateLimiter
+	assert.Zero(t, unlimited.reserve(1000000))
+
+	now := time.Now()
+	rl := newRateLimiter(1000)
+	rl.last = now
+	rl.now = func() time.Time { return now }
+
+	// A second worth of bytes can be sent right away
+	assert.Zero(t, rl.reserve(600))
+	assert.Zero(t, rl.reserve(400))
+	// Bytes beyond it have to wait until the bucket is refilled
+	assert.Equal(t, time.Millisecond*500, rl.reserve(500))
+	assert.Equal(t, time.Second, rl.reserve(500))
+
+	// The bucket is refilled at the configured rate
+	now = now.Add(time.Second * 2)
+	assert.Zero(t, rl.reserve(1000))
+	// and holds up to a second worth of bytes
+	now = now.Add(time.Second * 10)
+	assert.Zero(t, rl.reserve(1000))
+	assert.Equal(t, time.Millisecond*100, rl.reserve(100))
+}
+
+func TestIsThrottled(t *testing.T) {
+	assert.True(t, isThrottled(createGossipMsg().GossipMessage))
+	assert.True(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_StateResponse{StateResponse: &proto.RemoteStateResponse{}},
+	}))
+	assert.True(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_PrivateRes{PrivateRes: &proto.RemotePvtDataResponse{}},
+	}))
+	assert.True(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_DataUpdate{DataUpdate: &proto.DataUpdate{MsgType: proto.PullMsgType_BLOCK_MSG}},
+	}))
+	assert.False(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_DataUpdate{DataUpdate: &proto.DataUpdate{MsgType: proto.PullMsgType_IDENTITY_MSG}},
+	}))
+	assert.False(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}},
+	}))
+	assert.False(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_LeadershipMsg{LeadershipMsg: &proto.LeadershipMessage{}},
+	}))
+	assert.False(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}},
+	}))
+	assert.False(t, isThrottled(&proto.GossipMessage{
+		Content: &proto.GossipMessage_StateRequest{StateRequest: &proto.RemoteStateRequest{}},
+	}))
+}
+
+func TestMessageType(t *testing.T) {
+	assert.Equal(t, "data_msg", messageType(createGossipMsg().GossipMessage))
+	assert.Equal(t, "state_info_pull_req", messageType(&proto.GossipMessage{
+		Content: &proto.GossipMessage_StateInfoPullReq{},
+	}))
+	assert.Equal(t, "unknown", messageType(&proto.GossipMessage{}))
+	assert.Equal(t, "unknown", messageType(nil))
+}
@@ -42,6 +42,11 @@ type Sieve func(message *proto.SignedGossipMessage) bool
 //    part of the Envelope the SignedGossipMessage originates from
 type DisclosurePolicy func(remotePeer *NetworkMember) (Sieve, EnvelopeFilter)
 
+// MembershipFilter determines whether the remote peer of the given PKI-ID
+// is permitted to be part of the membership.
+// A nil MembershipFilter permits all peers.
+type MembershipFilter func(pkiID common.PKIidType) bool
+
 // CommService is an interface that the discovery expects to be implemented and passed on creation
 type CommService interface {
 	// Gossip gossips a message
@@ -117,12 +122,19 @@ type Discovery interface {
 	// UpdateEndpoint updates this instance's endpoint
 	UpdateEndpoint(string)
 
+	// UpdatePKIid updates this instance's PKI-ID, which is
+	// advertised in its alive messages
+	UpdatePKIid(common.PKIidType)
+
 	// Stops this instance
 	Stop()
 
 	// GetMembership returns the alive members in the view
 	GetMembership() []NetworkMember
 
+	// GetDeadMembership returns the members in the view that are considered dead
+	GetDeadMembership() []NetworkMember
+
 	// InitiateSync makes the instance ask a given number of peers
 	// for their membership information
 	InitiateSync(peerNum int)
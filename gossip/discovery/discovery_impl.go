@@ -88,6 +88,7 @@ type gossipDiscoveryImpl struct {
 	port             int
 	logger           util.Logger
 	disclosurePolicy DisclosurePolicy
+	membershipFilter MembershipFilter
 	pubsub           *util.PubSub
 
 	aliveTimeInterval            time.Duration
@@ -96,8 +97,9 @@ type gossipDiscoveryImpl struct {
 	reconnectInterval            time.Duration
 }
 
-// NewDiscoveryService returns a new discovery service with the comm module passed and the crypto service passed
-func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoService, disPol DisclosurePolicy) Discovery {
+// NewDiscoveryService returns a new discovery service with the comm module passed and the crypto service passed.
+// Peers which aren't permitted by the given membership filter are never part of the membership.
+func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoService, disPol DisclosurePolicy, memFilter MembershipFilter) Discovery {
 	d := &gossipDiscoveryImpl{
 		self:             self,
 		incTime:          uint64(time.Now().UnixNano()),
@@ -114,6 +116,7 @@ func NewDiscoveryService(self NetworkMember, comm CommService, crypt CryptoServi
 		toDieFlag:        int32(0),
 		logger:           util.GetLogger(util.DiscoveryLogger, self.InternalEndpoint),
 		disclosurePolicy: disPol,
+		membershipFilter: memFilter,
 		pubsub:           util.NewPubSub(),
 
 		aliveTimeInterval:            getAliveTimeInterval(),
@@ -340,6 +343,11 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 			return
 		}
 
+		if !d.isPermitted(selfInfoGossipMsg.GetAliveMsg().Membership.PkiId) {
+			d.logger.Warning("Ignoring membership request from", selfInfoGossipMsg.GetAliveMsg().Membership, "which isn't permitted to be part of the membership")
+			return
+		}
+
 		if d.msgStore.CheckValid(selfInfoGossipMsg) {
 			d.handleAliveMessage(selfInfoGossipMsg)
 		}
@@ -364,6 +372,10 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 		if d.isSentByMe(m) {
 			return
 		}
+		// If the sender isn't permitted to be part of the membership, don't forward it further
+		if !d.isPermitted(m.GetAliveMsg().Membership.PkiId) {
+			return
+		}
 
 		d.msgStore.Add(m)
 		d.handleAliveMessage(m)
@@ -401,6 +413,10 @@ func (d *gossipDiscoveryImpl) handleMsgFromComm(msg proto.ReceivedMessage) {
 				continue
 			}
 
+			if !d.isPermitted(dm.GetAliveMsg().Membership.PkiId) {
+				continue
+			}
+
 			newDeadMembers := []*proto.SignedGossipMessage{}
 			d.lock.RLock()
 			if _, known := d.id2Member[string(dm.GetAliveMsg().Membership.PkiId)]; !known {
@@ -502,6 +518,10 @@ func (d *gossipDiscoveryImpl) handleAliveMessage(m *proto.SignedGossipMessage) {
 	}
 
 	pkiID := m.GetAliveMsg().Membership.PkiId
+	if !d.isPermitted(pkiID) {
+		d.logger.Debug("Ignoring alive message of", m.GetAliveMsg().Membership, "which isn't permitted to be part of the membership")
+		return
+	}
 
 	ts := m.GetAliveMsg().Timestamp
 
@@ -554,6 +574,10 @@ func (d *gossipDiscoveryImpl) handleAliveMessage(m *proto.SignedGossipMessage) {
 	// else, ignore the message because it is too old
 }
 
+func (d *gossipDiscoveryImpl) isPermitted(pkiID common.PKIidType) bool {
+	return d.membershipFilter == nil || d.membershipFilter(pkiID)
+}
+
 func (d *gossipDiscoveryImpl) isSentByMe(m *proto.SignedGossipMessage) bool {
 	pkiID := m.GetAliveMsg().Membership.PkiId
 	if !equalPKIid(pkiID, d.self.PKIid) {
@@ -924,6 +948,31 @@ func (d *gossipDiscoveryImpl) learnNewMembers(aliveMembers []*proto.SignedGossip
 	}
 }
 
+func (d *gossipDiscoveryImpl) GetDeadMembership() []NetworkMember {
+	if d.toDie() {
+		return []NetworkMember{}
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	response := []NetworkMember{}
+	for _, m := range d.deadMembership.ToSlice() {
+		member := m.GetAliveMsg()
+		var internalEndpoint string
+		if knownMember, exists := d.id2Member[string(member.Membership.PkiId)]; exists {
+			internalEndpoint = knownMember.InternalEndpoint
+		}
+		response = append(response, NetworkMember{
+			PKIid:            member.Membership.PkiId,
+			Endpoint:         member.Membership.Endpoint,
+			Metadata:         member.Membership.Metadata,
+			InternalEndpoint: internalEndpoint,
+			Envelope:         m.Envelope,
+		})
+	}
+	return response
+}
+
 func (d *gossipDiscoveryImpl) GetMembership() []NetworkMember {
 	if d.toDie() {
 		return []NetworkMember{}
@@ -963,6 +1012,13 @@ func (d *gossipDiscoveryImpl) UpdateEndpoint(endpoint string) {
 	d.self.Endpoint = endpoint
 }
 
+func (d *gossipDiscoveryImpl) UpdatePKIid(pkiID common.PKIidType) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.self.PKIid = pkiID
+}
+
 func (d *gossipDiscoveryImpl) Self() NetworkMember {
 	var env *proto.Envelope
 	msg, _ := d.aliveMsgAndInternalEndpoint()
@@ -355,7 +355,15 @@ func createDiscoveryInstanceThatGossips(port int, id string, bootstrapPeers []st
 	return createDiscoveryInstanceThatGossipsWithInterceptors(port, id, bootstrapPeers, shouldGossip, pol, func(_ *proto.SignedGossipMessage) {})
 }
 
+func createDiscoveryInstanceWithMembershipFilter(port int, id string, bootstrapPeers []string, memFilter MembershipFilter) *gossipInstance {
+	return createDiscoveryInstanceWithInterceptorsAndMembershipFilter(port, id, bootstrapPeers, true, noopPolicy, func(_ *proto.SignedGossipMessage) {}, memFilter)
+}
+
 func createDiscoveryInstanceThatGossipsWithInterceptors(port int, id string, bootstrapPeers []string, shouldGossip bool, pol DisclosurePolicy, f func(*proto.SignedGossipMessage)) *gossipInstance {
+	return createDiscoveryInstanceWithInterceptorsAndMembershipFilter(port, id, bootstrapPeers, shouldGossip, pol, f, nil)
+}
+
+func createDiscoveryInstanceWithInterceptorsAndMembershipFilter(port int, id string, bootstrapPeers []string, shouldGossip bool, pol DisclosurePolicy, f func(*proto.SignedGossipMessage), memFilter MembershipFilter) *gossipInstance {
 	comm := &dummyCommModule{
 		conns:        make(map[string]*grpc.ClientConn),
 		streams:      make(map[string]proto.Gossip_GossipStreamClient),
@@ -383,7 +391,7 @@ func createDiscoveryInstanceThatGossipsWithInterceptors(port int, id string, boo
 	}
 	s := grpc.NewServer()
 
-	discSvc := NewDiscoveryService(self, comm, comm, pol)
+	discSvc := NewDiscoveryService(self, comm, comm, pol, memFilter)
 	for _, bootPeer := range bootstrapPeers {
 		bp := bootPeer
 		discSvc.Connect(NetworkMember{Endpoint: bp, InternalEndpoint: bootPeer}, func() (*PeerIdentification, error) {
@@ -825,6 +833,14 @@ func TestExpiration(t *testing.T) {
 
 	assertMembership(t, instances[:len(instances)-2], nodeNum-3)
 
+	for _, inst := range instances[:len(instances)-2] {
+		var deadEndpoints []string
+		for _, member := range inst.GetDeadMembership() {
+			deadEndpoints = append(deadEndpoints, member.Endpoint)
+		}
+		assert.ElementsMatch(t, []string{"localhost:2614", "localhost:2615"}, deadEndpoints)
+	}
+
 	stopAction := &sync.WaitGroup{}
 	for i, inst := range instances {
 		if i+2 == nodeNum {
@@ -1488,6 +1504,26 @@ func TestMemRespDisclosurePol(t *testing.T) {
 	assert.NotZero(t, d2.sentMsgCount())
 }
 
+func TestMembershipFilter(t *testing.T) {
+	t.Parallel()
+	// d1 permits only d2 to be part of its membership
+	memFilter := func(pkiID common.PKIidType) bool {
+		return string(pkiID) == "localhost:7882"
+	}
+	d1 := createDiscoveryInstanceWithMembershipFilter(7881, "d1", []string{}, memFilter)
+	defer d1.Stop()
+	d2 := createDiscoveryInstance(7882, "d2", []string{"localhost:7881"})
+	defer d2.Stop()
+	d3 := createDiscoveryInstance(7883, "d3", []string{"localhost:7881", "localhost:7882"})
+	defer d3.Stop()
+	// d2 and d3 know each other and d1
+	assertMembership(t, []*gossipInstance{d2, d3}, 2)
+	// d1 knows only about d2, although d3 reached out to it, and d2 knows about d3
+	assertMembership(t, []*gossipInstance{d1}, 1)
+	time.Sleep(getAliveTimeInterval() * 2)
+	assert.Equal(t, []int{7882}, portsOfMembers(d1.GetMembership()))
+}
+
 func TestMembersByID(t *testing.T) {
 	members := Members{
 		{PKIid: common.PKIidType("p0"), Endpoint: "p0"},
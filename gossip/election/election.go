@@ -91,6 +91,10 @@ type LeaderElectionService interface {
 	// IsLeader returns whether this peer is a leader or not
 	IsLeader() bool
 
+	// Leader returns the ID of the peer that this peer considers the leader,
+	// or nil if it isn't aware of a leader
+	Leader() []byte
+
 	// Stop stops the LeaderElectionService
 	Stop()
 
@@ -161,6 +165,7 @@ type leaderElectionSvcImpl struct {
 	isLeader      int32
 	toDie         int32
 	leaderExists  int32
+	leaderID      atomic.Value
 	yield         int32
 	sleeping      bool
 	adapter       LeaderElectionAdapter
@@ -209,6 +214,7 @@ func (le *leaderElectionSvcImpl) handleMessage(msg Msg) {
 		le.proposals.Add(string(msg.SenderID()))
 	} else if msg.IsDeclaration() {
 		atomic.StoreInt32(&le.leaderExists, int32(1))
+		le.leaderID.Store(msg.SenderID())
 		if le.sleeping && len(le.interruptChan) == 0 {
 			le.interruptChan <- struct{}{}
 		}
@@ -378,15 +384,26 @@ func (le *leaderElectionSvcImpl) IsLeader() bool {
 	return isLeader
 }
 
+// Leader returns the ID of the peer that this peer considers the leader,
+// or nil if it isn't aware of a leader
+func (le *leaderElectionSvcImpl) Leader() []byte {
+	leaderID, _ := le.leaderID.Load().(peerID)
+	return leaderID
+}
+
 func (le *leaderElectionSvcImpl) beLeader() {
 	le.logger.Info(le.id, ": Becoming a leader")
 	atomic.StoreInt32(&le.isLeader, int32(1))
+	le.leaderID.Store(le.id)
 	le.callback(true)
 }
 
 func (le *leaderElectionSvcImpl) stopBeingLeader() {
 	le.logger.Info(le.id, "Stopped being a leader")
 	atomic.StoreInt32(&le.isLeader, int32(0))
+	if bytes.Equal(le.Leader(), le.id) {
+		le.leaderID.Store(peerID(nil))
+	}
 	le.callback(false)
 }
 
@@ -301,11 +301,31 @@ func TestLeadershipTakeover(t *testing.T) {
 	leaders := waitForLeaderElection(t, peers)
 	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
 	assert.Equal(t, "p5", leaders[0])
+	waitForLeaderID(t, peers, "p5")
 	peers[0].Stop()
 	time.Sleep(getLeadershipDeclarationInterval() + getLeaderAliveThreshold()*3)
 	leaders = waitForLeaderElection(t, peers[1:])
 	assert.Len(t, leaders, 1, "Only 1 leader should have been elected")
 	assert.Equal(t, "p2", leaders[0])
+	waitForLeaderID(t, peers[1:], "p2")
+}
+
+// waitForLeaderID waits until all the given peers consider the peer with the given ID as the leader
+func waitForLeaderID(t *testing.T, peers []*peer, id string) {
+	end := time.Now().Add(testTimeout)
+	for time.Now().Before(end) {
+		agreed := true
+		for _, p := range peers {
+			if string(p.Leader()) != id {
+				agreed = false
+			}
+		}
+		if agreed {
+			return
+		}
+		time.Sleep(testPollInterval)
+	}
+	t.Fatal("Peers don't agree that", id, "is the leader")
 }
 
 func TestYield(t *testing.T) {
@@ -9,6 +9,7 @@ package gossip
 import (
 	"bytes"
 	"encoding/hex"
+	"sync"
 
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
@@ -21,6 +22,7 @@ import (
 
 // certStore supports pull dissemination of identity messages
 type certStore struct {
+	sync.RWMutex
 	selfIdentity api.PeerIdentityType
 	idMapper     identity.Mapper
 	pull         pull.Mediator
@@ -107,6 +109,25 @@ func (cs *certStore) validateIdentityMsg(msg *proto.SignedGossipMessage) error {
 	return cs.mcs.ValidateIdentity(api.PeerIdentityType(idMsg.Cert))
 }
 
+// updateSelfIdentity replaces the identity message of the peer
+// with an identity message of the given identity
+func (cs *certStore) updateSelfIdentity(selfIdentity api.PeerIdentityType) error {
+	cs.Lock()
+	defer cs.Unlock()
+
+	prevPKIID := cs.idMapper.GetPKIidOfCert(cs.selfIdentity)
+	prevIdentity := cs.selfIdentity
+	cs.selfIdentity = selfIdentity
+	selfIDMsg, err := cs.createIdentityMessage()
+	if err != nil {
+		cs.selfIdentity = prevIdentity
+		return errors.WithStack(err)
+	}
+	cs.pull.Remove(string(prevPKIID))
+	cs.pull.Add(selfIDMsg)
+	return nil
+}
+
 func (cs *certStore) createIdentityMessage() (*proto.SignedGossipMessage, error) {
 	pi := &proto.PeerIdentity{
 		Cert:     cs.selfIdentity,
@@ -86,6 +86,10 @@ type GossipChannel interface {
 	// LeaveChannel makes the peer leave the channel
 	LeaveChannel()
 
+	// UpdatePKIid updates the PKI-ID the peer publishes
+	// to other peers in the channel
+	UpdatePKIid(pkiID common.PKIidType)
+
 	// Stop stops the channel's activity
 	Stop()
 }
@@ -133,7 +137,7 @@ type gossipChannel struct {
 	sync.RWMutex
 	shouldGossipStateInfo     int32
 	mcs                       api.MessageCryptoService
-	pkiID                     common.PKIidType
+	pkiID                     atomic.Value
 	selfOrg                   api.OrgIdentityType
 	stopChan                  chan struct{}
 	stateInfoMsg              *proto.SignedGossipMessage
@@ -180,7 +184,6 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 	gc := &gossipChannel{
 		incTime:                   uint64(time.Now().UnixNano()),
 		selfOrg:                   org,
-		pkiID:                     pkiID,
 		mcs:                       mcs,
 		Adapter:                   adapter,
 		logger:                    util.GetLogger(util.ChannelLogger, adapter.GetConf().ID),
@@ -192,6 +195,8 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 		chainID:                   chainID,
 	}
 
+	gc.pkiID.Store(pkiID)
+
 	gc.memFilter = &membershipFilter{adapter: gc.Adapter, gossipChannel: gc}
 
 	comparator := proto.NewGossipMessageComparator(adapter.GetConf().MaxBlockCountToStore)
@@ -214,7 +219,7 @@ func NewGossipChannel(pkiID common.PKIidType, org api.OrgIdentityType, mcs api.M
 	verifyStateInfoMsg := func(msg *proto.SignedGossipMessage, orgs ...api.OrgIdentityType) bool {
 		si := msg.GetStateInfo()
 		// No point in verifying ourselves
-		if bytes.Equal(gc.pkiID, si.PkiId) {
+		if bytes.Equal(gc.selfPKIid(), si.PkiId) {
 			return true
 		}
 		peerIdentity := adapter.GetIdentityByPKIID(si.PkiId)
@@ -330,6 +335,25 @@ func (gc *gossipChannel) LeaveChannel() {
 	gc.updateProperties(height, chaincodes, true)
 }
 
+// UpdatePKIid updates the PKI-ID the peer publishes
+// to other peers in the channel
+func (gc *gossipChannel) UpdatePKIid(pkiID common.PKIidType) {
+	gc.Lock()
+	defer gc.Unlock()
+
+	gc.pkiID.Store(pkiID)
+	prevMsg := gc.stateInfoMsg
+	if prevMsg == nil {
+		return
+	}
+	props := prevMsg.GetStateInfo().Properties
+	gc.updateProperties(props.LedgerHeight, props.Chaincodes, props.LeftChannel)
+}
+
+func (gc *gossipChannel) selfPKIid() common.PKIidType {
+	return gc.pkiID.Load().(common.PKIidType)
+}
+
 func (gc *gossipChannel) hasLeftChannel() bool {
 	return atomic.LoadInt32(&gc.leftChannel) == 1
 }
@@ -812,7 +836,7 @@ func (gc *gossipChannel) createStateInfoRequest() (*proto.SignedGossipMessage, e
 		Nonce: 0,
 		Content: &proto.GossipMessage_StateInfoPullReq{
 			StateInfoPullReq: &proto.StateInfoPullRequest{
-				Channel_MAC: GenerateMAC(gc.pkiID, gc.chainID),
+				Channel_MAC: GenerateMAC(gc.selfPKIid(), gc.chainID),
 			},
 		},
 	}).NoopSign()
@@ -858,9 +882,10 @@ func (gc *gossipChannel) updateStateInfo(msg *proto.SignedGossipMessage) {
 }
 
 func (gc *gossipChannel) updateProperties(ledgerHeight uint64, chaincodes []*proto.Chaincode, leftChannel bool) {
+	pkiID := gc.selfPKIid()
 	stateInfMsg := &proto.StateInfo{
-		Channel_MAC: GenerateMAC(gc.pkiID, gc.chainID),
-		PkiId:       gc.pkiID,
+		Channel_MAC: GenerateMAC(pkiID, gc.chainID),
+		PkiId:       pkiID,
 		Timestamp: &proto.PeerTime{
 			IncNum: gc.incTime,
 			SeqNum: uint64(time.Now().UnixNano()),
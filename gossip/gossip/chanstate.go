@@ -93,6 +93,18 @@ func (cs *channelState) getGossipChannelByChainID(chainID common.ChainID) channe
 	return cs.channels[string(chainID)]
 }
 
+// chainIDOf returns the chain ID of the given channel, or nil if the channel isn't joined
+func (cs *channelState) chainIDOf(gc channel.GossipChannel) common.ChainID {
+	cs.RLock()
+	defer cs.RUnlock()
+	for chanName, ch := range cs.channels {
+		if ch == gc {
+			return common.ChainID(chanName)
+		}
+	}
+	return nil
+}
+
 func (cs *channelState) joinChannel(joinMsg api.JoinChannelMessage, chainID common.ChainID) {
 	if cs.isStopping() {
 		return
@@ -101,7 +113,11 @@ func (cs *channelState) joinChannel(joinMsg api.JoinChannelMessage, chainID comm
 	defer cs.Unlock()
 	if gc, exists := cs.channels[string(chainID)]; !exists {
 		pkiID := cs.g.comm.GetPKIid()
-		ga := &gossipAdapterImpl{gossipServiceImpl: cs.g, Discovery: cs.g.disc}
+		var disc discovery.Discovery = cs.g.disc
+		if cs.g.staticTopology != nil {
+			disc = &staticChannelDiscovery{Discovery: cs.g.disc, chainID: chainID, topology: cs.g.staticTopology}
+		}
+		ga := &gossipAdapterImpl{gossipServiceImpl: cs.g, Discovery: disc}
 		gc := channel.NewGossipChannel(pkiID, cs.g.selfOrg, cs.g.mcs, chainID, ga, joinMsg)
 		cs.channels[string(chainID)] = gc
 	} else {
@@ -109,6 +125,14 @@ func (cs *channelState) joinChannel(joinMsg api.JoinChannelMessage, chainID comm
 	}
 }
 
+func (cs *channelState) updatePKIid(pkiID common.PKIidType) {
+	cs.RLock()
+	defer cs.RUnlock()
+	for _, gc := range cs.channels {
+		gc.UpdatePKIid(pkiID)
+	}
+}
+
 type gossipAdapterImpl struct {
 	*gossipServiceImpl
 	discovery.Discovery
@@ -36,6 +36,9 @@ type Gossip interface {
 	// GetPeers returns the NetworkMembers considered alive
 	Peers() []discovery.NetworkMember
 
+	// DeadPeers returns the NetworkMembers considered dead
+	DeadPeers() []discovery.NetworkMember
+
 	// PeersOfChannel returns the NetworkMembers considered alive
 	// and also subscribed to the channel given
 	PeersOfChannel(common.ChainID) []discovery.NetworkMember
@@ -44,6 +47,10 @@ type Gossip interface {
 	// the peer publishes to other peers
 	UpdateMetadata(metadata []byte)
 
+	// UpdateIdentity replaces the identity of the peer, and propagates
+	// it to other peers, along with the PKI-ID derived from it
+	UpdateIdentity(identity api.PeerIdentityType) error
+
 	// UpdateLedgerHeight updates the ledger height the peer
 	// publishes to other peers in the channel
 	UpdateLedgerHeight(height uint64, chainID common.ChainID)
@@ -134,4 +141,14 @@ type Config struct {
 	InternalEndpoint         string        // Endpoint we publish to peers in our organization
 	ExternalEndpoint         string        // Peer publishes this endpoint instead of SelfEndpoint to foreign organizations
 	TimeForMembershipTracker time.Duration // Determines time for polling with membershipTracker
+
+	// StaticTopology holds the peers of each channel. If it isn't nil, peers aren't
+	// discovered dynamically, and the peer communicates only with these peers
+	StaticTopology map[string][]StaticPeer
+}
+
+// StaticPeer defines a peer of a static topology
+type StaticPeer struct {
+	Endpoint string // Endpoint of the peer
+	MSPID    string // MSP ID of the organization of the peer
 }
@@ -15,6 +15,8 @@ import (
 	"time"
 
 	protoG "github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/comm"
 	"github.com/hyperledger/fabric/gossip/common"
@@ -61,12 +63,13 @@ type gossipServiceImpl struct {
 	mcs               api.MessageCryptoService
 	stateInfoMsgStore msgstore.MessageStore
 	certPuller        pull.Mediator
+	staticTopology    *staticTopology
 }
 
 // NewGossipService creates a gossip instance attached to a gRPC server
 func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 	mcs api.MessageCryptoService, selfIdentity api.PeerIdentityType,
-	secureDialOpts api.PeerSecureDialOpts) Gossip {
+	secureDialOpts api.PeerSecureDialOpts, metricsProvider metrics.Provider) Gossip {
 	var err error
 
 	lgr := util.GetLogger(util.GossipLogger, conf.ID)
@@ -91,12 +94,17 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 	g.idMapper = identity.NewIdentityMapper(mcs, selfIdentity, func(pkiID common.PKIidType, identity api.PeerIdentityType) {
 		g.comm.CloseConn(&comm.RemotePeer{PKIID: pkiID})
 		g.certPuller.Remove(string(pkiID))
+		if g.staticTopology != nil {
+			// Let the endpoint of the peer be resolved to its new identity
+			g.staticTopology.unresolve(pkiID)
+		}
 	}, sa)
 
+	commMetrics := comm.NewCommMetrics(metricsProvider)
 	if s == nil {
-		g.comm, err = createCommWithServer(conf.BindPort, g.idMapper, selfIdentity, secureDialOpts, sa)
+		g.comm, err = createCommWithServer(conf.BindPort, g.idMapper, selfIdentity, secureDialOpts, sa, commMetrics)
 	} else {
-		g.comm, err = createCommWithoutServer(s, conf.TLSCerts, g.idMapper, selfIdentity, secureDialOpts, sa)
+		g.comm, err = createCommWithoutServer(s, conf.TLSCerts, g.idMapper, selfIdentity, secureDialOpts, sa, commMetrics)
 	}
 
 	if err != nil {
@@ -104,6 +112,13 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 		return nil
 	}
 
+	var memFilter discovery.MembershipFilter
+	if conf.StaticTopology != nil {
+		g.staticTopology = newStaticTopology(conf.StaticTopology, sa.OrgByPeerIdentity)
+		g.comm.SetConnectionFilter(g.staticTopology.permitConnection)
+		memFilter = g.staticTopology.isPermitted
+	}
+
 	g.chanState = newChannelState(g)
 	g.emitter = newBatchingEmitter(conf.PropagateIterations,
 		conf.MaxPropagationBurstSize, conf.MaxPropagationBurstLatency,
@@ -111,7 +126,7 @@ func NewGossipService(conf *Config, s *grpc.Server, sa api.SecurityAdvisor,
 
 	g.discAdapter = g.newDiscoveryAdapter()
 	g.disSecAdap = g.newDiscoverySecurityAdapter()
-	g.disc = discovery.NewDiscoveryService(g.selfNetworkMember(), g.discAdapter, g.disSecAdap, g.disclosurePolicy)
+	g.disc = discovery.NewDiscoveryService(g.selfNetworkMember(), g.discAdapter, g.disSecAdap, g.disclosurePolicy, memFilter)
 	g.logger.Infof("Creating gossip service with self membership of %s", g.selfNetworkMember())
 
 	g.certPuller = g.createCertStorePuller()
@@ -161,19 +176,20 @@ func newChannelState(g *gossipServiceImpl) *channelState {
 }
 
 func createCommWithoutServer(s *grpc.Server, certs *common.TLSCertificates, idStore identity.Mapper,
-	identity api.PeerIdentityType, secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor) (comm.Comm, error) {
-	return comm.NewCommInstance(s, certs, idStore, identity, secureDialOpts, sa)
+	identity api.PeerIdentityType, secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor,
+	commMetrics *comm.CommMetrics) (comm.Comm, error) {
+	return comm.NewCommInstance(s, certs, idStore, identity, secureDialOpts, sa, commMetrics)
 }
 
 // NewGossipServiceWithServer creates a new gossip instance with a gRPC server
 func NewGossipServiceWithServer(conf *Config, secAdvisor api.SecurityAdvisor, mcs api.MessageCryptoService,
 	identity api.PeerIdentityType, secureDialOpts api.PeerSecureDialOpts) Gossip {
-	return NewGossipService(conf, nil, secAdvisor, mcs, identity, secureDialOpts)
+	return NewGossipService(conf, nil, secAdvisor, mcs, identity, secureDialOpts, &disabled.Provider{})
 }
 
 func createCommWithServer(port int, idStore identity.Mapper, identity api.PeerIdentityType,
-	secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor) (comm.Comm, error) {
-	return comm.NewCommInstanceWithServer(port, idStore, identity, secureDialOpts, sa)
+	secureDialOpts api.PeerSecureDialOpts, sa api.SecurityAdvisor, commMetrics *comm.CommMetrics) (comm.Comm, error) {
+	return comm.NewCommInstanceWithServer(port, idStore, identity, secureDialOpts, sa, commMetrics)
 }
 
 func (g *gossipServiceImpl) toDie() bool {
@@ -218,6 +234,10 @@ func (g *gossipServiceImpl) periodicalIdentityValidation(suspectFunc api.PeerSus
 }
 
 func (g *gossipServiceImpl) learnAnchorPeers(channel string, orgOfAnchorPeers api.OrgIdentityType, anchorPeers []api.AnchorPeer) {
+	if g.staticTopology != nil {
+		g.logger.Info("Static topology is used, skipping connecting to the anchor peers of", string(orgOfAnchorPeers), "for channel", channel)
+		return
+	}
 	if len(anchorPeers) == 0 {
 		g.logger.Info("No configured anchor peers of", string(orgOfAnchorPeers), "for channel", channel, "to learn about")
 		return
@@ -368,6 +388,12 @@ func (g *gossipServiceImpl) handleMessage(m proto.ReceivedMessage) {
 				g.logger.Debug("No such channel", msg.Channel, "discarding message", msg)
 			}
 		} else {
+			// The channel is looked up rather than taken from the message,
+			// since StateInfo messages carry only the MAC of their channel
+			if chainID := g.chanState.chainIDOf(gc); g.staticTopology != nil && !g.staticTopology.inChannel(chainID, m.GetConnectionInfo().ID) {
+				g.logger.Warning("Peer", m.GetConnectionInfo().ID, "isn't a configured peer of channel", string(chainID), "discarding message", msg)
+				return
+			}
 			if m.GetGossipMessage().IsLeadershipMsg() {
 				if err := g.validateLeadershipMessage(m.GetGossipMessage()); err != nil {
 					g.logger.Warningf("Failed validating LeaderElection message: %+v", errors.WithStack(err))
@@ -578,6 +604,9 @@ func (g *gossipServiceImpl) gossipInChan(messages []*emittedGossipMessage, chanR
 		// Select the peers to send the messages to
 		// For leadership messages we will select all peers that pass routing factory - e.g. all peers in channel and org
 		membership := g.disc.GetMembership()
+		if g.staticTopology != nil {
+			membership = g.staticTopology.filterChannelMembers(channel, membership)
+		}
 		var peers2Send []*comm.RemotePeer
 		if messagesOfChannel[0].IsLeadershipMsg() {
 			peers2Send = filter.SelectPeers(len(membership), membership, chanRoutingFactory(gc))
@@ -715,6 +744,11 @@ func (g *gossipServiceImpl) Peers() []discovery.NetworkMember {
 	return g.disc.GetMembership()
 }
 
+// DeadPeers returns the NetworkMembers considered dead
+func (g *gossipServiceImpl) DeadPeers() []discovery.NetworkMember {
+	return g.disc.GetDeadMembership()
+}
+
 // PeersOfChannel returns the NetworkMembers considered alive
 // and also subscribed to the channel given
 func (g *gossipServiceImpl) PeersOfChannel(channel common.ChainID) []discovery.NetworkMember {
@@ -774,6 +808,27 @@ func (g *gossipServiceImpl) UpdateMetadata(md []byte) {
 	g.disc.UpdateMetadata(md)
 }
 
+// UpdateIdentity replaces the identity of the peer, and propagates it to
+// remote peers through alive messages, identity messages and state info messages
+func (g *gossipServiceImpl) UpdateIdentity(identity api.PeerIdentityType) error {
+	if org := g.secAdvisor.OrgByPeerIdentity(identity); !bytes.Equal(org, g.selfOrg) {
+		return errors.Errorf("identity is of organization %s, but peer is of organization %s", string(org), string(g.selfOrg))
+	}
+	if err := g.idMapper.UpdateSelf(identity); err != nil {
+		return errors.Wrap(err, "failed associating identity to its PKI-ID")
+	}
+	if err := g.certStore.updateSelfIdentity(identity); err != nil {
+		return errors.Wrap(err, "failed creating identity message")
+	}
+	pkiID := g.idMapper.GetPKIidOfCert(identity)
+	g.disSecAdap.updateIdentity(identity, g.conf.PublishCertPeriod)
+	g.disc.UpdatePKIid(pkiID)
+	g.chanState.updatePKIid(pkiID)
+	g.comm.UpdateIdentity(identity)
+	g.logger.Infof("Updated identity, new PKI-ID is %s", pkiID)
+	return nil
+}
+
 // UpdateLedgerHeight updates the ledger height the peer
 // publishes to other peers in the channel
 func (g *gossipServiceImpl) UpdateLedgerHeight(height uint64, chainID common.ChainID) {
@@ -976,6 +1031,7 @@ func (da *discoveryAdapter) CloseConn(peer *discovery.NetworkMember) {
 }
 
 type discoverySecurityAdapter struct {
+	lock                  sync.RWMutex
 	identity              api.PeerIdentityType
 	includeIdentityPeriod time.Time
 	idMapper              identity.Mapper
@@ -1036,9 +1092,11 @@ func (sa *discoverySecurityAdapter) SignMessage(m *proto.GossipMessage, internal
 	signer := func(msg []byte) ([]byte, error) {
 		return sa.mcs.Sign(msg)
 	}
+	sa.lock.RLock()
 	if m.IsAliveMsg() && time.Now().Before(sa.includeIdentityPeriod) {
 		m.GetAliveMsg().Identity = sa.identity
 	}
+	sa.lock.RUnlock()
 	sMsg := &proto.SignedGossipMessage{
 		GossipMessage: m,
 	}
@@ -1059,6 +1117,15 @@ func (sa *discoverySecurityAdapter) SignMessage(m *proto.GossipMessage, internal
 	return e
 }
 
+// updateIdentity replaces the identity included in AliveMessages,
+// and includes it in them for the given period of time
+func (sa *discoverySecurityAdapter) updateIdentity(identity api.PeerIdentityType, includePeriod time.Duration) {
+	sa.lock.Lock()
+	defer sa.lock.Unlock()
+	sa.identity = identity
+	sa.includeIdentityPeriod = time.Now().Add(includePeriod)
+}
+
 func (sa *discoverySecurityAdapter) validateAliveMsgSignature(m *proto.SignedGossipMessage, identity api.PeerIdentityType) bool {
 	am := m.GetAliveMsg()
 	// At this point we got the certificate of the peer, proceed to verifying the AliveMessage
@@ -1148,6 +1215,13 @@ func (g *gossipServiceImpl) sameOrgOrOurOrgPullFilter(msg proto.ReceivedMessage)
 }
 
 func (g *gossipServiceImpl) connect2BootstrapPeers() {
+	if g.staticTopology != nil {
+		if len(g.conf.BootstrapPeers) != 0 {
+			g.logger.Warning("Static topology is used, ignoring bootstrap peers", g.conf.BootstrapPeers)
+		}
+		g.connect2StaticPeers()
+		return
+	}
 	for _, endpoint := range g.conf.BootstrapPeers {
 		endpoint := endpoint
 		identifier := func() (*discovery.PeerIdentification, error) {
@@ -1173,6 +1247,35 @@ func (g *gossipServiceImpl) connect2BootstrapPeers() {
 
 }
 
+// connect2StaticPeers connects to the configured peers of all the channels, and resolves
+// the PKI-IDs of their endpoints by handshaking with them. A peer whose identity isn't of
+// the configured organization, or whose endpoint is already resolved to another peer,
+// isn't connected to
+func (g *gossipServiceImpl) connect2StaticPeers() {
+	for _, endpoint := range g.staticTopology.endpoints() {
+		endpoint := endpoint
+		identifier := func() (*discovery.PeerIdentification, error) {
+			remotePeerIdentity, err := g.comm.Handshake(&comm.RemotePeer{Endpoint: endpoint})
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			pkiID := g.mcs.GetPKIidOfCert(remotePeerIdentity)
+			if len(pkiID) == 0 {
+				return nil, errors.Errorf("Wasn't able to extract PKI-ID of remote peer with identity of %v", remotePeerIdentity)
+			}
+			if err := g.staticTopology.resolve(endpoint, pkiID, remotePeerIdentity); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			sameOrg := bytes.Equal(g.selfOrg, g.secAdvisor.OrgByPeerIdentity(remotePeerIdentity))
+			return &discovery.PeerIdentification{ID: pkiID, SelfOrg: sameOrg}, nil
+		}
+		g.disc.Connect(discovery.NetworkMember{
+			InternalEndpoint: endpoint,
+			Endpoint:         endpoint,
+		}, identifier)
+	}
+}
+
 func (g *gossipServiceImpl) hasExternalEndpoint(PKIID common.PKIidType) bool {
 	if nm := g.disc.Lookup(PKIID); nm != nil {
 		return nm.Endpoint != ""
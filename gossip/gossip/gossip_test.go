@@ -44,6 +44,7 @@ var tests = []func(t *testing.T){
 	TestMembershipRequestSpoofing,
 	TestDataLeakage,
 	TestLeaveChannel,
+	TestUpdateIdentity,
 	//TestDisseminateAll2All: {},
 	TestIdentityExpiration,
 	TestSendByCriteria,
@@ -52,6 +53,7 @@ var tests = []func(t *testing.T){
 	TestAnchorPeer,
 	TestBootstrapPeerMisConfiguration,
 	TestNoMessagesSelfLoop,
+	TestStaticTopology,
 }
 
 func init() {
@@ -325,6 +327,58 @@ func TestLeaveChannel(t *testing.T) {
 
 }
 
+func TestUpdateIdentity(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	portPrefix := 14610
+	// Scenario: Have 3 peers in a channel and make one of them replace its identity.
+	// Ensure the other peers learn about the new identity, and the PKI-ID derived from it,
+	// both in the membership and in the channel.
+
+	p0 := newGossipInstance(portPrefix, 0, 100, 2)
+	p0.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p0.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p0.Stop()
+
+	p1 := newGossipInstance(portPrefix, 1, 100, 0)
+	p1.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p1.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p1.Stop()
+
+	p2 := newGossipInstance(portPrefix, 2, 100, 1)
+	p2.JoinChan(&joinChanMsg{}, common.ChainID("A"))
+	p2.UpdateLedgerHeight(1, common.ChainID("A"))
+	defer p2.Stop()
+
+	newIdentity := api.PeerIdentityType(fmt.Sprintf("localhost:%d-renewed", portPrefix+2))
+
+	knowsPKIid := func(g Gossip, pkiID common.PKIidType) func() bool {
+		return func() bool {
+			for _, member := range g.PeersOfChannel(common.ChainID("A")) {
+				if bytes.Equal(member.PKIid, pkiID) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	oldPKIid := p2.SelfMembershipInfo().PKIid
+	waitUntilOrFail(t, knowsPKIid(p0, oldPKIid))
+	waitUntilOrFail(t, knowsPKIid(p1, oldPKIid))
+
+	assert.NoError(t, p2.UpdateIdentity(newIdentity))
+	newPKIid := common.PKIidType(newIdentity)
+	assert.Equal(t, newPKIid, p2.SelfMembershipInfo().PKIid)
+	assert.Equal(t, newPKIid, common.PKIidType(p2.SelfChannelInfo(common.ChainID("A")).GetStateInfo().PkiId))
+
+	waitUntilOrFail(t, knowsPKIid(p0, newPKIid))
+	waitUntilOrFail(t, knowsPKIid(p1, newPKIid))
+	identity, err := p0.(*gossipServiceImpl).idMapper.Get(newPKIid)
+	assert.NoError(t, err)
+	assert.Equal(t, newIdentity, identity)
+}
+
 func TestPull(t *testing.T) {
 	t.Parallel()
 	defer testWG.Done()
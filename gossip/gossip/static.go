@@ -0,0 +1,203 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gossip
+
+import (
+	"bytes"
+	"crypto/x509"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/pkg/errors"
+)
+
+// staticTopology keeps track of the statically configured peers of each channel,
+// and of the PKI-IDs their endpoints were resolved to, either by handshaking with
+// them or by authenticating connections with them
+type staticTopology struct {
+	lock     sync.RWMutex
+	channels map[string][]string         // endpoints of the peers of each channel
+	mspIDs   map[string]string           // MSP IDs of the organizations of the peers of the endpoints
+	pkiIDs   map[string]common.PKIidType // PKI-IDs the endpoints were resolved to
+	orgOf    func(identity api.PeerIdentityType) api.OrgIdentityType
+}
+
+func newStaticTopology(peers map[string][]StaticPeer, orgOf func(identity api.PeerIdentityType) api.OrgIdentityType) *staticTopology {
+	st := &staticTopology{
+		channels: make(map[string][]string),
+		mspIDs:   make(map[string]string),
+		pkiIDs:   make(map[string]common.PKIidType),
+		orgOf:    orgOf,
+	}
+	for channel, peersOfChannel := range peers {
+		for _, peer := range peersOfChannel {
+			st.channels[channel] = append(st.channels[channel], peer.Endpoint)
+			st.mspIDs[peer.Endpoint] = peer.MSPID
+		}
+	}
+	return st
+}
+
+// endpoints returns the distinct endpoints of the peers of all the channels
+func (st *staticTopology) endpoints() []string {
+	set := make(map[string]struct{})
+	for _, endpoints := range st.channels {
+		for _, endpoint := range endpoints {
+			set[endpoint] = struct{}{}
+		}
+	}
+	var endpoints []string
+	for endpoint := range set {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// endpointsOfCert returns the endpoints whose host the given TLS certificate is valid for
+func (st *staticTopology) endpointsOfCert(cert *x509.Certificate) []string {
+	if cert == nil {
+		return nil
+	}
+	var res []string
+	for _, endpoint := range st.endpoints() {
+		host, _, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			continue
+		}
+		if cert.VerifyHostname(host) == nil {
+			res = append(res, endpoint)
+		}
+	}
+	return res
+}
+
+// isOfConfiguredOrg returns whether the given identity belongs to
+// the organization configured for the peer of the given endpoint
+func (st *staticTopology) isOfConfiguredOrg(endpoint string, identity api.PeerIdentityType) bool {
+	mspID, exists := st.mspIDs[endpoint]
+	return exists && mspID != "" && mspID == string(st.orgOf(identity))
+}
+
+// resolve records the PKI-ID of the peer of the given endpoint. It fails if the identity
+// of the peer doesn't belong to the organization configured for the endpoint, or if the
+// endpoint is already resolved to another PKI-ID, so that a peer can't take over the
+// endpoint of another peer. An endpoint is resolved again only after the identity of
+// its peer is purged, e.g. when it expires.
+func (st *staticTopology) resolve(endpoint string, pkiID common.PKIidType, identity api.PeerIdentityType) error {
+	mspID, exists := st.mspIDs[endpoint]
+	if !exists {
+		return errors.Errorf("%s isn't a configured endpoint", endpoint)
+	}
+	if !st.isOfConfiguredOrg(endpoint, identity) {
+		return errors.Errorf("peer of %s isn't of organization %s", endpoint, mspID)
+	}
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	if resolved, exists := st.pkiIDs[endpoint]; exists && !bytes.Equal(resolved, pkiID) {
+		return errors.Errorf("%s is already resolved to another peer", endpoint)
+	}
+	st.pkiIDs[endpoint] = pkiID
+	return nil
+}
+
+// unresolve forgets the endpoints which were resolved to the given PKI-ID
+func (st *staticTopology) unresolve(pkiID common.PKIidType) {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	for endpoint, resolved := range st.pkiIDs {
+		if bytes.Equal(resolved, pkiID) {
+			delete(st.pkiIDs, endpoint)
+		}
+	}
+}
+
+// isResolved returns whether the given endpoint is resolved to a PKI-ID
+func (st *staticTopology) isResolved(endpoint string) bool {
+	st.lock.RLock()
+	defer st.lock.RUnlock()
+	_, exists := st.pkiIDs[endpoint]
+	return exists
+}
+
+// permitConnection returns whether connections with the remote peer of the given
+// connection info are permitted. Besides the peers which were already resolved, a peer
+// whose TLS certificate is valid for the host of an unresolved endpoint, and whose identity
+// belongs to the organization configured for it, is permitted even before we handshake
+// with it, and the endpoint is resolved to its PKI-ID. If several such endpoints share
+// the host, the peer is permitted but the endpoints are left to be resolved by
+// handshaking with them.
+func (st *staticTopology) permitConnection(connInfo *proto.ConnectionInfo, tlsCert *x509.Certificate) bool {
+	if st.isPermitted(connInfo.ID) {
+		return true
+	}
+	var endpoints []string
+	for _, endpoint := range st.endpointsOfCert(tlsCert) {
+		if !st.isResolved(endpoint) && st.isOfConfiguredOrg(endpoint, connInfo.Identity) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 1 {
+		return st.resolve(endpoints[0], connInfo.ID, connInfo.Identity) == nil
+	}
+	return len(endpoints) != 0
+}
+
+// isPermitted returns whether the peer of the given PKI-ID
+// is one of the configured peers of any of the channels
+func (st *staticTopology) isPermitted(pkiID common.PKIidType) bool {
+	st.lock.RLock()
+	defer st.lock.RUnlock()
+	for _, resolved := range st.pkiIDs {
+		if bytes.Equal(resolved, pkiID) {
+			return true
+		}
+	}
+	return false
+}
+
+// inChannel returns whether the peer of the given PKI-ID
+// is one of the configured peers of the given channel
+func (st *staticTopology) inChannel(chainID common.ChainID, pkiID common.PKIidType) bool {
+	st.lock.RLock()
+	defer st.lock.RUnlock()
+	for _, endpoint := range st.channels[string(chainID)] {
+		if resolved, exists := st.pkiIDs[endpoint]; exists && bytes.Equal(resolved, pkiID) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterChannelMembers returns the members which are configured peers of the given channel
+func (st *staticTopology) filterChannelMembers(chainID common.ChainID, members []discovery.NetworkMember) []discovery.NetworkMember {
+	var res []discovery.NetworkMember
+	for _, member := range members {
+		if st.inChannel(chainID, member.PKIid) {
+			res = append(res, member)
+		}
+	}
+	return res
+}
+
+// staticChannelDiscovery restricts the membership of
+// a channel to the configured peers of the channel
+type staticChannelDiscovery struct {
+	discovery.Discovery
+	chainID  common.ChainID
+	topology *staticTopology
+}
+
+// GetMembership returns the alive members which are configured peers of the channel
+func (scd *staticChannelDiscovery) GetMembership() []discovery.NetworkMember {
+	return scd.topology.filterChannelMembers(scd.chainID, scd.Discovery.GetMembership())
+}
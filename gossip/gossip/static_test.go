@@ -0,0 +1,216 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gossip
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/gossip/api"
+	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+// orgOfTestIdentity returns the organization of identities of the form org/peer
+func orgOfTestIdentity(identity api.PeerIdentityType) api.OrgIdentityType {
+	return api.OrgIdentityType(strings.Split(string(identity), "/")[0])
+}
+
+func TestStaticTopologyMembership(t *testing.T) {
+	st := newStaticTopology(map[string][]StaticPeer{
+		"A": {{Endpoint: "p0:7051", MSPID: "OrgA"}, {Endpoint: "p1:7051", MSPID: "OrgA"}},
+		"B": {{Endpoint: "p1:7051", MSPID: "OrgA"}, {Endpoint: "p2:7051", MSPID: "OrgB"}},
+	}, orgOfTestIdentity)
+	assert.Equal(t, []string{"p0:7051", "p1:7051", "p2:7051"}, st.endpoints())
+
+	// Nothing is permitted until the endpoints are resolved
+	assert.False(t, st.isPermitted(common.PKIidType("p0")))
+	assert.False(t, st.inChannel(common.ChainID("A"), common.PKIidType("p0")))
+
+	assert.NoError(t, st.resolve("p0:7051", common.PKIidType("p0"), api.PeerIdentityType("OrgA/p0")))
+	assert.NoError(t, st.resolve("p2:7051", common.PKIidType("p2"), api.PeerIdentityType("OrgB/p2")))
+	assert.True(t, st.isPermitted(common.PKIidType("p0")))
+	assert.True(t, st.isPermitted(common.PKIidType("p2")))
+	assert.False(t, st.isPermitted(common.PKIidType("p1")))
+	assert.False(t, st.isPermitted(common.PKIidType("p3")))
+	assert.True(t, st.inChannel(common.ChainID("A"), common.PKIidType("p0")))
+	assert.False(t, st.inChannel(common.ChainID("A"), common.PKIidType("p2")))
+	assert.True(t, st.inChannel(common.ChainID("B"), common.PKIidType("p2")))
+	assert.False(t, st.inChannel(common.ChainID("C"), common.PKIidType("p0")))
+
+	members := []discovery.NetworkMember{{PKIid: common.PKIidType("p0")}, {PKIid: common.PKIidType("p2")}, {PKIid: common.PKIidType("p3")}}
+	assert.Equal(t, []discovery.NetworkMember{{PKIid: common.PKIidType("p0")}}, st.filterChannelMembers(common.ChainID("A"), members))
+}
+
+func TestStaticTopologyResolve(t *testing.T) {
+	st := newStaticTopology(map[string][]StaticPeer{
+		"A": {{Endpoint: "p0:7051", MSPID: "OrgA"}, {Endpoint: "p1:7051", MSPID: "OrgB"}},
+	}, orgOfTestIdentity)
+
+	// An endpoint isn't resolved to a peer of another organization, nor is an unknown endpoint
+	assert.EqualError(t, st.resolve("p0:7051", common.PKIidType("p0"), api.PeerIdentityType("OrgB/p0")), "peer of p0:7051 isn't of organization OrgA")
+	assert.EqualError(t, st.resolve("p3:7051", common.PKIidType("p3"), api.PeerIdentityType("OrgA/p3")), "p3:7051 isn't a configured endpoint")
+	assert.False(t, st.isPermitted(common.PKIidType("p0")))
+	assert.False(t, st.isPermitted(common.PKIidType("p3")))
+
+	// An endpoint already resolved to a peer isn't resolved to another peer
+	assert.NoError(t, st.resolve("p0:7051", common.PKIidType("p0"), api.PeerIdentityType("OrgA/p0")))
+	assert.NoError(t, st.resolve("p0:7051", common.PKIidType("p0"), api.PeerIdentityType("OrgA/p0")))
+	assert.EqualError(t, st.resolve("p0:7051", common.PKIidType("p0'"), api.PeerIdentityType("OrgA/p0'")), "p0:7051 is already resolved to another peer")
+	assert.True(t, st.isPermitted(common.PKIidType("p0")))
+	assert.False(t, st.isPermitted(common.PKIidType("p0'")))
+
+	// Once the identity of the peer is purged, the endpoint is resolved to the new identity of the peer
+	st.unresolve(common.PKIidType("p0"))
+	assert.False(t, st.isPermitted(common.PKIidType("p0")))
+	assert.NoError(t, st.resolve("p0:7051", common.PKIidType("p0'"), api.PeerIdentityType("OrgA/p0'")))
+	assert.True(t, st.inChannel(common.ChainID("A"), common.PKIidType("p0'")))
+}
+
+func TestStaticTopologyPermitConnection(t *testing.T) {
+	st := newStaticTopology(map[string][]StaticPeer{
+		"A": {
+			{Endpoint: "p0:7051", MSPID: "OrgA"},
+			{Endpoint: "p1:7051", MSPID: "OrgA"},
+			{Endpoint: "p1:8051", MSPID: "OrgA"},
+			{Endpoint: "p2:7051", MSPID: "OrgA"},
+		},
+	}, orgOfTestIdentity)
+	connInfo := func(pkiID, identity string) *proto.ConnectionInfo {
+		return &proto.ConnectionInfo{ID: common.PKIidType(pkiID), Identity: api.PeerIdentityType(identity)}
+	}
+	p0Cert := &x509.Certificate{DNSNames: []string{"p0"}}
+	p1Cert := &x509.Certificate{DNSNames: []string{"p1"}}
+	p2Cert := &x509.Certificate{DNSNames: []string{"p2"}}
+	p3Cert := &x509.Certificate{DNSNames: []string{"p3"}}
+
+	// Without TLS, only peers which were resolved by handshaking with them are permitted
+	assert.False(t, st.permitConnection(connInfo("p0", "OrgA/p0"), nil))
+	assert.NoError(t, st.resolve("p0:7051", common.PKIidType("p0"), api.PeerIdentityType("OrgA/p0")))
+	assert.True(t, st.permitConnection(connInfo("p0", "OrgA/p0"), nil))
+
+	// A peer whose TLS certificate is valid for an endpoint that is already
+	// resolved to another peer isn't permitted, and doesn't take the endpoint over
+	assert.False(t, st.permitConnection(connInfo("p0'", "OrgA/p0'"), p0Cert))
+	assert.True(t, st.inChannel(common.ChainID("A"), common.PKIidType("p0")))
+	assert.False(t, st.isPermitted(common.PKIidType("p0'")))
+
+	// A peer of another organization whose TLS certificate is valid for
+	// an unresolved endpoint isn't permitted, and doesn't resolve the endpoint
+	assert.False(t, st.permitConnection(connInfo("p2'", "OrgB/p2'"), p2Cert))
+	assert.False(t, st.isPermitted(common.PKIidType("p2'")))
+
+	// A peer of the configured organization whose TLS certificate is valid
+	// for an unresolved endpoint is permitted, and the endpoint is resolved
+	assert.True(t, st.permitConnection(connInfo("p2", "OrgA/p2"), p2Cert))
+	assert.True(t, st.inChannel(common.ChainID("A"), common.PKIidType("p2")))
+
+	// A peer whose TLS certificate isn't valid for any configured endpoint isn't permitted
+	assert.False(t, st.permitConnection(connInfo("p3", "OrgA/p3"), p3Cert))
+
+	// A peer whose host has several configured endpoints is permitted,
+	// but none of the endpoints is resolved
+	assert.True(t, st.permitConnection(connInfo("p1", "OrgA/p1"), p1Cert))
+	assert.False(t, st.isPermitted(common.PKIidType("p1")))
+}
+
+func newStaticGossipInstance(portPrefix int, id int, topology map[string][]StaticPeer) Gossip {
+	port := id + portPrefix
+	conf := &Config{
+		BindPort:                   port,
+		ID:                         fmt.Sprintf("p%d", id),
+		MaxBlockCountToStore:       100,
+		MaxPropagationBurstLatency: time.Duration(500) * time.Millisecond,
+		MaxPropagationBurstSize:    20,
+		PropagateIterations:        1,
+		PropagatePeerNum:           3,
+		PullInterval:               time.Duration(4) * time.Second,
+		PullPeerNum:                5,
+		InternalEndpoint:           fmt.Sprintf("localhost:%d", port),
+		ExternalEndpoint:           fmt.Sprintf("1.2.3.4:%d", port),
+		PublishCertPeriod:          time.Duration(4) * time.Second,
+		PublishStateInfoInterval:   time.Duration(1) * time.Second,
+		RequestStateInfoInterval:   time.Duration(1) * time.Second,
+		TimeForMembershipTracker:   5 * time.Second,
+		StaticTopology:             topology,
+	}
+	selfID := api.PeerIdentityType(conf.InternalEndpoint)
+	return NewGossipServiceWithServer(conf, &orgCryptoService{}, &naiveCryptoService{}, selfID, nil)
+}
+
+func TestStaticTopology(t *testing.T) {
+	t.Parallel()
+	defer testWG.Done()
+	portPrefix := 9610
+	// Scenario: p0 and p1 use a static topology in which they are the peers of channel A.
+	// p2 uses dynamic discovery with p0 as its bootstrap peer, and joins channel A as well.
+	// Ensure p0 and p1 know only about each other and disseminate messages only among them,
+	// and that p2 doesn't learn about any of them.
+
+	topology := make(map[string][]StaticPeer)
+	for _, endpoint := range bootPeers(portPrefix, 0, 1) {
+		topology["A"] = append(topology["A"], StaticPeer{Endpoint: endpoint, MSPID: string(orgInChannelA)})
+	}
+	p0 := newStaticGossipInstance(portPrefix, 0, topology)
+	defer p0.Stop()
+	// Start p1 a bit later, so the peers don't keep sending their
+	// membership requests to each other at the very same time
+	time.Sleep(time.Millisecond * 1500)
+	p1 := newStaticGossipInstance(portPrefix, 1, topology)
+	defer p1.Stop()
+	p2 := newGossipInstance(portPrefix, 2, 100, 0)
+	defer p2.Stop()
+
+	// Anchor peers aren't used in a static topology
+	anchorPeers := &joinChanMsg{
+		members2AnchorPeers: map[string][]api.AnchorPeer{
+			string(orgInChannelA): {{Host: "localhost", Port: portPrefix + 2}},
+		},
+	}
+	for _, p := range []Gossip{p0, p1, p2} {
+		p.JoinChan(anchorPeers, common.ChainID("A"))
+		p.UpdateLedgerHeight(1, common.ChainID("A"))
+	}
+
+	waitUntilOrFail(t, checkPeersMembership(t, []Gossip{p0, p1}, 1))
+	waitUntilOrFail(t, func() bool {
+		return len(p0.PeersOfChannel(common.ChainID("A"))) == 1 && len(p1.PeersOfChannel(common.ChainID("A"))) == 1
+	})
+
+	acceptChan1, _ := p1.Accept(acceptData, false)
+	acceptChan2, _ := p2.Accept(acceptData, false)
+	p0.Gossip(createDataMsg(1, []byte{}, common.ChainID("A")))
+
+	select {
+	case msg := <-acceptChan1:
+		assert.Equal(t, uint64(1), msg.GetDataMsg().Payload.SeqNum)
+	case <-time.After(time.Second * 10):
+		assert.Fail(t, "p1 didn't receive the message within a timely manner")
+	}
+	select {
+	case msg := <-acceptChan2:
+		assert.Fail(t, "p2 shouldn't have received a message", "Got %v", msg)
+	case <-time.After(time.Second * 3):
+	}
+
+	assert.Equal(t, []string{"1.2.3.4:9611"}, endpointsOf(p0.Peers()))
+	assert.Equal(t, []string{"1.2.3.4:9610"}, endpointsOf(p1.Peers()))
+	assert.Empty(t, p2.Peers())
+}
+
+func endpointsOf(members []discovery.NetworkMember) []string {
+	var endpoints []string
+	for _, member := range members {
+		endpoints = append(endpoints, member.Endpoint)
+	}
+	return endpoints
+}
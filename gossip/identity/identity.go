@@ -50,6 +50,10 @@ type Mapper interface {
 	// IdentityInfo returns information known peer identities
 	IdentityInfo() api.PeerIdentitySet
 
+	// UpdateSelf associates the given identity to its pkiID, and makes it
+	// the identity of the peer, which is never purged from the Mapper
+	UpdateSelf(identity api.PeerIdentityType) error
+
 	// Stop stops all background computations of the Mapper
 	Stop()
 }
@@ -217,6 +221,19 @@ func (is *identityMapperImpl) validateIdentities(isSuspected api.PeerSuspector)
 	return revokedIdentities
 }
 
+// UpdateSelf associates the given identity to its pkiID, and makes it
+// the identity of the peer, which is never purged from the Mapper
+func (is *identityMapperImpl) UpdateSelf(identity api.PeerIdentityType) error {
+	pkiID := is.mcs.GetPKIidOfCert(identity)
+	if err := is.Put(pkiID, identity); err != nil {
+		return err
+	}
+	is.Lock()
+	defer is.Unlock()
+	is.selfPKIID = string(pkiID)
+	return nil
+}
+
 // IdentityInfo returns information known peer identities
 func (is *identityMapperImpl) IdentityInfo() api.PeerIdentitySet {
 	var res api.PeerIdentitySet
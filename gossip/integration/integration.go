@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/gossip"
@@ -55,13 +57,55 @@ func newConfig(selfEndpoint string, externalEndpoint string, certs *common.TLSCe
 		TimeForMembershipTracker:   util.GetDurationOrDefault("peer.gossip.membershipTrackerInterval", 5*time.Second),
 	}
 
+	if viper.GetBool("peer.gossip.staticTopology.enabled") {
+		conf.StaticTopology, err = staticTopology()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return conf, nil
 }
 
+// staticTopology returns the peers of each channel
+// according to the static topology configuration
+func staticTopology() (map[string][]gossip.StaticPeer, error) {
+	var channels []struct {
+		Name  string `mapstructure:"name"`
+		Peers []struct {
+			Endpoint string `mapstructure:"endpoint"`
+			MSPID    string `mapstructure:"mspid"`
+		} `mapstructure:"peers"`
+	}
+	if err := viperutil.EnhancedExactUnmarshalKey("peer.gossip.staticTopology.channels", &channels); err != nil {
+		return nil, errors.Wrap(err, "failed unmarshaling static topology")
+	}
+
+	topology := make(map[string][]gossip.StaticPeer)
+	mspIDs := make(map[string]string)
+	for _, channel := range channels {
+		if channel.Name == "" {
+			return nil, errors.New("static topology contains a channel without a name")
+		}
+		for _, peer := range channel.Peers {
+			if peer.Endpoint == "" || peer.MSPID == "" {
+				return nil, errors.Errorf("static topology of channel %s contains a peer without an endpoint or an MSP ID", channel.Name)
+			}
+			if mspID, exists := mspIDs[peer.Endpoint]; exists && mspID != peer.MSPID {
+				return nil, errors.Errorf("static topology contains peer %s with MSP IDs %s and %s", peer.Endpoint, mspID, peer.MSPID)
+			}
+			mspIDs[peer.Endpoint] = peer.MSPID
+			topology[channel.Name] = append(topology[channel.Name], gossip.StaticPeer{Endpoint: peer.Endpoint, MSPID: peer.MSPID})
+		}
+	}
+	return topology, nil
+}
+
 // NewGossipComponent creates a gossip component that attaches itself to the given gRPC server
 func NewGossipComponent(peerIdentity []byte, endpoint string, s *grpc.Server,
 	secAdv api.SecurityAdvisor, cryptSvc api.MessageCryptoService,
-	secureDialOpts api.PeerSecureDialOpts, certs *common.TLSCertificates, bootPeers ...string) (gossip.Gossip, error) {
+	secureDialOpts api.PeerSecureDialOpts, certs *common.TLSCertificates, metricsProvider metrics.Provider,
+	bootPeers ...string) (gossip.Gossip, error) {
 
 	externalEndpoint := viper.GetString("peer.gossip.externalEndpoint")
 
@@ -70,7 +114,7 @@ func NewGossipComponent(peerIdentity []byte, endpoint string, s *grpc.Server,
 		return nil, errors.WithStack(err)
 	}
 	gossipInstance := gossip.NewGossipService(conf, s, secAdv, cryptSvc,
-		peerIdentity, secureDialOpts)
+		peerIdentity, secureDialOpts, metricsProvider)
 
 	return gossipInstance, nil
 }
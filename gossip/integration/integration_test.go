@@ -13,9 +13,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/gossip/api"
 	"github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/gossip"
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/msp/mgmt/testtools"
@@ -53,13 +55,13 @@ func TestNewGossipCryptoService(t *testing.T) {
 	msptesttools.LoadMSPSetupForTesting()
 	peerIdentity, _ := mgmt.GetLocalSigningIdentityOrPanic().Serialize()
 	g1, err := NewGossipComponent(peerIdentity, endpoint1, s1, secAdv, cryptSvc,
-		defaultSecureDialOpts, nil)
+		defaultSecureDialOpts, nil, &disabled.Provider{})
 	assert.NoError(t, err)
 	g2, err := NewGossipComponent(peerIdentity, endpoint2, s2, secAdv, cryptSvc,
-		defaultSecureDialOpts, nil, endpoint1)
+		defaultSecureDialOpts, nil, &disabled.Provider{}, endpoint1)
 	assert.NoError(t, err)
 	g3, err := NewGossipComponent(peerIdentity, endpoint3, s3, secAdv, cryptSvc,
-		defaultSecureDialOpts, nil, endpoint1)
+		defaultSecureDialOpts, nil, &disabled.Provider{}, endpoint1)
 	assert.NoError(t, err)
 	defer g1.Stop()
 	defer g2.Stop()
@@ -118,3 +120,46 @@ func (s *cryptoService) VerifyByChannel(chainID common.ChainID, peerIdentity api
 func (s *cryptoService) ValidateIdentity(peerIdentity api.PeerIdentityType) error {
 	return nil
 }
+
+func TestStaticTopologyConfig(t *testing.T) {
+	setupTestEnv()
+	defer viper.Reset()
+
+	conf, err := newConfig("localhost:7051", "", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, conf.StaticTopology)
+
+	viper.Set("peer.gossip.staticTopology.enabled", true)
+	peer := func(endpoint, mspID string) map[string]interface{} {
+		return map[string]interface{}{"endpoint": endpoint, "mspid": mspID}
+	}
+	viper.Set("peer.gossip.staticTopology.channels", []interface{}{
+		map[string]interface{}{"name": "ch1", "peers": []interface{}{peer("peer0:7051", "Org1MSP"), peer("peer1:7051", "Org2MSP")}},
+		map[string]interface{}{"name": "ch2", "peers": []interface{}{peer("peer0:7051", "Org1MSP")}},
+	})
+	conf, err = newConfig("localhost:7051", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]gossip.StaticPeer{
+		"ch1": {{Endpoint: "peer0:7051", MSPID: "Org1MSP"}, {Endpoint: "peer1:7051", MSPID: "Org2MSP"}},
+		"ch2": {{Endpoint: "peer0:7051", MSPID: "Org1MSP"}},
+	}, conf.StaticTopology)
+
+	viper.Set("peer.gossip.staticTopology.channels", []interface{}{
+		map[string]interface{}{"peers": []interface{}{peer("peer0:7051", "Org1MSP")}},
+	})
+	_, err = newConfig("localhost:7051", "", nil)
+	assert.EqualError(t, err, "static topology contains a channel without a name")
+
+	viper.Set("peer.gossip.staticTopology.channels", []interface{}{
+		map[string]interface{}{"name": "ch1", "peers": []interface{}{map[string]interface{}{"endpoint": "peer0:7051"}}},
+	})
+	_, err = newConfig("localhost:7051", "", nil)
+	assert.EqualError(t, err, "static topology of channel ch1 contains a peer without an endpoint or an MSP ID")
+
+	viper.Set("peer.gossip.staticTopology.channels", []interface{}{
+		map[string]interface{}{"name": "ch1", "peers": []interface{}{peer("peer0:7051", "Org1MSP")}},
+		map[string]interface{}{"name": "ch2", "peers": []interface{}{peer("peer0:7051", "Org2MSP")}},
+	})
+	_, err = newConfig("localhost:7051", "", nil)
+	assert.EqualError(t, err, "static topology contains peer peer0:7051 with MSP IDs Org1MSP and Org2MSP")
+}
@@ -67,6 +67,10 @@ type TransientStore interface {
 	// after successful block commit, PurgeByHeight() is still required to remove orphan entries (as
 	// transaction that gets endorsed may not be submitted by the client for commit)
 	PurgeByHeight(maxBlockNumToRetain uint64) error
+
+	// PurgeKeys removes the writes of the given purged keys from the private write sets
+	// that were persisted at block height of maxBlockHeight or lower
+	PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error
 }
 
 // Coordinator orchestrates the flow of the new
@@ -242,6 +246,17 @@ func (c *coordinator) StoreBlock(block *common.Block, privateDataSets util.PvtDa
 		}
 	}
 
+	// The ledger updates the validation flags of the block upon commit, so that the purges of
+	// the transactions found invalid by the MVCC validation are discarded here
+	txsFilter := txValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	if purgedKeys := privateInfo.purgedKeys.ofValidTxns(txsFilter); len(purgedKeys) > 0 {
+		// Remove the private write sets that hold the purged keys and that were
+		// simulated prior to this block and are yet to be committed
+		if err := c.PurgeKeys(purgedKeys, block.Header.Number); err != nil {
+			logger.Error("Purging private data keys from transient store at block", block.Header.Number, "failed:", err)
+		}
+	}
+
 	seq := block.Header.Number
 	if seq%c.transientBlockRetention == 0 && seq > c.transientBlockRetention {
 		err := c.PurgeByHeight(seq - c.transientBlockRetention)
@@ -629,6 +644,21 @@ type privateDataInfo struct {
 	missingKeys             rwsetKeys
 	txns                    txns
 	missingRWSButIneligible []rwSetKey
+	purgedKeys              purgedKeysBySeqInBlock
+}
+
+// purgedKeysBySeqInBlock holds the keys purged by each transaction of a block
+type purgedKeysBySeqInBlock map[uint64][]*transientstore.PurgedKey
+
+// ofValidTxns returns the keys purged by the transactions that are valid as per the given validation flags
+func (p purgedKeysBySeqInBlock) ofValidTxns(txsFilter txValidationFlags) []*transientstore.PurgedKey {
+	var res []*transientstore.PurgedKey
+	for seqInBlock, purgedKeys := range p {
+		if int(seqInBlock) < len(txsFilter) && txsFilter[seqInBlock] == uint8(peer.TxValidationCode_VALID) {
+			res = append(res, purgedKeys...)
+		}
+	}
+	return res
 }
 
 // listMissingPrivateData identifies missing private write sets and attempts to retrieve them from local transient store
@@ -650,6 +680,7 @@ func (c *coordinator) listMissingPrivateData(block *common.Block, ownedRWsets ma
 		missingKeys:          missing,
 		ownedRWsets:          ownedRWsets,
 		privateRWsetsInBlock: privateRWsetsInBlock,
+		purgedKeys:           make(purgedKeysBySeqInBlock),
 		coordinator:          c,
 	}
 	txList, err := data.forEachTxn(txsFilter, bi.inspectTransaction)
@@ -662,6 +693,7 @@ func (c *coordinator) listMissingPrivateData(block *common.Block, ownedRWsets ma
 		missingKeysByTxIDs:      missing,
 		txns:                    txList,
 		missingRWSButIneligible: bi.missingRWSButIneligible,
+		purgedKeys:              bi.purgedKeys,
 	}
 
 	logger.Debug("Retrieving private write sets for", len(privateInfo.missingKeysByTxIDs), "transactions from transient store")
@@ -695,6 +727,7 @@ type transactionInspector struct {
 	sources                 map[rwSetKey][]*peer.Endorsement
 	ownedRWsets             map[rwSetKey][]byte
 	missingRWSButIneligible []rwSetKey
+	purgedKeys              purgedKeysBySeqInBlock
 }
 
 func (bi *transactionInspector) inspectTransaction(seqInBlock uint64, chdr *common.ChannelHeader, txRWSet *rwsetutil.TxRwSet, endorsers []*peer.Endorsement) error {
@@ -704,6 +737,16 @@ func (bi *transactionInspector) inspectTransaction(seqInBlock uint64, chdr *comm
 				continue
 			}
 
+			for _, hashedWrite := range hashedCollection.HashedRwSet.HashedWrites {
+				if hashedWrite.IsPurge {
+					bi.purgedKeys[seqInBlock] = append(bi.purgedKeys[seqInBlock], &transientstore.PurgedKey{
+						Namespace:  ns.NameSpace,
+						Collection: hashedCollection.CollectionName,
+						KeyHash:    hashedWrite.KeyHash,
+					})
+				}
+			}
+
 			// If an error occurred due to the unavailability of database, we should stop committing
 			// blocks for the associated chain. The policy can never be nil for a valid collection.
 			// For collections which were never defined, the policy would be nil and we can safely
@@ -128,6 +128,10 @@ func (store *mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error
 	return store.Called(maxBlockNumToRetain).Error(0)
 }
 
+func (store *mockTransientStore) PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error {
+	return store.Called(purgedKeys, maxBlockHeight).Error(0)
+}
+
 func (store *mockTransientStore) GetTxPvtRWSetByTxid(txid string, filter ledger.PvtNsCollFilter) (transientstore.RWSetScanner, error) {
 	store.lastReqTxID = txid
 	store.lastReqFilter = filter
@@ -346,6 +350,10 @@ func (cs *collectionStore) HasReadAccess(cc common.CollectionCriteria, sp *peer.
 	panic("implement me")
 }
 
+func (cs *collectionStore) HasWriteAccess(cc common.CollectionCriteria, sp *peer.SignedProposal, qe ledger.QueryExecutor) (bool, error) {
+	panic("implement me")
+}
+
 func (cs *collectionStore) RetrieveCollectionConfigPackage(cc common.CollectionCriteria) (*common.CollectionConfigPackage, error) {
 	return &common.CollectionConfigPackage{
 		Config: []*common.CollectionConfig{
@@ -391,6 +399,10 @@ func (cap *collectionAccessPolicy) IsMemberOnlyRead() bool {
 	return false
 }
 
+func (cap *collectionAccessPolicy) IsMemberOnlyWrite() bool {
+	return false
+}
+
 func (cap *collectionAccessPolicy) AccessFilter() privdata.Filter {
 	return func(sd common.SignedData) bool {
 		that, _ := asn1.Marshal(sd)
@@ -1419,3 +1431,51 @@ func TestIgnoreReadOnlyColRWSets(t *testing.T) {
 	assert.NoError(t, err)
 	assertCommitHappened()
 }
+
+func TestInspectTransactionCollectsPurgedKeys(t *testing.T) {
+	// Scenario: a transaction purges a private data key.
+	// The inspector should record the purged key so that it is removed from the transient store
+	// once the block is committed, and should ignore writes that are not purges. Only the keys
+	// purged by the transactions that are still valid once the block is committed are removed.
+	peerSelfSignedData := common.SignedData{}
+	cs := createcollectionStore(peerSelfSignedData).thatAcceptsAll()
+	c := NewCoordinator(Support{
+		CollectionStore: cs,
+		Committer:       &mocks.Committer{},
+		Fetcher:         &fetcherMock{t: t},
+		TransientStore:  &mockTransientStore{t: t},
+		Validator:       &validatorMock{},
+	}, peerSelfSignedData).(*coordinator)
+
+	collHashedRwSet := sampleCollHashedRwSet("c1", []byte("rws-hash"), true)
+	collHashedRwSet.HashedRwSet.HashedWrites = append(collHashedRwSet.HashedRwSet.HashedWrites,
+		&kvrwset.KVWriteHash{KeyHash: []byte("Key-5-hash"), IsDelete: true, IsPurge: true})
+	txRWSet := &rwsetutil.TxRwSet{
+		NsRwSets: []*rwsetutil.NsRwSet{
+			{
+				NameSpace:        "ns1",
+				KvRwSet:          sampleKvRwSet(),
+				CollHashedRwSets: []*rwsetutil.CollHashedRwSet{collHashedRwSet},
+			},
+		},
+	}
+
+	bi := &transactionInspector{
+		sources:              make(map[rwSetKey][]*peer.Endorsement),
+		missingKeys:          make(rwSetKeysByTxIDs),
+		ownedRWsets:          make(map[rwSetKey][]byte),
+		privateRWsetsInBlock: make(map[rwSetKey]struct{}),
+		purgedKeys:           make(purgedKeysBySeqInBlock),
+		coordinator:          c,
+	}
+	err := bi.inspectTransaction(1, &common.ChannelHeader{TxId: "tx1", ChannelId: "test"}, txRWSet, nil)
+	assert.NoError(t, err)
+	expectedPurgedKeys := []*transientstore.PurgedKey{
+		{Namespace: "ns1", Collection: "c1", KeyHash: []byte("Key-5-hash")},
+	}
+	assert.Equal(t, purgedKeysBySeqInBlock{1: expectedPurgedKeys}, bi.purgedKeys)
+
+	valid, mvccConflict := uint8(peer.TxValidationCode_VALID), uint8(peer.TxValidationCode_MVCC_READ_CONFLICT)
+	assert.Equal(t, expectedPurgedKeys, bi.purgedKeys.ofValidTxns(txValidationFlags{mvccConflict, valid}))
+	assert.Empty(t, bi.purgedKeys.ofValidTxns(txValidationFlags{valid, mvccConflict}))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/fabric/core/transientstore"
 	"github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/gossip/util"
+	fcommon "github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/pkg/errors"
@@ -47,13 +48,14 @@ type DataStore interface {
 }
 
 type dataRetriever struct {
-	store DataStore
+	store         DataStore
+	channelMSPIDs ChannelMSPIDs
 }
 
 // NewDataRetriever constructing function for implementation of the
 // StorageDataRetriever interface
-func NewDataRetriever(store DataStore) StorageDataRetriever {
-	return &dataRetriever{store: store}
+func NewDataRetriever(store DataStore, channelMSPIDs ChannelMSPIDs) StorageDataRetriever {
+	return &dataRetriever{store: store, channelMSPIDs: channelMSPIDs}
 }
 
 // CollectionRWSet retrieves for give digest relevant private data if
@@ -133,26 +135,9 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 			pvtRWSetWithConfig.RWSet = append(pvtRWSetWithConfig.RWSet, pvtRWSet...)
 		}
 
-		confHistoryRetriever, err := dr.store.GetConfigHistoryRetriever()
+		configs, err := dr.collectionConfig(dig)
 		if err != nil {
-			return nil, errors.Errorf("cannot obtain configuration history retriever, for collection <%s>"+
-				" txID <%s> block sequence number <%d> due to <%s>", dig.Collection, dig.TxId, dig.BlockSeq, err)
-		}
-
-		configInfo, err := confHistoryRetriever.MostRecentCollectionConfigBelow(dig.BlockSeq, dig.Namespace)
-		if err != nil {
-			return nil, errors.Errorf("cannot find recent collection config update below block sequence = %d,"+
-				" collection name = <%s> for chaincode <%s>", dig.BlockSeq, dig.Collection, dig.Namespace)
-		}
-
-		if configInfo == nil {
-			return nil, errors.Errorf("no collection config update below block sequence = <%d>"+
-				" collection name = <%s> for chaincode <%s> is available ", dig.BlockSeq, dig.Collection, dig.Namespace)
-		}
-		configs := extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
-		if configs == nil {
-			return nil, errors.Errorf("no collection config was found for collection <%s>"+
-				" namespace <%s> txID <%s>", dig.Collection, dig.Namespace, dig.TxId)
+			return nil, err
 		}
 		pvtRWSetWithConfig.CollectionConfig = configs
 		results[common.DigKey{
@@ -167,6 +152,41 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 	return results, nil
 }
 
+// collectionConfig returns the most recent configuration of the collection of the
+// given digest that was committed below the block of the digest
+func (dr *dataRetriever) collectionConfig(dig *gossip2.PvtDataDigest) (*fcommon.CollectionConfig, error) {
+	if configs, isImplicit := implicitCollectionConfig(dig.Collection, dr.channelMSPIDs); isImplicit {
+		if configs == nil {
+			return nil, errors.Errorf("no implicit collection <%s> exists for chaincode <%s> txID <%s>,"+
+				" as its org is not part of the channel", dig.Collection, dig.Namespace, dig.TxId)
+		}
+		return configs, nil
+	}
+
+	confHistoryRetriever, err := dr.store.GetConfigHistoryRetriever()
+	if err != nil {
+		return nil, errors.Errorf("cannot obtain configuration history retriever, for collection <%s>"+
+			" txID <%s> block sequence number <%d> due to <%s>", dig.Collection, dig.TxId, dig.BlockSeq, err)
+	}
+
+	configInfo, err := confHistoryRetriever.MostRecentCollectionConfigBelow(dig.BlockSeq, dig.Namespace)
+	if err != nil {
+		return nil, errors.Errorf("cannot find recent collection config update below block sequence = %d,"+
+			" collection name = <%s> for chaincode <%s>", dig.BlockSeq, dig.Collection, dig.Namespace)
+	}
+
+	if configInfo == nil {
+		return nil, errors.Errorf("no collection config update below block sequence = <%d>"+
+			" collection name = <%s> for chaincode <%s> is available ", dig.BlockSeq, dig.Collection, dig.Namespace)
+	}
+	configs := extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
+	if configs == nil {
+		return nil, errors.Errorf("no collection config was found for collection <%s>"+
+			" namespace <%s> txID <%s>", dig.Collection, dig.Namespace, dig.TxId)
+	}
+	return configs, nil
+}
+
 func (dr *dataRetriever) fromTransientStore(dig *gossip2.PvtDataDigest, filter map[string]ledger.PvtCollFilter) (*util.PrivateRWSetWithConfig, error) {
 	results := &util.PrivateRWSetWithConfig{}
 	it, err := dr.store.GetTxPvtRWSetByTxid(dig.TxId, filter)
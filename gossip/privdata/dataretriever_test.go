@@ -22,6 +22,11 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// channelMSPIDs returns the orgs of the channel the tests retrieve private data of
+var channelMSPIDs ChannelMSPIDs = func() []string {
+	return []string{"Org1MSP", "Org2MSP"}
+}
+
 /*
 	Test checks following scenario, it tries to obtain private data for
 	given block sequence which is greater than available ledger height,
@@ -70,7 +75,7 @@ func TestNewDataRetriever_GetDataFromTransientStore(t *testing.T) {
 	dataStore.On("LedgerHeight").Return(uint64(1), nil)
 	dataStore.On("GetTxPvtRWSetByTxid", "testTxID", mock.Anything).Return(rwSetScanner, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query transient store for missed private data
@@ -134,7 +139,7 @@ func TestNewDataRetriever_GetDataFromLedger(t *testing.T) {
 	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, namespace).Return(newCollectionConfig(collectionName), nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query ledger for missed private data
@@ -167,6 +172,66 @@ func TestNewDataRetriever_GetDataFromLedger(t *testing.T) {
 	assertion.Equal([]byte{1, 2, 3, 4}, mergedRWSet)
 }
 
+func TestNewDataRetriever_GetImplicitCollectionDataFromLedger(t *testing.T) {
+	t.Parallel()
+	dataStore := &mocks.DataStore{}
+
+	namespace := "testChaincodeName1"
+	collectionName := "_implicit_org_Org1MSP"
+
+	result := []*ledger.TxPvtData{{
+		WriteSet: &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				pvtReadWriteSet(namespace, collectionName, []byte{1, 2}),
+			},
+		},
+		SeqInBlock: 1,
+	}}
+
+	dataStore.On("LedgerHeight").Return(uint64(10), nil)
+	dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).Return(result, nil)
+
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
+
+	// the configuration of implicit collections is not looked up in the collection config history
+	rwSets, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
+		Namespace:  namespace,
+		Collection: collectionName,
+		BlockSeq:   uint64(5),
+		TxId:       "testTxID",
+		SeqInBlock: 1,
+	}}, uint64(5))
+
+	assertion := assert.New(t)
+	assertion.NoError(err)
+	pvtRWSet := rwSets[privdatacommon.DigKey{
+		Namespace:  namespace,
+		Collection: collectionName,
+		BlockSeq:   5,
+		TxId:       "testTxID",
+		SeqInBlock: 1,
+	}]
+	assertion.NotNil(pvtRWSet)
+	assertion.Equal(1, len(pvtRWSet.RWSet))
+	assertion.Equal([]byte{1, 2}, []byte(pvtRWSet.RWSet[0]))
+	assertion.Equal(collectionName, pvtRWSet.CollectionConfig.GetStaticCollectionConfig().Name)
+	dataStore.AssertNotCalled(t, "GetConfigHistoryRetriever")
+
+	// orgs that are not part of the channel have no implicit collection
+	result[0].WriteSet.NsPvtRwset[0].CollectionPvtRwset[0].CollectionName = "_implicit_org_Org3MSP"
+	_, _, err = retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
+		Namespace:  namespace,
+		Collection: "_implicit_org_Org3MSP",
+		BlockSeq:   uint64(5),
+		TxId:       "testTxID",
+		SeqInBlock: 1,
+	}}, uint64(5))
+	assertion.EqualError(err, "no implicit collection <_implicit_org_Org3MSP> exists for chaincode <testChaincodeName1>"+
+		" txID <testTxID>, as its org is not part of the channel")
+	dataStore.AssertNotCalled(t, "GetConfigHistoryRetriever")
+}
+
 func TestNewDataRetriever_FailGetPvtDataFromLedger(t *testing.T) {
 	t.Parallel()
 	dataStore := &mocks.DataStore{}
@@ -178,7 +243,7 @@ func TestNewDataRetriever_FailGetPvtDataFromLedger(t *testing.T) {
 	dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).
 		Return(nil, errors.New("failing retrieving private data"))
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query transient store for missed private data
@@ -221,7 +286,7 @@ func TestNewDataRetriever_GetOnlyRelevantPvtData(t *testing.T) {
 	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, namespace).Return(newCollectionConfig(collectionName), nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query transient store for missed private data
@@ -307,7 +372,7 @@ func TestNewDataRetriever_GetMultipleDigests(t *testing.T) {
 	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, ns2).Return(newCollectionConfig(col2), nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query transient store for missed private data
@@ -380,7 +445,7 @@ func TestNewDataRetriever_EmptyWriteSet(t *testing.T) {
 	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, ns1).Return(newCollectionConfig(col1), nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	rwSets, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
 		Namespace:  ns1,
@@ -430,7 +495,7 @@ func TestNewDataRetriever_FailedObtainConfigHistoryRetriever(t *testing.T) {
 	dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).Return(result, nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(nil, errors.New("failed to obtain ConfigHistoryRetriever"))
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	_, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
 		Namespace:  ns1,
@@ -483,7 +548,7 @@ func TestNewDataRetriever_NoCollectionConfig(t *testing.T) {
 		Return(nil, nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 	assertion := assert.New(t)
 
 	_, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
@@ -515,7 +580,7 @@ func TestNewDataRetriever_FailedGetLedgerHeight(t *testing.T) {
 	col1 := "testCollectionName1"
 
 	dataStore.On("LedgerHeight").Return(uint64(0), errors.New("failed to read ledger height"))
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	_, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
 		Namespace:  ns1,
@@ -541,7 +606,7 @@ func TestNewDataRetriever_FailToReadFromTransientStore(t *testing.T) {
 	dataStore.On("GetTxPvtRWSetByTxid", "testTxID", mock.Anything).
 		Return(nil, errors.New("fail to read form transient store"))
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	rwset, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
 		Namespace:  namespace,
@@ -570,7 +635,7 @@ func TestNewDataRetriever_FailedToReadNext(t *testing.T) {
 	dataStore.On("LedgerHeight").Return(uint64(1), nil)
 	dataStore.On("GetTxPvtRWSetByTxid", "testTxID", mock.Anything).Return(rwSetScanner, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	// Request digest for private data which is greater than current ledger height
 	// to make it query transient store for missed private data
@@ -620,7 +685,7 @@ func TestNewDataRetriever_EmptyPvtRWSetInTransientStore(t *testing.T) {
 	dataStore.On("LedgerHeight").Return(uint64(1), nil)
 	dataStore.On("GetTxPvtRWSetByTxid", "testTxID", mock.Anything).Return(rwSetScanner, nil)
 
-	retriever := NewDataRetriever(dataStore)
+	retriever := NewDataRetriever(dataStore, channelMSPIDs)
 
 	rwSets, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{{
 		Namespace:  namespace,
@@ -10,6 +10,29 @@ type MissingPvtDataTracker struct {
 	mock.Mock
 }
 
+// GetMissingPvtDataInfoForBlockRange provides a mock function with given fields: startBlock, endBlock
+func (_m *MissingPvtDataTracker) GetMissingPvtDataInfoForBlockRange(startBlock uint64, endBlock uint64) (ledger.MissingPvtDataInfo, error) {
+	ret := _m.Called(startBlock, endBlock)
+
+	var r0 ledger.MissingPvtDataInfo
+	if rf, ok := ret.Get(0).(func(uint64, uint64) ledger.MissingPvtDataInfo); ok {
+		r0 = rf(startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ledger.MissingPvtDataInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMissingPvtDataInfoForMostRecentBlocks provides a mock function with given fields: maxBlocks
 func (_m *MissingPvtDataTracker) GetMissingPvtDataInfoForMostRecentBlocks(maxBlocks int) (ledger.MissingPvtDataInfo, error) {
 	ret := _m.Called(maxBlocks)
@@ -100,6 +100,10 @@ func (cs mockCollectionStore) HasReadAccess(cc fcommon.CollectionCriteria, sp *p
 	panic("implement me")
 }
 
+func (cs mockCollectionStore) HasWriteAccess(cc fcommon.CollectionCriteria, sp *peer.SignedProposal, qe ledger.QueryExecutor) (bool, error) {
+	panic("implement me")
+}
+
 func (cs mockCollectionStore) AccessFilter(channelName string, collectionPolicyConfig *fcommon.CollectionPolicyConfig) (privdata.Filter, error) {
 	if cs.accessFilter != nil {
 		return cs.accessFilter, nil
@@ -152,6 +156,10 @@ func (mc *mockCollectionAccess) IsMemberOnlyRead() bool {
 	return false
 }
 
+func (mc *mockCollectionAccess) IsMemberOnlyWrite() bool {
+	return false
+}
+
 type dataRetrieverMock struct {
 	mock.Mock
 }
@@ -1089,7 +1097,7 @@ func TestPullerIntegratedWithDataRetreiver(t *testing.T) {
 	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, ns2).Return(newCollectionConfig(col2), nil)
 	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
 
-	dataRetreiver := &counterDataRetreiver{PrivateDataRetriever: NewDataRetriever(dataStore), numberOfCalls: 0}
+	dataRetreiver := &counterDataRetreiver{PrivateDataRetriever: NewDataRetriever(dataStore, channelMSPIDs), numberOfCalls: 0}
 	p2.PrivateDataRetriever = dataRetreiver
 
 	dig1 := &privdatacommon.DigKey{
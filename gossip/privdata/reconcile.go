@@ -10,6 +10,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -29,8 +30,13 @@ const (
 	reconcileBatchSizeConfigKey     = "peer.gossip.pvtData.reconcileBatchSize"
 	reconcileBatchSizeDefault       = 10
 	reconciliationEnabledConfigKey  = "peer.gossip.pvtData.reconciliationEnabled"
+
+	notAvailableErrMsg = "missing private data is not available on other peers"
+	hashMismatchErrMsg = "hash of the private data fetched from other peers does not match the hash in the block"
 )
 
+var errReconciliationDisabled = errors.New("private data reconciliation has been disabled")
+
 // ReconciliationFetcher interface which defines API to fetch
 // private data elements that have to be reconciled
 type ReconciliationFetcher interface {
@@ -48,15 +54,62 @@ type PvtDataReconciler interface {
 	Start()
 	// Stop function stops reconciler
 	Stop()
+	// Status returns the private data of eligible collections that is missing on the peer,
+	// along with the outcome of the most recent attempts to reconcile it
+	Status() (*ReconciliationStatus, error)
+	// ReconcileBlockRange immediately attempts to reconcile the missing private data of the blocks
+	// in the range [startBlock, endBlock], and returns the number of private data items reconciled
+	ReconcileBlockRange(startBlock, endBlock uint64) (int, error)
+}
+
+// ReconciliationStatus describes the private data that is missing on the peer
+type ReconciliationStatus struct {
+	// LastAttempt is the time of the most recent reconciliation attempt
+	LastAttempt time.Time
+	// LastError is the error of the most recent reconciliation attempt, or empty if it succeeded
+	LastError string
+	// Missing is the missing private data, ordered by chaincode and collection name
+	Missing []*MissingCollectionPvtData
+}
+
+// MissingCollectionPvtData describes the private data of a collection that is missing on the peer
+type MissingCollectionPvtData struct {
+	Namespace  string
+	Collection string
+	// BlockNums are the numbers of the blocks missing private data of the collection, in ascending order
+	BlockNums []uint64
+	// LastAttempt is the time of the most recent attempt to reconcile private data of the collection
+	LastAttempt time.Time
+	// LastError is the reason the most recent attempt failed to reconcile private data of the
+	// collection, or empty if it succeeded
+	LastError string
 }
 
 type Reconciler struct {
 	config *ReconcilerConfig
 	ReconciliationFetcher
 	committer.Committer
-	stopChan  chan struct{}
-	startOnce sync.Once
-	stopOnce  sync.Once
+	channelMSPIDs ChannelMSPIDs
+	stopChan      chan struct{}
+	startOnce     sync.Once
+	stopOnce      sync.Once
+
+	// lock serializes the reconciliation attempts
+	lock sync.Mutex
+	// statusLock guards the outcome of the reconciliation attempts
+	statusLock  sync.RWMutex
+	lastAttempt attemptOutcome
+	attempts    map[nsColl]attemptOutcome
+}
+
+// attemptOutcome is the outcome of a reconciliation attempt
+type attemptOutcome struct {
+	time time.Time
+	err  string
+}
+
+type nsColl struct {
+	namespace, collection string
 }
 
 // NoOpReconciler non functional reconciler to be used
@@ -73,6 +126,14 @@ func (*NoOpReconciler) Stop() {
 	// do nothing
 }
 
+func (*NoOpReconciler) Status() (*ReconciliationStatus, error) {
+	return nil, errReconciliationDisabled
+}
+
+func (*NoOpReconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	return 0, errReconciliationDisabled
+}
+
 // ReconcilerConfig holds config flags that are read from core.yaml
 type ReconcilerConfig struct {
 	sleepInterval time.Duration
@@ -97,12 +158,13 @@ func GetReconcilerConfig() *ReconcilerConfig {
 }
 
 // NewReconciler creates a new instance of reconciler
-func NewReconciler(c committer.Committer, fetcher ReconciliationFetcher, config *ReconcilerConfig) *Reconciler {
+func NewReconciler(c committer.Committer, fetcher ReconciliationFetcher, config *ReconcilerConfig, channelMSPIDs ChannelMSPIDs) *Reconciler {
 	logger.Debug("Private data reconciliation is enabled")
 	return &Reconciler{
 		config:                config,
 		Committer:             c,
 		ReconciliationFetcher: fetcher,
+		channelMSPIDs:         channelMSPIDs,
 		stopChan:              make(chan struct{}),
 	}
 }
@@ -134,17 +196,17 @@ func (r *Reconciler) run() {
 	}
 }
 
-// returns the number of items that were reconciled , minBlock, maxBlock (blocks range) and an error
-func (r *Reconciler) reconcile() error {
-	missingPvtDataTracker, err := r.GetMissingPvtDataTracker()
+func (r *Reconciler) reconcile() (err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	defer func() {
+		r.recordAttempt(err)
+	}()
+
+	missingPvtDataTracker, err := r.missingPvtDataTracker()
 	if err != nil {
-		logger.Error("reconciliation error when trying to get missingPvtDataTracker:", err)
 		return err
 	}
-	if missingPvtDataTracker == nil {
-		logger.Error("got nil as MissingPvtDataTracker, exiting...")
-		return errors.New("got nil as MissingPvtDataTracker, exiting...")
-	}
 	totalReconciled, minBlock, maxBlock := 0, uint64(math.MaxUint64), uint64(0)
 
 	for {
@@ -165,34 +227,245 @@ func (r *Reconciler) reconcile() error {
 
 		logger.Debug("got from ledger", len(missingPvtDataInfo), "blocks with missing private data, trying to reconcile...")
 
-		dig2collectionCfg, minB, maxB := r.getDig2CollectionConfig(missingPvtDataInfo)
-		fetchedData, err := r.FetchReconciledItems(dig2collectionCfg)
+		reconciled, minB, maxB, err := r.reconcileBatch(missingPvtDataInfo)
 		if err != nil {
-			logger.Error("reconciliation error when trying to fetch missing items from different peers:", err)
 			return err
 		}
-		if len(fetchedData.AvailableElements) == 0 {
-			logger.Warning("missing private data is not available on other peers")
+		if reconciled == 0 {
 			return nil
 		}
-
-		pvtDataToCommit := r.preparePvtDataToCommit(fetchedData.AvailableElements)
-		// commit missing private data that was reconciled and log mismatched
-		pvtdataHashMismatch, err := r.CommitPvtDataOfOldBlocks(pvtDataToCommit)
-		if err != nil {
-			return errors.Wrap(err, "failed to commit private data")
-		}
-		r.logMismatched(pvtdataHashMismatch)
 		if minB < minBlock {
 			minBlock = minB
 		}
 		if maxB > maxBlock {
 			maxBlock = maxB
 		}
-		totalReconciled += len(fetchedData.AvailableElements)
+		totalReconciled += reconciled
+	}
+}
+
+// ReconcileBlockRange immediately attempts to reconcile the missing private data of the blocks
+// in the range [startBlock, endBlock], and returns the number of private data items reconciled
+func (r *Reconciler) ReconcileBlockRange(startBlock, endBlock uint64) (totalReconciled int, err error) {
+	if startBlock > endBlock {
+		return 0, errors.Errorf("invalid block range [%d - %d]", startBlock, endBlock)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	defer func() {
+		r.recordAttempt(err)
+	}()
+
+	missingPvtDataTracker, err := r.missingPvtDataTracker()
+	if err != nil {
+		return 0, err
+	}
+	missingPvtDataInfo, err := missingPvtDataTracker.GetMissingPvtDataInfoForBlockRange(startBlock, endBlock)
+	if err != nil {
+		logger.Error("reconciliation error when trying to get missing pvt data info of blocks range:", err)
+		return 0, err
+	}
+
+	// unlike the periodic reconciliation, which stops at the first batch of blocks whose missing
+	// private data is not available, every batch of blocks in the range is attempted once
+	for _, batch := range splitMissingPvtDataInfo(missingPvtDataInfo, r.config.batchSize) {
+		reconciled, _, _, err := r.reconcileBatch(batch)
+		if err != nil {
+			return totalReconciled, err
+		}
+		totalReconciled += reconciled
+	}
+	logger.Infof("Reconciliation of blocks range [%d - %d] finished successfully. reconciled %d private data keys", startBlock, endBlock, totalReconciled)
+	return totalReconciled, nil
+}
+
+// Status returns the private data of eligible collections that is missing on the peer,
+// along with the outcome of the most recent attempts to reconcile it
+func (r *Reconciler) Status() (*ReconciliationStatus, error) {
+	missingPvtDataTracker, err := r.missingPvtDataTracker()
+	if err != nil {
+		return nil, err
+	}
+	missingPvtDataInfo, err := missingPvtDataTracker.GetMissingPvtDataInfoForBlockRange(0, math.MaxUint64)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get missing pvt data info")
+	}
+
+	blockNumsByColl := make(map[nsColl][]uint64)
+	for blockNum, blockPvtDataInfo := range missingPvtDataInfo {
+		for coll := range missingCollections(blockPvtDataInfo) {
+			blockNumsByColl[coll] = append(blockNumsByColl[coll], blockNum)
+		}
+	}
+
+	r.statusLock.RLock()
+	defer r.statusLock.RUnlock()
+
+	status := &ReconciliationStatus{
+		LastAttempt: r.lastAttempt.time,
+		LastError:   r.lastAttempt.err,
+	}
+	for coll, blockNums := range blockNumsByColl {
+		sort.Slice(blockNums, func(i, j int) bool {
+			return blockNums[i] < blockNums[j]
+		})
+		attempt := r.attempts[coll]
+		status.Missing = append(status.Missing, &MissingCollectionPvtData{
+			Namespace:   coll.namespace,
+			Collection:  coll.collection,
+			BlockNums:   blockNums,
+			LastAttempt: attempt.time,
+			LastError:   attempt.err,
+		})
+	}
+	sort.Slice(status.Missing, func(i, j int) bool {
+		if status.Missing[i].Namespace != status.Missing[j].Namespace {
+			return status.Missing[i].Namespace < status.Missing[j].Namespace
+		}
+		return status.Missing[i].Collection < status.Missing[j].Collection
+	})
+	return status, nil
+}
+
+func (r *Reconciler) missingPvtDataTracker() (ledger.MissingPvtDataTracker, error) {
+	missingPvtDataTracker, err := r.GetMissingPvtDataTracker()
+	if err != nil {
+		logger.Error("reconciliation error when trying to get missingPvtDataTracker:", err)
+		return nil, err
+	}
+	if missingPvtDataTracker == nil {
+		logger.Error("got nil as MissingPvtDataTracker, exiting...")
+		return nil, errors.New("got nil as MissingPvtDataTracker, exiting...")
+	}
+	return missingPvtDataTracker, nil
+}
+
+// reconcileBatch fetches the given missing private data from other peers and commits it.
+// returns the number of items that were reconciled, minBlock, maxBlock (blocks range) and an error
+func (r *Reconciler) reconcileBatch(missingPvtDataInfo ledger.MissingPvtDataInfo) (int, uint64, uint64, error) {
+	attemptTime := time.Now()
+
+	dig2collectionCfg, minB, maxB := r.getDig2CollectionConfig(missingPvtDataInfo)
+	fetchedData, err := r.FetchReconciledItems(dig2collectionCfg)
+	if err != nil {
+		logger.Error("reconciliation error when trying to fetch missing items from different peers:", err)
+		r.recordCollectionAttempts(attemptTime, missingPvtDataInfo, nil, nil, err.Error())
+		return 0, minB, maxB, err
+	}
+	if len(fetchedData.AvailableElements) == 0 {
+		logger.Warning(notAvailableErrMsg)
+		r.recordCollectionAttempts(attemptTime, missingPvtDataInfo, nil, nil, notAvailableErrMsg)
+		return 0, minB, maxB, nil
+	}
+
+	pvtDataToCommit := r.preparePvtDataToCommit(fetchedData.AvailableElements)
+	// commit missing private data that was reconciled and log mismatched
+	pvtdataHashMismatch, err := r.CommitPvtDataOfOldBlocks(pvtDataToCommit)
+	if err != nil {
+		err = errors.Wrap(err, "failed to commit private data")
+		r.recordCollectionAttempts(attemptTime, missingPvtDataInfo, nil, nil, err.Error())
+		return 0, minB, maxB, err
+	}
+	r.logMismatched(pvtdataHashMismatch)
+	r.recordCollectionAttempts(attemptTime, missingPvtDataInfo, fetchedData.AvailableElements, pvtdataHashMismatch, notAvailableErrMsg)
+	return len(fetchedData.AvailableElements), minB, maxB, nil
+}
+
+// recordAttempt records the outcome of a reconciliation attempt
+func (r *Reconciler) recordAttempt(err error) {
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	r.lastAttempt = attemptOutcome{time: time.Now()}
+	if err != nil {
+		r.lastAttempt.err = err.Error()
+	}
+}
+
+// recordCollectionAttempts records the outcome of an attempt to reconcile the given missing
+// private data for each of its collections. A collection is reconciled successfully if all its
+// missing private data is among the available elements and does not mismatch the hashes in the
+// block; otherwise, the attempt failed due to a hash mismatch or due to missingErrMsg.
+func (r *Reconciler) recordCollectionAttempts(attemptTime time.Time, missingPvtDataInfo ledger.MissingPvtDataInfo,
+	availableElements []*gossip2.PvtDataElement, pvtdataHashMismatch []*ledger.PvtdataHashMismatch, missingErrMsg string) {
+	available := make(map[privdatacommon.DigKey]struct{})
+	for _, element := range availableElements {
+		available[privdatacommon.DigKey{
+			Namespace:  element.Digest.Namespace,
+			Collection: element.Digest.Collection,
+			BlockSeq:   element.Digest.BlockSeq,
+			SeqInBlock: element.Digest.SeqInBlock,
+		}] = struct{}{}
+	}
+
+	outcomes := make(map[nsColl]string)
+	for blockNum, blockPvtDataInfo := range missingPvtDataInfo {
+		for seqInBlock, collectionPvtDataInfo := range blockPvtDataInfo {
+			for _, pvtDataInfo := range collectionPvtDataInfo {
+				coll := nsColl{namespace: pvtDataInfo.Namespace, collection: pvtDataInfo.Collection}
+				digKey := privdatacommon.DigKey{
+					Namespace:  pvtDataInfo.Namespace,
+					Collection: pvtDataInfo.Collection,
+					BlockSeq:   blockNum,
+					SeqInBlock: seqInBlock,
+				}
+				if _, exists := available[digKey]; !exists {
+					outcomes[coll] = missingErrMsg
+				} else if _, exists := outcomes[coll]; !exists {
+					outcomes[coll] = ""
+				}
+			}
+		}
+	}
+	for _, hashMismatch := range pvtdataHashMismatch {
+		outcomes[nsColl{namespace: hashMismatch.Namespace, collection: hashMismatch.Collection}] = hashMismatchErrMsg
+	}
+
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	if r.attempts == nil {
+		r.attempts = make(map[nsColl]attemptOutcome)
+	}
+	for coll, errMsg := range outcomes {
+		r.attempts[coll] = attemptOutcome{time: attemptTime, err: errMsg}
 	}
 }
 
+// missingCollections returns the collections that miss private data in the given block
+func missingCollections(blockPvtDataInfo ledger.MissingBlockPvtdataInfo) map[nsColl]struct{} {
+	collections := make(map[nsColl]struct{})
+	for _, collectionPvtDataInfo := range blockPvtDataInfo {
+		for _, pvtDataInfo := range collectionPvtDataInfo {
+			collections[nsColl{namespace: pvtDataInfo.Namespace, collection: pvtDataInfo.Collection}] = struct{}{}
+		}
+	}
+	return collections
+}
+
+// splitMissingPvtDataInfo splits the given missing private data into batches of at most
+// batchSize blocks, starting from the most recent blocks
+func splitMissingPvtDataInfo(missingPvtDataInfo ledger.MissingPvtDataInfo, batchSize int) []ledger.MissingPvtDataInfo {
+	var blockNums []uint64
+	for blockNum := range missingPvtDataInfo {
+		blockNums = append(blockNums, blockNum)
+	}
+	sort.Slice(blockNums, func(i, j int) bool {
+		return blockNums[i] > blockNums[j]
+	})
+
+	if batchSize <= 0 {
+		batchSize = len(blockNums)
+	}
+	var batches []ledger.MissingPvtDataInfo
+	for i, blockNum := range blockNums {
+		if i%batchSize == 0 {
+			batches = append(batches, make(ledger.MissingPvtDataInfo))
+		}
+		batches[len(batches)-1][blockNum] = missingPvtDataInfo[blockNum]
+	}
+	return batches
+}
+
 type collectionConfigKey struct {
 	chaincodeName, collectionName string
 	blockNum                      uint64
@@ -240,6 +513,14 @@ func (r *Reconciler) getDig2CollectionConfig(missingPvtDataInfo ledger.MissingPv
 }
 
 func (r *Reconciler) getMostRecentCollectionConfig(chaincodeName string, collectionName string, blockNum uint64) (*common.StaticCollectionConfig, error) {
+	if implicitConfig, isImplicit := implicitCollectionConfig(collectionName, r.channelMSPIDs); isImplicit {
+		if implicitConfig == nil {
+			return nil, errors.Errorf("no implicit collection %s exists for chaincode %s, as its org is not part of the channel",
+				collectionName, chaincodeName)
+		}
+		return implicitConfig.GetStaticCollectionConfig(), nil
+	}
+
 	configHistoryRetriever, err := r.GetConfigHistoryRetriever()
 	if err != nil {
 		return nil, errors.Wrap(err, "configHistoryRetriever is not available")
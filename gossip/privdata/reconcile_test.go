@@ -8,11 +8,14 @@ package privdata
 
 import (
 	"errors"
+	"math"
+	"sort"
 	"sync"
 	"testing"
 	"time"
 
 	util2 "github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/gossip/privdata/mocks"
@@ -81,6 +84,70 @@ func TestNotReconcilingWhenCollectionConfigNotAvailable(t *testing.T) {
 	assert.True(t, fetchCalled)
 }
 
+func TestReconcilingImplicitCollections(t *testing.T) {
+	// Scenario: the configuration of implicit collections is not part of the
+	// collection config history, so the reconciler generates it
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+	var missingInfo ledger.MissingPvtDataInfo
+
+	missingInfo = map[uint64]ledger.MissingBlockPvtdataInfo{
+		1: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "_implicit_org_Org1MSP", Namespace: "chain1"}},
+		},
+	}
+
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything).Return(missingInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	committer.On("GetConfigHistoryRetriever").Return(&mocks.ConfigHistoryRetriever{}, nil)
+
+	var fetchCalled bool
+	fetcher.On("FetchReconciledItems", mock.Anything).Run(func(args mock.Arguments) {
+		var dig2CollectionConfig = args.Get(0).(privdatacommon.Dig2CollectionConfig)
+		assert.Equal(t, 1, len(dig2CollectionConfig))
+		for digest, collectionConfig := range dig2CollectionConfig {
+			assert.Equal(t, "_implicit_org_Org1MSP", digest.Collection)
+			assert.Equal(t, privdata.GenerateImplicitCollectionForOrg("Org1MSP"), collectionConfig)
+		}
+		fetchCalled = true
+	}).Return(nil, errors.New("fetch failed"))
+
+	r := &Reconciler{config: &ReconcilerConfig{sleepInterval: time.Minute, batchSize: 1, IsEnabled: true}, ReconciliationFetcher: fetcher, Committer: committer, channelMSPIDs: channelMSPIDs}
+	err := r.reconcile()
+
+	assert.EqualError(t, err, "fetch failed")
+	assert.True(t, fetchCalled)
+}
+
+func TestReconcilingImplicitCollectionsOfOrgsNotInChannel(t *testing.T) {
+	// Scenario: orgs that are not part of the channel have no implicit
+	// collection, so there is no configuration to reconcile their data with
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+
+	missingInfo := ledger.MissingPvtDataInfo{
+		1: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "_implicit_org_Org3MSP", Namespace: "chain1"}},
+		},
+	}
+
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything).Return(missingInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	committer.On("GetConfigHistoryRetriever").Return(&mocks.ConfigHistoryRetriever{}, nil)
+
+	var fetchCalled bool
+	fetcher.On("FetchReconciledItems", mock.Anything).Run(func(args mock.Arguments) {
+		assert.Empty(t, args.Get(0).(privdatacommon.Dig2CollectionConfig))
+		fetchCalled = true
+	}).Return(nil, errors.New("fetch failed"))
+
+	r := &Reconciler{config: &ReconcilerConfig{sleepInterval: time.Minute, batchSize: 1, IsEnabled: true}, ReconciliationFetcher: fetcher, Committer: committer, channelMSPIDs: channelMSPIDs}
+	assert.EqualError(t, r.reconcile(), "fetch failed")
+	assert.True(t, fetchCalled)
+}
+
 func TestReconciliationHappyPathWithoutScheduler(t *testing.T) {
 	// Scenario: happy path when trying to reconcile missing private data.
 	committer := &mocks.Committer{}
@@ -230,7 +297,7 @@ func TestReconciliationHappyPathWithScheduler(t *testing.T) {
 		wg.Done()
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
 
-	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true})
+	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true}, channelMSPIDs)
 	r.Start()
 	wg.Wait()
 	r.Stop()
@@ -339,7 +406,7 @@ func TestReconciliationPullingMissingPrivateDataAtOnePass(t *testing.T) {
 		wg.Done()
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
 
-	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true})
+	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true}, channelMSPIDs)
 	r.Start()
 	<-stopC
 	r.Stop()
@@ -430,14 +497,14 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 	fetcher := &mocks.ReconciliationFetcher{}
 	committer.On("GetMissingPvtDataTracker").Return(nil, errors.New("failed to obtain missing pvt data tracker"))
 
-	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true})
+	r := NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true}, channelMSPIDs)
 	err := r.reconcile()
 	assert.Error(t, err)
 	assert.Contains(t, "failed to obtain missing pvt data tracker", err.Error())
 
 	committer.Mock = mock.Mock{}
 	committer.On("GetMissingPvtDataTracker").Return(nil, nil)
-	r = NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true})
+	r = NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true}, channelMSPIDs)
 	err = r.reconcile()
 	assert.Error(t, err)
 	assert.Contains(t, "got nil as MissingPvtDataTracker, exiting...", err.Error())
@@ -447,8 +514,162 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 
 	committer.Mock = mock.Mock{}
 	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
-	r = NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true})
+	r = NewReconciler(committer, fetcher, &ReconcilerConfig{sleepInterval: time.Millisecond * 100, batchSize: 1, IsEnabled: true}, channelMSPIDs)
 	err = r.reconcile()
 	assert.Error(t, err)
 	assert.Contains(t, "failed get missing pvt data for recent blocks", err.Error())
 }
+
+func TestReconcileBlockRange(t *testing.T) {
+	// Scenario: reconciliation of a blocks range is triggered, while the missing private data of
+	// collection col2 is not available on other peers.
+	// every batch of blocks of the range should be attempted, and the status should report the
+	// private data of col2 which is still missing along with the reason it wasn't reconciled.
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	configHistoryRetriever := &mocks.ConfigHistoryRetriever{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+
+	missingInfo := ledger.MissingPvtDataInfo{
+		3: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "col1", Namespace: "ns1"}},
+		},
+		4: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			0: {{Collection: "col2", Namespace: "ns1"}},
+		},
+		5: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			2: {{Collection: "col1", Namespace: "ns1"}},
+		},
+	}
+	stillMissingInfo := ledger.MissingPvtDataInfo{
+		4: missingInfo[4],
+	}
+
+	collectionConfigInfo := ledger.CollectionConfigInfo{
+		CollectionConfig: &common.CollectionConfigPackage{
+			Config: []*common.CollectionConfig{
+				{Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "col1",
+					},
+				}},
+				{Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "col2",
+					},
+				}},
+			},
+		},
+		CommittingBlockNum: 1,
+	}
+
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForBlockRange", uint64(2), uint64(6)).Return(missingInfo, nil)
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForBlockRange", uint64(0), uint64(math.MaxUint64)).Return(stillMissingInfo, nil)
+	configHistoryRetriever.On("MostRecentCollectionConfigBelow", mock.Anything, mock.Anything).Return(&collectionConfigInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	committer.On("GetConfigHistoryRetriever").Return(configHistoryRetriever, nil)
+
+	var fetchedBlocks [][]uint64
+	fetcher.On("FetchReconciledItems", mock.Anything).Return(func(dig2CollectionConfig privdatacommon.Dig2CollectionConfig) *privdatacommon.FetchedPvtDataContainer {
+		result := &privdatacommon.FetchedPvtDataContainer{}
+		var blocks []uint64
+		for digest := range dig2CollectionConfig {
+			blocks = append(blocks, digest.BlockSeq)
+			if digest.Collection != "col1" {
+				continue
+			}
+			result.AvailableElements = append(result.AvailableElements, &gossip2.PvtDataElement{
+				Digest: &gossip2.PvtDataDigest{
+					BlockSeq:   digest.BlockSeq,
+					Collection: digest.Collection,
+					Namespace:  digest.Namespace,
+					SeqInBlock: digest.SeqInBlock,
+				},
+				Payload: [][]byte{util2.ComputeSHA256([]byte("rws-pre-image"))},
+			})
+		}
+		sort.Slice(blocks, func(i, j int) bool {
+			return blocks[i] < blocks[j]
+		})
+		fetchedBlocks = append(fetchedBlocks, blocks)
+		return result
+	}, nil)
+	committer.On("CommitPvtDataOfOldBlocks", mock.Anything).Return([]*ledger.PvtdataHashMismatch{}, nil)
+
+	r := &Reconciler{config: &ReconcilerConfig{sleepInterval: time.Minute, batchSize: 2, IsEnabled: true}, ReconciliationFetcher: fetcher, Committer: committer}
+
+	_, err := r.ReconcileBlockRange(6, 2)
+	assert.EqualError(t, err, "invalid block range [6 - 2]")
+
+	reconciled, err := r.ReconcileBlockRange(2, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reconciled)
+	assert.Equal(t, [][]uint64{{4, 5}, {3}}, fetchedBlocks)
+	committer.AssertNumberOfCalls(t, "CommitPvtDataOfOldBlocks", 2)
+
+	status, err := r.Status()
+	assert.NoError(t, err)
+	assert.False(t, status.LastAttempt.IsZero())
+	assert.Empty(t, status.LastError)
+	assert.Len(t, status.Missing, 1)
+	assert.Equal(t, "ns1", status.Missing[0].Namespace)
+	assert.Equal(t, "col2", status.Missing[0].Collection)
+	assert.Equal(t, []uint64{4}, status.Missing[0].BlockNums)
+	assert.False(t, status.Missing[0].LastAttempt.IsZero())
+	assert.Equal(t, notAvailableErrMsg, status.Missing[0].LastError)
+}
+
+func TestReconciliationStatusAfterFailure(t *testing.T) {
+	// Scenario: reconciler fails to fetch the missing private data from other peers.
+	// the status should report the error of the last attempt, both for the attempt and for the collection.
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+
+	missingInfo := ledger.MissingPvtDataInfo{
+		1: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "_implicit_org_Org1MSP", Namespace: "ns1"}},
+			2: {{Collection: "_implicit_org_Org1MSP", Namespace: "ns1"}},
+		},
+		2: map[uint64][]*ledger.MissingCollectionPvtDataInfo{
+			1: {{Collection: "_implicit_org_Org1MSP", Namespace: "ns1"}},
+		},
+	}
+
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything).Return(missingInfo, nil)
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForBlockRange", mock.Anything, mock.Anything).Return(missingInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	fetcher.On("FetchReconciledItems", mock.Anything).Return(nil, errors.New("failed fetching"))
+
+	r := &Reconciler{config: &ReconcilerConfig{sleepInterval: time.Minute, batchSize: 1, IsEnabled: true}, ReconciliationFetcher: fetcher, Committer: committer, channelMSPIDs: channelMSPIDs}
+
+	status, err := r.Status()
+	assert.NoError(t, err)
+	assert.True(t, status.LastAttempt.IsZero())
+	assert.Len(t, status.Missing, 1)
+	assert.Equal(t, []uint64{1, 2}, status.Missing[0].BlockNums)
+	assert.True(t, status.Missing[0].LastAttempt.IsZero())
+
+	assert.EqualError(t, r.reconcile(), "failed fetching")
+
+	status, err = r.Status()
+	assert.NoError(t, err)
+	assert.False(t, status.LastAttempt.IsZero())
+	assert.Equal(t, "failed fetching", status.LastError)
+	assert.Len(t, status.Missing, 1)
+	assert.False(t, status.Missing[0].LastAttempt.IsZero())
+	assert.Equal(t, "failed fetching", status.Missing[0].LastError)
+
+	missingPvtDataTracker.Mock = mock.Mock{}
+	missingPvtDataTracker.On("GetMissingPvtDataInfoForBlockRange", mock.Anything, mock.Anything).Return(nil, errors.New("leveldb is closed"))
+	_, err = r.Status()
+	assert.EqualError(t, err, "failed to get missing pvt data info: leveldb is closed")
+}
+
+func TestNoOpReconciler(t *testing.T) {
+	r := &NoOpReconciler{}
+	_, err := r.Status()
+	assert.EqualError(t, err, "private data reconciliation has been disabled")
+	_, err = r.ReconcileBlockRange(0, 10)
+	assert.EqualError(t, err, "private data reconciliation has been disabled")
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
@@ -240,6 +241,32 @@ func extractCollectionConfig(configPackage *common.CollectionConfigPackage, coll
 	return nil
 }
 
+// ChannelMSPIDs returns the IDs of the application MSPs defined in the current
+// configuration of a channel, whose orgs are the only ones with an implicit collection
+type ChannelMSPIDs func() []string
+
+// implicitCollectionConfig returns true if the given collection is an implicit collection,
+// along with its configuration if it belongs to one of the orgs of the channel, and nil otherwise.
+// Implicit collections are not part of the collection configuration history of the chaincodes
+func implicitCollectionConfig(collectionName string, channelMSPIDs ChannelMSPIDs) (*common.CollectionConfig, bool) {
+	isImplicit, mspID := privdata.MSPIDIfImplicitCollection(collectionName)
+	if !isImplicit {
+		return nil, false
+	}
+	if channelMSPIDs == nil {
+		return nil, true
+	}
+	staticConfig, exists := privdata.GenerateImplicitCollectionForChannelOrg(mspID, channelMSPIDs())
+	if !exists {
+		return nil, true
+	}
+	return &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: staticConfig,
+		},
+	}, true
+}
+
 type pvtDataFactory struct {
 	data []*ledger.TxPvtData
 }
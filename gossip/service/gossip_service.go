@@ -7,8 +7,10 @@ SPDX-License-Identifier: Apache-2.0
 package service
 
 import (
+	"bytes"
 	"sync"
 
+	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/committer"
 	"github.com/hyperledger/fabric/core/committer/txvalidator"
 	"github.com/hyperledger/fabric/core/common/privdata"
@@ -50,6 +52,10 @@ type GossipService interface {
 	InitializeChannel(chainID string, endpoints []string, support Support)
 	// AddPayload appends message payload to for given chain
 	AddPayload(chainID string, payload *gproto.Payload) error
+	// Reconciler returns the private data reconciler of the given chain, or nil if the chain wasn't initialized
+	Reconciler(chainID string) privdata2.PvtDataReconciler
+	// View returns the membership and the channels as seen by the gossip service
+	View() *View
 }
 
 // DeliveryServiceFactory factory to create and initialize delivery service instance
@@ -90,6 +96,7 @@ type gossipServiceImpl struct {
 	chains          map[string]state.GossipStateProvider
 	leaderElection  map[string]election.LeaderElectionService
 	deliveryService map[string]deliverclient.DeliverService
+	anchorPeers     map[string]map[string][]api.AnchorPeer
 	deliveryFactory DeliveryServiceFactory
 	lock            sync.RWMutex
 	mcs             api.MessageCryptoService
@@ -126,20 +133,21 @@ func (jcm *joinChannelMessage) AnchorPeersOf(org api.OrgIdentityType) []api.Anch
 var logger = util.GetLogger(util.ServiceLogger, "")
 
 // InitGossipService initialize gossip service
-func InitGossipService(peerIdentity []byte, endpoint string, s *grpc.Server, certs *gossipCommon.TLSCertificates,
-	mcs api.MessageCryptoService, secAdv api.SecurityAdvisor, secureDialOpts api.PeerSecureDialOpts, bootPeers ...string) error {
+func InitGossipService(peerIdentity []byte, metricsProvider metrics.Provider, endpoint string, s *grpc.Server,
+	certs *gossipCommon.TLSCertificates, mcs api.MessageCryptoService, secAdv api.SecurityAdvisor,
+	secureDialOpts api.PeerSecureDialOpts, bootPeers ...string) error {
 	// TODO: Remove this.
 	// TODO: This is a temporary work-around to make the gossip leader election module load its logger at startup
 	// TODO: in order for the flogging package to register this logger in time so it can set the log levels as requested in the config
 	util.GetLogger(util.ElectionLogger, "")
-	return InitGossipServiceCustomDeliveryFactory(peerIdentity, endpoint, s, certs, &deliveryFactoryImpl{},
-		mcs, secAdv, secureDialOpts, bootPeers...)
+	return InitGossipServiceCustomDeliveryFactory(peerIdentity, metricsProvider, endpoint, s, certs,
+		&deliveryFactoryImpl{}, mcs, secAdv, secureDialOpts, bootPeers...)
 }
 
 // InitGossipServiceCustomDeliveryFactory initialize gossip service with customize delivery factory
 // implementation, might be useful for testing and mocking purposes
-func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, endpoint string, s *grpc.Server,
-	certs *gossipCommon.TLSCertificates, factory DeliveryServiceFactory, mcs api.MessageCryptoService,
+func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, metricsProvider metrics.Provider, endpoint string,
+	s *grpc.Server, certs *gossipCommon.TLSCertificates, factory DeliveryServiceFactory, mcs api.MessageCryptoService,
 	secAdv api.SecurityAdvisor, secureDialOpts api.PeerSecureDialOpts, bootPeers ...string) error {
 	var err error
 	var gossip gossip.Gossip
@@ -151,7 +159,7 @@ func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, endpoint string
 		logger.Info("Initialize gossip with endpoint", endpoint, "and bootstrap set", bootPeers)
 
 		gossip, err = integration.NewGossipComponent(peerIdentity, endpoint, s, secAdv,
-			mcs, secureDialOpts, certs, bootPeers...)
+			mcs, secureDialOpts, certs, metricsProvider, bootPeers...)
 		gossipServiceInstance = &gossipServiceImpl{
 			mcs:             mcs,
 			gossipSvc:       gossip,
@@ -159,6 +167,7 @@ func InitGossipServiceCustomDeliveryFactory(peerIdentity []byte, endpoint string
 			chains:          make(map[string]state.GossipStateProvider),
 			leaderElection:  make(map[string]election.LeaderElectionService),
 			deliveryService: make(map[string]deliverclient.DeliverService),
+			anchorPeers:     make(map[string]map[string][]api.AnchorPeer),
 			deliveryFactory: factory,
 			peerIdentity:    peerIdentity,
 			secAdv:          secAdv,
@@ -194,6 +203,17 @@ func (g *gossipServiceImpl) DistributePrivateData(chainID string, txID string, p
 	return nil
 }
 
+// Reconciler returns the private data reconciler of the given chain, or nil if the chain wasn't initialized
+func (g *gossipServiceImpl) Reconciler(chainID string) privdata2.PvtDataReconciler {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	handler, exists := g.privateHandlers[chainID]
+	if !exists {
+		return nil
+	}
+	return handler.reconciler
+}
+
 // NewConfigEventer creates a ConfigProcessor which the channelconfig.BundleSource can ultimately route config updates to
 func (g *gossipServiceImpl) NewConfigEventer() ConfigProcessor {
 	return newConfigEventer(g)
@@ -207,6 +227,7 @@ type Support struct {
 	Store                privdata2.TransientStore
 	Cs                   privdata.CollectionStore
 	IdDeserializeFactory privdata2.IdentityDeserializerFactory
+	ChannelMSPIDs        privdata2.ChannelMSPIDs
 }
 
 // DataStoreSupport aggregates interfaces capable
@@ -232,7 +253,7 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 		Committer:      support.Committer,
 	}
 	// Initialize private data fetcher
-	dataRetriever := privdata2.NewDataRetriever(storeSupport)
+	dataRetriever := privdata2.NewDataRetriever(storeSupport, support.ChannelMSPIDs)
 	collectionAccessFactory := privdata2.NewCollectionAccessFactory(support.IdDeserializeFactory)
 	fetcher := privdata2.NewPuller(support.Cs, g.gossipSvc, dataRetriever, collectionAccessFactory, chainID)
 
@@ -249,7 +270,7 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, endpoints []string
 	var reconciler privdata2.PvtDataReconciler
 
 	if reconcilerConfig.IsEnabled {
-		reconciler = privdata2.NewReconciler(support.Committer, fetcher, reconcilerConfig)
+		reconciler = privdata2.NewReconciler(support.Committer, fetcher, reconcilerConfig, support.ChannelMSPIDs)
 	} else {
 		reconciler = &privdata2.NoOpReconciler{}
 	}
@@ -335,6 +356,13 @@ func (g *gossipServiceImpl) updateAnchors(config Config) {
 		}
 	}
 
+	g.lock.Lock()
+	if g.anchorPeers == nil {
+		g.anchorPeers = make(map[string]map[string][]api.AnchorPeer)
+	}
+	g.anchorPeers[config.ChainID()] = jcm.members2AnchorPeers
+	g.lock.Unlock()
+
 	// Initialize new state provider for given committer
 	logger.Debug("Creating state provider for chainID", config.ChainID())
 	g.JoinChan(jcm, gossipCommon.ChainID(config.ChainID()))
@@ -379,6 +407,37 @@ func (g *gossipServiceImpl) Stop() {
 	g.gossipSvc.Stop()
 }
 
+// UpdateIdentity replaces the identity of the peer, and restarts
+// the leader elections so that they use the PKI-ID of the new identity
+func (g *gossipServiceImpl) UpdateIdentity(identity api.PeerIdentityType) error {
+	g.lock.RLock()
+	unchanged := bytes.Equal(identity, g.peerIdentity)
+	g.lock.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	if err := g.gossipSvc.UpdateIdentity(identity); err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.peerIdentity = identity
+	for chainID, le := range g.leaderElection {
+		wasLeader := le.IsLeader()
+		le.Stop()
+		callback := g.onStatusChangeFactory(chainID, g.privateHandlers[chainID].support.Committer)
+		if wasLeader {
+			callback(false)
+		}
+		logger.Infof("Restarting leader election for %s with the new identity", chainID)
+		g.leaderElection[chainID] = g.newLeaderElectionComponent(chainID, callback)
+	}
+	return nil
+}
+
 func (g *gossipServiceImpl) newLeaderElectionComponent(chainID string, callback func(bool)) election.LeaderElectionService {
 	PKIid := g.mcs.GetPKIidOfCert(g.peerIdentity)
 	adapter := election.NewAdapter(g, PKIid, gossipCommon.ChainID(chainID))
@@ -16,6 +16,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/core/deliverservice"
 	"github.com/hyperledger/fabric/core/deliverservice/blocksprovider"
 	"github.com/hyperledger/fabric/core/ledger"
@@ -49,6 +50,10 @@ func (*mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*mockTransientStore) PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error {
+	return nil
+}
+
 func (*mockTransientStore) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
@@ -81,8 +86,8 @@ func TestInitGossipService(t *testing.T) {
 			defer wg.Done()
 			messageCryptoService := peergossip.NewMCS(&mocks.ChannelPolicyManagerGetter{}, localmsp.NewSigner(), mgmt.NewDeserializersManager())
 			secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
-			err := InitGossipService(identity, "localhost:5611", grpcServer, nil, messageCryptoService,
-				secAdv, nil)
+			err := InitGossipService(identity, &disabled.Provider{}, "localhost:5611", grpcServer, nil,
+				messageCryptoService, secAdv, nil)
 			assert.NoError(t, err)
 		}()
 	}
@@ -208,8 +213,18 @@ func TestWithStaticDeliverClientLeader(t *testing.T) {
 	for i := 0; i < n; i++ {
 		assert.NotNil(t, gossips[i].(*gossipServiceImpl).deliveryService[channelName], "Delivery service for channel %s not initiated in peer %d", channelName, i)
 		assert.True(t, gossips[i].(*gossipServiceImpl).deliveryService[channelName].(*mockDeliverService).running[channelName], "Block deliverer not started for peer %d", i)
+		assert.NotNil(t, gossips[i].Reconciler(channelName), "Reconciler for channel %s not initiated in peer %d", channelName, i)
+		assert.Nil(t, gossips[i].Reconciler("chanB"))
 	}
 
+	view := gossips[0].View()
+	assert.Equal(t, "1.2.3.4:20200", view.Self.Endpoint)
+	assert.Len(t, view.Channels, 1)
+	assert.Equal(t, channelName, view.Channels[0].ChainID)
+	assert.False(t, view.Channels[0].LeaderElection)
+	assert.True(t, view.Channels[0].IsLeader)
+	assert.Equal(t, view.Self.PKIid, view.Channels[0].Leader)
+
 	channelName = "chanB"
 	for i := 0; i < n; i++ {
 		deliverServiceFactory.service.running[channelName] = false
@@ -766,7 +781,7 @@ func TestInvalidInitialization(t *testing.T) {
 	defer grpcServer.Stop()
 
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
-	err := InitGossipService(api.PeerIdentityType("IDENTITY"), "localhost:7611", grpcServer, nil,
+	err := InitGossipService(api.PeerIdentityType("IDENTITY"), &disabled.Provider{}, "localhost:7611", grpcServer, nil,
 		&naiveCryptoService{}, secAdv, nil)
 	assert.NoError(t, err)
 	gService := GetGossipService().(*gossipServiceImpl)
@@ -791,7 +806,7 @@ func TestChannelConfig(t *testing.T) {
 	defer grpcServer.Stop()
 
 	secAdv := peergossip.NewSecurityAdvisor(mgmt.NewDeserializersManager())
-	error = InitGossipService(api.PeerIdentityType("IDENTITY"), "localhost:6611", grpcServer, nil,
+	error = InitGossipService(api.PeerIdentityType("IDENTITY"), &disabled.Provider{}, "localhost:6611", grpcServer, nil,
 		&naiveCryptoService{}, secAdv, nil)
 	assert.NoError(t, error)
 	gService := GetGossipService().(*gossipServiceImpl)
@@ -30,6 +30,10 @@ func (*transientStoreMock) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*transientStoreMock) PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error {
+	return nil
+}
+
 func (*transientStoreMock) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
@@ -64,6 +64,10 @@ func (*gossipMock) Peers() []discovery.NetworkMember {
 	panic("implement me")
 }
 
+func (*gossipMock) DeadPeers() []discovery.NetworkMember {
+	panic("implement me")
+}
+
 func (*gossipMock) PeersOfChannel(common.ChainID) []discovery.NetworkMember {
 	panic("implement me")
 }
@@ -72,6 +76,11 @@ func (*gossipMock) UpdateMetadata(metadata []byte) {
 	panic("implement me")
 }
 
+// UpdateIdentity replaces the identity of the peer
+func (*gossipMock) UpdateIdentity(identity api.PeerIdentityType) error {
+	panic("implement me")
+}
+
 // UpdateLedgerHeight updates the ledger height the peer
 // publishes to other peers in the channel
 func (*gossipMock) UpdateLedgerHeight(height uint64, chainID common.ChainID) {
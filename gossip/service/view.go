@@ -0,0 +1,87 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package service
+
+import (
+	"sort"
+
+	"github.com/hyperledger/fabric/gossip/api"
+	gossipCommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/discovery"
+	"github.com/spf13/viper"
+)
+
+// View describes the membership and the channels as seen by the gossip service of the peer
+type View struct {
+	// Self is the membership information of the peer
+	Self discovery.NetworkMember
+	// Alive are the members considered alive
+	Alive []discovery.NetworkMember
+	// Dead are the members considered dead
+	Dead []discovery.NetworkMember
+	// Channels are the channels the peer has joined, ordered by name
+	Channels []*ChannelView
+}
+
+// ChannelView describes a channel as seen by the gossip service of the peer
+type ChannelView struct {
+	ChainID string
+	// Self is the membership information of the peer, along with
+	// the ledger height and chaincodes it publishes in the channel
+	Self discovery.NetworkMember
+	// Peers are the alive members of the channel, along with the ledger
+	// height and chaincodes they published in their StateInfo messages
+	Peers []discovery.NetworkMember
+	// LeaderElection is whether the leader of the organization of the peer
+	// is elected dynamically, rather than being configured statically
+	LeaderElection bool
+	// IsLeader is whether the peer is the leader of its organization
+	IsLeader bool
+	// Leader is the PKI-ID of the peer considered the leader of the
+	// organization of the peer, or nil if there is no known leader
+	Leader gossipCommon.PKIidType
+	// AnchorPeers are the anchor peers of the channel by organization
+	AnchorPeers map[string][]api.AnchorPeer
+}
+
+// View returns the membership and the channels as seen by the gossip service
+func (g *gossipServiceImpl) View() *View {
+	self := g.SelfMembershipInfo()
+	view := &View{
+		Self:  self,
+		Alive: g.Peers(),
+		Dead:  g.DeadPeers(),
+	}
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	for chainID := range g.chains {
+		channelView := &ChannelView{
+			ChainID:     chainID,
+			Self:        self,
+			Peers:       g.PeersOfChannel(gossipCommon.ChainID(chainID)),
+			AnchorPeers: g.anchorPeers[chainID],
+		}
+		if stateInfo := g.SelfChannelInfo(gossipCommon.ChainID(chainID)); stateInfo != nil {
+			channelView.Self.Properties = stateInfo.GetStateInfo().Properties
+		}
+		if le, exists := g.leaderElection[chainID]; exists {
+			channelView.LeaderElection = true
+			channelView.IsLeader = le.IsLeader()
+			channelView.Leader = le.Leader()
+		} else if g.deliveryService[chainID] != nil && viper.GetBool("peer.gossip.orgLeader") {
+			channelView.IsLeader = true
+			channelView.Leader = self.PKIid
+		}
+		view.Channels = append(view.Channels, channelView)
+	}
+	sort.Slice(view.Channels, func(i, j int) bool {
+		return view.Channels[i].ChainID < view.Channels[j].ChainID
+	})
+	return view
+}
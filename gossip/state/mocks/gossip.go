@@ -37,6 +37,11 @@ func (g *GossipMock) SuspectPeers(s api.PeerSuspector) {
 	g.Called(s)
 }
 
+// UpdateIdentity replaces the identity of the peer
+func (g *GossipMock) UpdateIdentity(identity api.PeerIdentityType) error {
+	return g.Called(identity).Error(0)
+}
+
 // UpdateLedgerHeight updates the ledger height the peer
 // publishes to other peers in the channel
 func (g *GossipMock) UpdateLedgerHeight(height uint64, chainID common.ChainID) {
@@ -61,6 +66,10 @@ func (g *GossipMock) Peers() []discovery.NetworkMember {
 	return g.Called().Get(0).([]discovery.NetworkMember)
 }
 
+func (g *GossipMock) DeadPeers() []discovery.NetworkMember {
+	return g.Called().Get(0).([]discovery.NetworkMember)
+}
+
 func (g *GossipMock) PeersOfChannel(chainID common.ChainID) []discovery.NetworkMember {
 	args := g.Called(chainID)
 	return args.Get(0).([]discovery.NetworkMember)
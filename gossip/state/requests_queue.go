@@ -0,0 +1,116 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"container/heap"
+	"sync"
+
+	proto "github.com/hyperledger/fabric/protos/gossip"
+)
+
+// stateRequestsQueue is a bounded priority queue of state requests.
+// Requests for recent blocks are dequeued before requests for older
+// blocks, so that peers which lag slightly behind get the blocks they
+// miss before peers which catch up from far behind, and requests for
+// the same blocks are dequeued in the order they were enqueued.
+type stateRequestsQueue struct {
+	lock      sync.Mutex
+	requests  stateRequests
+	capacity  int
+	seq       uint64
+	readyChan chan struct{}
+}
+
+func newStateRequestsQueue(capacity int) *stateRequestsQueue {
+	return &stateRequestsQueue{
+		capacity:  capacity,
+		readyChan: make(chan struct{}, 1),
+	}
+}
+
+// push enqueues the given state request, and returns
+// false if the request was dropped since the queue is full
+func (q *stateRequestsQueue) push(msg proto.ReceivedMessage) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if len(q.requests) >= q.capacity {
+		return false
+	}
+	q.seq++
+	heap.Push(&q.requests, &stateRequest{msg: msg, seq: q.seq})
+	q.signal()
+	return true
+}
+
+// pop dequeues the state request with the highest priority,
+// or returns nil if the queue is empty
+func (q *stateRequestsQueue) pop() proto.ReceivedMessage {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if len(q.requests) == 0 {
+		return nil
+	}
+	r := heap.Pop(&q.requests).(*stateRequest)
+	if len(q.requests) > 0 {
+		q.signal()
+	}
+	return r.msg
+}
+
+// ready returns a channel that is signaled as long as there are enqueued requests
+func (q *stateRequestsQueue) ready() <-chan struct{} {
+	return q.readyChan
+}
+
+func (q *stateRequestsQueue) signal() {
+	select {
+	case q.readyChan <- struct{}{}:
+	default:
+	}
+}
+
+type stateRequest struct {
+	msg proto.ReceivedMessage
+	seq uint64
+}
+
+func (r *stateRequest) startSeqNum() uint64 {
+	return r.msg.GetGossipMessage().GetStateRequest().StartSeqNum
+}
+
+// stateRequests implements heap.Interface
+type stateRequests []*stateRequest
+
+func (rs stateRequests) Len() int {
+	return len(rs)
+}
+
+func (rs stateRequests) Less(i, j int) bool {
+	if rs[i].startSeqNum() != rs[j].startSeqNum() {
+		return rs[i].startSeqNum() > rs[j].startSeqNum()
+	}
+	return rs[i].seq < rs[j].seq
+}
+
+func (rs stateRequests) Swap(i, j int) {
+	rs[i], rs[j] = rs[j], rs[i]
+}
+
+func (rs *stateRequests) Push(x interface{}) {
+	*rs = append(*rs, x.(*stateRequest))
+}
+
+func (rs *stateRequests) Pop() interface{} {
+	old := *rs
+	n := len(old)
+	r := old[n-1]
+	*rs = old[:n-1]
+	return r
+}
@@ -0,0 +1,57 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/gossip/comm"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+)
+
+func stateRequestMsg(nonce, startSeqNum uint64) proto.ReceivedMessage {
+	msg, _ := (&proto.GossipMessage{
+		Nonce: nonce,
+		Content: &proto.GossipMessage_StateRequest{
+			StateRequest: &proto.RemoteStateRequest{StartSeqNum: startSeqNum, EndSeqNum: startSeqNum + 9},
+		},
+	}).NoopSign()
+	return &comm.ReceivedMessageImpl{SignedGossipMessage: msg}
+}
+
+func TestStateRequestsQueue(t *testing.T) {
+	q := newStateRequestsQueue(4)
+	assert.Nil(t, q.pop())
+
+	assert.True(t, q.push(stateRequestMsg(1, 10)))
+	assert.True(t, q.push(stateRequestMsg(2, 500)))
+	assert.True(t, q.push(stateRequestMsg(3, 10)))
+	assert.True(t, q.push(stateRequestMsg(4, 90)))
+	// The queue is full
+	assert.False(t, q.push(stateRequestMsg(5, 1000)))
+
+	// Requests for recent blocks are dequeued first, and requests
+	// for the same blocks are dequeued in the order they were enqueued
+	var nonces []uint64
+	for i := 0; i < 4; i++ {
+		select {
+		case <-q.ready():
+		default:
+			assert.Fail(t, "Queue isn't ready although it has requests")
+		}
+		nonces = append(nonces, q.pop().GetGossipMessage().Nonce)
+	}
+	assert.Equal(t, []uint64{2, 4, 1, 3}, nonces)
+	assert.Nil(t, q.pop())
+
+	select {
+	case <-q.ready():
+		assert.Fail(t, "Queue is ready although it has no requests")
+	default:
+	}
+}
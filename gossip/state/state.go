@@ -138,7 +138,8 @@ type GossipStateProviderImpl struct {
 
 	stateResponseCh chan proto.ReceivedMessage
 
-	stateRequestCh chan proto.ReceivedMessage
+	// Queue of state requests, ordered by the recency of the requested blocks
+	stateRequests *stateRequestsQueue
 
 	stopCh chan struct{}
 
@@ -217,7 +218,7 @@ func NewGossipStateProvider(chainID string, services *ServicesMediator, ledger l
 
 		stateResponseCh: make(chan proto.ReceivedMessage, defChannelBufferSize),
 
-		stateRequestCh: make(chan proto.ReceivedMessage, defChannelBufferSize),
+		stateRequests: newStateRequestsQueue(defChannelBufferSize),
 
 		stopCh: make(chan struct{}, 1),
 
@@ -345,10 +346,10 @@ func (s *GossipStateProviderImpl) directMessage(msg proto.ReceivedMessage) {
 	incoming := msg.GetGossipMessage()
 
 	if incoming.GetStateRequest() != nil {
-		if len(s.stateRequestCh) < defChannelBufferSize {
-			// Forward state request to the channel, if there are too
-			// many message of state request ignore to avoid flooding.
-			s.stateRequestCh <- msg
+		// Enqueue the state request, if there are too many
+		// state requests ignore it to avoid flooding.
+		if !s.stateRequests.push(msg) {
+			logger.Debug("Too many pending state requests, ignoring state request", incoming.GetStateRequest())
 		}
 	} else if incoming.GetStateResponse() != nil {
 		// If no state transfer procedure activate there is
@@ -365,8 +366,8 @@ func (s *GossipStateProviderImpl) processStateRequests() {
 
 	for {
 		select {
-		case msg := <-s.stateRequestCh:
-			s.handleStateRequest(msg)
+		case <-s.stateRequests.ready():
+			s.handleStateRequest(s.stateRequests.pop())
 		case <-s.stopCh:
 			s.stopCh <- struct{}{}
 			return
@@ -498,7 +499,6 @@ func (s *GossipStateProviderImpl) Stop() {
 		s.done.Wait()
 		// Close all resources
 		s.ledger.Close()
-		close(s.stateRequestCh)
 		close(s.stateResponseCh)
 		close(s.stopCh)
 	})
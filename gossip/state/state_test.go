@@ -175,6 +175,10 @@ func (*mockTransientStore) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return nil
 }
 
+func (*mockTransientStore) PurgeKeys(purgedKeys []*transientstore.PurgedKey, maxBlockHeight uint64) error {
+	return nil
+}
+
 func (*mockTransientStore) Persist(txid string, blockHeight uint64, privateSimulationResults *rwset.TxPvtReadWriteSet) error {
 	panic("implement me")
 }
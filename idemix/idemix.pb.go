@@ -32,7 +32,7 @@ func (m *ECP) Reset()         { *m = ECP{} }
 func (m *ECP) String() string { return proto.CompactTextString(m) }
 func (*ECP) ProtoMessage()    {}
 func (*ECP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{0}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{0}
 }
 func (m *ECP) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ECP.Unmarshal(m, b)
@@ -82,7 +82,7 @@ func (m *ECP2) Reset()         { *m = ECP2{} }
 func (m *ECP2) String() string { return proto.CompactTextString(m) }
 func (*ECP2) ProtoMessage()    {}
 func (*ECP2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{1}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{1}
 }
 func (m *ECP2) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ECP2.Unmarshal(m, b)
@@ -155,7 +155,7 @@ func (m *IssuerPublicKey) Reset()         { *m = IssuerPublicKey{} }
 func (m *IssuerPublicKey) String() string { return proto.CompactTextString(m) }
 func (*IssuerPublicKey) ProtoMessage()    {}
 func (*IssuerPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{2}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{2}
 }
 func (m *IssuerPublicKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssuerPublicKey.Unmarshal(m, b)
@@ -260,7 +260,7 @@ func (m *IssuerKey) Reset()         { *m = IssuerKey{} }
 func (m *IssuerKey) String() string { return proto.CompactTextString(m) }
 func (*IssuerKey) ProtoMessage()    {}
 func (*IssuerKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{3}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{3}
 }
 func (m *IssuerKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IssuerKey.Unmarshal(m, b)
@@ -312,7 +312,7 @@ func (m *Credential) Reset()         { *m = Credential{} }
 func (m *Credential) String() string { return proto.CompactTextString(m) }
 func (*Credential) ProtoMessage()    {}
 func (*Credential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{4}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{4}
 }
 func (m *Credential) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Credential.Unmarshal(m, b)
@@ -386,7 +386,7 @@ func (m *CredRequest) Reset()         { *m = CredRequest{} }
 func (m *CredRequest) String() string { return proto.CompactTextString(m) }
 func (*CredRequest) ProtoMessage()    {}
 func (*CredRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{5}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{5}
 }
 func (m *CredRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CredRequest.Unmarshal(m, b)
@@ -467,7 +467,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{6}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{6}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Signature.Unmarshal(m, b)
@@ -619,7 +619,7 @@ func (m *NonRevocationProof) Reset()         { *m = NonRevocationProof{} }
 func (m *NonRevocationProof) String() string { return proto.CompactTextString(m) }
 func (*NonRevocationProof) ProtoMessage()    {}
 func (*NonRevocationProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{7}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{7}
 }
 func (m *NonRevocationProof) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NonRevocationProof.Unmarshal(m, b)
@@ -653,6 +653,57 @@ func (m *NonRevocationProof) GetNonRevocationProof() []byte {
 	return nil
 }
 
+// PlainSigNonRevokedProof is the non-revocation proof of the ALG_PLAIN_SIGNATURE revocation algorithm.
+// It proves knowledge of a weak Boneh-Boyen signature on the (hidden) revocation handle of the credential,
+// valid under the public key of the revocation authority for the current epoch
+type PlainSigNonRevokedProof struct {
+	// sigma_prime is the randomized signature on the revocation handle
+	SigmaPrime *ECP `protobuf:"bytes,1,opt,name=sigma_prime,json=sigmaPrime,proto3" json:"sigma_prime,omitempty"`
+	// proof_s_r is the s-value proving knowledge of the randomness of sigma_prime
+	ProofSR              []byte   `protobuf:"bytes,2,opt,name=proof_s_r,json=proofSR,proto3" json:"proof_s_r,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlainSigNonRevokedProof) Reset()         { *m = PlainSigNonRevokedProof{} }
+func (m *PlainSigNonRevokedProof) String() string { return proto.CompactTextString(m) }
+func (*PlainSigNonRevokedProof) ProtoMessage()    {}
+func (*PlainSigNonRevokedProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{8}
+}
+func (m *PlainSigNonRevokedProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlainSigNonRevokedProof.Unmarshal(m, b)
+}
+func (m *PlainSigNonRevokedProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlainSigNonRevokedProof.Marshal(b, m, deterministic)
+}
+func (dst *PlainSigNonRevokedProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlainSigNonRevokedProof.Merge(dst, src)
+}
+func (m *PlainSigNonRevokedProof) XXX_Size() int {
+	return xxx_messageInfo_PlainSigNonRevokedProof.Size(m)
+}
+func (m *PlainSigNonRevokedProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlainSigNonRevokedProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlainSigNonRevokedProof proto.InternalMessageInfo
+
+func (m *PlainSigNonRevokedProof) GetSigmaPrime() *ECP {
+	if m != nil {
+		return m.SigmaPrime
+	}
+	return nil
+}
+
+func (m *PlainSigNonRevokedProof) GetProofSR() []byte {
+	if m != nil {
+		return m.ProofSR
+	}
+	return nil
+}
+
 // NymSignature specifies a signature object that signs a message
 // with respect to a pseudonym. It differs from the standard idemix.signature in the fact that
 // the  standard signature object also proves that the pseudonym is based on a secret certified by
@@ -676,7 +727,7 @@ func (m *NymSignature) Reset()         { *m = NymSignature{} }
 func (m *NymSignature) String() string { return proto.CompactTextString(m) }
 func (*NymSignature) ProtoMessage()    {}
 func (*NymSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{8}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{9}
 }
 func (m *NymSignature) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NymSignature.Unmarshal(m, b)
@@ -724,6 +775,97 @@ func (m *NymSignature) GetNonce() []byte {
 	return nil
 }
 
+// MessageSignature contains a weak Boneh-Boyen signature on an unrevoked revocation handle,
+// valid under the public key of the revocation authority for a certain epoch
+type MessageSignature struct {
+	// revocation_handle is the unrevoked revocation handle
+	RevocationHandle []byte `protobuf:"bytes,1,opt,name=revocation_handle,json=revocationHandle,proto3" json:"revocation_handle,omitempty"`
+	// rh_sig is the signature on the revocation handle
+	RhSig                *ECP     `protobuf:"bytes,2,opt,name=rh_sig,json=rhSig,proto3" json:"rh_sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageSignature) Reset()         { *m = MessageSignature{} }
+func (m *MessageSignature) String() string { return proto.CompactTextString(m) }
+func (*MessageSignature) ProtoMessage()    {}
+func (*MessageSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{10}
+}
+func (m *MessageSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageSignature.Unmarshal(m, b)
+}
+func (m *MessageSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageSignature.Marshal(b, m, deterministic)
+}
+func (dst *MessageSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSignature.Merge(dst, src)
+}
+func (m *MessageSignature) XXX_Size() int {
+	return xxx_messageInfo_MessageSignature.Size(m)
+}
+func (m *MessageSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageSignature proto.InternalMessageInfo
+
+func (m *MessageSignature) GetRevocationHandle() []byte {
+	if m != nil {
+		return m.RevocationHandle
+	}
+	return nil
+}
+
+func (m *MessageSignature) GetRhSig() *ECP {
+	if m != nil {
+		return m.RhSig
+	}
+	return nil
+}
+
+// PlainSigRevocationData is the revocation data of the ALG_PLAIN_SIGNATURE revocation algorithm,
+// carried in the revocation_data of a CredentialRevocationInformation
+type PlainSigRevocationData struct {
+	// signatures contains a signature on every unrevoked revocation handle
+	Signatures           []*MessageSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PlainSigRevocationData) Reset()         { *m = PlainSigRevocationData{} }
+func (m *PlainSigRevocationData) String() string { return proto.CompactTextString(m) }
+func (*PlainSigRevocationData) ProtoMessage()    {}
+func (*PlainSigRevocationData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{11}
+}
+func (m *PlainSigRevocationData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlainSigRevocationData.Unmarshal(m, b)
+}
+func (m *PlainSigRevocationData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlainSigRevocationData.Marshal(b, m, deterministic)
+}
+func (dst *PlainSigRevocationData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlainSigRevocationData.Merge(dst, src)
+}
+func (m *PlainSigRevocationData) XXX_Size() int {
+	return xxx_messageInfo_PlainSigRevocationData.Size(m)
+}
+func (m *PlainSigRevocationData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlainSigRevocationData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlainSigRevocationData proto.InternalMessageInfo
+
+func (m *PlainSigRevocationData) GetSignatures() []*MessageSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 type CredentialRevocationInformation struct {
 	// epoch contains the epoch (time window) in which this CRI is valid
 	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -744,7 +886,7 @@ func (m *CredentialRevocationInformation) Reset()         { *m = CredentialRevoc
 func (m *CredentialRevocationInformation) String() string { return proto.CompactTextString(m) }
 func (*CredentialRevocationInformation) ProtoMessage()    {}
 func (*CredentialRevocationInformation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_idemix_ea623f6980eee47e, []int{9}
+	return fileDescriptor_idemix_dc101c249e55a2e8, []int{12}
 }
 func (m *CredentialRevocationInformation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CredentialRevocationInformation.Unmarshal(m, b)
@@ -808,63 +950,73 @@ func init() {
 	proto.RegisterType((*CredRequest)(nil), "CredRequest")
 	proto.RegisterType((*Signature)(nil), "Signature")
 	proto.RegisterType((*NonRevocationProof)(nil), "NonRevocationProof")
+	proto.RegisterType((*PlainSigNonRevokedProof)(nil), "PlainSigNonRevokedProof")
 	proto.RegisterType((*NymSignature)(nil), "NymSignature")
+	proto.RegisterType((*MessageSignature)(nil), "MessageSignature")
+	proto.RegisterType((*PlainSigRevocationData)(nil), "PlainSigRevocationData")
 	proto.RegisterType((*CredentialRevocationInformation)(nil), "CredentialRevocationInformation")
 }
 
-func init() { proto.RegisterFile("idemix/idemix.proto", fileDescriptor_idemix_ea623f6980eee47e) }
+func init() { proto.RegisterFile("idemix/idemix.proto", fileDescriptor_idemix_dc101c249e55a2e8) }
 
-var fileDescriptor_idemix_ea623f6980eee47e = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_idemix_dc101c249e55a2e8 = []byte{
+	// 915 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0xd6, 0x60, 0x9b, 0x84, 0x83, 0x13, 0xb2, 0x93, 0xa8, 0x3b, 0xfd, 0x53, 0x59, 0xab, 0xdb,
-	0x8d, 0x7a, 0x41, 0xba, 0x44, 0x7d, 0x80, 0x2c, 0xa5, 0xd5, 0xaa, 0x12, 0x42, 0xe6, 0xae, 0x37,
-	0xa3, 0x19, 0x18, 0xb0, 0x05, 0xb6, 0xe9, 0xd8, 0x74, 0x71, 0x2f, 0xfa, 0x34, 0x7d, 0x9b, 0x5e,
-	0xf4, 0x95, 0xaa, 0xf9, 0x01, 0x0f, 0x61, 0xb7, 0x57, 0xf1, 0x39, 0xdf, 0xf9, 0xe3, 0xfb, 0x3e,
-	0xc7, 0x70, 0x9b, 0x2e, 0x44, 0x96, 0xee, 0x1f, 0xcc, 0x9f, 0xc1, 0x56, 0x16, 0x55, 0x11, 0xbd,
-	0x02, 0x6f, 0x3c, 0x9a, 0xe2, 0x10, 0xd0, 0x9e, 0xa0, 0x3e, 0xba, 0x0f, 0x63, 0xb4, 0x57, 0x51,
-	0x4d, 0x5a, 0x26, 0xaa, 0xa3, 0x9f, 0xc1, 0x1f, 0x8f, 0xa6, 0x43, 0x7c, 0x0d, 0xad, 0x3d, 0xb3,
-	0x45, 0xad, 0x3d, 0xd3, 0x31, 0xb7, 0x65, 0xad, 0x3d, 0x57, 0x71, 0xcd, 0x88, 0x67, 0xe2, 0x5a,
-	0xe3, 0x35, 0x27, 0xbe, 0x8d, 0x79, 0xf4, 0x77, 0x0b, 0x7a, 0xef, 0xcb, 0x72, 0x27, 0xe4, 0x74,
-	0xc7, 0x37, 0xe9, 0xfc, 0x57, 0x51, 0xe3, 0x37, 0xd0, 0x63, 0x55, 0x25, 0x53, 0xbe, 0xab, 0x04,
-	0xcd, 0x59, 0x26, 0x4a, 0x82, 0xfa, 0xde, 0x7d, 0x27, 0xbe, 0x3e, 0xa6, 0x27, 0x2a, 0x8b, 0x5f,
-	0x82, 0x9f, 0xd0, 0x72, 0xad, 0xd7, 0x75, 0x87, 0xfe, 0x60, 0x3c, 0x9a, 0xc6, 0x5e, 0x32, 0x5b,
-	0xe3, 0x2f, 0xa1, 0x9d, 0x50, 0xc9, 0xf2, 0x85, 0xde, 0x7c, 0x80, 0x82, 0x24, 0x66, 0xf9, 0x02,
-	0x7f, 0x0d, 0x17, 0x09, 0x55, 0x93, 0x4a, 0xe2, 0xf7, 0xbd, 0x23, 0xda, 0x4e, 0x9e, 0x54, 0x0e,
-	0xdf, 0x02, 0xfa, 0x40, 0x02, 0xdd, 0x16, 0x28, 0x60, 0x18, 0xa3, 0x0f, 0x6a, 0x20, 0x67, 0x92,
-	0xae, 0xde, 0x92, 0xb6, 0x3b, 0x90, 0x33, 0xf9, 0xcb, 0xdb, 0x23, 0x38, 0x24, 0x17, 0xcf, 0xc1,
-	0x21, 0x7e, 0x09, 0x17, 0x5b, 0x59, 0x14, 0x4b, 0x3a, 0x27, 0x97, 0xfa, 0x57, 0xb7, 0x75, 0x38,
-	0x6a, 0x80, 0x92, 0x74, 0x1c, 0x60, 0x86, 0x31, 0xf8, 0x09, 0x2b, 0x13, 0x02, 0x3a, 0xab, 0x9f,
-	0xa3, 0x27, 0xe8, 0x18, 0x96, 0x14, 0x3f, 0x37, 0xe0, 0xa5, 0xe5, 0xda, 0x92, 0xae, 0x1e, 0x71,
-	0x04, 0x5e, 0xba, 0x3d, 0xf0, 0x70, 0x33, 0x78, 0x46, 0x68, 0xac, 0xc0, 0x68, 0x09, 0x30, 0x92,
-	0x62, 0x21, 0xf2, 0x2a, 0x65, 0x1b, 0x8c, 0x01, 0x19, 0xd9, 0x0e, 0xe7, 0x22, 0xa6, 0x72, 0xfc,
-	0x84, 0x4b, 0xc4, 0x95, 0xea, 0xc2, 0xca, 0x87, 0x84, 0x8a, 0x4a, 0x2b, 0x1e, 0x2a, 0xf1, 0x1d,
-	0x04, 0x86, 0xc6, 0xa0, 0xef, 0xdd, 0x87, 0xb1, 0x09, 0xa2, 0x3f, 0xa1, 0xab, 0xf6, 0xc4, 0xe2,
-	0xf7, 0x9d, 0x28, 0x2b, 0xfc, 0x19, 0x78, 0x79, 0x9d, 0x9d, 0xac, 0x52, 0x09, 0xfc, 0x0a, 0xc2,
-	0x54, 0x9f, 0x49, 0xf3, 0x22, 0x9f, 0x0b, 0x6b, 0x99, 0xae, 0xc9, 0x4d, 0x54, 0xca, 0xa5, 0xce,
-	0xfb, 0x14, 0x75, 0xbe, 0x4b, 0x5d, 0xf4, 0xaf, 0x0f, 0x9d, 0x59, 0xba, 0xca, 0x59, 0xb5, 0x93,
-	0x42, 0x09, 0xcd, 0xe8, 0x56, 0xa6, 0x99, 0x38, 0x59, 0xdf, 0x66, 0x53, 0x95, 0xc3, 0x9f, 0x43,
-	0xc0, 0x28, 0x67, 0xf2, 0xe4, 0x27, 0xfb, 0xec, 0x1d, 0x93, 0xaa, 0x93, 0xdb, 0x4e, 0xd7, 0x40,
-	0x6d, 0x6e, 0x3a, 0x9d, 0xc3, 0xfc, 0x93, 0xc3, 0xbe, 0x02, 0xb0, 0x87, 0x29, 0x5b, 0x06, 0x1a,
-	0xbb, 0x34, 0xb7, 0xcd, 0xd6, 0xf8, 0x0b, 0xe8, 0x1c, 0x50, 0xa1, 0x7d, 0x14, 0xc6, 0x66, 0xce,
-	0x6c, 0xec, 0x76, 0x4a, 0xe3, 0xa3, 0x63, 0x67, 0x3c, 0x3c, 0x41, 0x1f, 0xad, 0x8f, 0x0e, 0xe8,
-	0x23, 0x7e, 0x0d, 0xbd, 0xe3, 0x56, 0x7b, 0xb5, 0x71, 0x54, 0x68, 0x57, 0x9b, 0xab, 0x23, 0xb8,
-	0x3a, 0x94, 0x19, 0xd9, 0x40, 0xcb, 0xd6, 0x35, 0x45, 0xc6, 0xfc, 0x77, 0x10, 0x18, 0x39, 0xba,
-	0x7a, 0x80, 0x09, 0x0e, 0x1a, 0x86, 0xe7, 0x1a, 0x1e, 0x27, 0x4a, 0xaa, 0x2a, 0xae, 0x74, 0x17,
-	0xd8, 0xcb, 0x26, 0x75, 0x86, 0x7f, 0x84, 0x5b, 0x29, 0xfe, 0x28, 0xe6, 0xac, 0x4a, 0x8b, 0x9c,
-	0x8a, 0x6d, 0x31, 0x4f, 0xe8, 0x76, 0x4d, 0xae, 0xdd, 0xf7, 0xeb, 0x45, 0x53, 0x31, 0x56, 0x05,
-	0xd3, 0x35, 0xfe, 0x1e, 0x9c, 0x24, 0xdd, 0xae, 0x69, 0x99, 0xae, 0x48, 0x4f, 0x4f, 0xef, 0x35,
-	0xc0, 0x74, 0x3d, 0x4b, 0x57, 0xea, 0x66, 0x3d, 0x97, 0xdc, 0xf4, 0xd1, 0xbd, 0x17, 0x9b, 0x00,
-	0x8f, 0xe1, 0x2e, 0x2f, 0x72, 0xea, 0x4e, 0x51, 0x57, 0x91, 0x17, 0x7a, 0xf3, 0xed, 0x60, 0x52,
-	0xe4, 0x71, 0x33, 0x48, 0x41, 0x31, 0xce, 0xcf, 0x72, 0x51, 0x06, 0xf8, 0xbc, 0x12, 0xbf, 0x86,
-	0x6b, 0x67, 0x30, 0xdb, 0xac, 0xb4, 0xc1, 0x82, 0xf8, 0xaa, 0xc9, 0x3e, 0x6d, 0x56, 0xf8, 0x87,
-	0x4f, 0xdc, 0x60, 0xbc, 0xfe, 0xb1, 0x75, 0x7f, 0x41, 0x38, 0xa9, 0xb3, 0xc6, 0xc2, 0x8e, 0xd3,
-	0xd0, 0xff, 0x38, 0xad, 0xf5, 0xcc, 0x69, 0x67, 0xc2, 0x78, 0x67, 0xc2, 0x1c, 0x95, 0xf6, 0x1d,
-	0xa5, 0xa3, 0x7f, 0x10, 0x7c, 0xd3, 0xfc, 0x97, 0x68, 0xae, 0x7b, 0x9f, 0x2f, 0x0b, 0x99, 0xe9,
-	0xc7, 0x86, 0x6f, 0xe4, 0xf2, 0xdd, 0x87, 0xcb, 0xa3, 0xba, 0x2d, 0x57, 0xdd, 0x0b, 0x61, 0x35,
-	0xed, 0x43, 0x78, 0xa8, 0xd0, 0x72, 0xda, 0x9b, 0x2c, 0xac, 0x94, 0x3c, 0xa7, 0xd5, 0xff, 0x18,
-	0xad, 0x6f, 0xc0, 0xf1, 0x00, 0x5d, 0xb0, 0x8a, 0xd9, 0x57, 0xcd, 0xe9, 0xfe, 0x89, 0x55, 0xec,
-	0xdd, 0x77, 0xbf, 0x7d, 0xbb, 0x4a, 0xab, 0x64, 0xc7, 0x07, 0xf3, 0x22, 0x7b, 0x48, 0xea, 0xad,
-	0x90, 0x1b, 0xb1, 0x58, 0x09, 0xf9, 0xb0, 0x64, 0x5c, 0xa6, 0x73, 0xfb, 0xd5, 0xe3, 0x6d, 0xfd,
-	0xd9, 0x7b, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xcc, 0x9c, 0x10, 0x0d, 0x07, 0x00, 0x00,
+	0x14, 0x96, 0xb1, 0x4d, 0x92, 0x03, 0x01, 0x32, 0x89, 0x36, 0xd3, 0x3f, 0x95, 0xb5, 0xba, 0xdd,
+	0xa8, 0x95, 0x48, 0x43, 0xd4, 0x07, 0xc8, 0x52, 0xda, 0xae, 0x56, 0x45, 0xc8, 0xdc, 0x55, 0x2b,
+	0x59, 0x63, 0x98, 0xd8, 0x23, 0xb0, 0x4d, 0xc7, 0xa6, 0x8b, 0x7b, 0xd1, 0xa7, 0xe9, 0xdb, 0xf4,
+	0xa2, 0xaf, 0x54, 0xcd, 0x8f, 0xf1, 0x38, 0x64, 0xf7, 0x0a, 0xce, 0xf9, 0xce, 0x9c, 0xf3, 0xf9,
+	0x7c, 0xdf, 0xd8, 0x70, 0xc9, 0x56, 0x34, 0x61, 0xfb, 0x5b, 0xf5, 0x33, 0xda, 0xf2, 0xac, 0xc8,
+	0xbc, 0x97, 0x60, 0x4f, 0x27, 0x73, 0xd4, 0x05, 0x6b, 0x8f, 0xad, 0xa1, 0x75, 0xd3, 0xf5, 0xad,
+	0xbd, 0x88, 0x4a, 0xdc, 0x52, 0x51, 0xe9, 0xfd, 0x0c, 0xce, 0x74, 0x32, 0x1f, 0xa3, 0x1e, 0xb4,
+	0xf6, 0x44, 0x17, 0xb5, 0xf6, 0x44, 0xc6, 0xa1, 0x2e, 0x6b, 0xed, 0x43, 0x11, 0x97, 0x04, 0xdb,
+	0x2a, 0x2e, 0x25, 0x5e, 0x86, 0xd8, 0xd1, 0x71, 0xe8, 0xfd, 0xd3, 0x82, 0xfe, 0xdb, 0x3c, 0xdf,
+	0x51, 0x3e, 0xdf, 0x85, 0x1b, 0xb6, 0x7c, 0x47, 0x4b, 0xf4, 0x1a, 0xfa, 0xa4, 0x28, 0x38, 0x0b,
+	0x77, 0x05, 0x0d, 0x52, 0x92, 0xd0, 0x1c, 0x5b, 0x43, 0xfb, 0xe6, 0xcc, 0xef, 0x1d, 0xd2, 0x33,
+	0x91, 0x45, 0xd7, 0xe0, 0xc4, 0x41, 0xbe, 0x96, 0xe3, 0x3a, 0x63, 0x67, 0x34, 0x9d, 0xcc, 0x7d,
+	0x3b, 0x5e, 0xac, 0xd1, 0x17, 0xd0, 0x8e, 0x03, 0x4e, 0xd2, 0x15, 0xb6, 0x0d, 0xc8, 0x8d, 0x7d,
+	0x92, 0xae, 0xd0, 0x57, 0x70, 0x12, 0x07, 0xa2, 0x53, 0x8e, 0x9d, 0xa1, 0x7d, 0x40, 0xdb, 0xf1,
+	0x83, 0xc8, 0xa1, 0x4b, 0xb0, 0x3e, 0x60, 0x57, 0x1e, 0x73, 0x05, 0x30, 0xf6, 0xad, 0x0f, 0xa2,
+	0x61, 0x48, 0x78, 0x10, 0xdd, 0xe1, 0xb6, 0xd9, 0x30, 0x24, 0xfc, 0x97, 0xbb, 0x03, 0x38, 0xc6,
+	0x27, 0x4f, 0xc1, 0x31, 0xba, 0x86, 0x93, 0x2d, 0xcf, 0xb2, 0xc7, 0x60, 0x89, 0x4f, 0xe5, 0x53,
+	0xb7, 0x65, 0x38, 0xa9, 0x81, 0x1c, 0x9f, 0x19, 0xc0, 0x02, 0x21, 0x70, 0x62, 0x92, 0xc7, 0x18,
+	0x64, 0x56, 0xfe, 0xf7, 0x1e, 0xe0, 0x4c, 0x6d, 0x49, 0xec, 0x67, 0x00, 0x36, 0xcb, 0xd7, 0x7a,
+	0xe9, 0xe2, 0x2f, 0xf2, 0xc0, 0x66, 0xdb, 0x6a, 0x0f, 0x83, 0xd1, 0x93, 0x85, 0xfa, 0x02, 0xf4,
+	0x1e, 0x01, 0x26, 0x9c, 0xae, 0x68, 0x5a, 0x30, 0xb2, 0x41, 0x08, 0x2c, 0x25, 0x5b, 0x45, 0xd7,
+	0x22, 0x22, 0x17, 0x36, 0x76, 0x69, 0x85, 0x42, 0x75, 0xaa, 0xe5, 0xb3, 0xa8, 0x88, 0x72, 0x2d,
+	0x9e, 0x95, 0xa3, 0x2b, 0x70, 0xd5, 0x1a, 0xdd, 0xa1, 0x7d, 0xd3, 0xf5, 0x55, 0xe0, 0xfd, 0x05,
+	0x1d, 0x31, 0xc7, 0xa7, 0x7f, 0xec, 0x68, 0x5e, 0xa0, 0x17, 0x60, 0xa7, 0x65, 0xd2, 0x18, 0x25,
+	0x12, 0xe8, 0x25, 0x74, 0x99, 0xa4, 0x19, 0xa4, 0x59, 0xba, 0xa4, 0xda, 0x32, 0x1d, 0x95, 0x9b,
+	0x89, 0x94, 0xb9, 0x3a, 0xfb, 0x63, 0xab, 0x73, 0xcc, 0xd5, 0x79, 0xff, 0x39, 0x70, 0xb6, 0x60,
+	0x51, 0x4a, 0x8a, 0x1d, 0xa7, 0x42, 0x68, 0x12, 0x6c, 0x39, 0x4b, 0x68, 0x63, 0x7c, 0x9b, 0xcc,
+	0x45, 0x0e, 0x7d, 0x06, 0x2e, 0x09, 0x42, 0xc2, 0x1b, 0x8f, 0xec, 0x90, 0x37, 0x84, 0x8b, 0x93,
+	0xa1, 0x3e, 0x69, 0x1a, 0xa8, 0x1d, 0xaa, 0x93, 0x06, 0x31, 0xa7, 0x41, 0xec, 0x4b, 0x00, 0x4d,
+	0x4c, 0xd8, 0xd2, 0x95, 0xd8, 0xa9, 0xe2, 0xb6, 0x58, 0xa3, 0xcf, 0xe1, 0xac, 0x42, 0xa9, 0xf4,
+	0x51, 0xd7, 0x57, 0x7d, 0x16, 0x53, 0xf3, 0x24, 0x57, 0x3e, 0x3a, 0x9c, 0xf4, 0xc7, 0x0d, 0xf4,
+	0x1e, 0x9f, 0x36, 0xd0, 0x7b, 0xf4, 0x0a, 0xfa, 0x87, 0xa9, 0x9a, 0xb5, 0x72, 0x54, 0x57, 0x8f,
+	0x56, 0xac, 0x3d, 0x38, 0xaf, 0xca, 0x94, 0x6c, 0x20, 0x65, 0xeb, 0xa8, 0x22, 0x65, 0xfe, 0x2b,
+	0x70, 0x95, 0x1c, 0x1d, 0xd9, 0x40, 0x05, 0x95, 0x86, 0xdd, 0x63, 0x0d, 0x0f, 0x1d, 0x79, 0x20,
+	0x2a, 0xce, 0xe5, 0x29, 0xd0, 0xcc, 0x66, 0x65, 0x82, 0x7e, 0x84, 0x4b, 0x4e, 0xff, 0xcc, 0x96,
+	0xa4, 0x60, 0x59, 0x1a, 0xd0, 0x6d, 0xb6, 0x8c, 0x83, 0xed, 0x1a, 0xf7, 0xcc, 0xfb, 0x75, 0x51,
+	0x57, 0x4c, 0x45, 0xc1, 0x7c, 0x8d, 0xbe, 0x03, 0x23, 0x19, 0x6c, 0xd7, 0x41, 0xce, 0x22, 0xdc,
+	0x97, 0xdd, 0xfb, 0x35, 0x30, 0x5f, 0x2f, 0x58, 0x24, 0x38, 0xcb, 0xbe, 0x78, 0x30, 0xb4, 0x6e,
+	0x6c, 0x5f, 0x05, 0x68, 0x0a, 0x57, 0x69, 0x96, 0x06, 0x66, 0x17, 0xc1, 0x0a, 0x5f, 0xc8, 0xc9,
+	0x97, 0xa3, 0x59, 0x96, 0xfa, 0x75, 0x23, 0x01, 0xf9, 0x28, 0x3d, 0xca, 0x79, 0x09, 0xa0, 0xe3,
+	0x4a, 0xf4, 0x0a, 0x7a, 0x46, 0x63, 0xb2, 0x89, 0xa4, 0xc1, 0x5c, 0xff, 0xbc, 0xce, 0x3e, 0x6c,
+	0x22, 0xf4, 0xc3, 0x47, 0x38, 0x28, 0xaf, 0x3f, 0x37, 0xee, 0x3d, 0x5c, 0xcf, 0x37, 0x84, 0xa5,
+	0x0b, 0x16, 0xe9, 0xb1, 0x6b, 0xba, 0xaa, 0x66, 0x76, 0x72, 0x16, 0x25, 0xcf, 0x39, 0x1a, 0x24,
+	0xa0, 0x54, 0x36, 0x4c, 0xc6, 0x71, 0xcb, 0x34, 0x99, 0xef, 0xfd, 0x0d, 0xdd, 0x59, 0x99, 0xd4,
+	0x17, 0xc4, 0xf0, 0xb1, 0xf5, 0x09, 0x1f, 0xb7, 0x9e, 0xf8, 0xf8, 0x48, 0x76, 0xfb, 0x48, 0xf6,
+	0x83, 0x8f, 0x1c, 0xc3, 0x47, 0xde, 0x7b, 0x18, 0xfc, 0x46, 0xf3, 0x9c, 0x44, 0xb4, 0xe6, 0xf0,
+	0x7d, 0x43, 0xe9, 0x98, 0xa4, 0xab, 0x0d, 0xd5, 0x6c, 0x06, 0x35, 0xf0, 0xab, 0xcc, 0x8b, 0x37,
+	0x2d, 0x8f, 0xa5, 0x17, 0xcc, 0x3b, 0xeb, 0xf2, 0x78, 0xc1, 0x22, 0xef, 0x1d, 0xbc, 0xa8, 0x76,
+	0x57, 0xaf, 0xf5, 0x27, 0x52, 0x10, 0x74, 0x07, 0x90, 0x57, 0x03, 0xd5, 0xb7, 0xa4, 0x33, 0xbe,
+	0x18, 0x3d, 0xa5, 0xe2, 0x1b, 0x45, 0xde, 0xbf, 0x16, 0x7c, 0x5d, 0xbf, 0x2e, 0xeb, 0x7e, 0x6f,
+	0xd3, 0xc7, 0x8c, 0x27, 0xf2, 0x6f, 0x6d, 0x3c, 0xcb, 0x34, 0xde, 0x10, 0x4e, 0x0f, 0x36, 0x6f,
+	0x99, 0x36, 0x3f, 0xa1, 0xda, 0xdc, 0x43, 0xe8, 0x56, 0x15, 0xf2, 0x59, 0xf4, 0xfa, 0x34, 0x2c,
+	0x2c, 0x7d, 0xec, 0x2f, 0xe7, 0x39, 0x7f, 0xbd, 0x06, 0xe3, 0x32, 0x04, 0x2b, 0x52, 0x10, 0xfd,
+	0xce, 0xe9, 0xf1, 0xc6, 0x02, 0xde, 0x7c, 0xfb, 0xfb, 0x37, 0x11, 0x2b, 0xe2, 0x5d, 0x38, 0x5a,
+	0x66, 0xc9, 0x6d, 0x5c, 0x6e, 0x29, 0xdf, 0xd0, 0x55, 0x44, 0xf9, 0xed, 0x23, 0x09, 0x39, 0x5b,
+	0xea, 0xcf, 0x7f, 0xd8, 0x96, 0xdf, 0xff, 0xfb, 0xff, 0x07, 0x00, 0x66, 0xd1, 0x01, 0x32, 0x16,
+	0x08, 0x00, 0x00,
 }
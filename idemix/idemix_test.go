@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/stretchr/testify/assert"
 )
@@ -152,6 +153,83 @@ func TestIdemix(t *testing.T) {
 	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
 	assert.NoError(t, err)
 
+	// A signature is only valid in the epoch of its CRI
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch+1)
+	assert.EqualError(t, err, "signature invalid: signature epoch 0 differs from the current epoch 1")
+
+	// unless the epoch is ignored
+	err = sig.VerIgnoringEpoch(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey)
+	assert.NoError(t, err)
+
+	// Test revocation with plain signatures on the unrevoked handles
+	epoch = 1
+	cri, err = CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(7), attrs[rhindex]}, epoch, ALG_PLAIN_SIGNATURE, rng)
+	assert.NoError(t, err)
+	err = VerifyEpochPK(&revocationKey.PublicKey, cri.EpochPk, cri.EpochPkSig, int(cri.Epoch), RevocationAlgorithm(cri.RevocationAlg))
+	assert.NoError(t, err)
+
+	sig, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, disclosure, msg, rhindex, cri, rng)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
+	assert.NoError(t, err)
+
+	// Signatures using revocation are only valid if the epoch is checked
+	err = sig.VerIgnoringEpoch(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey)
+	assert.EqualError(t, err, "signature invalid: revocation algorithm 1 requires the epoch to be checked")
+
+	// The revocation handle must remain hidden
+	_, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, []byte{0, 1, 1, 1, 1}, msg, rhindex, cri, rng)
+	assert.Error(t, err)
+
+	// Tampering with the non-revocation proof makes the signature invalid
+	proofBackup := sig.NonRevocationProof.NonRevocationProof
+	plainSigProof := &PlainSigNonRevokedProof{}
+	assert.NoError(t, proto.Unmarshal(proofBackup, plainSigProof))
+	plainSigProof.ProofSR = BigToBytes(RandModOrder(rng))
+	sig.NonRevocationProof.NonRevocationProof, err = proto.Marshal(plainSigProof)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
+	assert.EqualError(t, err, "signature invalid: zero-knowledge proof is invalid")
+	sig.NonRevocationProof.NonRevocationProof = proofBackup
+
+	// A CRI of the previous epoch, or with an epoch key not signed by the revocation authority, is not accepted
+	noRevocationCri, err := CreateCRI(revocationKey, []*FP256BN.BIG{}, 0, ALG_NO_REVOCATION, rng)
+	assert.NoError(t, err)
+	noRevocationCri.Epoch = int64(epoch)
+	sig, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, disclosure, msg, rhindex, noRevocationCri, rng)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
+	assert.EqualError(t, err, "signature invalid: epoch public key is invalid: EpochPKSig invalid")
+
+	otherRevocationKey, err := GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+	otherCri, err := CreateCRI(otherRevocationKey, []*FP256BN.BIG{attrs[rhindex]}, epoch, ALG_PLAIN_SIGNATURE, rng)
+	assert.NoError(t, err)
+	sig, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, disclosure, msg, rhindex, otherCri, rng)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch)
+	assert.EqualError(t, err, "signature invalid: epoch public key is invalid: EpochPKSig invalid")
+
+	// A revoked handle can't prove that it is unrevoked
+	revokedCri, err := CreateCRI(revocationKey, []*FP256BN.BIG{FP256BN.NewBIGint(7)}, epoch+1, ALG_PLAIN_SIGNATURE, rng)
+	assert.NoError(t, err)
+	_, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, disclosure, msg, rhindex, revokedCri, rng)
+	assert.EqualError(t, err, "failed to compute non-revoked proof: the revocation handle is not among the unrevoked handles of epoch 2")
+
+	// nor with a signature on its handle that is not valid under the epoch key
+	revocationData := &PlainSigRevocationData{
+		Signatures: []*MessageSignature{{
+			RevocationHandle: BigToBytes(attrs[rhindex]),
+			RhSig:            EcpToProto(GenG1.Mul(RandModOrder(rng))),
+		}},
+	}
+	revokedCri.RevocationData, err = proto.Marshal(revocationData)
+	assert.NoError(t, err)
+	sig, err = NewSignature(cred, sk, Nym, RandNym, key.Ipk, disclosure, msg, rhindex, revokedCri, rng)
+	assert.NoError(t, err)
+	err = sig.Ver(disclosure, key.Ipk, msg, attrs, rhindex, &revocationKey.PublicKey, epoch+1)
+	assert.EqualError(t, err, "signature invalid: zero-knowledge proof is invalid")
+
 	// Test NymSignatures
 	nymsig, err := NewNymSignature(sk, Nym, RandNym, key.Ipk, []byte("testing"), rng)
 	assert.NoError(t, err)
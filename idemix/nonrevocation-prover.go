@@ -7,6 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
@@ -34,11 +37,77 @@ func (prover *nopNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRe
 	return ret, nil
 }
 
+// plainSigNonRevokedProver is the nonRevokedProver of the ALG_PLAIN_SIGNATURE revocation algorithm.
+// It proves knowledge of a weak Boneh-Boyen signature sigma = g1^(1/(x+rh)) on the revocation handle rh,
+// valid under the epoch public key W = g2^x, without revealing either of them.
+// To do so, the signature is randomized as sigma' = sigma^r, and the prover proves knowledge of r and rh
+// such that e(sigma', W) = e(g1, g2)^r * e(sigma', g2)^(-rh), where rh is the same (hidden) revocation
+// handle whose knowledge is proven by the credential proof.
+type plainSigNonRevokedProver struct {
+	sigmaPrime *FP256BN.ECP
+	r          *FP256BN.BIG
+	rR         *FP256BN.BIG
+}
+
+func (prover *plainSigNonRevokedProver) getFSContribution(rh *FP256BN.BIG, rRh *FP256BN.BIG, cri *CredentialRevocationInformation, rng *amcl.RAND) ([]byte, error) {
+	revocationData := &PlainSigRevocationData{}
+	if err := proto.Unmarshal(cri.RevocationData, revocationData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revocation data")
+	}
+
+	// look up the signature on the revocation handle
+	rhBytes := BigToBytes(rh)
+	var sigma *FP256BN.ECP
+	for _, sig := range revocationData.Signatures {
+		if bytes.Equal(sig.RevocationHandle, rhBytes) {
+			sigma = EcpFromProto(sig.RhSig)
+			break
+		}
+	}
+	if sigma == nil {
+		return nil, errors.Errorf("the revocation handle is not among the unrevoked handles of epoch %d", cri.Epoch)
+	}
+
+	// randomize the signature
+	prover.r = RandModOrder(rng)
+	prover.sigmaPrime = sigma.Mul(prover.r)
+
+	// compute the commitment value t = e(g1^rR * sigma'^(-rRh), g2)
+	prover.rR = RandModOrder(rng)
+	t := GenG1.Mul(prover.rR)
+	t.Sub(prover.sigmaPrime.Mul(rRh))
+	t.Affine()
+	commitment := FP256BN.Fexp(FP256BN.Ate(GenG2, t))
+
+	data := make([]byte, ProofBytes[ALG_PLAIN_SIGNATURE])
+	index := appendBytesG1(data, 0, prover.sigmaPrime)
+	index = appendBytesG2(data, index, Ecp2FromProto(cri.EpochPk))
+	appendBytesGT(data, index, commitment)
+	return data, nil
+}
+
+func (prover *plainSigNonRevokedProver) getNonRevokedProof(chal *FP256BN.BIG) (*NonRevocationProof, error) {
+	proof, err := proto.Marshal(&PlainSigNonRevokedProof{
+		SigmaPrime: EcpToProto(prover.sigmaPrime),
+		// s_r = rR + C \cdot r
+		ProofSR: BigToBytes(Modadd(prover.rR, FP256BN.Modmul(chal, prover.r, GroupOrder), GroupOrder)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal non-revocation proof")
+	}
+	return &NonRevocationProof{
+		RevocationAlg:      int32(ALG_PLAIN_SIGNATURE),
+		NonRevocationProof: proof,
+	}, nil
+}
+
 // getNonRevocationProver returns the nonRevokedProver bound to the passed revocation algorithm
 func getNonRevocationProver(algorithm RevocationAlgorithm) (nonRevokedProver, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevokedProver{}, nil
+	case ALG_PLAIN_SIGNATURE:
+		return &plainSigNonRevokedProver{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package idemix
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/pkg/errors"
 )
@@ -24,11 +25,45 @@ func (verifier *nopNonRevocationVerifier) recomputeFSContribution(proof *NonRevo
 	return nil, nil
 }
 
+// plainSigNonRevocationVerifier is the nonRevocationVerifier of the ALG_PLAIN_SIGNATURE revocation algorithm
+type plainSigNonRevocationVerifier struct{}
+
+func (verifier *plainSigNonRevocationVerifier) recomputeFSContribution(proof *NonRevocationProof, chal *FP256BN.BIG, epochPK *FP256BN.ECP2, proofSRh *FP256BN.BIG) ([]byte, error) {
+	plainSigProof := &PlainSigNonRevokedProof{}
+	if err := proto.Unmarshal(proof.NonRevocationProof, plainSigProof); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal non-revocation proof")
+	}
+	if plainSigProof.SigmaPrime == nil {
+		return nil, errors.Errorf("non-revocation proof invalid: missing randomized signature")
+	}
+	sigmaPrime := EcpFromProto(plainSigProof.SigmaPrime)
+	if sigmaPrime.Is_infinity() {
+		return nil, errors.Errorf("non-revocation proof invalid: randomized signature = 1")
+	}
+	proofSR := FP256BN.FromBytes(plainSigProof.ProofSR)
+
+	// recompute the commitment value t = e(g1^s_r * sigma'^(-s_rh), g2) * e(sigma', W)^(-C)
+	t := GenG1.Mul(proofSR)
+	t.Sub(sigmaPrime.Mul(proofSRh))
+	t.Affine()
+	sigmaPrimeC := sigmaPrime.Mul(FP256BN.Modneg(chal, GroupOrder))
+	sigmaPrimeC.Affine()
+	commitment := FP256BN.Fexp(FP256BN.Ate2(GenG2, t, epochPK, sigmaPrimeC))
+
+	data := make([]byte, ProofBytes[ALG_PLAIN_SIGNATURE])
+	index := appendBytesG1(data, 0, sigmaPrime)
+	index = appendBytesG2(data, index, epochPK)
+	appendBytesGT(data, index, commitment)
+	return data, nil
+}
+
 // getNonRevocationVerifier returns the nonRevocationVerifier bound to the passed revocation algorithm
 func getNonRevocationVerifier(algorithm RevocationAlgorithm) (nonRevocationVerifier, error) {
 	switch algorithm {
 	case ALG_NO_REVOCATION:
 		return &nopNonRevocationVerifier{}, nil
+	case ALG_PLAIN_SIGNATURE:
+		return &plainSigNonRevocationVerifier{}, nil
 	default:
 		// unknown revocation algorithm
 		return nil, errors.Errorf("unknown revocation algorithm %d", algorithm)
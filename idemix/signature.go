@@ -243,7 +243,19 @@ func NewSignature(cred *Credential, sk *FP256BN.BIG, Nym *FP256BN.ECP, RNym *FP2
 // Disclosure steers which attributes it expects to be disclosed
 // attributeValues contains the desired attribute values.
 // This function will check that if attribute i is disclosed, the i-th attribute equals attributeValues[i].
+// It also checks that the signature was produced against the revocation information of the passed epoch.
 func (sig *Signature) Ver(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, attributeValues []*FP256BN.BIG, rhIndex int, revPk *ecdsa.PublicKey, epoch int) error {
+	return sig.ver(Disclosure, ipk, msg, attributeValues, rhIndex, revPk, epoch, true)
+}
+
+// VerIgnoringEpoch verifies an idemix signature like Ver, except that it does not check the epoch
+// of the revocation information the signature was produced against. Since revocation is only
+// enforced through the epoch, it only accepts signatures that do not use revocation.
+func (sig *Signature) VerIgnoringEpoch(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, attributeValues []*FP256BN.BIG, rhIndex int, revPk *ecdsa.PublicKey) error {
+	return sig.ver(Disclosure, ipk, msg, attributeValues, rhIndex, revPk, 0, false)
+}
+
+func (sig *Signature) ver(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, attributeValues []*FP256BN.BIG, rhIndex int, revPk *ecdsa.PublicKey, epoch int, checkEpoch bool) error {
 	// Validate inputs
 	if ipk == nil || revPk == nil {
 		return errors.Errorf("cannot verify idemix signature: received nil input")
@@ -257,6 +269,20 @@ func (sig *Signature) Ver(Disclosure []byte, ipk *IssuerPublicKey, msg []byte, a
 		return errors.Errorf("Attribute %d is disclosed but is also used as revocation handle, which should remain hidden.", rhIndex)
 	}
 
+	if checkEpoch {
+		// Check that the signature was produced against the revocation information of the current epoch,
+		// so that credentials revoked since a previous epoch cannot be used anymore
+		if int(sig.Epoch) != epoch {
+			return errors.Errorf("signature invalid: signature epoch %d differs from the current epoch %d", sig.Epoch, epoch)
+		}
+		err := VerifyEpochPK(revPk, sig.RevocationEpochPk, sig.RevocationPkSig, epoch, RevocationAlgorithm(sig.NonRevocationProof.RevocationAlg))
+		if err != nil {
+			return errors.WithMessage(err, "signature invalid: epoch public key is invalid")
+		}
+	} else if sig.NonRevocationProof.RevocationAlg != int32(ALG_NO_REVOCATION) {
+		return errors.Errorf("signature invalid: revocation algorithm %d requires the epoch to be checked", sig.NonRevocationProof.RevocationAlg)
+	}
+
 	HiddenIndices := hiddenIndices(Disclosure)
 
 	// Parse signature
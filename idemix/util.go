@@ -71,6 +71,11 @@ func appendBytesG2(data []byte, index int, E *FP256BN.ECP2) int {
 	E.ToBytes(data[index : index+length])
 	return index + length
 }
+func appendBytesGT(data []byte, index int, E *FP256BN.FP12) int {
+	length := 12 * FieldBytes
+	E.ToBytes(data[index : index+length])
+	return index + length
+}
 func appendBytesBig(data []byte, index int, B *FP256BN.BIG) int {
 	length := FieldBytes
 	B.ToBytes(data[index : index+length])
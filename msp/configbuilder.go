@@ -16,6 +16,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
 	"github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
@@ -360,29 +361,116 @@ func getMspConfig(dir string, ID string, sigid *msp.SigningIdentityInfo) (*msp.M
 const (
 	IdemixConfigDirMsp                  = "msp"
 	IdemixConfigDirUser                 = "user"
+	IdemixConfigDirIssuers              = "issuers"
 	IdemixConfigFileIssuerPublicKey     = "IssuerPublicKey"
 	IdemixConfigFileRevocationPublicKey = "RevocationPublicKey"
 	IdemixConfigFileSigner              = "SignerConfig"
+	IdemixConfigFileRevocationInfo      = "CredentialRevocationInformation"
+	IdemixConfigFileIssuerValidity      = "IssuerValidity"
 )
 
-// GetIdemixMspConfig returns the configuration for the Idemix MSP
-func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
-	ipkBytes, err := readFile(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigFileIssuerPublicKey))
+// IdemixIssuerValidity is used to represent the window of epochs
+// in which the Idemix MSP accepts the credentials of an issuer
+type IdemixIssuerValidity struct {
+	// FirstEpoch is the first epoch in which the credentials are accepted
+	FirstEpoch int64 `yaml:"FirstEpoch,omitempty"`
+	// ExpirationEpoch is the first epoch in which the credentials
+	// are no longer accepted, or 0 if they never expire
+	ExpirationEpoch int64 `yaml:"ExpirationEpoch,omitempty"`
+}
+
+// getIdemixIssuerConfig returns the configuration of the issuer in the given directory
+func getIdemixIssuerConfig(dir string) (*msp.IdemixMSPIssuerConfig, error) {
+	ipkBytes, err := readFile(filepath.Join(dir, IdemixConfigFileIssuerPublicKey))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to read issuer public key file")
 	}
 
-	revocationPkBytes, err := readFile(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigFileRevocationPublicKey))
+	revocationPkBytes, err := readFile(filepath.Join(dir, IdemixConfigFileRevocationPublicKey))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to read revocation public key file")
 	}
 
-	idemixConfig := &msp.IdemixMSPConfig{
-		Name:         ID,
+	issuerConfig := &msp.IdemixMSPIssuerConfig{
 		Ipk:          ipkBytes,
 		RevocationPk: revocationPkBytes,
 	}
 
+	// The credential revocation information of the current epoch is optional
+	criBytes, err := readFile(filepath.Join(dir, IdemixConfigFileRevocationInfo))
+	if err == nil {
+		issuerConfig.CredentialRevocationInformation = criBytes
+	}
+
+	// The validity is optional, without it the credentials are accepted in every epoch
+	validityBytes, err := readFile(filepath.Join(dir, IdemixConfigFileIssuerValidity))
+	if err == nil {
+		validity := &IdemixIssuerValidity{}
+		err = yaml.Unmarshal(validityBytes, validity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal issuer validity")
+		}
+		issuerConfig.FirstEpoch = validity.FirstEpoch
+		issuerConfig.ExpirationEpoch = validity.ExpirationEpoch
+	}
+
+	return issuerConfig, nil
+}
+
+// GetIdemixMspConfig returns the configuration for the Idemix MSP
+func GetIdemixMspConfig(dir string, ID string) (*msp.MSPConfig, error) {
+	// The issuers of the credentials are the one in the msp directory, and the
+	// ones in its issuers directory. The former may be omitted only if there are
+	// some of the latter.
+	var issuers []*msp.IdemixMSPIssuerConfig
+	issuerDirs, err := ioutil.ReadDir(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigDirIssuers))
+	if err != nil {
+		issuerDirs = nil
+	}
+	_, err = os.Stat(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigFileIssuerPublicKey))
+	if err == nil || len(issuerDirs) == 0 {
+		issuer, err := getIdemixIssuerConfig(filepath.Join(dir, IdemixConfigDirMsp))
+		if err != nil {
+			return nil, err
+		}
+		issuers = append(issuers, issuer)
+	}
+	for _, issuerDir := range issuerDirs {
+		if !issuerDir.IsDir() {
+			continue
+		}
+		issuer, err := getIdemixIssuerConfig(filepath.Join(dir, IdemixConfigDirMsp, IdemixConfigDirIssuers, issuerDir.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read issuer %s", issuerDir.Name())
+		}
+		issuers = append(issuers, issuer)
+	}
+
+	idemixConfig := &msp.IdemixMSPConfig{Name: ID}
+	if len(issuers) == 1 && issuers[0].FirstEpoch == 0 && issuers[0].ExpirationEpoch == 0 {
+		idemixConfig.Ipk = issuers[0].Ipk
+		idemixConfig.RevocationPk = issuers[0].RevocationPk
+		idemixConfig.CredentialRevocationInformation = issuers[0].CredentialRevocationInformation
+	} else {
+		idemixConfig.Issuers = issuers
+	}
+
+	// The MSP is set up at the latest epoch of the credential revocation information,
+	// or at epoch 0 without any
+	for _, issuer := range issuers {
+		if len(issuer.CredentialRevocationInformation) == 0 {
+			continue
+		}
+		cri := &idemix.CredentialRevocationInformation{}
+		err = proto.Unmarshal(issuer.CredentialRevocationInformation, cri)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal credential revocation information")
+		}
+		if cri.Epoch > idemixConfig.Epoch {
+			idemixConfig.Epoch = cri.Epoch
+		}
+	}
+
 	signerBytes, err := readFile(filepath.Join(dir, IdemixConfigDirUser, IdemixConfigFileSigner))
 	if err == nil {
 		signerConfig := &msp.IdemixMSPSignerConfig{}
@@ -16,6 +16,7 @@ const (
 	MSPv1_0 = iota
 	MSPv1_1
 	MSPv1_3
+	MSPv1_4_3
 )
 
 // NewOpts represent
@@ -27,6 +28,11 @@ type NewOpts interface {
 // NewBaseOpts is the default base type for all MSP instantiation Opts
 type NewBaseOpts struct {
 	Version MSPVersion
+
+	// Local is true for the local MSP of a node. The local MSP does not need to
+	// agree with the MSPs of other nodes on the identities it accepts, hence it
+	// supports the features gated behind newer MSP versions regardless of Version
+	Local bool
 }
 
 func (o *NewBaseOpts) GetVersion() MSPVersion {
@@ -47,25 +53,43 @@ type IdemixNewOpts struct {
 func New(opts NewOpts) (MSP, error) {
 	switch opts.(type) {
 	case *BCCSPNewOpts:
+		var mspInst MSP
+		var err error
 		switch opts.GetVersion() {
 		case MSPv1_0:
-			return newBccspMsp(MSPv1_0)
+			mspInst, err = newBccspMsp(MSPv1_0)
 		case MSPv1_1:
-			return newBccspMsp(MSPv1_1)
+			mspInst, err = newBccspMsp(MSPv1_1)
 		case MSPv1_3:
-			return newBccspMsp(MSPv1_3)
+			mspInst, err = newBccspMsp(MSPv1_3)
+		case MSPv1_4_3:
+			mspInst, err = newBccspMsp(MSPv1_4_3)
 		default:
 			return nil, errors.Errorf("Invalid *BCCSPNewOpts. Version not recognized [%v]", opts.GetVersion())
 		}
+		if err != nil {
+			return nil, err
+		}
+		mspInst.(*bccspmsp).local = opts.(*BCCSPNewOpts).Local
+		return mspInst, nil
 	case *IdemixNewOpts:
+		var mspInst MSP
+		var err error
 		switch opts.GetVersion() {
+		case MSPv1_4_3:
+			mspInst, err = newIdemixMsp(MSPv1_4_3)
 		case MSPv1_3:
-			return newIdemixMsp(MSPv1_3)
+			mspInst, err = newIdemixMsp(MSPv1_3)
 		case MSPv1_1:
-			return newIdemixMsp(MSPv1_1)
+			mspInst, err = newIdemixMsp(MSPv1_1)
 		default:
 			return nil, errors.Errorf("Invalid *IdemixNewOpts. Version not recognized [%v]", opts.GetVersion())
 		}
+		if err != nil {
+			return nil, err
+		}
+		mspInst.(*idemixmsp).local = opts.(*IdemixNewOpts).Local
+		return mspInst, nil
 	default:
 		return nil, errors.Errorf("Invalid msp.NewOpts instance. It must be either *BCCSPNewOpts or *IdemixNewOpts. It was [%v]", opts)
 	}
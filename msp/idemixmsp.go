@@ -16,6 +16,7 @@ import (
 	"github.com/hyperledger/fabric/bccsp"
 	idemixbccsp "github.com/hyperledger/fabric/bccsp/idemix"
 	"github.com/hyperledger/fabric/bccsp/sw"
+	"github.com/hyperledger/fabric/idemix"
 	m "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
@@ -58,13 +59,29 @@ const rhIndex = 3
 var discloseFlags = []byte{1, 1, 0, 0}
 
 type idemixmsp struct {
-	csp          bccsp.BCCSP
-	version      MSPVersion
+	csp     bccsp.BCCSP
+	version MSPVersion
+	// local is true if this is the local msp of the node
+	local  bool
+	signer *idemixSigningIdentity
+	name   string
+	epoch  int
+	// issuers contains the issuers whose credentials are accepted in the current epoch
+	issuers []*idemixIssuer
+}
+
+// idemixIssuer holds the key material of an issuer of idemix credentials
+type idemixIssuer struct {
 	ipk          bccsp.Key
-	signer       *idemixSigningIdentity
-	name         string
 	revocationPK bccsp.Key
-	epoch        int
+	// cri is the credential revocation information of the current epoch, if any
+	cri []byte
+	// epoch is the epoch of cri, which may lag behind the epoch of the MSP
+	// while the issuers publish the revocation information of a new epoch
+	epoch int
+	// attributeNames contains the names of the attributes that the issuer
+	// certifies in addition to the four attributes above
+	attributeNames []string
 }
 
 // newIdemixMsp creates a new instance of idemixmsp
@@ -99,51 +116,39 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	}
 
 	msp.name = conf.Name
+	if !msp.epochsSupported() && (conf.Epoch != 0 || len(conf.CredentialRevocationInformation) != 0) {
+		return errors.Errorf("revocation epochs are unsupported in MSP versions prior to MSPv1_4_3")
+	}
+	msp.epoch = int(conf.Epoch)
 	mspLogger.Debugf("Setting up Idemix MSP instance %s", msp.name)
 
-	// Import Issuer Public Key
-	IssuerPublicKey, err := msp.csp.KeyImport(
-		conf.Ipk,
-		&bccsp.IdemixIssuerPublicKeyImportOpts{
-			Temporary: true,
-			AttributeNames: []string{
-				AttributeNameOU,
-				AttributeNameRole,
-				AttributeNameEnrollmentId,
-				AttributeNameRevocationHandle,
-			},
-		})
-	if err != nil {
-		importErr, ok := errors.Cause(err).(*bccsp.IdemixIssuerPublicKeyImporterError)
-		if !ok {
-			panic("unexpected condition, BCCSP did not return the expected *bccsp.IdemixIssuerPublicKeyImporterError")
+	// Set up the issuers whose credentials are accepted in the current epoch
+	if !msp.epochsSupported() && len(conf.Issuers) != 0 {
+		return errors.Errorf("multiple issuers are unsupported in MSP versions prior to MSPv1_4_3")
+	}
+	issuerConfigs := conf.Issuers
+	if len(conf.Ipk) != 0 || len(conf.Issuers) == 0 {
+		issuerConfigs = append([]*m.IdemixMSPIssuerConfig{{
+			Ipk:                             conf.Ipk,
+			RevocationPk:                    conf.RevocationPk,
+			CredentialRevocationInformation: conf.CredentialRevocationInformation,
+		}}, issuerConfigs...)
+	}
+	msp.issuers = nil
+	for i, issuerConfig := range issuerConfigs {
+		if int64(msp.epoch) < issuerConfig.FirstEpoch || (issuerConfig.ExpirationEpoch != 0 && int64(msp.epoch) >= issuerConfig.ExpirationEpoch) {
+			mspLogger.Debugf("Skipping issuer %d of Idemix MSP instance %s, its credentials are not accepted in epoch %d", i, msp.name, msp.epoch)
+			continue
 		}
-		switch importErr.Type {
-		case bccsp.IdemixIssuerPublicKeyImporterUnmarshallingError:
-			return errors.WithMessage(err, "failed to unmarshal ipk from idemix msp config")
-		case bccsp.IdemixIssuerPublicKeyImporterHashError:
-			return errors.WithMessage(err, "setting the hash of the issuer public key failed")
-		case bccsp.IdemixIssuerPublicKeyImporterValidationError:
-			return errors.WithMessage(err, "cannot setup idemix msp with invalid public key")
-		case bccsp.IdemixIssuerPublicKeyImporterNumAttributesError:
-			fallthrough
-		case bccsp.IdemixIssuerPublicKeyImporterAttributeNameError:
-			return errors.Errorf("issuer public key must have have attributes OU, Role, EnrollmentId, and RevocationHandle")
-		default:
-			panic(fmt.Sprintf("unexpected condtion, issuer public key import error not valid, got [%d]", importErr.Type))
+		issuer, err := msp.setupIssuer(issuerConfig)
+		if err != nil {
+			return err
 		}
+		msp.issuers = append(msp.issuers, issuer)
 	}
-	msp.ipk = IssuerPublicKey
-
-	// Import revocation public key
-	RevocationPublicKey, err := msp.csp.KeyImport(
-		conf.RevocationPk,
-		&bccsp.IdemixRevocationPublicKeyImportOpts{Temporary: true},
-	)
-	if err != nil {
-		return errors.WithMessage(err, "failed to import revocation public key")
+	if len(msp.issuers) == 0 {
+		return errors.Errorf("no issuer is valid in epoch %d", msp.epoch)
 	}
-	msp.revocationPK = RevocationPublicKey
 
 	if conf.Signer == nil {
 		// No credential in config, so we don't setup a default signer
@@ -159,8 +164,59 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		return errors.WithMessage(err, "failed importing signer secret key")
 	}
 
+	role := &m.MSPRole{
+		MspIdentifier: msp.name,
+		Role:          m.MSPRole_MEMBER,
+	}
+	if checkRole(int(conf.Signer.Role), ADMIN) {
+		role.Role = m.MSPRole_ADMIN
+	}
+
+	enrollmentId := conf.Signer.EnrollmentId
+
+	// Find the issuer of the credential
+	var issuer *idemixIssuer
+	var disclosedAttributes []*m.IdemixAttribute
+	var additionalAttributes []bccsp.IdemixAttribute
+	for _, candidate := range msp.issuers {
+		// The attributes in addition to OU and Role which the signer discloses
+		disclosedAttributes, err = candidate.sortAttributes(conf.Signer.DisclosedAttributes)
+		if err != nil {
+			err = errors.WithMessage(err, "invalid disclosed attributes of the signer")
+			continue
+		}
+		additionalAttributes = candidate.additionalAttributes(disclosedAttributes)
+
+		// Verify credential
+		var valid bool
+		valid, err = msp.csp.Verify(
+			UserKey,
+			conf.Signer.Cred,
+			nil,
+			&bccsp.IdemixCredentialSignerOpts{
+				IssuerPK: candidate.ipk,
+				Attributes: append([]bccsp.IdemixAttribute{
+					{Type: bccsp.IdemixBytesAttribute, Value: []byte(conf.Signer.OrganizationalUnitIdentifier)},
+					{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
+					{Type: bccsp.IdemixBytesAttribute, Value: []byte(enrollmentId)},
+					{Type: bccsp.IdemixHiddenAttribute},
+				}, additionalAttributes...),
+			},
+		)
+		if err == nil && valid {
+			issuer = candidate
+			break
+		}
+	}
+	if issuer == nil {
+		if err == nil {
+			return errors.New("Credential is not cryptographically valid")
+		}
+		return errors.WithMessage(err, "Credential is not cryptographically valid")
+	}
+
 	// Derive NymPublicKey
-	NymKey, err := msp.csp.KeyDeriv(UserKey, &bccsp.IdemixNymKeyDerivationOpts{Temporary: true, IssuerPK: IssuerPublicKey})
+	NymKey, err := msp.csp.KeyDeriv(UserKey, &bccsp.IdemixNymKeyDerivationOpts{Temporary: true, IssuerPK: issuer.ipk})
 	if err != nil {
 		return errors.WithMessage(err, "failed deriving nym")
 	}
@@ -169,39 +225,17 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		return errors.Wrapf(err, "failed getting public nym key")
 	}
 
-	role := &m.MSPRole{
-		MspIdentifier: msp.name,
-		Role:          m.MSPRole_MEMBER,
-	}
-	if checkRole(int(conf.Signer.Role), ADMIN) {
-		role.Role = m.MSPRole_ADMIN
-	}
-
 	ou := &m.OrganizationUnit{
 		MspIdentifier:                msp.name,
 		OrganizationalUnitIdentifier: conf.Signer.OrganizationalUnitIdentifier,
-		CertifiersIdentifier:         IssuerPublicKey.SKI(),
+		CertifiersIdentifier:         issuer.ipk.SKI(),
 	}
 
-	enrollmentId := conf.Signer.EnrollmentId
-
-	// Verify credential
-	valid, err := msp.csp.Verify(
-		UserKey,
-		conf.Signer.Cred,
-		nil,
-		&bccsp.IdemixCredentialSignerOpts{
-			IssuerPK: IssuerPublicKey,
-			Attributes: []bccsp.IdemixAttribute{
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(conf.Signer.OrganizationalUnitIdentifier)},
-				{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(role)},
-				{Type: bccsp.IdemixBytesAttribute, Value: []byte(enrollmentId)},
-				{Type: bccsp.IdemixHiddenAttribute},
-			},
-		},
-	)
-	if err != nil || !valid {
-		return errors.WithMessage(err, "Credential is not cryptographically valid")
+	// The credential revocation information of the current epoch in the MSP config
+	// takes precedence over the one of the signer
+	cri := issuer.cri
+	if len(cri) == 0 {
+		cri = conf.Signer.CredentialRevocationInformation
 	}
 
 	// Create the cryptographic evidence that this identity is valid
@@ -211,15 +245,16 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 		&bccsp.IdemixSignerOpts{
 			Credential: conf.Signer.Cred,
 			Nym:        NymKey,
-			IssuerPK:   IssuerPublicKey,
-			Attributes: []bccsp.IdemixAttribute{
+			IssuerPK:   issuer.ipk,
+			Attributes: append([]bccsp.IdemixAttribute{
 				{Type: bccsp.IdemixBytesAttribute},
 				{Type: bccsp.IdemixIntAttribute},
 				{Type: bccsp.IdemixHiddenAttribute},
 				{Type: bccsp.IdemixHiddenAttribute},
-			},
+			}, additionalAttributes...),
 			RhIndex: rhIndex,
-			CRI:     conf.Signer.CredentialRevocationInformation,
+			Epoch:   issuer.epoch,
+			CRI:     cri,
 		},
 	)
 	if err != nil {
@@ -228,7 +263,7 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 
 	// Set up default signer
 	msp.signer = &idemixSigningIdentity{
-		idemixidentity: newIdemixIdentity(msp, NymPublicKey, role, ou, proof),
+		idemixidentity: newIdemixIdentity(msp, issuer, NymPublicKey, role, ou, disclosedAttributes, proof),
 		Cred:           conf.Signer.Cred,
 		UserKey:        UserKey,
 		NymKey:         NymKey,
@@ -237,6 +272,149 @@ func (msp *idemixmsp) Setup(conf1 *m.MSPConfig) error {
 	return nil
 }
 
+// setupIssuer imports the key material of the given issuer
+func (msp *idemixmsp) setupIssuer(conf *m.IdemixMSPIssuerConfig) (*idemixIssuer, error) {
+	issuer := &idemixIssuer{}
+
+	// The issuer may certify attributes in addition to the four attributes every
+	// idemix credential has, and these must follow them in the issuer public key
+	attributeNames := []string{
+		AttributeNameOU,
+		AttributeNameRole,
+		AttributeNameEnrollmentId,
+		AttributeNameRevocationHandle,
+	}
+	ipk := &idemix.IssuerPublicKey{}
+	if err := proto.Unmarshal(conf.Ipk, ipk); err == nil && len(ipk.AttributeNames) > len(attributeNames) {
+		issuer.attributeNames = ipk.AttributeNames[len(attributeNames):]
+		attributeNames = append(attributeNames, issuer.attributeNames...)
+	}
+
+	// Import Issuer Public Key
+	IssuerPublicKey, err := msp.csp.KeyImport(
+		conf.Ipk,
+		&bccsp.IdemixIssuerPublicKeyImportOpts{
+			Temporary:      true,
+			AttributeNames: attributeNames,
+		})
+	if err != nil {
+		importErr, ok := errors.Cause(err).(*bccsp.IdemixIssuerPublicKeyImporterError)
+		if !ok {
+			panic("unexpected condition, BCCSP did not return the expected *bccsp.IdemixIssuerPublicKeyImporterError")
+		}
+		switch importErr.Type {
+		case bccsp.IdemixIssuerPublicKeyImporterUnmarshallingError:
+			return nil, errors.WithMessage(err, "failed to unmarshal ipk from idemix msp config")
+		case bccsp.IdemixIssuerPublicKeyImporterHashError:
+			return nil, errors.WithMessage(err, "setting the hash of the issuer public key failed")
+		case bccsp.IdemixIssuerPublicKeyImporterValidationError:
+			return nil, errors.WithMessage(err, "cannot setup idemix msp with invalid public key")
+		case bccsp.IdemixIssuerPublicKeyImporterNumAttributesError:
+			fallthrough
+		case bccsp.IdemixIssuerPublicKeyImporterAttributeNameError:
+			return nil, errors.Errorf("issuer public key must have have attributes OU, Role, EnrollmentId, and RevocationHandle")
+		default:
+			panic(fmt.Sprintf("unexpected condtion, issuer public key import error not valid, got [%d]", importErr.Type))
+		}
+	}
+	issuer.ipk = IssuerPublicKey
+
+	// Import revocation public key
+	RevocationPublicKey, err := msp.csp.KeyImport(
+		conf.RevocationPk,
+		&bccsp.IdemixRevocationPublicKeyImportOpts{Temporary: true},
+	)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to import revocation public key")
+	}
+	issuer.revocationPK = RevocationPublicKey
+
+	// Verify the credential revocation information of the current epoch, if any.
+	// The issuers publish it independently, hence it may lag behind the epoch of the MSP
+	issuer.epoch = msp.epoch
+	if len(conf.CredentialRevocationInformation) != 0 {
+		cri := &idemix.CredentialRevocationInformation{}
+		if err := proto.Unmarshal(conf.CredentialRevocationInformation, cri); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal credential revocation information")
+		}
+		if cri.Epoch > int64(msp.epoch) {
+			return nil, errors.Errorf("credential revocation information of epoch %d is ahead of the epoch %d of the MSP", cri.Epoch, msp.epoch)
+		}
+		issuer.epoch = int(cri.Epoch)
+
+		valid, err := msp.csp.Verify(RevocationPublicKey, conf.CredentialRevocationInformation, nil, &bccsp.IdemixCRISignerOpts{Epoch: issuer.epoch})
+		if err != nil {
+			return nil, errors.WithMessage(err, "credential revocation information is not valid")
+		}
+		if !valid {
+			return nil, errors.New("credential revocation information is not valid")
+		}
+		issuer.cri = conf.CredentialRevocationInformation
+	}
+
+	return issuer, nil
+}
+
+// epochsSupported returns true if this MSP checks that the signatures of the identities
+// were produced against the credential revocation information of its epoch. MSP versions
+// prior to MSPv1_4_3 always set up at epoch 0 and ignore the epoch of the signatures,
+// hence they do not support revocation
+func (msp *idemixmsp) epochsSupported() bool {
+	return msp.version >= MSPv1_4_3 || msp.local
+}
+
+// issuerOf returns the issuer whose public key has the given identifier
+func (msp *idemixmsp) issuerOf(certifiersIdentifier []byte) (*idemixIssuer, error) {
+	for _, issuer := range msp.issuers {
+		if bytes.Equal(issuer.ipk.SKI(), certifiersIdentifier) {
+			return issuer, nil
+		}
+	}
+	return nil, errors.Errorf("the issuer %s is not among the issuers valid in epoch %d", hex.EncodeToString(certifiersIdentifier), msp.epoch)
+}
+
+// sortAttributes checks that the given attributes are among the additional attributes
+// certified by the issuer, and returns them in the order in which the issuer certifies them
+func (issuer *idemixIssuer) sortAttributes(attributes []*m.IdemixAttribute) ([]*m.IdemixAttribute, error) {
+	byName := make(map[string]*m.IdemixAttribute, len(attributes))
+	for _, attr := range attributes {
+		if _, exists := byName[attr.Name]; exists {
+			return nil, errors.Errorf("attribute %s is disclosed more than once", attr.Name)
+		}
+		byName[attr.Name] = attr
+	}
+
+	var sorted []*m.IdemixAttribute
+	for _, name := range issuer.attributeNames {
+		if attr, exists := byName[name]; exists {
+			sorted = append(sorted, attr)
+			delete(byName, name)
+		}
+	}
+	for _, attr := range attributes {
+		if _, exists := byName[attr.Name]; exists {
+			return nil, errors.Errorf("attribute %s is not certified by the issuer", attr.Name)
+		}
+	}
+
+	return sorted, nil
+}
+
+// additionalAttributes returns the idemix attributes that follow the four attributes every
+// credential has, disclosing the given (sorted) attributes and hiding the others
+func (issuer *idemixIssuer) additionalAttributes(disclosed []*m.IdemixAttribute) []bccsp.IdemixAttribute {
+	attributes := make([]bccsp.IdemixAttribute, len(issuer.attributeNames))
+	for i, name := range issuer.attributeNames {
+		if len(disclosed) != 0 && disclosed[0].Name == name {
+			attributes[i] = bccsp.IdemixAttribute{Type: bccsp.IdemixBytesAttribute, Value: []byte(disclosed[0].Value)}
+			disclosed = disclosed[1:]
+			continue
+		}
+		attributes[i] = bccsp.IdemixAttribute{Type: bccsp.IdemixHiddenAttribute}
+	}
+	return attributes
+}
+
 // GetVersion returns the version of this MSP
 func (msp *idemixmsp) GetVersion() MSPVersion {
 	return msp.version
@@ -314,7 +492,19 @@ func (msp *idemixmsp) deserializeIdentityInternal(serializedID []byte) (Identity
 		return nil, errors.Wrap(err, "cannot deserialize the role of the identity")
 	}
 
-	return newIdemixIdentity(msp, NymPublicKey, role, ou, serialized.Proof), nil
+	// Issuer
+	issuer, err := msp.issuerOf(ou.CertifiersIdentifier)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot find the issuer of the identity")
+	}
+
+	// Disclosed attributes
+	attributes, err := issuer.sortAttributes(serialized.Attributes)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot deserialize the attributes of the identity")
+	}
+
+	return newIdemixIdentity(msp, issuer, NymPublicKey, role, ou, attributes, serialized.Proof), nil
 }
 
 func (msp *idemixmsp) Validate(id Identity) error {
@@ -338,19 +528,20 @@ func (msp *idemixmsp) Validate(id Identity) error {
 func (id *idemixidentity) verifyProof() error {
 	// Verify signature
 	valid, err := id.msp.csp.Verify(
-		id.msp.ipk,
+		id.issuer.ipk,
 		id.associationProof,
 		nil,
 		&bccsp.IdemixSignerOpts{
-			RevocationPublicKey: id.msp.revocationPK,
-			Attributes: []bccsp.IdemixAttribute{
+			RevocationPublicKey: id.issuer.revocationPK,
+			Attributes: append([]bccsp.IdemixAttribute{
 				{Type: bccsp.IdemixBytesAttribute, Value: []byte(id.OU.OrganizationalUnitIdentifier)},
 				{Type: bccsp.IdemixIntAttribute, Value: getIdemixRoleFromMSPRole(id.Role)},
 				{Type: bccsp.IdemixHiddenAttribute},
 				{Type: bccsp.IdemixHiddenAttribute},
-			},
-			RhIndex: rhIndex,
-			Epoch:   id.msp.epoch,
+			}, id.issuer.additionalAttributes(id.Attributes)...),
+			RhIndex:     rhIndex,
+			Epoch:       id.issuer.epoch,
+			IgnoreEpoch: !id.msp.epochsSupported(),
 		},
 	)
 	if err == nil && !valid {
@@ -475,6 +666,37 @@ func (msp *idemixmsp) satisfiesPrincipalValidated(id Identity, principal *m.MSPP
 		}
 		// The identity satisfies all the principals
 		return nil
+	case m.MSPPrincipal_ATTRIBUTE:
+		// Attribute principals are new in MSPv1_4_3 and refused by the MSPs of earlier versions,
+		// so that all the peers of a channel agree on the policies that use them
+		if msp.version < MSPv1_4_3 {
+			return errors.Errorf("Attribute MSP Principals are unsupported in MSP versions prior to MSPv1_4_3")
+		}
+
+		attr := &m.MSPAttribute{}
+		err := proto.Unmarshal(principal.Principal, attr)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal MSPAttribute from principal")
+		}
+
+		mspLogger.Debugf("Checking if identity discloses attribute \"%s\" of mspid \"%s\"", attr.Name, attr.MspIdentifier)
+
+		// at first, we check whether the MSP
+		// identifier is the same as that of the identity
+		if attr.MspIdentifier != msp.name {
+			return errors.Errorf("the identity is a member of a different MSP (expected %s, got %s)", attr.MspIdentifier, id.GetMSPIdentifier())
+		}
+
+		for _, disclosed := range id.(*idemixidentity).Attributes {
+			if disclosed.Name != attr.Name {
+				continue
+			}
+			if disclosed.Value != attr.Value {
+				return errors.Errorf("the value of attribute %s of the user is not the desired one", attr.Name)
+			}
+			return nil
+		}
+		return errors.Errorf("user does not disclose attribute %s", attr.Name)
 	case m.MSPPrincipal_ANONYMITY:
 		if msp.version <= MSPv1_1 {
 			return errors.Errorf("Anonymity MSP Principals are unsupported in MSPv1_1")
@@ -523,9 +745,13 @@ func (msp *idemixmsp) GetTLSIntermediateCerts() [][]byte {
 type idemixidentity struct {
 	NymPublicKey bccsp.Key
 	msp          *idemixmsp
+	issuer       *idemixIssuer
 	id           *IdentityIdentifier
 	Role         *m.MSPRole
 	OU           *m.OrganizationUnit
+	// Attributes contains the certified attributes, in addition to Role and OU,
+	// that the identity discloses, in the order in which the issuer certifies them
+	Attributes []*m.IdemixAttribute
 	// associationProof contains cryptographic proof that this identity
 	// belongs to the MSP id.msp, i.e., it proves that the pseudonym
 	// is constructed from a secret key on which the CA issued a credential.
@@ -536,12 +762,14 @@ func (id *idemixidentity) Anonymous() bool {
 	return true
 }
 
-func newIdemixIdentity(msp *idemixmsp, NymPublicKey bccsp.Key, role *m.MSPRole, ou *m.OrganizationUnit, proof []byte) *idemixidentity {
+func newIdemixIdentity(msp *idemixmsp, issuer *idemixIssuer, NymPublicKey bccsp.Key, role *m.MSPRole, ou *m.OrganizationUnit, attributes []*m.IdemixAttribute, proof []byte) *idemixidentity {
 	id := &idemixidentity{}
 	id.NymPublicKey = NymPublicKey
 	id.msp = msp
+	id.issuer = issuer
 	id.Role = role
 	id.OU = ou
+	id.Attributes = attributes
 	id.associationProof = proof
 
 	raw, err := NymPublicKey.Bytes()
@@ -572,8 +800,8 @@ func (id *idemixidentity) GetMSPIdentifier() string {
 }
 
 func (id *idemixidentity) GetOrganizationalUnits() []*OUIdentifier {
-	// we use the (serialized) public key of this MSP as the CertifiersIdentifier
-	certifiersIdentifier, err := id.msp.ipk.Bytes()
+	// we use the (serialized) public key of the issuer of this identity as the CertifiersIdentifier
+	certifiersIdentifier, err := id.issuer.ipk.Bytes()
 	if err != nil {
 		mspIdentityLogger.Errorf("Failed to marshal ipk in GetOrganizationalUnits: %s", err)
 		return nil
@@ -597,7 +825,7 @@ func (id *idemixidentity) Verify(msg []byte, sig []byte) error {
 		sig,
 		msg,
 		&bccsp.IdemixNymSignerOpts{
-			IssuerPK: id.msp.ipk,
+			IssuerPK: id.issuer.ipk,
 		},
 	)
 	return err
@@ -630,6 +858,7 @@ func (id *idemixidentity) Serialize() ([]byte, error) {
 
 	serialized.Ou = ouBytes
 	serialized.Role = roleBytes
+	serialized.Attributes = id.Attributes
 	serialized.Proof = id.associationProof
 
 	idemixIDBytes, err := proto.Marshal(serialized)
@@ -662,7 +891,7 @@ func (id *idemixSigningIdentity) Sign(msg []byte) ([]byte, error) {
 		msg,
 		&bccsp.IdemixNymSignerOpts{
 			Nym:      id.NymKey,
-			IssuerPK: id.msp.ipk,
+			IssuerPK: id.issuer.ipk,
 		},
 	)
 	if err != nil {
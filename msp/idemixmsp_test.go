@@ -7,9 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package msp
 
 import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-amcl/amcl/FP256BN"
 	"github.com/hyperledger/fabric/idemix"
 	"github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
@@ -38,6 +44,41 @@ func setupWithVersion(configPath string, ID string, version MSPVersion) (MSP, er
 	return msp, nil
 }
 
+// setupWithCRI sets up the MSP with the given credential revocation information
+// in its config, as distributed in the channel config
+func setupWithCRI(configPath string, ID string, cri *idemix.CredentialRevocationInformation) (MSP, error) {
+	idemixMsp, err := newIdemixMsp(MSPv1_4_3)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := GetIdemixMspConfig(configPath, ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Getting MSP config failed")
+	}
+	idemixConfig := &msp.IdemixMSPConfig{}
+	err = proto.Unmarshal(conf.Config, idemixConfig)
+	if err != nil {
+		return nil, err
+	}
+	criBytes, err := proto.Marshal(cri)
+	if err != nil {
+		return nil, err
+	}
+	idemixConfig.Epoch = cri.Epoch
+	idemixConfig.CredentialRevocationInformation = criBytes
+	conf.Config, err = proto.Marshal(idemixConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = idemixMsp.Setup(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "Setting up MSP failed")
+	}
+	return idemixMsp, nil
+}
+
 func getDefaultSigner(msp MSP) (SigningIdentity, error) {
 	id, err := msp.GetDefaultSigningIdentity()
 	if err != nil {
@@ -743,3 +784,398 @@ func TestRolePeerV11(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid MSP role type")
 }
+
+func attributePrincipal(t *testing.T, mspID, name, value string) *msp.MSPPrincipal {
+	principalBytes, err := proto.Marshal(&msp.MSPAttribute{MspIdentifier: mspID, Name: name, Value: value})
+	assert.NoError(t, err)
+	return &msp.MSPPrincipal{
+		PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE,
+		Principal:               principalBytes}
+}
+
+func TestPrincipalAttribute(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1OU1Attributes", "MSP1OU1", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	id1, err := getDefaultSigner(msp1)
+	assert.NoError(t, err)
+
+	err = id1.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "clearance", "secret"))
+	assert.NoError(t, err)
+	err = id1.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "level", "3"))
+	assert.NoError(t, err)
+
+	// The disclosed attributes survive serialization
+	serializedID, err := id1.Serialize()
+	assert.NoError(t, err)
+	id2, err := msp1.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	err = id2.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "clearance", "secret"))
+	assert.NoError(t, err)
+}
+
+func TestPrincipalAttributeBad(t *testing.T) {
+	msp1, err := setupWithVersion("testdata/idemix/MSP1OU1Attributes", "MSP1OU1", MSPv1_4_3)
+	assert.NoError(t, err)
+
+	id1, err := getDefaultSigner(msp1)
+	assert.NoError(t, err)
+
+	err = id1.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "clearance", "top secret"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the value of attribute clearance of the user is not the desired one")
+
+	err = id1.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "department", "finance"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "user does not disclose attribute department")
+
+	err = id1.SatisfiesPrincipal(attributePrincipal(t, "DifferentMSP", "clearance", "secret"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the identity is a member of a different MSP")
+
+	err = id1.SatisfiesPrincipal(&msp.MSPPrincipal{PrincipalClassification: msp.MSPPrincipal_ATTRIBUTE, Principal: []byte("barf")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "could not unmarshal MSPAttribute from principal")
+
+	// An identity claiming a different value of a disclosed attribute is not valid
+	id1.(*idemixSigningIdentity).idemixidentity.Attributes[0] = &msp.IdemixAttribute{Name: "clearance", Value: "top secret"}
+	serializedID, err := id1.Serialize()
+	assert.NoError(t, err)
+	id2, err := msp1.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	err = id2.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "clearance", "top secret"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "identity is not valid with respect to this MSP")
+
+	// An identity disclosing an attribute the issuer does not certify cannot be deserialized
+	sID := &msp.SerializedIdentity{}
+	assert.NoError(t, proto.Unmarshal(serializedID, sID))
+	serialized := &msp.SerializedIdemixIdentity{}
+	assert.NoError(t, proto.Unmarshal(sID.IdBytes, serialized))
+	serialized.Attributes = append(serialized.Attributes, &msp.IdemixAttribute{Name: "department", Value: "finance"})
+	sID.IdBytes, err = proto.Marshal(serialized)
+	assert.NoError(t, err)
+	serializedID, err = proto.Marshal(sID)
+	assert.NoError(t, err)
+	_, err = msp1.DeserializeIdentity(serializedID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot deserialize the attributes of the identity: attribute department is not certified by the issuer")
+
+	// An identity without additional attributes does not satisfy the principal
+	msp2, err := setupWithVersion("testdata/idemix/MSP1OU1", "MSP1OU1", MSPv1_4_3)
+	assert.NoError(t, err)
+	id3, err := getDefaultSigner(msp2)
+	assert.NoError(t, err)
+	err = id3.SatisfiesPrincipal(attributePrincipal(t, id3.GetMSPIdentifier(), "clearance", "secret"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "user does not disclose attribute clearance")
+}
+
+func TestPrincipalAttributePriorToV143(t *testing.T) {
+	for _, version := range []MSPVersion{MSPv1_1, MSPv1_3} {
+		msp1, err := setupWithVersion("testdata/idemix/MSP1OU1Attributes", "MSP1OU1", version)
+		assert.NoError(t, err)
+		id1, err := getDefaultSigner(msp1)
+		assert.NoError(t, err)
+
+		err = id1.SatisfiesPrincipal(attributePrincipal(t, id1.GetMSPIdentifier(), "clearance", "secret"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Attribute MSP Principals are unsupported in MSP versions prior to MSPv1_4_3")
+	}
+}
+
+func TestIdemixRevocation(t *testing.T) {
+	keyBytes, err := ioutil.ReadFile(filepath.Join("testdata/idemix/MSP1OU1", "ca", "RevocationKey"))
+	assert.NoError(t, err)
+	block, _ := pem.Decode(keyBytes)
+	assert.NotNil(t, block)
+	revocationKey, err := x509.ParseECPrivateKey(block.Bytes)
+	assert.NoError(t, err)
+
+	signerBytes, err := ioutil.ReadFile(filepath.Join("testdata/idemix/MSP1OU1", IdemixConfigDirUser, IdemixConfigFileSigner))
+	assert.NoError(t, err)
+	signerConfig := &msp.IdemixMSPSignerConfig{}
+	assert.NoError(t, proto.Unmarshal(signerBytes, signerConfig))
+	cred := &idemix.Credential{}
+	assert.NoError(t, proto.Unmarshal(signerConfig.Cred, cred))
+	rh := FP256BN.FromBytes(cred.Attrs[rhIndex])
+
+	newCRI := func(epoch int, key *ecdsa.PrivateKey, unrevokedHandles ...*FP256BN.BIG) *idemix.CredentialRevocationInformation {
+		rng, err := idemix.GetRand()
+		assert.NoError(t, err)
+		cri, err := idemix.CreateCRI(key, unrevokedHandles, epoch, idemix.ALG_PLAIN_SIGNATURE, rng)
+		assert.NoError(t, err)
+		return cri
+	}
+
+	// The signer is not revoked in epoch 1
+	cri := newCRI(1, revocationKey, FP256BN.NewBIGint(5), rh)
+	msp1, err := setupWithCRI("testdata/idemix/MSP1OU1", "MSP1", cri)
+	assert.NoError(t, err)
+	id1, err := getDefaultSigner(msp1)
+	assert.NoError(t, err)
+	serializedID, err := id1.Serialize()
+	assert.NoError(t, err)
+
+	// validate checks the serialized identity as the verifier MSP receives it
+	validate := func(verMsp MSP) error {
+		id, err := verMsp.DeserializeIdentity(serializedID)
+		if err != nil {
+			return err
+		}
+		return verMsp.Validate(id)
+	}
+
+	verMsp, err := setupWithCRI("testdata/idemix/MSP1Verifier", "MSP1", cri)
+	assert.NoError(t, err)
+	assert.NoError(t, validate(verMsp))
+
+	// A verifier still in epoch 0 rejects the identity
+	verMsp, err = setupWithVersion("testdata/idemix/MSP1Verifier", "MSP1", MSPv1_4_3)
+	assert.NoError(t, err)
+	err = validate(verMsp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signature epoch 1 differs from the current epoch 0")
+
+	// MSP versions prior to MSPv1_4_3 neither support revocation epochs in their
+	// config, nor accept the identities whose signatures use revocation
+	verMsp, err = setup("testdata/idemix/MSP1Verifier", "MSP1")
+	assert.NoError(t, err)
+	err = validate(verMsp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "revocation algorithm 1 requires the epoch to be checked")
+
+	conf, err := GetIdemixMspConfig("testdata/idemix/MSP1Verifier", "MSP1")
+	assert.NoError(t, err)
+	idemixConfig := &msp.IdemixMSPConfig{}
+	assert.NoError(t, proto.Unmarshal(conf.Config, idemixConfig))
+	idemixConfig.Epoch = cri.Epoch
+	idemixConfig.CredentialRevocationInformation, err = proto.Marshal(cri)
+	assert.NoError(t, err)
+	conf.Config, err = proto.Marshal(idemixConfig)
+	assert.NoError(t, err)
+	v13Msp, err := newIdemixMsp(MSPv1_3)
+	assert.NoError(t, err)
+	err = v13Msp.Setup(conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "revocation epochs are unsupported in MSP versions prior to MSPv1_4_3")
+
+	// Once the channel config moves to epoch 2, in which the signer is revoked,
+	// the identity of epoch 1 is rejected, and the signer cannot set up a new one
+	cri = newCRI(2, revocationKey, FP256BN.NewBIGint(5))
+	verMsp, err = setupWithCRI("testdata/idemix/MSP1Verifier", "MSP1", cri)
+	assert.NoError(t, err)
+	err = validate(verMsp)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signature epoch 1 differs from the current epoch 2")
+
+	_, err = setupWithCRI("testdata/idemix/MSP1OU1", "MSP1", cri)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "the revocation handle is not among the unrevoked handles of epoch 2")
+
+	// The credential revocation information must be signed by the revocation authority
+	otherKey, err := idemix.GenerateLongTermRevocationKey()
+	assert.NoError(t, err)
+	_, err = setupWithCRI("testdata/idemix/MSP1Verifier", "MSP1", newCRI(3, otherKey, rh))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "credential revocation information is not valid")
+}
+
+// setupAtEpoch sets up the MSP in the given epoch, with the credential revocation
+// information of that epoch for each of its issuers, as distributed in the channel config
+func setupAtEpoch(configPath string, ID string, epoch int) (MSP, error) {
+	return setupAtEpochs(configPath, ID, epoch, epoch, epoch)
+}
+
+// setupAtEpochs sets up the MSP in the given epoch, with the credential revocation
+// information of the given epochs for its issuers, as distributed in the channel config
+func setupAtEpochs(configPath string, ID string, epoch int, issuerEpochs ...int) (MSP, error) {
+	idemixMsp, err := newIdemixMsp(MSPv1_4_3)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := GetIdemixMspConfig(configPath, ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Getting MSP config failed")
+	}
+	idemixConfig := &msp.IdemixMSPConfig{}
+	err = proto.Unmarshal(conf.Config, idemixConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuers are the one in the ca directory, followed by the ones in its issuers directory
+	caDirs := []string{filepath.Join(configPath, "ca")}
+	issuerDirs, err := ioutil.ReadDir(filepath.Join(configPath, "ca", IdemixConfigDirIssuers))
+	if err != nil {
+		return nil, err
+	}
+	for _, issuerDir := range issuerDirs {
+		caDirs = append(caDirs, filepath.Join(configPath, "ca", IdemixConfigDirIssuers, issuerDir.Name()))
+	}
+	for i, issuerConfig := range idemixConfig.Issuers {
+		keyBytes, err := ioutil.ReadFile(filepath.Join(caDirs[i], "RevocationKey"))
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(keyBytes)
+		revocationKey, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		rng, err := idemix.GetRand()
+		if err != nil {
+			return nil, err
+		}
+		cri, err := idemix.CreateCRI(revocationKey, nil, issuerEpochs[i], idemix.ALG_NO_REVOCATION, rng)
+		if err != nil {
+			return nil, err
+		}
+		issuerConfig.CredentialRevocationInformation, err = proto.Marshal(cri)
+		if err != nil {
+			return nil, err
+		}
+	}
+	idemixConfig.Epoch = int64(epoch)
+	conf.Config, err = proto.Marshal(idemixConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = idemixMsp.Setup(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "Setting up MSP failed")
+	}
+	return idemixMsp, nil
+}
+
+func TestIdemixIssuerRollover(t *testing.T) {
+	// The signer of MSP1Rollover is certified by the initial issuer, which expires in epoch 3,
+	// and the one of MSP1RolloverOU1 by the new issuer, which takes over in epoch 1
+	_, err := setupAtEpoch("testdata/idemix/MSP1Rollover", "MSP1", 0)
+	assert.NoError(t, err)
+	_, err = setupAtEpoch("testdata/idemix/MSP1RolloverOU1", "MSP1", 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Credential is not cryptographically valid")
+
+	// Both issuers are valid in epoch 2, during which the signers move to the new issuer
+	oldMsp, err := setupAtEpoch("testdata/idemix/MSP1Rollover", "MSP1", 2)
+	assert.NoError(t, err)
+	oldID, err := getDefaultSigner(oldMsp)
+	assert.NoError(t, err)
+	oldSerializedID, err := oldID.Serialize()
+	assert.NoError(t, err)
+
+	newMsp, err := setupAtEpoch("testdata/idemix/MSP1RolloverOU1", "MSP1", 2)
+	assert.NoError(t, err)
+	newID, err := getDefaultSigner(newMsp)
+	assert.NoError(t, err)
+	newSerializedID, err := newID.Serialize()
+	assert.NoError(t, err)
+
+	for _, serializedID := range [][]byte{oldSerializedID, newSerializedID} {
+		for _, verMsp := range []MSP{oldMsp, newMsp} {
+			id, err := verMsp.DeserializeIdentity(serializedID)
+			assert.NoError(t, err)
+			assert.NoError(t, verMsp.Validate(id))
+		}
+	}
+
+	// The credentials of the initial issuer are no longer accepted in epoch 3
+	_, err = setupAtEpoch("testdata/idemix/MSP1Rollover", "MSP1", 3)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Credential is not cryptographically valid")
+
+	verMsp, err := setupAtEpoch("testdata/idemix/MSP1RolloverOU1", "MSP1", 3)
+	assert.NoError(t, err)
+	_, err = getDefaultSigner(verMsp)
+	assert.NoError(t, err)
+	_, err = verMsp.DeserializeIdentity(oldSerializedID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot find the issuer of the identity")
+}
+
+func TestIdemixIssuerRolloverLaggingCRI(t *testing.T) {
+	// In epoch 2, the initial issuer still publishes the credential revocation
+	// information of epoch 1, while the new issuer already moved to epoch 2
+	oldMsp, err := setupAtEpochs("testdata/idemix/MSP1Rollover", "MSP1", 2, 1, 2)
+	assert.NoError(t, err)
+	oldID, err := getDefaultSigner(oldMsp)
+	assert.NoError(t, err)
+	oldSerializedID, err := oldID.Serialize()
+	assert.NoError(t, err)
+
+	newMsp, err := setupAtEpochs("testdata/idemix/MSP1RolloverOU1", "MSP1", 2, 1, 2)
+	assert.NoError(t, err)
+	newID, err := getDefaultSigner(newMsp)
+	assert.NoError(t, err)
+	newSerializedID, err := newID.Serialize()
+	assert.NoError(t, err)
+
+	// The identities are verified against the epoch of the revocation information of their issuer
+	for _, serializedID := range [][]byte{oldSerializedID, newSerializedID} {
+		for _, verMsp := range []MSP{oldMsp, newMsp} {
+			id, err := verMsp.DeserializeIdentity(serializedID)
+			assert.NoError(t, err)
+			assert.NoError(t, verMsp.Validate(id))
+		}
+	}
+
+	// Once the initial issuer publishes the revocation information of epoch 2,
+	// the identity produced against the one of epoch 1 is rejected
+	verMsp, err := setupAtEpoch("testdata/idemix/MSP1RolloverOU1", "MSP1", 2)
+	assert.NoError(t, err)
+	id, err := verMsp.DeserializeIdentity(oldSerializedID)
+	if err == nil {
+		err = verMsp.Validate(id)
+	}
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "signature epoch 1 differs from the current epoch 2")
+
+	// The revocation information of an issuer can't be ahead of the epoch of the MSP
+	_, err = setupAtEpochs("testdata/idemix/MSP1Rollover", "MSP1", 1, 1, 2)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "credential revocation information of epoch 2 is ahead of the epoch 1 of the MSP")
+}
+
+func TestIdemixIssuerRolloverBad(t *testing.T) {
+	conf, err := GetIdemixMspConfig("testdata/idemix/MSP1Rollover", "MSP1")
+	assert.NoError(t, err)
+	idemixConfig := &msp.IdemixMSPConfig{}
+	assert.NoError(t, proto.Unmarshal(conf.Config, idemixConfig))
+	assert.Empty(t, idemixConfig.Ipk)
+	assert.Len(t, idemixConfig.Issuers, 2)
+	assert.Equal(t, int64(3), idemixConfig.Issuers[0].ExpirationEpoch)
+	assert.Equal(t, int64(1), idemixConfig.Issuers[1].FirstEpoch)
+
+	// Without the new issuer, no issuer is valid in epoch 3
+	idemixConfig.Issuers = idemixConfig.Issuers[:1]
+	idemixConfig.Epoch = 3
+	conf.Config, err = proto.Marshal(idemixConfig)
+	assert.NoError(t, err)
+	idemixMsp, err := newIdemixMsp(MSPv1_4_3)
+	assert.NoError(t, err)
+	err = idemixMsp.Setup(conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no issuer is valid in epoch 3")
+
+	// An issuer with a bad public key fails the setup
+	idemixConfig.Issuers = append(idemixConfig.Issuers, &msp.IdemixMSPIssuerConfig{Ipk: []byte("barf")})
+	conf.Config, err = proto.Marshal(idemixConfig)
+	assert.NoError(t, err)
+	err = idemixMsp.Setup(conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal ipk from idemix msp config")
+
+	// Multiple issuers are unsupported prior to MSPv1_4_3
+	idemixConfig.Issuers = idemixConfig.Issuers[:1]
+	idemixConfig.Epoch = 0
+	conf.Config, err = proto.Marshal(idemixConfig)
+	assert.NoError(t, err)
+	idemixMsp, err = newIdemixMsp(MSPv1_3)
+	assert.NoError(t, err)
+	err = idemixMsp.Setup(conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "multiple issuers are unsupported in MSP versions prior to MSPv1_4_3")
+}
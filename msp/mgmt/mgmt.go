@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/cache"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
@@ -29,7 +30,14 @@ func LoadLocalMspWithType(dir string, bccspConfig *factory.FactoryOpts, mspID, m
 		return err
 	}
 
-	return GetLocalMSP().Setup(conf)
+	if err := GetLocalMSP().Setup(conf); err != nil {
+		return err
+	}
+
+	setLocalMspConfigLoader(func() (*mspproto.MSPConfig, error) {
+		return msp.GetLocalMspConfigWithType(dir, bccspConfig, mspID, mspType)
+	})
+	return nil
 }
 
 // LoadLocalMsp loads the local MSP from the specified directory
@@ -43,7 +51,14 @@ func LoadLocalMsp(dir string, bccspConfig *factory.FactoryOpts, mspID string) er
 		return err
 	}
 
-	return GetLocalMSP().Setup(conf)
+	if err := GetLocalMSP().Setup(conf); err != nil {
+		return err
+	}
+
+	setLocalMspConfigLoader(func() (*mspproto.MSPConfig, error) {
+		return msp.GetLocalMspConfig(dir, bccspConfig, mspID)
+	})
+	return nil
 }
 
 // FIXME: AS SOON AS THE CHAIN MANAGEMENT CODE IS COMPLETE,
@@ -138,7 +153,7 @@ func GetLocalMSP() msp.MSP {
 		return localMsp
 	}
 
-	localMsp = loadLocaMSP()
+	localMsp = newReloadableMSP(loadLocaMSP())
 
 	return localMsp
 }
@@ -151,8 +166,8 @@ func loadLocaMSP() msp.MSP {
 	}
 
 	var mspOpts = map[string]msp.NewOpts{
-		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_0}},
-		msp.ProviderTypeToString(msp.IDEMIX): &msp.IdemixNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_1}},
+		msp.ProviderTypeToString(msp.FABRIC): &msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_0, Local: true}},
+		msp.ProviderTypeToString(msp.IDEMIX): &msp.IdemixNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_1, Local: true}},
 	}
 	newOpts, found := mspOpts[mspType]
 	if !found {
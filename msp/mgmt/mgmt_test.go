@@ -12,6 +12,8 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/hyperledger/fabric/msp"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -134,3 +136,44 @@ func LoadMSPSetupForTesting() error {
 
 	return nil
 }
+
+func TestReloadLocalMsp(t *testing.T) {
+	defer func(loadConfig func() (*mspproto.MSPConfig, error)) {
+		localMspConfigLoader = loadConfig
+	}(localMspConfigLoader)
+
+	localMspConfigLoader = nil
+	err := ReloadLocalMsp()
+	assert.EqualError(t, err, "the local MSP was not loaded from a directory")
+
+	dir, err := configtest.GetDevMspDir()
+	assert.NoError(t, err)
+	err = LoadLocalMsp(dir, nil, "SampleOrg")
+	assert.NoError(t, err)
+
+	localMSP := GetLocalMSP()
+	mspBefore := localMSP.(*reloadableMSP).get()
+	idBefore, err := GetLocalSigningIdentityOrPanic().Serialize()
+	assert.NoError(t, err)
+
+	err = ReloadLocalMsp()
+	assert.NoError(t, err)
+	assert.True(t, localMSP == GetLocalMSP())
+	assert.False(t, mspBefore == localMSP.(*reloadableMSP).get())
+
+	sid := GetLocalSigningIdentityOrPanic()
+	idAfter, err := sid.Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, idBefore, idAfter)
+	sig, err := sid.Sign([]byte("msg"))
+	assert.NoError(t, err)
+	assert.NoError(t, sid.Verify([]byte("msg"), sig))
+
+	mspBefore = localMSP.(*reloadableMSP).get()
+	localMspConfigLoader = func() (*mspproto.MSPConfig, error) {
+		return nil, errors.New("no such directory")
+	}
+	err = ReloadLocalMsp()
+	assert.EqualError(t, err, "failed loading the local MSP configuration: no such directory")
+	assert.True(t, mspBefore == localMSP.(*reloadableMSP).get())
+}
@@ -0,0 +1,123 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"sync/atomic"
+
+	"github.com/hyperledger/fabric/msp"
+	mspproto "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+// localMspConfigLoader loads the configuration of the local MSP
+// from the directory it was last loaded from
+var localMspConfigLoader func() (*mspproto.MSPConfig, error)
+
+// ReloadLocalMsp loads the local MSP anew from the directory it was last
+// loaded from, and atomically replaces the MSP behind GetLocalMSP with it.
+// Identities and signatures obtained from the local MSP from then on
+// are those of the reloaded signing identity.
+func ReloadLocalMsp() error {
+	m.Lock()
+	loadConfig := localMspConfigLoader
+	m.Unlock()
+
+	if loadConfig == nil {
+		return errors.New("the local MSP was not loaded from a directory")
+	}
+
+	conf, err := loadConfig()
+	if err != nil {
+		return errors.WithMessage(err, "failed loading the local MSP configuration")
+	}
+
+	mspInst := loadLocaMSP()
+	if err := mspInst.Setup(conf); err != nil {
+		return errors.WithMessage(err, "failed setting up the local MSP")
+	}
+
+	GetLocalMSP().(*reloadableMSP).replace(mspInst)
+	mspLogger.Info("Reloaded the local MSP")
+
+	return nil
+}
+
+func setLocalMspConfigLoader(loadConfig func() (*mspproto.MSPConfig, error)) {
+	m.Lock()
+	defer m.Unlock()
+
+	localMspConfigLoader = loadConfig
+}
+
+// reloadableMSP is the local MSP. It delegates to an underlying
+// MSP which is replaced whenever the local MSP is reloaded.
+type reloadableMSP struct {
+	current atomic.Value
+}
+
+func newReloadableMSP(mspInst msp.MSP) *reloadableMSP {
+	r := &reloadableMSP{}
+	r.replace(mspInst)
+	return r
+}
+
+func (r *reloadableMSP) replace(mspInst msp.MSP) {
+	r.current.Store(&mspInst)
+}
+
+func (r *reloadableMSP) get() msp.MSP {
+	return *r.current.Load().(*msp.MSP)
+}
+
+func (r *reloadableMSP) DeserializeIdentity(serializedIdentity []byte) (msp.Identity, error) {
+	return r.get().DeserializeIdentity(serializedIdentity)
+}
+
+func (r *reloadableMSP) IsWellFormed(identity *mspproto.SerializedIdentity) error {
+	return r.get().IsWellFormed(identity)
+}
+
+func (r *reloadableMSP) Setup(config *mspproto.MSPConfig) error {
+	return r.get().Setup(config)
+}
+
+func (r *reloadableMSP) GetVersion() msp.MSPVersion {
+	return r.get().GetVersion()
+}
+
+func (r *reloadableMSP) GetType() msp.ProviderType {
+	return r.get().GetType()
+}
+
+func (r *reloadableMSP) GetIdentifier() (string, error) {
+	return r.get().GetIdentifier()
+}
+
+func (r *reloadableMSP) GetSigningIdentity(identifier *msp.IdentityIdentifier) (msp.SigningIdentity, error) {
+	return r.get().GetSigningIdentity(identifier)
+}
+
+func (r *reloadableMSP) GetDefaultSigningIdentity() (msp.SigningIdentity, error) {
+	return r.get().GetDefaultSigningIdentity()
+}
+
+func (r *reloadableMSP) GetTLSRootCerts() [][]byte {
+	return r.get().GetTLSRootCerts()
+}
+
+func (r *reloadableMSP) GetTLSIntermediateCerts() [][]byte {
+	return r.get().GetTLSIntermediateCerts()
+}
+
+func (r *reloadableMSP) Validate(id msp.Identity) error {
+	return r.get().Validate(id)
+}
+
+func (r *reloadableMSP) SatisfiesPrincipal(id msp.Identity, principal *mspproto.MSPPrincipal) error {
+	return r.get().SatisfiesPrincipal(id, principal)
+}
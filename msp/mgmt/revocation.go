@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mgmt
+
+import (
+	"github.com/hyperledger/fabric/msp"
+)
+
+// revocationChecker checks the revocation status of the identities
+// validated locally by the peer, or is nil when it doesn't
+var revocationChecker *msp.RevocationChecker
+
+// SetRevocationChecker sets the checker used to determine whether the
+// identities validated locally, such as those of clients and of remote
+// peers, have been revoked. A nil checker disables these checks.
+func SetRevocationChecker(checker *msp.RevocationChecker) {
+	m.Lock()
+	defer m.Unlock()
+
+	revocationChecker = checker
+}
+
+// GetRevocationChecker returns the checker set by SetRevocationChecker,
+// or nil if revocation checking is disabled
+func GetRevocationChecker() *msp.RevocationChecker {
+	m.Lock()
+	defer m.Unlock()
+
+	return revocationChecker
+}
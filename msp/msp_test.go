@@ -9,6 +9,7 @@ package msp
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/x509"
 	"encoding/hex"
 	"encoding/pem"
@@ -465,6 +466,67 @@ func TestSignAndVerify(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEd25519SignAndVerify(t *testing.T) {
+	thisMSP := getLocalMSPWithVersion(t, "testdata/ed25519", MSPv1_4_3)
+
+	id, err := thisMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	assert.NoError(t, id.Validate())
+
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+	idBack, err := thisMSP.DeserializeIdentity(serializedID)
+	assert.NoError(t, err)
+	assert.NoError(t, idBack.Validate())
+
+	msg := []byte("foo")
+	sig, err := id.Sign(msg)
+	assert.NoError(t, err)
+	assert.Len(t, sig, ed25519.SignatureSize)
+
+	// Ed25519 signatures are computed over the message itself
+	assert.True(t, ed25519.Verify(id.(*signingidentity).cert.PublicKey.(ed25519.PublicKey), msg, sig))
+
+	assert.NoError(t, id.Verify(msg, sig))
+	assert.NoError(t, idBack.Verify(msg, sig))
+	assert.Error(t, id.Verify(msg[1:], sig))
+	assert.Error(t, id.Verify(msg, sig[1:]))
+}
+
+func TestEd25519MSPVersion(t *testing.T) {
+	conf, err := GetLocalMspConfig("testdata/ed25519", nil, "SampleOrg")
+	assert.NoError(t, err)
+	ks, err := sw.NewFileBasedKeyStore(nil, filepath.Join("testdata/ed25519", "keystore"), true)
+	assert.NoError(t, err)
+	csp, err := sw.NewWithParams(256, "SHA2", ks)
+	assert.NoError(t, err)
+
+	// channel MSPs prior to MSPv1_4_3 reject Ed25519 certificates
+	for _, version := range []MSPVersion{MSPv1_0, MSPv1_1, MSPv1_3} {
+		thisMSP, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: version}})
+		assert.NoError(t, err)
+		thisMSP.(*bccspmsp).bccsp = csp
+		err = thisMSP.Setup(conf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Ed25519 certificates are unsupported in MSP versions prior to MSPv1_4_3")
+	}
+
+	// the local MSP accepts them regardless of its version
+	localMSP, err := New(&BCCSPNewOpts{NewBaseOpts: NewBaseOpts{Version: MSPv1_0, Local: true}})
+	assert.NoError(t, err)
+	localMSP.(*bccspmsp).bccsp = csp
+	assert.NoError(t, localMSP.Setup(conf))
+
+	// channel MSPs prior to MSPv1_4_3 reject the Ed25519 identities they deserialize
+	id, err := localMSP.GetDefaultSigningIdentity()
+	assert.NoError(t, err)
+	serializedID, err := id.Serialize()
+	assert.NoError(t, err)
+	_, err = getLocalMSPWithVersion(t, "testdata/nodeous1", MSPv1_3).DeserializeIdentity(serializedID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Ed25519 certificates are unsupported in MSP versions prior to MSPv1_4_3")
+}
+
 func TestSignAndVerifyFailures(t *testing.T) {
 	msg := []byte("foo")
 
@@ -8,6 +8,7 @@ package msp
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
@@ -36,6 +37,8 @@ type satisfiesPrincipalInternalFuncType func(id Identity, principal *m.MSPPrinci
 type bccspmsp struct {
 	// version specifies the behaviour of this msp
 	version MSPVersion
+	// local is true if this is the local msp of the node
+	local bool
 	// The following function pointers are used to change the behaviour
 	// of this MSP depending on its version.
 	// internalSetupFunc is the pointer to the setup function
@@ -116,7 +119,7 @@ func newBccspMsp(version MSPVersion) (MSP, error) {
 		theMsp.internalSetupFunc = theMsp.setupV11
 		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV11
 		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalPreV13
-	case MSPv1_3:
+	case MSPv1_3, MSPv1_4_3:
 		theMsp.internalSetupFunc = theMsp.setupV11
 		theMsp.internalValidateIdentityOusFunc = theMsp.validateIdentityOUsV11
 		theMsp.internalSatisfiesPrincipalInternalFunc = theMsp.satisfiesPrincipalInternalV13
@@ -145,9 +148,27 @@ func (msp *bccspmsp) getCertFromPem(idBytes []byte) (*x509.Certificate, error) {
 		return nil, errors.Wrap(err, "getCertFromPem error: failed to parse x509 cert")
 	}
 
+	if err := msp.checkPublicKeyAlgorithm(cert); err != nil {
+		return nil, errors.WithMessage(err, "getCertFromPem error")
+	}
+
 	return cert, nil
 }
 
+// checkPublicKeyAlgorithm returns an error if the public key of the given
+// certificate uses an algorithm that is not supported by the version of this MSP.
+// Ed25519 requires MSPv1_4_3 so that all the peers of a channel agree on the
+// identities they accept, and is always supported by the local MSP
+func (msp *bccspmsp) checkPublicKeyAlgorithm(cert *x509.Certificate) error {
+	if cert.PublicKeyAlgorithm != x509.Ed25519 {
+		return nil
+	}
+	if msp.version < MSPv1_4_3 && !msp.local {
+		return errors.New("Ed25519 certificates are unsupported in MSP versions prior to MSPv1_4_3")
+	}
+	return nil
+}
+
 func (msp *bccspmsp) getIdentityFromConf(idBytes []byte) (Identity, bccsp.Key, error) {
 	// get a cert
 	cert, err := msp.getCertFromPem(idBytes)
@@ -187,9 +208,13 @@ func (msp *bccspmsp) getSigningIdentityFromConf(sidInfo *m.SigningIdentityInfo)
 		}
 
 		pemKey, _ := pem.Decode(sidInfo.PrivateSigner.KeyMaterial)
-		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true})
+		var importOpts bccsp.KeyImportOpts = &bccsp.ECDSAPrivateKeyImportOpts{Temporary: true}
+		if _, isEd25519 := idPub.(*identity).cert.PublicKey.(ed25519.PublicKey); isEd25519 {
+			importOpts = &bccsp.ED25519PrivateKeyImportOpts{Temporary: true}
+		}
+		privKey, err = msp.bccsp.KeyImport(pemKey.Bytes, importOpts)
 		if err != nil {
-			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import EC private key")
+			return nil, errors.WithMessage(err, "getIdentityFromBytes error: Failed to import private key")
 		}
 	}
 
@@ -362,6 +387,10 @@ func (msp *bccspmsp) deserializeIdentityInternal(serializedIdentity []byte) (Ide
 		return nil, errors.Wrap(err, "parseCertificate failed")
 	}
 
+	if err := msp.checkPublicKeyAlgorithm(cert); err != nil {
+		return nil, err
+	}
+
 	// Now we have the certificate; make sure that its fields
 	// (e.g. the Issuer.OU or the Subject.OU) match with the
 	// MSP id that this MSP has; otherwise it might be an attack
@@ -0,0 +1,246 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The ASN.1 structures of OCSP requests and responses, see https://tools.ietf.org/html/rfc6960
+
+var (
+	oidSHA1          = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidOCSPBasic     = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+	oidOCSPSigningKU = x509.ExtKeyUsageOCSPSigning
+
+	ocspSignatureAlgorithms = []struct {
+		oid  asn1.ObjectIdentifier
+		algo x509.SignatureAlgorithm
+	}{
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}, x509.SHA256WithRSA},
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 12}, x509.SHA384WithRSA},
+		{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 13}, x509.SHA512WithRSA},
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}, x509.ECDSAWithSHA256},
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}, x509.ECDSAWithSHA384},
+		{asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 4}, x509.ECDSAWithSHA512},
+		{asn1.ObjectIdentifier{1, 3, 101, 112}, x509.PureEd25519},
+	}
+)
+
+type ocspCertID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+type ocspRequestEntry struct {
+	Cert ocspCertID
+}
+
+type ocspTBSRequest struct {
+	Version     int `asn1:"explicit,tag:0,default:0,optional"`
+	RequestList []ocspRequestEntry
+}
+
+type ocspRequest struct {
+	TBSRequest ocspTBSRequest
+}
+
+type ocspResponse struct {
+	Status   asn1.Enumerated
+	Response ocspResponseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    ocspResponseData
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseData struct {
+	Raw            asn1.RawContent
+	Version        int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID asn1.RawValue
+	ProducedAt     time.Time `asn1:"generalized"`
+	Responses      []ocspSingleResponse
+}
+
+type ocspSingleResponse struct {
+	CertID           ocspCertID
+	Good             asn1.Flag        `asn1:"tag:0,optional"`
+	Revoked          ocspRevokedInfo  `asn1:"tag:1,optional"`
+	Unknown          asn1.Flag        `asn1:"tag:2,optional"`
+	ThisUpdate       time.Time        `asn1:"generalized"`
+	NextUpdate       time.Time        `asn1:"generalized,explicit,tag:0,optional"`
+	SingleExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+type ocspRevokedInfo struct {
+	RevocationTime time.Time       `asn1:"generalized"`
+	Reason         asn1.Enumerated `asn1:"explicit,tag:0,optional"`
+}
+
+// ocspStatus is the revocation status of a certificate
+// as stated by an OCSP responder
+type ocspStatus struct {
+	revoked    bool
+	thisUpdate time.Time
+	nextUpdate time.Time
+}
+
+// newOCSPCertID returns the identifier OCSP responders know
+// the given certificate, issued by the given issuer, by
+func newOCSPCertID(cert, issuer *x509.Certificate) (*ocspCertID, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, errors.Wrap(err, "failed parsing the public key of the issuer")
+	}
+
+	nameHash := crypto.SHA1.New()
+	nameHash.Write(issuer.RawSubject)
+	keyHash := crypto.SHA1.New()
+	keyHash.Write(spki.PublicKey.RightAlign())
+
+	return &ocspCertID{
+		HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+		NameHash:      nameHash.Sum(nil),
+		IssuerKeyHash: keyHash.Sum(nil),
+		SerialNumber:  cert.SerialNumber,
+	}, nil
+}
+
+func (id *ocspCertID) equal(other *ocspCertID) bool {
+	return id.HashAlgorithm.Algorithm.Equal(other.HashAlgorithm.Algorithm) &&
+		bytes.Equal(id.NameHash, other.NameHash) &&
+		bytes.Equal(id.IssuerKeyHash, other.IssuerKeyHash) &&
+		id.SerialNumber.Cmp(other.SerialNumber) == 0
+}
+
+// createOCSPRequest creates an OCSP request for the revocation
+// status of the given certificate, issued by the given issuer
+func createOCSPRequest(cert, issuer *x509.Certificate) ([]byte, error) {
+	certID, err := newOCSPCertID(cert, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(ocspRequest{
+		TBSRequest: ocspTBSRequest{
+			RequestList: []ocspRequestEntry{{Cert: *certID}},
+		},
+	})
+}
+
+// parseOCSPResponse parses the given OCSP response to a request for the
+// revocation status of the given certificate, and verifies that it was
+// signed by the issuer of the certificate or by a responder it authorized
+// and whose certificate is valid at the given time
+func parseOCSPResponse(der []byte, cert, issuer *x509.Certificate, now time.Time) (*ocspStatus, error) {
+	resp := &ocspResponse{}
+	rest, err := asn1.Unmarshal(der, resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing OCSP response")
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data in OCSP response")
+	}
+	if resp.Status != 0 {
+		return nil, errors.Errorf("OCSP responder returned status %d", resp.Status)
+	}
+	if !resp.Response.ResponseType.Equal(oidOCSPBasic) {
+		return nil, errors.Errorf("unsupported OCSP response type %s", resp.Response.ResponseType)
+	}
+
+	basic := &ocspBasicResponse{}
+	if _, err := asn1.Unmarshal(resp.Response.Response, basic); err != nil {
+		return nil, errors.Wrap(err, "failed parsing basic OCSP response")
+	}
+
+	if err := verifyOCSPSignature(basic, issuer, now); err != nil {
+		return nil, err
+	}
+
+	certID, err := newOCSPCertID(cert, issuer)
+	if err != nil {
+		return nil, err
+	}
+	for _, single := range basic.TBSResponseData.Responses {
+		if !certID.equal(&single.CertID) {
+			continue
+		}
+		switch {
+		case bool(single.Good):
+			return &ocspStatus{thisUpdate: single.ThisUpdate, nextUpdate: single.NextUpdate}, nil
+		case bool(single.Unknown):
+			return nil, errors.New("OCSP responder doesn't know the certificate")
+		default:
+			return &ocspStatus{revoked: true, thisUpdate: single.ThisUpdate, nextUpdate: single.NextUpdate}, nil
+		}
+	}
+	return nil, errors.New("OCSP response doesn't contain the status of the certificate")
+}
+
+// verifyOCSPSignature verifies the signature of the given OCSP response,
+// which is made either by the issuer itself or by a certificate issued
+// by the issuer for OCSP signing, valid at the given time
+func verifyOCSPSignature(basic *ocspBasicResponse, issuer *x509.Certificate, now time.Time) error {
+	algo := x509.UnknownSignatureAlgorithm
+	for _, candidate := range ocspSignatureAlgorithms {
+		if candidate.oid.Equal(basic.SignatureAlgorithm.Algorithm) {
+			algo = candidate.algo
+		}
+	}
+	if algo == x509.UnknownSignatureAlgorithm {
+		return errors.Errorf("unsupported OCSP signature algorithm %s", basic.SignatureAlgorithm.Algorithm)
+	}
+
+	signer := issuer
+	if len(basic.Certificates) != 0 {
+		responder, err := x509.ParseCertificate(basic.Certificates[0].FullBytes)
+		if err != nil {
+			return errors.Wrap(err, "failed parsing OCSP responder certificate")
+		}
+		if !bytes.Equal(responder.Raw, issuer.Raw) {
+			if err := responder.CheckSignatureFrom(issuer); err != nil {
+				return errors.Wrap(err, "OCSP responder certificate isn't issued by the issuer")
+			}
+			authorized := false
+			for _, usage := range responder.ExtKeyUsage {
+				authorized = authorized || usage == oidOCSPSigningKU
+			}
+			if !authorized {
+				return errors.New("OCSP responder certificate isn't authorized for OCSP signing")
+			}
+			if now.Before(responder.NotBefore) || now.After(responder.NotAfter) {
+				return errors.Errorf("OCSP responder certificate isn't valid at %s", now)
+			}
+			signer = responder
+		}
+	}
+
+	if err := signer.CheckSignature(algo, basic.TBSResponseData.Raw, basic.Signature.RightAlign()); err != nil {
+		return errors.Wrap(err, "invalid OCSP response signature")
+	}
+	return nil
+}
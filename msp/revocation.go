@@ -0,0 +1,277 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxRevocationResponseSize bounds the size of the CRLs
+// and OCSP responses fetched by a RevocationChecker
+const maxRevocationResponseSize = 16 * 1024 * 1024
+
+// RevocationCheckerOpts contains the configuration of a RevocationChecker
+type RevocationCheckerOpts struct {
+	// Timeout bounds the time spent fetching a CRL or querying an OCSP responder
+	Timeout time.Duration
+	// CacheTTL is the maximum amount of time the revocation status of a
+	// certificate, and a CRL, are cached. They are cached for less time
+	// if the CRL or the OCSP response is updated sooner.
+	CacheTTL time.Duration
+	// FailOpen makes certificates whose revocation status can't be determined
+	// be considered as not revoked. Otherwise, they are considered as revoked.
+	FailOpen bool
+}
+
+// RevocationChecker checks whether certificates have been revoked by querying
+// the OCSP responders, and fetching the CRLs from the distribution points,
+// listed in the certificates. Unlike the CRLs in the MSP configuration, this
+// makes the outcome of the validation of an identity depend on the time and
+// on the peer it is performed on, so it must only be used to validate
+// identities locally, and never to validate transactions.
+type RevocationChecker struct {
+	opts   RevocationCheckerOpts
+	client *http.Client
+	now    func() time.Time
+
+	mutex    sync.Mutex
+	statuses map[string]*revocationStatus
+	crls     map[string]*cachedCRL
+}
+
+type revocationStatus struct {
+	revoked bool
+	expiry  time.Time
+}
+
+type cachedCRL struct {
+	crl    *pkix.CertificateList
+	expiry time.Time
+}
+
+// NewRevocationChecker creates a RevocationChecker with the given configuration
+func NewRevocationChecker(opts RevocationCheckerOpts) *RevocationChecker {
+	return &RevocationChecker{
+		opts:     opts,
+		client:   &http.Client{Timeout: opts.Timeout},
+		now:      time.Now,
+		statuses: make(map[string]*revocationStatus),
+		crls:     make(map[string]*cachedCRL),
+	}
+}
+
+// CheckIdentity checks whether any certificate of the certification chain of
+// the given identity has been revoked. Identities that aren't X.509 based,
+// such as Idemix identities, are not checked.
+func (rc *RevocationChecker) CheckIdentity(id Identity) error {
+	var x509Identity *identity
+	switch i := id.(type) {
+	case *identity:
+		x509Identity = i
+	case *signingidentity:
+		x509Identity = &i.identity
+	default:
+		return nil
+	}
+
+	chain, err := x509Identity.msp.getCertificationChainForBCCSPIdentity(x509Identity)
+	if err != nil {
+		return errors.WithMessage(err, "could not obtain certification chain")
+	}
+	// The last certificate of the chain is the root CA certificate,
+	// which can only be distrusted by removing it from the MSP
+	for i := 0; i < len(chain)-1; i++ {
+		if err := rc.CheckCertificate(chain[i], chain[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckCertificate checks whether the given certificate,
+// issued by the given issuer, has been revoked
+func (rc *RevocationChecker) CheckCertificate(cert, issuer *x509.Certificate) error {
+	key := revocationStatusKey(cert, issuer)
+
+	rc.mutex.Lock()
+	status, cached := rc.statuses[key]
+	rc.mutex.Unlock()
+
+	if !cached || !rc.now().Before(status.expiry) {
+		var err error
+		status, err = rc.fetchStatus(cert, issuer)
+		if err != nil {
+			if rc.opts.FailOpen {
+				mspLogger.Warningf("Could not determine whether certificate %s was revoked, assuming it wasn't: %s", cert.Subject, err)
+				return nil
+			}
+			return errors.WithMessage(err, "could not determine whether the certificate has been revoked")
+		}
+
+		rc.mutex.Lock()
+		rc.statuses[key] = status
+		rc.mutex.Unlock()
+	}
+
+	if status.revoked {
+		return errors.New("The certificate has been revoked")
+	}
+	return nil
+}
+
+func revocationStatusKey(cert, issuer *x509.Certificate) string {
+	issuerHash := sha256.Sum256(issuer.Raw)
+	return fmt.Sprintf("%x/%s", issuerHash, cert.SerialNumber)
+}
+
+// fetchStatus determines the revocation status of the given certificate by
+// querying its OCSP responders first, and by fetching its CRLs otherwise
+func (rc *RevocationChecker) fetchStatus(cert, issuer *x509.Certificate) (*revocationStatus, error) {
+	now := rc.now()
+	expiry := now.Add(rc.opts.CacheTTL)
+
+	if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+		return &revocationStatus{expiry: expiry}, nil
+	}
+
+	var failures []string
+	for _, url := range cert.OCSPServer {
+		status, err := rc.queryOCSP(url, cert, issuer)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("OCSP responder %s: %s", url, err))
+			continue
+		}
+		if !status.nextUpdate.IsZero() && status.nextUpdate.Before(expiry) {
+			expiry = status.nextUpdate
+		}
+		if status.nextUpdate.IsZero() && status.thisUpdate.Add(rc.opts.CacheTTL).Before(expiry) {
+			expiry = status.thisUpdate.Add(rc.opts.CacheTTL)
+		}
+		return &revocationStatus{revoked: status.revoked, expiry: expiry}, nil
+	}
+
+	for _, url := range cert.CRLDistributionPoints {
+		crl, err := rc.getCRL(url, issuer)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("CRL distribution point %s: %s", url, err))
+			continue
+		}
+		if crl.expiry.Before(expiry) {
+			expiry = crl.expiry
+		}
+		revoked := false
+		for _, entry := range crl.crl.TBSCertList.RevokedCertificates {
+			revoked = revoked || entry.SerialNumber.Cmp(cert.SerialNumber) == 0
+		}
+		return &revocationStatus{revoked: revoked, expiry: expiry}, nil
+	}
+
+	return nil, errors.Errorf("failed obtaining revocation status: %v", failures)
+}
+
+func (rc *RevocationChecker) queryOCSP(url string, cert, issuer *x509.Certificate) (*ocspStatus, error) {
+	req, err := createOCSPRequest(cert, issuer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed creating OCSP request")
+	}
+
+	resp, err := rc.client.Post(url, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	now := rc.now()
+	status, err := parseOCSPResponse(body, cert, issuer, now)
+	if err != nil {
+		return nil, err
+	}
+	if now.Before(status.thisUpdate) {
+		return nil, errors.Errorf("OCSP response is issued in the future, at %s", status.thisUpdate)
+	}
+	if !status.nextUpdate.IsZero() && now.After(status.nextUpdate) {
+		return nil, errors.Errorf("OCSP response expired at %s", status.nextUpdate)
+	}
+	// Without a next update, responses are considered fresh for as long as statuses are cached
+	if status.nextUpdate.IsZero() && now.After(status.thisUpdate.Add(rc.opts.CacheTTL)) {
+		return nil, errors.Errorf("OCSP response issued at %s is stale", status.thisUpdate)
+	}
+	return status, nil
+}
+
+// getCRL returns the CRL at the given URL, signed by the given issuer.
+// CRLs are cached, so that all the certificates they list are checked
+// against them without fetching them again.
+func (rc *RevocationChecker) getCRL(url string, issuer *x509.Certificate) (*cachedCRL, error) {
+	now := rc.now()
+
+	rc.mutex.Lock()
+	cached, exists := rc.crls[url]
+	rc.mutex.Unlock()
+	if exists && now.Before(cached.expiry) {
+		if err := issuer.CheckCRLSignature(cached.crl); err != nil {
+			return nil, errors.Wrap(err, "CRL isn't signed by the issuer")
+		}
+		return cached, nil
+	}
+
+	resp, err := rc.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	crl, err := x509.ParseCRL(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed parsing CRL")
+	}
+	if err := issuer.CheckCRLSignature(crl); err != nil {
+		return nil, errors.Wrap(err, "CRL isn't signed by the issuer")
+	}
+	if crl.HasExpired(now) {
+		return nil, errors.Errorf("CRL expired at %s", crl.TBSCertList.NextUpdate)
+	}
+
+	cached = &cachedCRL{crl: crl, expiry: now.Add(rc.opts.CacheTTL)}
+	if !crl.TBSCertList.NextUpdate.IsZero() && crl.TBSCertList.NextUpdate.Before(cached.expiry) {
+		cached.expiry = crl.TBSCertList.NextUpdate
+	}
+
+	rc.mutex.Lock()
+	rc.crls[url] = cached
+	rc.mutex.Unlock()
+
+	return cached, nil
+}
+
+func readResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxRevocationResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading response")
+	}
+	return body, nil
+}
@@ -0,0 +1,369 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/stretchr/testify/assert"
+)
+
+// revocationServer is a CA that publishes the revocation
+// status of the certificates it issues through a CRL
+// distribution point and an OCSP responder
+type revocationServer struct {
+	*httptest.Server
+	t       *testing.T
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	serial  int64
+	lock    sync.Mutex
+	revoked []pkix.RevokedCertificate
+	// signer signs the OCSP responses and the CRLs
+	signer *ecdsa.PrivateKey
+	// responder is the certificate of the signer of the OCSP
+	// responses, when they aren't signed by the CA itself
+	responder *x509.Certificate
+	// ocspAge is the age of the OCSP responses, which have
+	// no next update if ocspNoNextUpdate is set
+	ocspAge          time.Duration
+	ocspNoNextUpdate bool
+	fail             bool
+	crlHits          int32
+	ocspHits         int32
+}
+
+func newRevocationServer(t *testing.T) *revocationServer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	rs := &revocationServer{t: t, cert: cert, key: key, signer: key, serial: 1}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/crl", rs.serveCRL)
+	mux.HandleFunc("/ocsp", rs.serveOCSP)
+	rs.Server = httptest.NewServer(mux)
+	return rs
+}
+
+func (rs *revocationServer) issue(withOCSP, withCRL bool) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(rs.t, err)
+
+	rs.lock.Lock()
+	rs.serial++
+	serial := rs.serial
+	rs.lock.Unlock()
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(serial),
+		Subject:        pkix.Name{CommonName: "peer0.example.com"},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(24 * time.Hour),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		AuthorityKeyId: rs.cert.SubjectKeyId,
+	}
+	if withOCSP {
+		template.OCSPServer = []string{rs.URL + "/ocsp"}
+	}
+	if withCRL {
+		template.CRLDistributionPoints = []string{rs.URL + "/crl"}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, rs.cert, &key.PublicKey, rs.key)
+	assert.NoError(rs.t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(rs.t, err)
+	return cert
+}
+
+// delegate makes the OCSP responses be signed by a responder
+// authorized by the CA, valid between the given times
+func (rs *revocationServer) delegate(notBefore, notAfter time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(rs.t, err)
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1000),
+		Subject:        pkix.Name{CommonName: "ocsp.example.com"},
+		NotBefore:      notBefore,
+		NotAfter:       notAfter,
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+		AuthorityKeyId: rs.cert.SubjectKeyId,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, rs.cert, &key.PublicKey, rs.key)
+	assert.NoError(rs.t, err)
+	rs.responder, err = x509.ParseCertificate(der)
+	assert.NoError(rs.t, err)
+	rs.signer = key
+}
+
+func (rs *revocationServer) revoke(cert *x509.Certificate) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	rs.revoked = append(rs.revoked, pkix.RevokedCertificate{
+		SerialNumber:   cert.SerialNumber,
+		RevocationTime: time.Now(),
+	})
+}
+
+func (rs *revocationServer) isRevoked(serial *big.Int) bool {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	for _, rc := range rs.revoked {
+		if rc.SerialNumber.Cmp(serial) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (rs *revocationServer) serveCRL(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&rs.crlHits, 1)
+	if rs.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	rs.lock.Lock()
+	revoked := append([]pkix.RevokedCertificate(nil), rs.revoked...)
+	rs.lock.Unlock()
+
+	crl, err := rs.cert.CreateCRL(rand.Reader, rs.signer, revoked, time.Now(), time.Now().Add(time.Hour))
+	assert.NoError(rs.t, err)
+	w.Write(crl)
+}
+
+func (rs *revocationServer) serveOCSP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&rs.ocspHits, 1)
+	if rs.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	assert.NoError(rs.t, err)
+	req := &ocspRequest{}
+	_, err = asn1.Unmarshal(body, req)
+	assert.NoError(rs.t, err)
+	certID := req.TBSRequest.RequestList[0].Cert
+
+	single := ocspSingleResponse{
+		CertID:     certID,
+		ThisUpdate: time.Now().Add(-rs.ocspAge).UTC(),
+		NextUpdate: time.Now().Add(time.Hour).UTC(),
+	}
+	if rs.ocspNoNextUpdate {
+		single.NextUpdate = time.Time{}
+	}
+	if rs.isRevoked(certID.SerialNumber) {
+		single.Revoked = ocspRevokedInfo{RevocationTime: time.Now().UTC()}
+	} else {
+		single.Good = true
+	}
+
+	responderID, err := asn1.Marshal(certID.IssuerKeyHash)
+	assert.NoError(rs.t, err)
+	tbs, err := asn1.Marshal(ocspResponseData{
+		RawResponderID: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, IsCompound: true, Bytes: responderID},
+		ProducedAt:     time.Now().UTC(),
+		Responses:      []ocspSingleResponse{single},
+	})
+	assert.NoError(rs.t, err)
+
+	digest := sha256.Sum256(tbs)
+	signature, err := rs.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	assert.NoError(rs.t, err)
+	basicResp := ocspBasicResponse{
+		TBSResponseData:    ocspResponseData{Raw: tbs},
+		SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+		Signature:          asn1.BitString{Bytes: signature, BitLength: 8 * len(signature)},
+	}
+	if rs.responder != nil {
+		basicResp.Certificates = []asn1.RawValue{{FullBytes: rs.responder.Raw}}
+	}
+	basic, err := asn1.Marshal(basicResp)
+	assert.NoError(rs.t, err)
+	resp, err := asn1.Marshal(ocspResponse{
+		Response: ocspResponseBytes{ResponseType: oidOCSPBasic, Response: basic},
+	})
+	assert.NoError(rs.t, err)
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	w.Write(resp)
+}
+
+func TestRevocationCheckerOCSP(t *testing.T) {
+	rs := newRevocationServer(t)
+	defer rs.Close()
+
+	rc := NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute})
+	good, revoked := rs.issue(true, true), rs.issue(true, true)
+	rs.revoke(revoked)
+
+	assert.NoError(t, rc.CheckCertificate(good, rs.cert))
+	assert.EqualError(t, rc.CheckCertificate(revoked, rs.cert), "The certificate has been revoked")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&rs.ocspHits))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&rs.crlHits))
+
+	// The status is cached until the cache TTL elapses
+	rs.revoke(good)
+	assert.NoError(t, rc.CheckCertificate(good, rs.cert))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&rs.ocspHits))
+	rc.now = func() time.Time { return time.Now().Add(time.Minute) }
+	assert.EqualError(t, rc.CheckCertificate(good, rs.cert), "The certificate has been revoked")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&rs.ocspHits))
+}
+
+func TestRevocationCheckerOCSPFreshness(t *testing.T) {
+	rs := newRevocationServer(t)
+	defer rs.Close()
+	cert := rs.issue(true, false)
+	newChecker := func() *RevocationChecker {
+		return NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute})
+	}
+
+	// Responses issued in the future are rejected
+	rs.ocspAge = -time.Hour
+	err := newChecker().CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "OCSP response is issued in the future")
+
+	// Responses without a next update are fresh as long as statuses are cached
+	rs.ocspAge, rs.ocspNoNextUpdate = 2*time.Minute, true
+	err = newChecker().CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "is stale")
+	rs.ocspAge = 0
+	assert.NoError(t, newChecker().CheckCertificate(cert, rs.cert))
+
+	// Responses signed by a responder authorized by the issuer are only
+	// accepted within the validity period of the responder certificate
+	rs.delegate(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	assert.NoError(t, newChecker().CheckCertificate(cert, rs.cert))
+	rs.delegate(time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	err = newChecker().CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "OCSP responder certificate isn't valid at")
+	rs.delegate(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+	err = newChecker().CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "OCSP responder certificate isn't valid at")
+}
+
+func TestRevocationCheckerCRL(t *testing.T) {
+	rs := newRevocationServer(t)
+	defer rs.Close()
+
+	rc := NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute})
+	good, revoked := rs.issue(false, true), rs.issue(false, true)
+	rs.revoke(revoked)
+
+	assert.NoError(t, rc.CheckCertificate(good, rs.cert))
+	assert.EqualError(t, rc.CheckCertificate(revoked, rs.cert), "The certificate has been revoked")
+	// The CRL is fetched once for both certificates
+	assert.Equal(t, int32(1), atomic.LoadInt32(&rs.crlHits))
+
+	// The CRL is fetched again once the cache TTL elapsed
+	rs.revoke(good)
+	rc.now = func() time.Time { return time.Now().Add(time.Minute) }
+	assert.EqualError(t, rc.CheckCertificate(good, rs.cert), "The certificate has been revoked")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&rs.crlHits))
+}
+
+func TestRevocationCheckerFailurePolicy(t *testing.T) {
+	rs := newRevocationServer(t)
+	defer rs.Close()
+	cert := rs.issue(true, true)
+	rs.fail = true
+
+	rc := NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute, FailOpen: true})
+	assert.NoError(t, rc.CheckCertificate(cert, rs.cert))
+
+	rc = NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute})
+	err := rc.CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "could not determine whether the certificate has been revoked")
+	assert.Contains(t, err.Error(), "OCSP responder "+rs.URL+"/ocsp: unexpected status 503 Service Unavailable")
+	assert.Contains(t, err.Error(), "CRL distribution point "+rs.URL+"/crl: unexpected status 503 Service Unavailable")
+
+	// Failures aren't cached
+	rs.fail = false
+	assert.NoError(t, rc.CheckCertificate(cert, rs.cert))
+
+	// Responses that aren't signed by the issuer are rejected
+	rs.signer, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	cert = rs.issue(true, false)
+	err = rc.CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "invalid OCSP response signature")
+	cert = rs.issue(false, true)
+	err = rc.CheckCertificate(cert, rs.cert)
+	assert.Contains(t, err.Error(), "CRL isn't signed by the issuer")
+
+	// Certificates without OCSP responders nor CRL distribution points are not revoked
+	cert = rs.issue(false, false)
+	assert.NoError(t, rc.CheckCertificate(cert, rs.cert))
+}
+
+func TestRevocationCheckerCheckIdentity(t *testing.T) {
+	rs := newRevocationServer(t)
+	defer rs.Close()
+
+	mspInst, err := newBccspMsp(MSPv1_0)
+	assert.NoError(t, err)
+	fabricConf, err := proto.Marshal(&mspprotos.FabricMSPConfig{
+		Name:      "RevocationMSP",
+		RootCerts: [][]byte{pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rs.cert.Raw})},
+	})
+	assert.NoError(t, err)
+	err = mspInst.Setup(&mspprotos.MSPConfig{Type: int32(FABRIC), Config: fabricConf})
+	assert.NoError(t, err)
+
+	deserialize := func(cert *x509.Certificate) Identity {
+		sID, err := proto.Marshal(&mspprotos.SerializedIdentity{
+			Mspid:   "RevocationMSP",
+			IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+		})
+		assert.NoError(t, err)
+		id, err := mspInst.DeserializeIdentity(sID)
+		assert.NoError(t, err)
+		assert.NoError(t, id.Validate())
+		return id
+	}
+
+	rc := NewRevocationChecker(RevocationCheckerOpts{Timeout: time.Second, CacheTTL: time.Minute})
+	good, revoked := rs.issue(true, false), rs.issue(true, false)
+	rs.revoke(revoked)
+
+	assert.NoError(t, rc.CheckIdentity(deserialize(good)))
+	assert.EqualError(t, rc.CheckIdentity(deserialize(revoked)), "The certificate has been revoked")
+
+	// Identities that aren't X.509 based aren't checked
+	assert.NoError(t, rc.CheckIdentity(&idemixidentity{}))
+}
@@ -40,21 +40,23 @@ type TopLevel struct {
 
 // General contains config which should be common among all orderer types.
 type General struct {
-	LedgerType     string
-	ListenAddress  string
-	ListenPort     uint16
-	TLS            TLS
-	Cluster        Cluster
-	Keepalive      Keepalive
-	GenesisMethod  string
-	GenesisProfile string
-	SystemChannel  string
-	GenesisFile    string
-	Profile        Profile
-	LocalMSPDir    string
-	LocalMSPID     string
-	BCCSP          *bccsp.FactoryOpts
-	Authentication Authentication
+	LedgerType            string
+	ListenAddress         string
+	ListenPort            uint16
+	TLS                   TLS
+	Cluster               Cluster
+	Keepalive             Keepalive
+	GenesisMethod         string
+	GenesisProfile        string
+	SystemChannel         string
+	GenesisFile           string
+	Profile               Profile
+	LocalMSPDir           string
+	LocalMSPID            string
+	BCCSP                 *bccsp.FactoryOpts
+	Authentication        Authentication
+	CredentialsReload     CredentialsReload
+	CertificateExpiration CertificateExpiration
 }
 
 type Cluster struct {
@@ -68,6 +70,19 @@ type Cluster struct {
 	ReplicationRetryTimeout time.Duration
 }
 
+// CredentialsReload contains configuration for reloading the local MSP
+// and the TLS certificates when their files change.
+type CredentialsReload struct {
+	Interval time.Duration
+}
+
+// CertificateExpiration contains configuration for monitoring the
+// expiration of the certificates of the orderer and its channels.
+type CertificateExpiration struct {
+	CheckInterval     time.Duration
+	WarningThresholds []time.Duration
+}
+
 // Keepalive contains configuration for gRPC servers.
 type Keepalive struct {
 	ServerMinInterval time.Duration
@@ -311,6 +326,13 @@ func (c *TopLevel) completeInitialization(configDir string) {
 		coreconfig.TranslatePathInPlace(configDir, &c.General.TLS.Certificate)
 		coreconfig.TranslatePathInPlace(configDir, &c.General.GenesisFile)
 		coreconfig.TranslatePathInPlace(configDir, &c.General.LocalMSPDir)
+		// Translate any paths for the TLS configuration of the remote signer
+		if c.General.BCCSP != nil && c.General.BCCSP.RemoteOpts != nil {
+			remoteTLS := &c.General.BCCSP.RemoteOpts.TLS
+			coreconfig.TranslatePathInPlace(configDir, &remoteTLS.Certificate)
+			coreconfig.TranslatePathInPlace(configDir, &remoteTLS.Key)
+			remoteTLS.RootCAs = translateCAs(configDir, remoteTLS.RootCAs)
+		}
 	}()
 
 	for {
@@ -11,6 +11,8 @@ import (
 	"testing"
 	"time"
 
+	bccsp "github.com/hyperledger/fabric/bccsp/factory"
+	"github.com/hyperledger/fabric/bccsp/remote"
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +24,7 @@ func TestLoadGoodConfig(t *testing.T) {
 	cfg, err := Load()
 	assert.NotNil(t, cfg, "Could not load config")
 	assert.Nil(t, err, "Load good config returned unexpected error")
+	assert.Equal(t, []time.Duration{720 * time.Hour, 168 * time.Hour, 24 * time.Hour}, cfg.General.CertificateExpiration.WarningThresholds)
 }
 
 func TestLoadMissingConfigFile(t *testing.T) {
@@ -183,3 +186,21 @@ Consensus:
 	assert.Equal(t, foo.Foo, "bar")
 	assert.Equal(t, foo.Hello.World, 42)
 }
+
+func TestRemoteSignerPathTranslation(t *testing.T) {
+	uconf := &TopLevel{General: General{BCCSP: &bccsp.FactoryOpts{
+		RemoteOpts: &remote.RemoteOpts{
+			TLS: remote.TLSOpts{
+				Certificate: "tls/client.crt",
+				Key:         "/tls/client.key",
+				RootCAs:     []string{"tls/ca.crt"},
+			},
+		},
+	}}}
+	uconf.completeInitialization("/dummy/path")
+
+	remoteTLS := uconf.General.BCCSP.RemoteOpts.TLS
+	assert.Equal(t, "/dummy/path/tls/client.crt", remoteTLS.Certificate)
+	assert.Equal(t, "/tls/client.key", remoteTLS.Key)
+	assert.Equal(t, []string{"/dummy/path/tls/ca.crt"}, remoteTLS.RootCAs)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/certmonitor"
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/operations"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	"github.com/pkg/errors"
+)
+
+// startCertificateMonitor starts monitoring the expiration of the local
+// enrollment certificate, of the TLS certificates of the orderer, and of the
+// CA and Raft consenter certificates of its channels, and registers an
+// operations endpoint that lists them
+func startCertificateMonitor(
+	conf *localconfig.TopLevel,
+	opsSystem *operations.System,
+	metricsProvider metrics.Provider,
+	grpcServer *comm.GRPCServer,
+	clusterDialer *cluster.PredicateDialer,
+	lf blockledger.Factory,
+	registrar *multichannel.Registrar,
+) *certmonitor.Monitor {
+	monitor := certmonitor.New(certmonitor.Config{
+		Interval:          conf.General.CertificateExpiration.CheckInterval,
+		WarningThresholds: conf.General.CertificateExpiration.WarningThresholds,
+		MetricsProvider:   metricsProvider,
+	})
+
+	monitor.AddSource("local", func() ([]certmonitor.Certificate, error) {
+		return localCertificates(grpcServer, clusterDialer)
+	})
+	monitor.AddSource("channels", func() ([]certmonitor.Certificate, error) {
+		return channelCertificates(lf, registrar)
+	})
+
+	opsSystem.RegisterHandler("/certificates", monitor)
+	monitor.Start()
+
+	return monitor
+}
+
+// localCertificates returns the certificates the orderer currently uses,
+// which change when its credentials are reloaded
+func localCertificates(grpcServer *comm.GRPCServer, clusterDialer *cluster.PredicateDialer) ([]certmonitor.Certificate, error) {
+	signingIdentity, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed obtaining the local signing identity")
+	}
+	serializedIdentity, err := signingIdentity.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed serializing the local signing identity")
+	}
+	certs, err := certmonitor.FromSerializedIdentity(certmonitor.KindEnrollment, serializedIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grpcServer.TLSEnabled() {
+		return certs, nil
+	}
+	serverCerts, err := certmonitor.FromTLSCertificate(certmonitor.KindTLSServer, grpcServer.ServerCertificate())
+	if err != nil {
+		return certs, errors.WithMessage(err, "invalid TLS server certificate")
+	}
+	certs = append(certs, serverCerts...)
+
+	clusterConfig := clusterDialer.Config.Load().(comm.ClientConfig)
+	if clusterConfig.SecOpts == nil {
+		return certs, nil
+	}
+	clientCerts, err := certmonitor.FromPEM(certmonitor.KindTLSClient, "", "", clusterConfig.SecOpts.Certificate)
+	if err != nil {
+		return certs, errors.WithMessage(err, "invalid cluster client TLS certificate")
+	}
+	return append(certs, clientCerts...), nil
+}
+
+// channelCertificates returns the CA certificates of the MSPs
+// and the TLS certificates of the Raft consenters of the channels
+func channelCertificates(lf blockledger.Factory, registrar *multichannel.Registrar) ([]certmonitor.Certificate, error) {
+	var certs []certmonitor.Certificate
+	var failures []error
+	for _, channel := range lf.ChainIDs() {
+		cs := registrar.GetChain(channel)
+		if cs == nil {
+			continue
+		}
+
+		channelCerts, err := certmonitor.ChannelCertificates(channel, cs.ConfigtxValidator().ConfigProto())
+		if err != nil {
+			failures = append(failures, errors.WithMessage(err, fmt.Sprintf("channel %s", channel)))
+			continue
+		}
+		certs = append(certs, channelCerts...)
+
+		if cs.SharedConfig().ConsensusType() != "etcdraft" {
+			continue
+		}
+		consenterCerts, err := certmonitor.ConsenterCertificates(channel, cs.SharedConfig().ConsensusMetadata())
+		if err != nil {
+			failures = append(failures, errors.WithMessage(err, fmt.Sprintf("channel %s", channel)))
+			continue
+		}
+		certs = append(certs, consenterCerts...)
+	}
+
+	if len(failures) != 0 {
+		return certs, errors.Errorf("failed obtaining certificates of channels: %v", failures)
+	}
+	return certs, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+
+	"github.com/hyperledger/fabric/common/reload"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/operations"
+	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/pkg/errors"
+)
+
+// startCredentialsReloader starts reloading the local MSP, the TLS certificate
+// of the gRPC server and the client TLS certificate of the cluster when their
+// files change, and registers an operations endpoint that reloads them on demand
+func startCredentialsReloader(conf *localconfig.TopLevel, opsSystem *operations.System, grpcServer *comm.GRPCServer, caSupport *comm.CASupport, clusterDialer *cluster.PredicateDialer) (*reload.Reloader, error) {
+	reloader := reload.New(conf.General.CredentialsReload.Interval)
+
+	err := reloader.Register("local MSP", mspmgmt.ReloadLocalMsp, conf.General.LocalMSPDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.General.TLS.Enabled {
+		reloadServerCert := func() error {
+			return reloadServerCertificate(conf, grpcServer)
+		}
+		err := reloader.Register("TLS certificates", reloadServerCert, conf.General.TLS.Certificate, conf.General.TLS.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.General.TLS.Enabled && conf.General.Cluster.ClientCertificate != "" {
+		reloadClusterCert := func() error {
+			return reloadClusterClientCertificate(conf, caSupport, clusterDialer)
+		}
+		err := reloader.Register("cluster client TLS certificates", reloadClusterCert, conf.General.Cluster.ClientCertificate, conf.General.Cluster.ClientPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opsSystem.RegisterHandler("/credentials/reload", reloader)
+	reloader.Start()
+
+	return reloader, nil
+}
+
+// reloadServerCertificate makes the gRPC server present
+// the TLS certificate currently on the file system
+func reloadServerCertificate(conf *localconfig.TopLevel, grpcServer *comm.GRPCServer) error {
+	certBytes, keyBytes, err := readKeyPair(conf.General.TLS.Certificate, conf.General.TLS.PrivateKey)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.X509KeyPair(certBytes, keyBytes)
+	if err != nil {
+		return errors.Wrap(err, "error parsing TLS key pair")
+	}
+
+	grpcServer.SetServerCertificate(cert)
+	return nil
+}
+
+// reloadClusterClientCertificate makes the connections to other cluster
+// members authenticate with the client TLS certificate currently on the
+// file system. Existing connections keep their certificate until they
+// are re-established.
+func reloadClusterClientCertificate(conf *localconfig.TopLevel, caSupport *comm.CASupport, clusterDialer *cluster.PredicateDialer) error {
+	certBytes, keyBytes, err := readKeyPair(conf.General.Cluster.ClientCertificate, conf.General.Cluster.ClientPrivateKey)
+	if err != nil {
+		return err
+	}
+	if _, err := tls.X509KeyPair(certBytes, keyBytes); err != nil {
+		return errors.Wrap(err, "error parsing TLS key pair")
+	}
+
+	// The root CAs of the cluster are updated under the same lock
+	caSupport.Lock()
+	defer caSupport.Unlock()
+
+	clusterConfig := clusterDialer.Config.Load().(comm.ClientConfig)
+	secOpts := *clusterConfig.SecOpts
+	secOpts.Certificate = certBytes
+	secOpts.Key = keyBytes
+	clusterConfig.SecOpts = &secOpts
+	clusterDialer.SetConfig(clusterConfig)
+	return nil
+}
+
+func readKeyPair(certFile, keyFile string) ([]byte, []byte, error) {
+	certBytes, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error loading TLS certificate %s", certFile)
+	}
+	keyBytes, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error loading TLS key %s", keyFile)
+	}
+	return certBytes, keyBytes, nil
+}
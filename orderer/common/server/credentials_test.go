@@ -0,0 +1,119 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadServerCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	initialPair, err := ca.NewServerCertKeyPair("localhost")
+	assert.NoError(t, err)
+	renewedPair, err := ca.NewServerCertKeyPair("localhost")
+	assert.NoError(t, err)
+
+	conf := &localconfig.TopLevel{
+		General: localconfig.General{
+			TLS: localconfig.TLS{
+				Enabled:     true,
+				Certificate: filepath.Join(dir, "server.crt"),
+				PrivateKey:  filepath.Join(dir, "server.key"),
+			},
+		},
+	}
+
+	grpcServer, err := comm.NewGRPCServer("localhost:0", comm.ServerConfig{
+		SecOpts: &comm.SecureOptions{
+			UseTLS:      true,
+			Certificate: initialPair.Cert,
+			Key:         initialPair.Key,
+		},
+	})
+	assert.NoError(t, err)
+	defer grpcServer.Listener().Close()
+
+	// The files don't exist
+	err = reloadServerCertificate(conf, grpcServer)
+	assert.Contains(t, err.Error(), "error loading TLS certificate")
+
+	// The key doesn't match the certificate
+	assert.NoError(t, ioutil.WriteFile(conf.General.TLS.Certificate, renewedPair.Cert, 0644))
+	assert.NoError(t, ioutil.WriteFile(conf.General.TLS.PrivateKey, initialPair.Key, 0600))
+	err = reloadServerCertificate(conf, grpcServer)
+	assert.Contains(t, err.Error(), "error parsing TLS key pair")
+	assert.Equal(t, initialPair.TLSCert.Raw, grpcServer.ServerCertificate().Certificate[0])
+
+	assert.NoError(t, ioutil.WriteFile(conf.General.TLS.PrivateKey, renewedPair.Key, 0600))
+	assert.NoError(t, reloadServerCertificate(conf, grpcServer))
+	assert.Equal(t, renewedPair.TLSCert.Raw, grpcServer.ServerCertificate().Certificate[0])
+}
+
+func TestReloadClusterClientCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, err := tlsgen.NewCA()
+	assert.NoError(t, err)
+	initialPair, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+	renewedPair, err := ca.NewClientCertKeyPair()
+	assert.NoError(t, err)
+
+	conf := &localconfig.TopLevel{
+		General: localconfig.General{
+			Cluster: localconfig.Cluster{
+				ClientCertificate: filepath.Join(dir, "client.crt"),
+				ClientPrivateKey:  filepath.Join(dir, "client.key"),
+			},
+		},
+	}
+
+	initialSecOpts := &comm.SecureOptions{
+		UseTLS:            true,
+		RequireClientCert: true,
+		ServerRootCAs:     [][]byte{ca.CertBytes()},
+		Certificate:       initialPair.Cert,
+		Key:               initialPair.Key,
+	}
+	clusterDialer := &cluster.PredicateDialer{}
+	clusterDialer.SetConfig(comm.ClientConfig{SecOpts: initialSecOpts})
+	caSupport := &comm.CASupport{}
+
+	// The key doesn't match the certificate
+	assert.NoError(t, ioutil.WriteFile(conf.General.Cluster.ClientCertificate, renewedPair.Cert, 0644))
+	assert.NoError(t, ioutil.WriteFile(conf.General.Cluster.ClientPrivateKey, initialPair.Key, 0600))
+	err = reloadClusterClientCertificate(conf, caSupport, clusterDialer)
+	assert.Contains(t, err.Error(), "error parsing TLS key pair")
+
+	assert.NoError(t, ioutil.WriteFile(conf.General.Cluster.ClientPrivateKey, renewedPair.Key, 0600))
+	assert.NoError(t, reloadClusterClientCertificate(conf, caSupport, clusterDialer))
+
+	clusterConfig, err := clusterDialer.ClientConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, renewedPair.Cert, clusterConfig.SecOpts.Certificate)
+	assert.Equal(t, renewedPair.Key, clusterConfig.SecOpts.Key)
+	assert.Equal(t, [][]byte{ca.CertBytes()}, clusterConfig.SecOpts.ServerRootCAs)
+	assert.True(t, clusterConfig.SecOpts.RequireClientCert)
+	// The previous options aren't modified, as they may be in use
+	assert.Equal(t, initialPair.Cert, initialSecOpts.Certificate)
+}
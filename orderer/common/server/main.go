@@ -143,7 +143,16 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		}
 	}
 
+	credentialsReloader, err := startCredentialsReloader(conf, opsSystem, grpcServer, caSupport, clusterDialer)
+	if err != nil {
+		logger.Panicf("failed to start reloading credentials: %s", err)
+	}
+	defer credentialsReloader.Stop()
+
 	manager := initializeMultichannelRegistrar(bootstrapBlock, clusterDialer, serverConfig, grpcServer, conf, signer, metricsProvider, opsSystem, lf, tlsCallback)
+
+	certificateMonitor := startCertificateMonitor(conf, opsSystem, metricsProvider, grpcServer, clusterDialer, lf, manager)
+	defer certificateMonitor.Stop()
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	server := NewServer(manager, metricsProvider, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS)
 
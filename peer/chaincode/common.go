@@ -153,12 +153,14 @@ func chaincodeInvokeOrQuery(cmd *cobra.Command, invoke bool, cf *ChaincodeCmdFac
 }
 
 type collectionConfigJson struct {
-	Name           string `json:"name"`
-	Policy         string `json:"policy"`
-	RequiredCount  int32  `json:"requiredPeerCount"`
-	MaxPeerCount   int32  `json:"maxPeerCount"`
-	BlockToLive    uint64 `json:"blockToLive"`
-	MemberOnlyRead bool   `json:"memberOnlyRead"`
+	Name              string `json:"name"`
+	Policy            string `json:"policy"`
+	RequiredCount     int32  `json:"requiredPeerCount"`
+	MaxPeerCount      int32  `json:"maxPeerCount"`
+	BlockToLive       uint64 `json:"blockToLive"`
+	MemberOnlyRead    bool   `json:"memberOnlyRead"`
+	MemberOnlyWrite   bool   `json:"memberOnlyWrite"`
+	EndorsementPolicy string `json:"endorsementPolicy"`
 }
 
 // getCollectionConfig retrieves the collection configuration
@@ -196,6 +198,19 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 			},
 		}
 
+		var ep *pcommon.CollectionPolicyConfig
+		if cconfitem.EndorsementPolicy != "" {
+			sp, err := cauthdsl.FromString(cconfitem.EndorsementPolicy)
+			if err != nil {
+				return nil, errors.WithMessage(err, fmt.Sprintf("invalid endorsement policy %s", cconfitem.EndorsementPolicy))
+			}
+			ep = &pcommon.CollectionPolicyConfig{
+				Payload: &pcommon.CollectionPolicyConfig_SignaturePolicy{
+					SignaturePolicy: sp,
+				},
+			}
+		}
+
 		cc := &pcommon.CollectionConfig{
 			Payload: &pcommon.CollectionConfig_StaticCollectionConfig{
 				StaticCollectionConfig: &pcommon.StaticCollectionConfig{
@@ -205,6 +220,8 @@ func getCollectionConfigFromBytes(cconfBytes []byte) ([]byte, error) {
 					MaximumPeerCount:  cconfitem.MaxPeerCount,
 					BlockToLive:       cconfitem.BlockToLive,
 					MemberOnlyRead:    cconfitem.MemberOnlyRead,
+					MemberOnlyWrite:   cconfitem.MemberOnlyWrite,
+					EndorsementPolicy: ep,
 				},
 			},
 		}
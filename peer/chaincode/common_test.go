@@ -183,7 +183,25 @@ const sampleCollectionConfigGood = `[
 		"requiredPeerCount": 3,
 		"maxPeerCount": 483279847,
 		"blockToLive":10,
-		"memberOnlyRead": true
+		"memberOnlyRead": true,
+		"memberOnlyWrite": true,
+		"endorsementPolicy": "AND('A.peer', 'B.peer')"
+	},
+	{
+		"name": "bar",
+		"policy": "OR('A.member')",
+		"requiredPeerCount": 1,
+		"maxPeerCount": 2
+	}
+]`
+
+const sampleCollectionConfigBadEndorsementPolicy = `[
+	{
+		"name": "foo",
+		"policy": "OR('A.member', 'B.member')",
+		"requiredPeerCount": 3,
+		"maxPeerCount": 483279847,
+		"endorsementPolicy": "barf"
 	}
 ]`
 
@@ -210,8 +228,17 @@ func TestCollectionParsing(t *testing.T) {
 	assert.Equal(t, pol, conf.MemberOrgsPolicy.GetSignaturePolicy())
 	assert.Equal(t, 10, int(conf.BlockToLive))
 	assert.Equal(t, true, conf.MemberOnlyRead)
+	assert.Equal(t, true, conf.MemberOnlyWrite)
+	assert.False(t, ccp.Config[1].GetStaticCollectionConfig().MemberOnlyWrite)
+	ep, _ := cauthdsl.FromString("AND('A.peer', 'B.peer')")
+	assert.Equal(t, ep, conf.EndorsementPolicy.GetSignaturePolicy())
+	assert.Nil(t, ccp.Config[1].GetStaticCollectionConfig().EndorsementPolicy)
 	t.Logf("conf=%s", conf)
 
+	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBadEndorsementPolicy))
+	assert.Contains(t, err.Error(), "invalid endorsement policy barf")
+	assert.Nil(t, cc)
+
 	cc, err = getCollectionConfigFromBytes([]byte(sampleCollectionConfigBad))
 	assert.Error(t, err)
 	assert.Nil(t, cc)
@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	cb "github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -136,6 +137,12 @@ func (cci ccInfo) String() string {
 		if isBytes(f) {
 			val = hex.EncodeToString(f.Bytes())
 		}
+		// Show the endorsement policy in the policy language when possible
+		if md2.Field(i).Name == "Policy" && len(f.Bytes()) != 0 {
+			if policy, err := policyString(f.Bytes()); err == nil {
+				val = policy
+			}
+		}
 		if len(val) == 0 {
 			continue
 		}
@@ -149,6 +156,16 @@ func (cci ccInfo) String() string {
 
 }
 
+// policyString returns the representation in the policy
+// language of the given marshaled signature policy
+func policyString(policyBytes []byte) (string, error) {
+	policy := &cb.SignaturePolicyEnvelope{}
+	if err := proto.Unmarshal(policyBytes, policy); err != nil {
+		return "", err
+	}
+	return cauthdsl.ToString(policy)
+}
+
 func isBytes(v reflect.Value) bool {
 	return v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
 }
@@ -12,8 +12,10 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/peer/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -150,4 +152,13 @@ func TestString(t *testing.T) {
 		},
 	}
 	assert.Equal(t, "Name: ccName, Version: 1.0, Input: input, Escc: escc, Vscc: vscc, Id: 0102030405", ccInf.String())
+
+	policy, err := cauthdsl.FromString("AND('Org1.member', 'Org2.member')")
+	assert.NoError(t, err)
+	ccInf.Policy = utils.MarshalOrPanic(policy)
+	assert.Equal(t, "Name: ccName, Version: 1.0, Input: input, Escc: escc, Vscc: vscc, Id: 0102030405, Policy: AND('Org1.member', 'Org2.member')", ccInf.String())
+
+	// Policies which can't be expressed in the policy language are shown as hex
+	ccInf.Policy = []byte{1, 2, 3}
+	assert.Equal(t, "Name: ccName, Version: 1.0, Input: input, Escc: escc, Vscc: vscc, Id: 0102030405, Policy: 010203", ccInf.String())
 }
@@ -40,6 +40,11 @@ var (
 	channelTxFile string
 	outputBlock   string
 	timeout       time.Duration
+
+	// getinfo related variables
+	stateHash         bool
+	stateHashBlock    int64
+	expectedStateHash string
 )
 
 // Cmd returns the cobra command for Node
@@ -77,6 +82,9 @@ func resetFlags() {
 	flags.StringVarP(&channelTxFile, "file", "f", "", "Configuration transaction file generated by a tool such as configtxgen for submitting to orderer")
 	flags.StringVarP(&outputBlock, "outputBlock", "", common.UndefinedParamValue, `The path to write the genesis block for the channel. (default ./<channelID>.block)`)
 	flags.DurationVarP(&timeout, "timeout", "t", 5*time.Second, "Channel creation timeout")
+	flags.BoolVarP(&stateHash, "statehash", "", false, "Include the state hash in the blockchain information")
+	flags.Int64VarP(&stateHashBlock, "statehashBlock", "", -1, "The block number at which the state hash is retrieved, -1 for the last committed block")
+	flags.StringVarP(&expectedStateHash, "expectedStateHash", "", "", "Hex encoded state hash that is expected at the block specified by --statehashBlock; the peer reports a mismatch if it differs")
 }
 
 func attachFlags(cmd *cobra.Command, names []string) {
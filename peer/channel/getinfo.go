@@ -8,8 +8,10 @@ package channel
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/scc/qscc"
@@ -32,19 +34,47 @@ func getinfoCmd(cf *ChannelCmdFactory) *cobra.Command {
 	}
 	flagList := []string{
 		"channelID",
+		"statehash",
+		"statehashBlock",
+		"expectedStateHash",
 	}
 	attachFlags(getinfoCmd, flagList)
 
 	return getinfoCmd
 }
 func (cc *endorserClient) getBlockChainInfo() (*cb.BlockchainInfo, error) {
+	payload, err := cc.invokeQSCC([]byte(qscc.GetChainInfo), []byte(channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	blockChainInfo := &cb.BlockchainInfo{}
+	err = proto.Unmarshal(payload, blockChainInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read qscc response")
+	}
+
+	return blockChainInfo, nil
+
+}
+
+func (cc *endorserClient) getStateHash(blockNum uint64) ([]byte, error) {
+	return cc.invokeQSCC([]byte(qscc.GetStateHash), []byte(channelID), []byte(strconv.FormatUint(blockNum, 10)))
+}
+
+func (cc *endorserClient) verifyStateHash(blockNum uint64, expectedStateHash string) error {
+	_, err := cc.invokeQSCC([]byte(qscc.VerifyStateHash), []byte(channelID), []byte(strconv.FormatUint(blockNum, 10)), []byte(expectedStateHash))
+	return err
+}
+
+func (cc *endorserClient) invokeQSCC(args ...[]byte) ([]byte, error) {
 	var err error
 
 	invocation := &pb.ChaincodeInvocationSpec{
 		ChaincodeSpec: &pb.ChaincodeSpec{
 			Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]),
 			ChaincodeId: &pb.ChaincodeID{Name: "qscc"},
-			Input:       &pb.ChaincodeInput{Args: [][]byte{[]byte(qscc.GetChainInfo), []byte(channelID)}},
+			Input:       &pb.ChaincodeInput{Args: args},
 		},
 	}
 
@@ -70,14 +100,7 @@ func (cc *endorserClient) getBlockChainInfo() (*cb.BlockchainInfo, error) {
 		return nil, errors.Errorf("received bad response, status %d: %s", proposalResp.Response.Status, proposalResp.Response.Message)
 	}
 
-	blockChainInfo := &cb.BlockchainInfo{}
-	err = proto.Unmarshal(proposalResp.Response.Payload, blockChainInfo)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot read qscc response")
-	}
-
-	return blockChainInfo, nil
-
+	return proposalResp.Response.Payload, nil
 }
 
 func getinfo(cmd *cobra.Command, cf *ChannelCmdFactory) error {
@@ -109,5 +132,31 @@ func getinfo(cmd *cobra.Command, cf *ChannelCmdFactory) error {
 
 	fmt.Printf("Blockchain info: %s\n", string(jsonBytes))
 
+	if !stateHash && expectedStateHash == "" {
+		return nil
+	}
+	if blockChainInfo.Height == 0 {
+		return errors.New("no block has been committed yet")
+	}
+	blockNum := blockChainInfo.Height - 1
+	if stateHashBlock >= 0 {
+		blockNum = uint64(stateHashBlock)
+	}
+
+	if expectedStateHash != "" {
+		if err := client.verifyStateHash(blockNum, expectedStateHash); err != nil {
+			return err
+		}
+		fmt.Printf("State hash at block %d matches the expected state hash\n", blockNum)
+	}
+
+	if stateHash {
+		stateHashBytes, err := client.getStateHash(blockNum)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("State hash at block %d: %s\n", blockNum, hex.EncodeToString(stateHashBytes))
+	}
+
 	return nil
 }
@@ -54,6 +54,51 @@ func TestGetChannelInfo(t *testing.T) {
 	assert.NoError(t, cmd.Execute())
 }
 
+func TestGetChannelInfoWithStateHash(t *testing.T) {
+	InitMSP()
+	resetFlags()
+
+	mockBlockchainInfo := &cb.BlockchainInfo{
+		Height:            2,
+		CurrentBlockHash:  []byte("CurrentBlockHash"),
+		PreviousBlockHash: []byte("PreviousBlockHash"),
+	}
+	mockPayload, err := proto.Marshal(mockBlockchainInfo)
+	assert.NoError(t, err)
+
+	mockResponse := &pb.ProposalResponse{
+		Response: &pb.Response{
+			Status:  200,
+			Payload: mockPayload,
+		},
+		Endorsement: &pb.Endorsement{},
+	}
+
+	signer, err := common.GetDefaultSigner()
+	assert.NoError(t, err)
+
+	mockCF := &ChannelCmdFactory{
+		EndorserClient:   common.GetMockEndorserClient(mockResponse, nil),
+		BroadcastFactory: mockBroadcastClientFactory,
+		Signer:           signer,
+	}
+
+	cmd := getinfoCmd(mockCF)
+	AddFlags(cmd)
+
+	args := []string{"-c", mockChannel, "--statehash", "--statehashBlock", "1", "--expectedStateHash", "0102"}
+	cmd.SetArgs(args)
+
+	assert.NoError(t, cmd.Execute())
+
+	resetFlags()
+	mockResponse.Response = &pb.Response{Status: 500, Message: "State hash mismatch"}
+	cmd = getinfoCmd(mockCF)
+	AddFlags(cmd)
+	cmd.SetArgs(args)
+	assert.EqualError(t, cmd.Execute(), "received bad response, status 500: State hash mismatch")
+}
+
 func TestGetChannelInfoMissingChannelID(t *testing.T) {
 	InitMSP()
 	resetFlags()
@@ -0,0 +1,57 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+type envelopeWrapper func(msg proto.Message) *common2.Envelope
+
+// GossipCmdFactory holds the clients used by GossipCmd
+type GossipCmdFactory struct {
+	AdminClient      pb.AdminClient
+	Writer           io.Writer
+	wrapWithEnvelope envelopeWrapper
+}
+
+// InitCmdFactory init the GossipCmdFactory with default admin client
+func InitCmdFactory() (*GossipCmdFactory, error) {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return nil, errors.Errorf("failed obtaining default signer: %v", err)
+	}
+
+	localSigner := crypto.NewSignatureHeaderCreator(signer)
+	wrapEnv := func(msg proto.Message) *common2.Envelope {
+		env, err := utils.CreateSignedEnvelope(common2.HeaderType_PEER_ADMIN_OPERATION, "", localSigner, msg, 0, 0)
+		if err != nil {
+			logger.Panicf("Failed signing: %v", err)
+		}
+		return env
+	}
+
+	return &GossipCmdFactory{
+		AdminClient:      adminClient,
+		Writer:           os.Stdout,
+		wrapWithEnvelope: wrapEnv,
+	}, nil
+}
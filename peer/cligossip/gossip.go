@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	gossipFuncName = "gossip"
+	gossipCmdDes   = "Gossip membership and channel inspection: view."
+)
+
+var logger = flogging.MustGetLogger("cli.gossip")
+
+// Cmd returns the cobra command for Gossip
+func Cmd(cf *GossipCmdFactory) *cobra.Command {
+	gossipCmd.AddCommand(viewCmd(cf))
+
+	return gossipCmd
+}
+
+var gossipCmd = &cobra.Command{
+	Use:              gossipFuncName,
+	Short:            fmt.Sprint(gossipCmdDes),
+	Long:             fmt.Sprint(gossipCmdDes),
+	PersistentPreRun: common.InitCmd,
+}
@@ -0,0 +1,61 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func initGossipTest(err error) (*GossipCmdFactory, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	return &GossipCmdFactory{
+		AdminClient: common.GetMockAdminClient(err),
+		Writer:      out,
+		wrapWithEnvelope: func(msg proto.Message) *common2.Envelope {
+			pl := &common2.Payload{
+				Data: utils.MarshalOrPanic(msg),
+			}
+			return &common2.Envelope{
+				Payload: utils.MarshalOrPanic(pl),
+			}
+		},
+	}, out
+}
+
+func TestView(t *testing.T) {
+	cf, out := initGossipTest(nil)
+	cmd := viewCmd(cf)
+	cmd.SetArgs([]string{"-c", "mychannel"})
+	assert.NoError(t, cmd.Execute())
+
+	assert.Contains(t, out.String(), "Self: peer0:7051 [7030]")
+	assert.Contains(t, out.String(), "Alive members (1):\n  peer1:7051 [7031] height: 10 chaincodes: mycc:1.0\n")
+	assert.Contains(t, out.String(), "Dead members (1):\n  peer2:7051 [7032]\n")
+	assert.Contains(t, out.String(), "Channel mychannel:\n")
+	assert.Contains(t, out.String(), "  Leader: peer1:7051 (elected)\n")
+	assert.Contains(t, out.String(), "  Anchor peers:\n    Org1MSP: peer1:7051\n")
+	assert.Contains(t, out.String(), "  Peers (1):\n    peer1:7051 [7031] height: 10 chaincodes: mycc:1.0\n")
+}
+
+func TestViewFailure(t *testing.T) {
+	cf, _ := initGossipTest(errors.New("access denied"))
+	cmd := viewCmd(cf)
+	cmd.SetArgs([]string{})
+	assert.EqualError(t, cmd.Execute(), "failed retrieving gossip view: access denied")
+
+	cmd = viewCmd(cf)
+	cmd.SetArgs([]string{"extra"})
+	assert.EqualError(t, cmd.Execute(), "trailing args detected: [extra]")
+}
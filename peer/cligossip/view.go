@@ -0,0 +1,142 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cligossip
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func viewCmd(cf *GossipCmdFactory) *cobra.Command {
+	var channelID string
+	var gossipViewCmd = &cobra.Command{
+		Use:   "view",
+		Short: "Returns the gossip membership and channels of the peer.",
+		Long: `Returns the alive and dead members known to the gossip service of the peer, along with ` +
+			`the ledger height and chaincodes of the peers, the leader and the anchor peers of each channel.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return view(cf, cmd, args, channelID)
+		},
+	}
+	flags := gossipViewCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", "", "Channel to restrict the view to. All the channels of the peer are returned if omitted.")
+
+	return gossipViewCmd
+}
+
+func view(cf *GossipCmdFactory, cmd *cobra.Command, args []string, channelID string) (err error) {
+	if len(args) != 0 {
+		return errors.Errorf("trailing args detected: %s", args)
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(&pb.AdminOperation{
+		Content: &pb.AdminOperation_GossipViewReq{
+			GossipViewReq: &pb.GossipViewRequest{
+				ChannelId: channelID,
+			},
+		},
+	})
+	response, err := cf.AdminClient.GetGossipView(context.Background(), env)
+	if err != nil {
+		return errors.WithMessage(err, "failed retrieving gossip view")
+	}
+	printView(cf.Writer, response)
+	return nil
+}
+
+// printView renders the gossip view of the peer in a human readable form
+func printView(w io.Writer, view *pb.GossipViewResponse) {
+	fmt.Fprintf(w, "Self: %s\n", member(view.Self))
+	printMembers(w, "Alive members", "", view.Alive)
+	printMembers(w, "Dead members", "", view.Dead)
+	for _, channel := range view.Channels {
+		fmt.Fprintf(w, "Channel %s:\n", channel.ChannelId)
+		fmt.Fprintf(w, "  Self: %s\n", member(channel.Self))
+		fmt.Fprintf(w, "  Leader: %s\n", leader(channel))
+		fmt.Fprintf(w, "  Anchor peers:")
+		if len(channel.AnchorPeers) == 0 {
+			fmt.Fprintf(w, " none\n")
+		} else {
+			fmt.Fprintln(w)
+		}
+		for _, org := range channel.AnchorPeers {
+			var endpoints []string
+			for _, anchorPeer := range org.AnchorPeers {
+				endpoints = append(endpoints, fmt.Sprintf("%s:%d", anchorPeer.Host, anchorPeer.Port))
+			}
+			fmt.Fprintf(w, "    %s: %s\n", org.MspId, strings.Join(endpoints, ", "))
+		}
+		printMembers(w, "Peers", "  ", channel.Peers)
+	}
+}
+
+func printMembers(w io.Writer, title, indent string, members []*pb.GossipMember) {
+	fmt.Fprintf(w, "%s%s (%d):\n", indent, title, len(members))
+	for _, m := range members {
+		fmt.Fprintf(w, "%s  %s\n", indent, member(m))
+	}
+}
+
+func member(m *pb.GossipMember) string {
+	if m == nil {
+		return "unknown"
+	}
+	desc := fmt.Sprintf("%s [%s]", m.Endpoint, hex.EncodeToString(m.PkiId))
+	if m.InternalEndpoint != "" && m.InternalEndpoint != m.Endpoint {
+		desc += fmt.Sprintf(" internal endpoint: %s", m.InternalEndpoint)
+	}
+	if m.LedgerHeight != 0 {
+		desc += fmt.Sprintf(" height: %d", m.LedgerHeight)
+	}
+	if len(m.Chaincodes) != 0 {
+		var chaincodes []string
+		for _, cc := range m.Chaincodes {
+			chaincodes = append(chaincodes, fmt.Sprintf("%s:%s", cc.Name, cc.Version))
+		}
+		desc += fmt.Sprintf(" chaincodes: %s", strings.Join(chaincodes, ", "))
+	}
+	if m.LeftChannel {
+		desc += " (left channel)"
+	}
+	return desc
+}
+
+func leader(channel *pb.GossipChannelView) string {
+	mode := "static"
+	if channel.LeaderElection {
+		mode = "elected"
+	}
+	if len(channel.LeaderPkiId) == 0 {
+		return fmt.Sprintf("none (%s)", mode)
+	}
+	endpoint := hex.EncodeToString(channel.LeaderPkiId)
+	if channel.Self != nil && bytes.Equal(channel.Self.PkiId, channel.LeaderPkiId) {
+		endpoint = channel.Self.Endpoint + " (self)"
+	}
+	for _, peer := range channel.Peers {
+		if bytes.Equal(peer.PkiId, channel.LeaderPkiId) {
+			endpoint = peer.Endpoint
+		}
+	}
+	return fmt.Sprintf("%s (%s)", endpoint, mode)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -134,6 +135,7 @@ func InitCrypto(mspMgrConfigDir, localMSPID, localMSPType string) error {
 
 	// Init the BCCSP
 	SetBCCSPKeystorePath()
+	SetBCCSPRemoteSignerPaths()
 	var bccspConfig *factory.FactoryOpts
 	err = viperutil.EnhancedExactUnmarshalKey("peer.BCCSP", &bccspConfig)
 	if err != nil {
@@ -155,6 +157,27 @@ func SetBCCSPKeystorePath() {
 		config.GetPath("peer.BCCSP.SW.FileKeyStore.KeyStore"))
 }
 
+// SetBCCSPRemoteSignerPaths sets the paths of the TLS credentials used
+// to connect to the remote signer of the REMOTE BCCSP provider to
+// absolute paths relative to the config file
+func SetBCCSPRemoteSignerPaths() {
+	for _, key := range []string{"peer.BCCSP.REMOTE.TLS.Certificate", "peer.BCCSP.REMOTE.TLS.Key"} {
+		if viper.IsSet(key) {
+			viper.Set(key, config.GetPath(key))
+		}
+	}
+
+	rootCAsKey := "peer.BCCSP.REMOTE.TLS.RootCAs"
+	if viper.IsSet(rootCAsKey) {
+		configDir := filepath.Dir(viper.ConfigFileUsed())
+		var rootCAs []string
+		for _, rootCA := range viper.GetStringSlice(rootCAsKey) {
+			rootCAs = append(rootCAs, config.TranslatePath(configDir, rootCA))
+		}
+		viper.Set(rootCAsKey, rootCAs)
+	}
+}
+
 // GetDefaultSigner return a default Signer(Default/PERR) for cli
 func GetDefaultSigner() (msp.SigningIdentity, error) {
 	signer, err := mspmgmt.GetLocalMSP().GetDefaultSigningIdentity()
@@ -107,6 +107,28 @@ func TestSetBCCSPKeystorePath(t *testing.T) {
 	os.Unsetenv("FABRIC_CFG_PATH")
 }
 
+func TestSetBCCSPRemoteSignerPaths(t *testing.T) {
+	cfgPath := "./testdata"
+	absPath, _ := filepath.Abs(cfgPath)
+
+	os.Setenv("FABRIC_CFG_PATH", cfgPath)
+	defer os.Unsetenv("FABRIC_CFG_PATH")
+	defer viper.Reset()
+
+	viper.Reset()
+	_ = common.InitConfig("relative")
+	common.SetBCCSPRemoteSignerPaths()
+	assert.False(t, viper.IsSet("peer.BCCSP.REMOTE"))
+
+	viper.Set("peer.BCCSP.REMOTE.TLS.Certificate", "tls/client.crt")
+	viper.Set("peer.BCCSP.REMOTE.TLS.Key", "/tls/client.key")
+	viper.Set("peer.BCCSP.REMOTE.TLS.RootCAs", []string{"tls/ca.crt", "/tls/other-ca.crt"})
+	common.SetBCCSPRemoteSignerPaths()
+	assert.Equal(t, filepath.Join(absPath, "tls/client.crt"), viper.GetString("peer.BCCSP.REMOTE.TLS.Certificate"))
+	assert.Equal(t, "/tls/client.key", viper.GetString("peer.BCCSP.REMOTE.TLS.Key"))
+	assert.Equal(t, []string{filepath.Join(absPath, "tls/ca.crt"), "/tls/other-ca.crt"}, viper.GetStringSlice("peer.BCCSP.REMOTE.TLS.RootCAs"))
+}
+
 func TestCheckLogLevel(t *testing.T) {
 	type args struct {
 		level string
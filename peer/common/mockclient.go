@@ -113,3 +113,42 @@ func (m *mockAdminClient) SetLogSpec(ctx context.Context, in *cb.Envelope, opts
 	response := &pb.LogSpecResponse{LogSpec: "info"}
 	return response, m.err
 }
+
+func (m *mockAdminClient) GetTransientStoreEntries(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.TransientStoreEntriesResponse, error) {
+	return &pb.TransientStoreEntriesResponse{}, m.err
+}
+
+func (m *mockAdminClient) GetReconciliationStatus(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.ReconciliationStatusResponse, error) {
+	return &pb.ReconciliationStatusResponse{}, m.err
+}
+
+func (m *mockAdminClient) Reconcile(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.ReconcileResponse, error) {
+	return &pb.ReconcileResponse{}, m.err
+}
+
+func (m *mockAdminClient) GetGossipView(ctx context.Context, env *cb.Envelope, opts ...grpc.CallOption) (*pb.GossipViewResponse, error) {
+	op := &pb.AdminOperation{}
+	pl := &cb.Payload{}
+	proto.Unmarshal(env.Payload, pl)
+	proto.Unmarshal(pl.Data, op)
+	self := &pb.GossipMember{PkiId: []byte("p0"), Endpoint: "peer0:7051"}
+	peer := &pb.GossipMember{PkiId: []byte("p1"), Endpoint: "peer1:7051", LedgerHeight: 10,
+		Chaincodes: []*pb.GossipChaincode{{Name: "mycc", Version: "1.0"}}}
+	response := &pb.GossipViewResponse{
+		Self:  self,
+		Alive: []*pb.GossipMember{peer},
+		Dead:  []*pb.GossipMember{{PkiId: []byte("p2"), Endpoint: "peer2:7051"}},
+		Channels: []*pb.GossipChannelView{{
+			ChannelId:      op.GetGossipViewReq().GetChannelId(),
+			Self:           self,
+			Peers:          []*pb.GossipMember{peer},
+			LeaderElection: true,
+			LeaderPkiId:    []byte("p1"),
+			AnchorPeers: []*pb.GossipOrgAnchorPeers{{
+				MspId:       "Org1MSP",
+				AnchorPeers: []*pb.AnchorPeer{{Host: "peer1", Port: 7051}},
+			}},
+		}},
+	}
+	return response, m.err
+}
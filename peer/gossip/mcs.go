@@ -41,6 +41,21 @@ type MSPMessageCryptoService struct {
 	channelPolicyManagerGetter policies.ChannelPolicyManagerGetter
 	localSigner                crypto.LocalSigner
 	deserializer               mgmt.DeserializersManager
+	revocationChecker          func() revocationChecker
+}
+
+// revocationChecker checks whether an identity has been revoked
+type revocationChecker interface {
+	CheckIdentity(id msp.Identity) error
+}
+
+// localRevocationChecker returns the revocation checker
+// of the peer, or nil if revocation checking is disabled
+func localRevocationChecker() revocationChecker {
+	if checker := mgmt.GetRevocationChecker(); checker != nil {
+		return checker
+	}
+	return nil
 }
 
 // NewMCS creates a new instance of MSPMessageCryptoService
@@ -50,7 +65,12 @@ type MSPMessageCryptoService struct {
 // 2. an instance of crypto.LocalSigner
 // 3. an identity deserializer manager
 func NewMCS(channelPolicyManagerGetter policies.ChannelPolicyManagerGetter, localSigner crypto.LocalSigner, deserializer mgmt.DeserializersManager) *MSPMessageCryptoService {
-	return &MSPMessageCryptoService{channelPolicyManagerGetter: channelPolicyManagerGetter, localSigner: localSigner, deserializer: deserializer}
+	return &MSPMessageCryptoService{
+		channelPolicyManagerGetter: channelPolicyManagerGetter,
+		localSigner:                localSigner,
+		deserializer:               deserializer,
+		revocationChecker:          localRevocationChecker,
+	}
 }
 
 // ValidateIdentity validates the identity of a remote peer.
@@ -61,8 +81,20 @@ func (s *MSPMessageCryptoService) ValidateIdentity(peerIdentity api.PeerIdentity
 	// below we check only that peerIdentity is not
 	// invalid, revoked or expired.
 
-	_, _, err := s.getValidatedIdentity(peerIdentity)
-	return err
+	identity, _, err := s.getValidatedIdentity(peerIdentity)
+	if err != nil {
+		return err
+	}
+
+	// Remote peers are validated locally, hence their certificates
+	// can be checked against the CRL distribution points and the
+	// OCSP responders they list, if the peer is configured to
+	if checker := s.revocationChecker(); checker != nil {
+		if err := checker.CheckIdentity(identity); err != nil {
+			return errors.WithMessage(err, "identity is revoked")
+		}
+	}
+	return nil
 }
 
 // GetPKIidOfCert returns the PKI-ID of a peer's identity
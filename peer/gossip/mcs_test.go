@@ -123,6 +123,53 @@ func TestValidateIdentity(t *testing.T) {
 	assert.Equal(t, "identity is not well formed: invalid form", err.Error())
 }
 
+type revocationCheckerFunc func(id msp.Identity) error
+
+func (f revocationCheckerFunc) CheckIdentity(id msp.Identity) error {
+	return f(id)
+}
+
+func TestValidateIdentityRevocation(t *testing.T) {
+	deserializersManager := &mocks.DeserializersManager{
+		LocalDeserializer: &mocks.IdentityDeserializer{Identity: []byte("Alice"), Msg: []byte("msg1"), Mock: mock.Mock{}},
+		ChannelDeserializers: map[string]msp.IdentityDeserializer{
+			"A": &mocks.IdentityDeserializer{Identity: []byte("Bob"), Msg: []byte("msg2"), Mock: mock.Mock{}},
+		},
+	}
+	msgCryptoService := NewMCS(
+		&mocks.ChannelPolicyManagerGetterWithManager{},
+		&mockscrypto.LocalSigner{Identity: []byte("Charlie")},
+		deserializersManager,
+	)
+
+	// Revocation checking is disabled by default
+	assert.Nil(t, msgCryptoService.revocationChecker())
+
+	var checked []string
+	msgCryptoService.revocationChecker = func() revocationChecker {
+		return revocationCheckerFunc(func(id msp.Identity) error {
+			msg := string(id.(*mocks.Identity).Msg)
+			checked = append(checked, msg)
+			if msg == "msg2" {
+				return errors.New("The certificate has been revoked")
+			}
+			return nil
+		})
+	}
+
+	err := msgCryptoService.ValidateIdentity([]byte("Alice"))
+	assert.NoError(t, err)
+
+	err = msgCryptoService.ValidateIdentity([]byte("Bob"))
+	assert.EqualError(t, err, "identity is revoked: The certificate has been revoked")
+	assert.Equal(t, []string{"msg1", "msg2"}, checked)
+
+	// Invalid identities are rejected before their revocation status is checked
+	err = msgCryptoService.ValidateIdentity([]byte("Charlie"))
+	assert.Error(t, err)
+	assert.Equal(t, []string{"msg1", "msg2"}, checked)
+}
+
 func TestSign(t *testing.T) {
 	msgCryptoService := NewMCS(
 		&mocks.ChannelPolicyManagerGetter{},
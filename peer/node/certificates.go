@@ -0,0 +1,115 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric/common/certmonitor"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/operations"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// startCertificateMonitor starts monitoring the expiration of the local
+// enrollment certificate, of the TLS certificates of the peer, and of the
+// CA certificates of the channels the peer joined, and registers an
+// operations endpoint that lists them
+func startCertificateMonitor(opsSystem *operations.System, metricsProvider metrics.Provider, peerServer *comm.GRPCServer) (*certmonitor.Monitor, error) {
+	conf, err := certificateMonitorConfig(metricsProvider)
+	if err != nil {
+		return nil, err
+	}
+	monitor := certmonitor.New(conf)
+
+	monitor.AddSource("local", func() ([]certmonitor.Certificate, error) {
+		return localCertificates(peerServer)
+	})
+	monitor.AddSource("channels", channelCertificates)
+
+	opsSystem.RegisterHandler("/certificates", monitor)
+	monitor.Start()
+
+	return monitor, nil
+}
+
+func certificateMonitorConfig(metricsProvider metrics.Provider) (certmonitor.Config, error) {
+	var thresholds []time.Duration
+	for _, threshold := range viper.GetStringSlice("peer.certificateExpiration.warningThresholds") {
+		d, err := time.ParseDuration(threshold)
+		if err != nil {
+			return certmonitor.Config{}, errors.Wrapf(err, "invalid certificate expiration warning threshold %s", threshold)
+		}
+		thresholds = append(thresholds, d)
+	}
+
+	return certmonitor.Config{
+		Interval:          viper.GetDuration("peer.certificateExpiration.checkInterval"),
+		WarningThresholds: thresholds,
+		MetricsProvider:   metricsProvider,
+	}, nil
+}
+
+// localCertificates returns the certificates the peer currently uses,
+// which change when its credentials are reloaded
+func localCertificates(peerServer *comm.GRPCServer) ([]certmonitor.Certificate, error) {
+	signingIdentity, err := mgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed obtaining the local signing identity")
+	}
+	serializedIdentity, err := signingIdentity.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed serializing the local signing identity")
+	}
+	certs, err := certmonitor.FromSerializedIdentity(certmonitor.KindEnrollment, serializedIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if !peerServer.TLSEnabled() {
+		return certs, nil
+	}
+	serverCerts, err := certmonitor.FromTLSCertificate(certmonitor.KindTLSServer, peerServer.ServerCertificate())
+	if err != nil {
+		return certs, errors.WithMessage(err, "invalid TLS server certificate")
+	}
+	clientCerts, err := certmonitor.FromTLSCertificate(certmonitor.KindTLSClient, comm.GetCredentialSupport().GetClientCertificate())
+	if err != nil {
+		return certs, errors.WithMessage(err, "invalid TLS client certificate")
+	}
+	certs = append(certs, serverCerts...)
+	return append(certs, clientCerts...), nil
+}
+
+// channelCertificates returns the CA certificates of the MSPs
+// of the channels the peer joined
+func channelCertificates() ([]certmonitor.Certificate, error) {
+	var certs []certmonitor.Certificate
+	var failures []error
+	for _, channel := range peer.GetChannelsInfo() {
+		res := peer.GetStableChannelConfig(channel.ChannelId)
+		if res == nil {
+			continue
+		}
+		channelCerts, err := certmonitor.ChannelCertificates(channel.ChannelId, res.ConfigtxValidator().ConfigProto())
+		if err != nil {
+			failures = append(failures, errors.WithMessage(err, fmt.Sprintf("channel %s", channel.ChannelId)))
+			continue
+		}
+		certs = append(certs, channelCerts...)
+	}
+
+	if len(failures) != 0 {
+		return certs, errors.Errorf("failed obtaining certificates of channels: %v", failures)
+	}
+	return certs, nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateMonitorConfig(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("peer.certificateExpiration.checkInterval", "1h")
+	viper.Set("peer.certificateExpiration.warningThresholds", []string{"720h", "24h"})
+	conf, err := certificateMonitorConfig(&disabled.Provider{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, conf.Interval)
+	assert.Equal(t, []time.Duration{720 * time.Hour, 24 * time.Hour}, conf.WarningThresholds)
+
+	viper.Set("peer.certificateExpiration.warningThresholds", []string{"a month"})
+	_, err = certificateMonitorConfig(&disabled.Provider{})
+	assert.EqualError(t, err, `invalid certificate expiration warning threshold a month: time: invalid duration "a month"`)
+}
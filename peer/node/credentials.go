@@ -0,0 +1,152 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/reload"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/config"
+	"github.com/hyperledger/fabric/core/operations"
+	"github.com/hyperledger/fabric/core/peer"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/gossip/service"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// localSigningIdentity signs with, and serializes, the signing identity
+// the local MSP has at the time, which changes when the local MSP is reloaded
+type localSigningIdentity struct{}
+
+func (localSigningIdentity) Sign(message []byte) ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Sign(message)
+}
+
+func (localSigningIdentity) Serialize() ([]byte, error) {
+	return mgmt.GetLocalSigningIdentityOrPanic().Serialize()
+}
+
+// newLocalSelfSignedData returns a function which returns data signed by the signing
+// identity the local MSP has at the time. The data is signed again only after the
+// local MSP is reloaded with a new signing identity
+func newLocalSelfSignedData() func() common.SignedData {
+	var lock sync.Mutex
+	var signedData common.SignedData
+	return func() common.SignedData {
+		identity, err := localSigningIdentity{}.Serialize()
+		if err != nil {
+			logger.Panicf("Failed serializing self identity: %v", err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		if !bytes.Equal(identity, signedData.Identity) {
+			signedData = createSelfSignedData()
+		}
+		return signedData
+	}
+}
+
+// newGossipTLSCertificates returns the TLS certificates gossip binds
+// the identities of peers to, or nil if TLS is disabled
+func newGossipTLSCertificates(peerServer *comm.GRPCServer) (*gossipcommon.TLSCertificates, error) {
+	if !peerServer.TLSEnabled() {
+		return nil, nil
+	}
+	serverCert := peerServer.ServerCertificate()
+	clientCert, err := peer.GetClientCertificate()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed obtaining client certificates")
+	}
+	certs := &gossipcommon.TLSCertificates{}
+	certs.TLSServerCert.Store(&serverCert)
+	certs.TLSClientCert.Store(&clientCert)
+	return certs, nil
+}
+
+// startCredentialsReloader starts reloading the local MSP and the TLS
+// certificates of the given servers when their files change, and registers
+// an operations endpoint that reloads them on demand
+func startCredentialsReloader(opsSystem *operations.System, gossipCerts *gossipcommon.TLSCertificates, servers ...*comm.GRPCServer) (*reload.Reloader, error) {
+	reloader := reload.New(viper.GetDuration("peer.credentialsReload.interval"))
+
+	err := reloader.Register("local MSP", reloadLocalMSP, config.GetPath("peer.mspConfigPath"))
+	if err != nil {
+		return nil, err
+	}
+
+	if viper.GetBool("peer.tls.enabled") {
+		reloadTLS := func() error {
+			return reloadTLSCertificates(gossipCerts, servers...)
+		}
+		if err := reloader.Register("TLS certificates", reloadTLS, tlsCertificatePaths()...); err != nil {
+			return nil, err
+		}
+	}
+
+	opsSystem.RegisterHandler("/credentials/reload", reloader)
+	reloader.Start()
+
+	return reloader, nil
+}
+
+// reloadLocalMSP reloads the local MSP, and propagates its
+// new signing identity to the rest of the network through gossip
+func reloadLocalMSP() error {
+	if err := mgmt.ReloadLocalMsp(); err != nil {
+		return err
+	}
+
+	signingIdentity, err := mgmt.GetLocalMSP().GetDefaultSigningIdentity()
+	if err != nil {
+		return errors.WithMessage(err, "failed obtaining the local signing identity")
+	}
+	serializedIdentity, err := signingIdentity.Serialize()
+	if err != nil {
+		return errors.WithMessage(err, "failed serializing the local signing identity")
+	}
+
+	return service.GetGossipService().UpdateIdentity(serializedIdentity)
+}
+
+// reloadTLSCertificates makes the given servers, the gRPC clients
+// and gossip use the TLS certificates currently on the file system
+func reloadTLSCertificates(gossipCerts *gossipcommon.TLSCertificates, servers ...*comm.GRPCServer) error {
+	serverCert, err := peer.GetServerCertificate()
+	if err != nil {
+		return err
+	}
+	clientCert, err := peer.GetClientCertificate()
+	if err != nil {
+		return err
+	}
+
+	for _, server := range servers {
+		server.SetServerCertificate(serverCert)
+	}
+	comm.GetCredentialSupport().SetClientCertificate(clientCert)
+	if gossipCerts != nil {
+		gossipCerts.TLSServerCert.Store(&serverCert)
+		gossipCerts.TLSClientCert.Store(&clientCert)
+	}
+
+	return nil
+}
+
+func tlsCertificatePaths() []string {
+	var paths []string
+	for _, key := range []string{"peer.tls.cert.file", "peer.tls.key.file", "peer.tls.clientCert.file", "peer.tls.clientKey.file"} {
+		if viper.GetString(key) != "" {
+			paths = append(paths, config.GetPath(key))
+		}
+	}
+	return paths
+}
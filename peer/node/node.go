@@ -26,7 +26,7 @@ import (
 
 const (
 	nodeFuncName = "node"
-	nodeCmdDes   = "Operate a peer node: start|status."
+	nodeCmdDes   = "Operate a peer node: start|status|reconcile|reconcilestatus."
 )
 
 var logger = flogging.MustGetLogger("nodeCmd")
@@ -35,6 +35,8 @@ var logger = flogging.MustGetLogger("nodeCmd")
 func Cmd() *cobra.Command {
 	nodeCmd.AddCommand(startCmd())
 	nodeCmd.AddCommand(statusCmd())
+	nodeCmd.AddCommand(reconcileCmd())
+	nodeCmd.AddCommand(reconcileStatusCmd())
 
 	return nodeCmd
 }
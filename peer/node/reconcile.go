@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	channelID  string
+	startBlock uint64
+	endBlock   uint64
+)
+
+func reconcileCmd() *cobra.Command {
+	flags := nodeReconcileCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel whose missing private data is reconciled.")
+	flags.Uint64VarP(&startBlock, "startBlock", "", 0, "First block of the range whose missing private data is reconciled.")
+	flags.Uint64VarP(&endBlock, "endBlock", "", 0, "Last block of the range whose missing private data is reconciled.")
+	return nodeReconcileCmd
+}
+
+func reconcileStatusCmd() *cobra.Command {
+	flags := nodeReconcileStatusCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel whose missing private data is returned.")
+	return nodeReconcileStatusCmd
+}
+
+var nodeReconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconciles missing private data of a range of blocks.",
+	Long:  `Immediately reconciles the private data of a range of blocks of a channel which is missing on the running node.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		if channelID == common.UndefinedParamValue {
+			return errors.New("must supply channel ID")
+		}
+		if startBlock > endBlock {
+			return errors.Errorf("start block %d must not be greater than end block %d", startBlock, endBlock)
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return reconcile(channelID, startBlock, endBlock)
+	},
+}
+
+var nodeReconcileStatusCmd = &cobra.Command{
+	Use:   "reconcilestatus",
+	Short: "Returns the missing private data of the node.",
+	Long:  `Returns the private data of a channel which is missing on the running node, along with the outcome of the attempts to reconcile it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return fmt.Errorf("trailing args detected: %s", args)
+		}
+		if channelID == common.UndefinedParamValue {
+			return errors.New("must supply channel ID")
+		}
+		// Parsing of the command line is done so silence cmd usage
+		cmd.SilenceUsage = true
+		return reconcileStatus(channelID)
+	},
+}
+
+func reconcile(channelID string, startBlock, endBlock uint64) error {
+	adminClient, wrapEnv, err := newAdminClient()
+	if err != nil {
+		return err
+	}
+	response, err := adminClient.Reconcile(context.Background(), wrapEnv(&pb.AdminOperation{
+		Content: &pb.AdminOperation_ReconcileReq{
+			ReconcileReq: &pb.ReconcileRequest{
+				ChannelId:  channelID,
+				StartBlock: startBlock,
+				EndBlock:   endBlock,
+			},
+		},
+	}))
+	if err != nil {
+		return errors.WithMessage(err, "failed reconciling missing private data")
+	}
+	fmt.Printf("Reconciled %d private data items of blocks [%d - %d] of channel %s\n", response.Reconciled, startBlock, endBlock, channelID)
+	return nil
+}
+
+func reconcileStatus(channelID string) error {
+	adminClient, wrapEnv, err := newAdminClient()
+	if err != nil {
+		return err
+	}
+	response, err := adminClient.GetReconciliationStatus(context.Background(), wrapEnv(&pb.AdminOperation{
+		Content: &pb.AdminOperation_ReconciliationStatusReq{
+			ReconciliationStatusReq: &pb.ReconciliationStatusRequest{
+				ChannelId: channelID,
+			},
+		},
+	}))
+	if err != nil {
+		return errors.WithMessage(err, "failed retrieving reconciliation status")
+	}
+	fmt.Println(response)
+	return nil
+}
+
+// newAdminClient returns a client of the admin service of the peer, along with
+// a function wrapping admin operations in envelopes signed by the default signer
+func newAdminClient() (pb.AdminClient, func(*pb.AdminOperation) *common2.Envelope, error) {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return nil, nil, errors.Errorf("failed obtaining default signer: %v", err)
+	}
+
+	localSigner := crypto.NewSignatureHeaderCreator(signer)
+	wrapEnv := func(op *pb.AdminOperation) *common2.Envelope {
+		env, err := utils.CreateSignedEnvelope(common2.HeaderType_PEER_ADMIN_OPERATION, "", localSigner, op, 0, 0)
+		if err != nil {
+			logger.Panicf("Failed signing: %v", err)
+		}
+		return env
+	}
+	return adminClient, wrapEnv, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/admin"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	"github.com/hyperledger/fabric/msp"
+	common2 "github.com/hyperledger/fabric/peer/common"
+	"github.com/hyperledger/fabric/peer/mocks"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockReconciler struct {
+	status     *privdata.ReconciliationStatus
+	reconciled int
+	err        error
+}
+
+func (*mockReconciler) Start() {}
+
+func (*mockReconciler) Stop() {}
+
+func (m *mockReconciler) Status() (*privdata.ReconciliationStatus, error) {
+	return m.status, m.err
+}
+
+func (m *mockReconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	return m.reconciled, m.err
+}
+
+type mockReconcilerProvider map[string]privdata.PvtDataReconciler
+
+func (m mockReconcilerProvider) Reconciler(channel string) privdata.PvtDataReconciler {
+	return m[channel]
+}
+
+func TestReconcile(t *testing.T) {
+	defer viper.Reset()
+
+	signer := &mocks.Signer{}
+	common2.GetDefaultSignerFnc = func() (msp.SigningIdentity, error) {
+		return signer, nil
+	}
+	viper.Set("peer.address", "localhost:7074")
+	peerServer, err := peer.NewPeerServer("localhost:7074", comm.ServerConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create peer server (%s)", err)
+	}
+	reconcilers := mockReconcilerProvider{
+		"ch1": &mockReconciler{
+			status: &privdata.ReconciliationStatus{
+				Missing: []*privdata.MissingCollectionPvtData{
+					{Namespace: "cc1", Collection: "coll1", BlockNums: []uint64{3}},
+				},
+			},
+			reconciled: 1,
+		},
+		"ch2": &mockReconciler{err: errors.New("fetch failed")},
+	}
+	pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, peer.TransientStoreFactory, reconcilers, nil))
+	go peerServer.Start()
+	defer peerServer.Stop()
+
+	assert.NoError(t, reconcileStatus("ch1"))
+	assert.NoError(t, reconcile("ch1", 1, 5))
+
+	err = reconcileStatus("ch2")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed retrieving reconciliation status")
+	err = reconcile("ch2", 1, 5)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed reconciling missing private data")
+
+	err = reconcile("ch3", 1, 5)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "reconciler for channel ch3 not found")
+}
+
+func TestReconcileCmdArgs(t *testing.T) {
+	defer func() {
+		channelID = common2.UndefinedParamValue
+		startBlock, endBlock = 0, 0
+	}()
+
+	cmd := reconcileCmd()
+	cmd.SetArgs([]string{"--startBlock", "5", "--endBlock", "1"})
+	assert.EqualError(t, cmd.Execute(), "must supply channel ID")
+
+	cmd.SetArgs([]string{"-c", "ch1", "--startBlock", "5", "--endBlock", "1"})
+	assert.EqualError(t, cmd.Execute(), "start block 5 must not be greater than end block 1")
+
+	cmd.SetArgs([]string{"-c", "ch1", "extra"})
+	assert.EqualError(t, cmd.Execute(), "trailing args detected: [extra]")
+}
@@ -0,0 +1,26 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/msp"
+	"github.com/spf13/viper"
+)
+
+// newRevocationChecker creates the checker used to determine whether the
+// certificates of clients and of remote peers have been revoked, or returns
+// nil if peer.revocationCheck is disabled
+func newRevocationChecker() *msp.RevocationChecker {
+	if !viper.GetBool("peer.revocationCheck.enabled") {
+		return nil
+	}
+	return msp.NewRevocationChecker(msp.RevocationCheckerOpts{
+		Timeout:  viper.GetDuration("peer.revocationCheck.timeout"),
+		CacheTTL: viper.GetDuration("peer.revocationCheck.cacheTTL"),
+		FailOpen: viper.GetBool("peer.revocationCheck.failOpen"),
+	})
+}
@@ -0,0 +1,25 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRevocationChecker(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Nil(t, newRevocationChecker())
+
+	viper.Set("peer.revocationCheck.enabled", true)
+	viper.Set("peer.revocationCheck.timeout", "5s")
+	viper.Set("peer.revocationCheck.cacheTTL", "10m")
+	assert.NotNil(t, newRevocationChecker())
+}
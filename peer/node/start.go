@@ -134,6 +134,11 @@ func serve(args []string) error {
 		panic("Unsupported msp type " + msp.ProviderTypeToString(mspType))
 	}
 
+	if revocationChecker := newRevocationChecker(); revocationChecker != nil {
+		logger.Info("Checking the revocation status of client and peer certificates")
+		mgmt.SetRevocationChecker(revocationChecker)
+	}
+
 	// Trace RPCs with the golang.org/x/net/trace package. This was moved out of
 	// the deliver service connection factory as it has process wide implications
 	// and was racy with respect to initialization of gRPC clients and servers.
@@ -171,7 +176,7 @@ func serve(args []string) error {
 	logObserver := floggingmetrics.NewObserver(metricsProvider)
 	flogging.Global.SetObserver(logObserver)
 
-	membershipInfoProvider := privdata.NewMembershipInfoProvider(createSelfSignedData(), identityDeserializerFactory)
+	membershipInfoProvider := privdata.NewMembershipInfoProvider(newLocalSelfSignedData(), identityDeserializerFactory)
 	//initialize resource management exit
 	ledgermgmt.Initialize(
 		&ledgermgmt.Initializer{
@@ -256,7 +261,7 @@ func serve(args []string) error {
 		}
 	}
 
-	abServer := peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, metricsProvider)
+	abServer := peer.NewDeliverEventsServer(mutualTLS, policyCheckerProvider, &peer.DeliverChainManager{}, &peer.DeliverPvtDataSupport{}, metricsProvider)
 	pb.RegisterDeliverServer(peerServer.Server(), abServer)
 
 	// Initialize chaincode service
@@ -264,19 +269,10 @@ func serve(args []string) error {
 
 	logger.Debugf("Running peer")
 
-	// Start the Admin server
-	startAdminServer(listenAddr, peerServer.Server(), metricsProvider)
-
 	privDataDist := func(channel string, txID string, privateData *transientstore.TxPvtReadWriteSetWithConfigInfo, blkHt uint64) error {
 		return service.GetGossipService().DistributePrivateData(channel, txID, privateData, blkHt)
 	}
 
-	signingIdentity := mgmt.GetLocalSigningIdentityOrPanic()
-	serializedIdentity, err := signingIdentity.Serialize()
-	if err != nil {
-		logger.Panicf("Failed serializing self identity: %v", err)
-	}
-
 	libConf := library.Config{}
 	if err = viperutil.EnhancedExactUnmarshalKey("peer.handlers", &libConf); err != nil {
 		return errors.WithMessage(err, "could not load YAML config")
@@ -285,7 +281,7 @@ func serve(args []string) error {
 
 	authFilters := reg.Lookup(library.Auth).([]authHandler.Filter)
 	endorserSupport := &endorser.SupportImpl{
-		SignerSupport:    signingIdentity,
+		SignerSupport:    localSigningIdentity{},
 		Peer:             peer.Default,
 		PeerSupport:      peer.DefaultSupport,
 		ChaincodeSupport: chaincodeSupport,
@@ -312,15 +308,40 @@ func serve(args []string) error {
 	policyMgr := peer.NewChannelPolicyManagerGetter()
 
 	// Initialize gossip component
-	err = initGossipService(policyMgr, peerServer, serializedIdentity, peerEndpoint.Address)
+	gossipCerts, err := newGossipTLSCertificates(peerServer)
+	if err != nil {
+		return err
+	}
+	err = initGossipService(policyMgr, metricsProvider, peerServer, gossipCerts, localSigningIdentity{}.Serialize, peerEndpoint.Address)
 	if err != nil {
 		return err
 	}
 	defer service.GetGossipService().Stop()
 
+	// Start the Admin server, once the gossip service which provides the
+	// private data reconcilers has been initialized
+	tlsServers := []*comm.GRPCServer{peerServer}
+	if adminServer := startAdminServer(listenAddr, peerServer.Server(), metricsProvider); adminServer != nil {
+		tlsServers = append(tlsServers, adminServer)
+	}
+
+	// Reload the local MSP and the TLS certificates when they are renewed
+	credentialsReloader, err := startCredentialsReloader(opsSystem, gossipCerts, tlsServers...)
+	if err != nil {
+		return errors.WithMessage(err, "failed to start reloading credentials")
+	}
+	defer credentialsReloader.Stop()
+
+	// Monitor the expiration of the certificates of the peer and its channels
+	certificateMonitor, err := startCertificateMonitor(opsSystem, metricsProvider, peerServer)
+	if err != nil {
+		return errors.WithMessage(err, "failed to start monitoring certificates")
+	}
+	defer certificateMonitor.Stop()
+
 	// register prover grpc service
 	// FAB-12971 disable prover service before v1.4 cut. Will uncomment after v1.4 cut
-	// err = registerProverService(peerServer, aclProvider, signingIdentity)
+	// err = registerProverService(peerServer, aclProvider, mgmt.GetLocalSigningIdentityOrPanic())
 	// if err != nil {
 	// 	return err
 	// }
@@ -773,12 +794,15 @@ func adminHasSeparateListener(peerListenAddr string, adminListenAddress string)
 	return adminPort != peerPort
 }
 
-func startAdminServer(peerListenAddr string, peerServer *grpc.Server, metricsProvider metrics.Provider) {
+// startAdminServer registers the admin service, and returns the server
+// dedicated to it, or nil if it shares the server of the peer
+func startAdminServer(peerListenAddr string, peerServer *grpc.Server, metricsProvider metrics.Provider) *comm.GRPCServer {
 	adminListenAddress := viper.GetString("peer.adminService.listenAddress")
 	separateLsnrForAdmin := adminHasSeparateListener(peerListenAddr, adminListenAddress)
 	mspID := viper.GetString("peer.localMspId")
 	adminPolicy := localPolicy(cauthdsl.SignedByAnyAdmin([]string{mspID}))
 	gRPCService := peerServer
+	var adminServer *comm.GRPCServer
 	if separateLsnrForAdmin {
 		logger.Info("Creating gRPC server for admin service on", adminListenAddress)
 		serverConfig, err := peer.GetServerConfig()
@@ -800,7 +824,7 @@ func startAdminServer(peerListenAddr string, peerServer *grpc.Server, metricsPro
 			grpclogging.StreamServerInterceptor(flogging.MustGetLogger("comm.grpc.server").Zap()),
 			throttle.StreamServerInterceptor,
 		)
-		adminServer, err := peer.NewPeerServer(adminListenAddress, serverConfig)
+		adminServer, err = peer.NewPeerServer(adminListenAddress, serverConfig)
 		if err != nil {
 			logger.Fatalf("Failed to create admin server (%s)", err)
 		}
@@ -810,7 +834,8 @@ func startAdminServer(peerListenAddr string, peerServer *grpc.Server, metricsPro
 		}()
 	}
 
-	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy))
+	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy, peer.TransientStoreFactory, service.GetGossipService(), service.GetGossipService()))
+	return adminServer
 }
 
 // secureDialOpts is the callback function for secure dial options for gossip service
@@ -845,19 +870,12 @@ func secureDialOpts() []grpc.DialOption {
 // 2. Init the message crypto service;
 // 3. Init the security advisor;
 // 4. Init gossip related struct.
-func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, peerServer *comm.GRPCServer, serializedIdentity []byte, peerAddr string) error {
-	var certs *gossipcommon.TLSCertificates
-	if peerServer.TLSEnabled() {
-		serverCert := peerServer.ServerCertificate()
-		clientCert, err := peer.GetClientCertificate()
-		if err != nil {
-			return errors.Wrap(err, "failed obtaining client certificates")
-		}
-		certs = &gossipcommon.TLSCertificates{}
-		certs.TLSServerCert.Store(&serverCert)
-		certs.TLSClientCert.Store(&clientCert)
+func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, metricsProvider metrics.Provider,
+	peerServer *comm.GRPCServer, certs *gossipcommon.TLSCertificates, serializeIdentity func() ([]byte, error), peerAddr string) error {
+	serializedIdentity, err := serializeIdentity()
+	if err != nil {
+		return errors.WithMessage(err, "failed serializing self identity")
 	}
-
 	messageCryptoService := peergossip.NewMCS(
 		policyMgr,
 		localmsp.NewSigner(),
@@ -868,6 +886,7 @@ func initGossipService(policyMgr policies.ChannelPolicyManagerGetter, peerServer
 
 	return service.InitGossipService(
 		serializedIdentity,
+		metricsProvider,
 		peerAddr,
 		peerServer.Server(),
 		certs,
@@ -57,7 +57,7 @@ func TestStatusCmd(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create peer server (%s)", err)
 	} else {
-		pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}))
+		pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, peer.TransientStoreFactory, nil, nil))
 		go peerServer.Start()
 		defer peerServer.Stop()
 
@@ -109,7 +109,7 @@ func TestStatus(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to create peer server (%s)", err)
 			} else {
-				pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}))
+				pb.RegisterAdminServer(peerServer.Server(), admin.NewAdminServer(&mockEvaluator{}, peer.TransientStoreFactory, nil, nil))
 				go peerServer.Start()
 				defer peerServer.Stop()
 				if test.shouldSucceed {
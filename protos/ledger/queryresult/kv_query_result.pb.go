@@ -33,7 +33,7 @@ func (m *KV) Reset()         { *m = KV{} }
 func (m *KV) String() string { return proto.CompactTextString(m) }
 func (*KV) ProtoMessage()    {}
 func (*KV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kv_query_result_8f59f813f4fe5e5a, []int{0}
+	return fileDescriptor_kv_query_result_80702f358f1f064a, []int{0}
 }
 func (m *KV) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KV.Unmarshal(m, b)
@@ -75,12 +75,15 @@ func (m *KV) GetValue() []byte {
 }
 
 // KeyModification -- QueryResult for history query. Holds a transaction ID, value,
-// timestamp, and delete marker which resulted from a history query.
+// timestamp, and delete marker which resulted from a history query. For a private
+// data history query, the purge marker denotes a modification whose value has been
+// purged from the private data store as per the BlockToLive of the collection.
 type KeyModification struct {
 	TxId                 string               `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	IsDelete             bool                 `protobuf:"varint,4,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	IsPurged             bool                 `protobuf:"varint,5,opt,name=is_purged,json=isPurged,proto3" json:"is_purged,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -90,7 +93,7 @@ func (m *KeyModification) Reset()         { *m = KeyModification{} }
 func (m *KeyModification) String() string { return proto.CompactTextString(m) }
 func (*KeyModification) ProtoMessage()    {}
 func (*KeyModification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_kv_query_result_8f59f813f4fe5e5a, []int{1}
+	return fileDescriptor_kv_query_result_80702f358f1f064a, []int{1}
 }
 func (m *KeyModification) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyModification.Unmarshal(m, b)
@@ -138,33 +141,41 @@ func (m *KeyModification) GetIsDelete() bool {
 	return false
 }
 
+func (m *KeyModification) GetIsPurged() bool {
+	if m != nil {
+		return m.IsPurged
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*KV)(nil), "queryresult.KV")
 	proto.RegisterType((*KeyModification)(nil), "queryresult.KeyModification")
 }
 
 func init() {
-	proto.RegisterFile("ledger/queryresult/kv_query_result.proto", fileDescriptor_kv_query_result_8f59f813f4fe5e5a)
-}
-
-var fileDescriptor_kv_query_result_8f59f813f4fe5e5a = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x4f, 0x4b, 0xc3, 0x30,
-	0x1c, 0xa5, 0xdd, 0x26, 0x6b, 0x26, 0x28, 0xd1, 0x43, 0x99, 0x82, 0x65, 0xa7, 0x9e, 0x12, 0xd1,
-	0x83, 0x9e, 0xc5, 0x8b, 0x0e, 0x2f, 0x45, 0x3c, 0x78, 0x29, 0x69, 0xfb, 0x6b, 0x17, 0xda, 0x2e,
-	0x35, 0x7f, 0xc6, 0xfa, 0x39, 0xfc, 0xc2, 0x62, 0xb2, 0xd9, 0x82, 0xb7, 0xbc, 0xf7, 0x7b, 0xef,
-	0xf1, 0x78, 0x41, 0x71, 0x03, 0x45, 0x05, 0x92, 0x7e, 0x19, 0x90, 0xbd, 0x04, 0x65, 0x1a, 0x4d,
-	0xeb, 0x5d, 0x6a, 0x61, 0xea, 0x30, 0xe9, 0xa4, 0xd0, 0x02, 0x2f, 0x46, 0x92, 0xe5, 0x4d, 0x25,
-	0x44, 0xd5, 0x00, 0xb5, 0xa7, 0xcc, 0x94, 0x54, 0xf3, 0x16, 0x94, 0x66, 0x6d, 0xe7, 0xd4, 0xab,
-	0x57, 0xe4, 0xaf, 0x3f, 0xf0, 0x35, 0x0a, 0xb6, 0xac, 0x05, 0xd5, 0xb1, 0x1c, 0x42, 0x2f, 0xf2,
-	0xe2, 0x20, 0x19, 0x08, 0x7c, 0x8e, 0x26, 0x35, 0xf4, 0xa1, 0x6f, 0xf9, 0xdf, 0x27, 0xbe, 0x44,
-	0xb3, 0x1d, 0x6b, 0x0c, 0x84, 0x93, 0xc8, 0x8b, 0x4f, 0x13, 0x07, 0x56, 0xdf, 0x1e, 0x3a, 0x5b,
-	0x43, 0xff, 0x26, 0x0a, 0x5e, 0xf2, 0x9c, 0x69, 0x2e, 0xb6, 0xf8, 0x02, 0xcd, 0xf4, 0x3e, 0xe5,
-	0xc5, 0x21, 0x75, 0xaa, 0xf7, 0x2f, 0xc5, 0x60, 0xf7, 0x47, 0x76, 0xfc, 0x88, 0x82, 0xbf, 0x76,
-	0x36, 0x78, 0x71, 0xb7, 0x24, 0xae, 0x3f, 0x39, 0xf6, 0x27, 0xef, 0x47, 0x45, 0x32, 0x88, 0xf1,
-	0x15, 0x0a, 0xb8, 0x4a, 0x0b, 0x68, 0x40, 0x43, 0x38, 0x8d, 0xbc, 0x78, 0x9e, 0xcc, 0xb9, 0x7a,
-	0xb6, 0xf8, 0xa9, 0x46, 0xb7, 0x42, 0x56, 0x64, 0xd3, 0x77, 0x20, 0xdd, 0x88, 0xa4, 0x64, 0x99,
-	0xe4, 0xb9, 0x0b, 0x55, 0xe4, 0x40, 0x8e, 0x66, 0xfb, 0x7c, 0xa8, 0xb8, 0xde, 0x98, 0x8c, 0xe4,
-	0xa2, 0xa5, 0x23, 0x23, 0x75, 0x46, 0xb7, 0xa6, 0xa2, 0xff, 0xbf, 0x24, 0x3b, 0xb1, 0xa7, 0xfb,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xb7, 0x3e, 0x86, 0xaf, 0x01, 0x00, 0x00,
+	proto.RegisterFile("ledger/queryresult/kv_query_result.proto", fileDescriptor_kv_query_result_80702f358f1f064a)
+}
+
+var fileDescriptor_kv_query_result_80702f358f1f064a = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0x4d, 0x4b, 0xfb, 0x30,
+	0x1c, 0xa6, 0x7b, 0xf9, 0xb3, 0x66, 0x7f, 0x50, 0xa2, 0x87, 0x32, 0x05, 0xc7, 0x4e, 0x3d, 0x25,
+	0xa2, 0x07, 0x3d, 0x8b, 0x17, 0x1d, 0x82, 0x14, 0xf1, 0xe0, 0xa5, 0xa4, 0xcd, 0x6f, 0x59, 0x58,
+	0xbb, 0xd4, 0xbc, 0x8c, 0xf5, 0x5b, 0xf9, 0x11, 0xc5, 0x64, 0xb3, 0x05, 0x6f, 0x7d, 0xde, 0x7e,
+	0x7d, 0x78, 0x82, 0xd2, 0x0a, 0xb8, 0x00, 0x4d, 0x3f, 0x1d, 0xe8, 0x56, 0x83, 0x71, 0x95, 0xa5,
+	0x9b, 0x5d, 0xee, 0x61, 0x1e, 0x30, 0x69, 0xb4, 0xb2, 0x0a, 0x4f, 0x7b, 0x96, 0xd9, 0x95, 0x50,
+	0x4a, 0x54, 0x40, 0xbd, 0x54, 0xb8, 0x15, 0xb5, 0xb2, 0x06, 0x63, 0x59, 0xdd, 0x04, 0xf7, 0xe2,
+	0x19, 0x0d, 0x96, 0xef, 0xf8, 0x12, 0xc5, 0x5b, 0x56, 0x83, 0x69, 0x58, 0x09, 0x49, 0x34, 0x8f,
+	0xd2, 0x38, 0xeb, 0x08, 0x7c, 0x8a, 0x86, 0x1b, 0x68, 0x93, 0x81, 0xe7, 0x7f, 0x3e, 0xf1, 0x39,
+	0x1a, 0xef, 0x58, 0xe5, 0x20, 0x19, 0xce, 0xa3, 0xf4, 0x7f, 0x16, 0xc0, 0xe2, 0x2b, 0x42, 0x27,
+	0x4b, 0x68, 0x5f, 0x14, 0x97, 0x2b, 0x59, 0x32, 0x2b, 0xd5, 0x16, 0x9f, 0xa1, 0xb1, 0xdd, 0xe7,
+	0x92, 0x1f, 0xae, 0x8e, 0xec, 0xfe, 0x89, 0x77, 0xf1, 0x41, 0x2f, 0x8e, 0xef, 0x51, 0xfc, 0xdb,
+	0xce, 0x1f, 0x9e, 0xde, 0xcc, 0x48, 0xe8, 0x4f, 0x8e, 0xfd, 0xc9, 0xdb, 0xd1, 0x91, 0x75, 0x66,
+	0x7c, 0x81, 0x62, 0x69, 0x72, 0x0e, 0x15, 0x58, 0x48, 0x46, 0xf3, 0x28, 0x9d, 0x64, 0x13, 0x69,
+	0x1e, 0x3d, 0x3e, 0x88, 0x8d, 0xd3, 0x02, 0x78, 0x32, 0x3e, 0x8a, 0xaf, 0x1e, 0x3f, 0x6c, 0xd0,
+	0xb5, 0xd2, 0x82, 0xac, 0xdb, 0x06, 0x74, 0x58, 0x98, 0xac, 0x58, 0xa1, 0x65, 0x19, 0xfe, 0x68,
+	0xc8, 0x81, 0xec, 0x6d, 0xfa, 0x71, 0x27, 0xa4, 0x5d, 0xbb, 0x82, 0x94, 0xaa, 0xa6, 0xbd, 0x20,
+	0x0d, 0xc1, 0x30, 0xb5, 0xa1, 0x7f, 0xdf, 0xab, 0xf8, 0xe7, 0xa5, 0xdb, 0xef, 0x01, 0x00, 0xe4,
+	0x23, 0xf3, 0x1e, 0xcc, 0x01, 0x00, 0x00,
 }
@@ -35,7 +35,7 @@ func (m *SerializedIdentity) Reset()         { *m = SerializedIdentity{} }
 func (m *SerializedIdentity) String() string { return proto.CompactTextString(m) }
 func (*SerializedIdentity) ProtoMessage()    {}
 func (*SerializedIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identities_8fa8af3e5bf2070a, []int{0}
+	return fileDescriptor_identities_13a5a5596e7b2fd7, []int{0}
 }
 func (m *SerializedIdentity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SerializedIdentity.Unmarshal(m, b)
@@ -87,17 +87,20 @@ type SerializedIdemixIdentity struct {
 	// role contains the role of this identity (e.g., ADMIN or MEMBER)
 	Role []byte `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	// proof contains the cryptographic evidence that this identity is valid
-	Proof                []byte   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Proof []byte `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	// attributes contains the certified attributes, other than ou and role,
+	// that this identity discloses
+	Attributes           []*IdemixAttribute `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *SerializedIdemixIdentity) Reset()         { *m = SerializedIdemixIdentity{} }
 func (m *SerializedIdemixIdentity) String() string { return proto.CompactTextString(m) }
 func (*SerializedIdemixIdentity) ProtoMessage()    {}
 func (*SerializedIdemixIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identities_8fa8af3e5bf2070a, []int{1}
+	return fileDescriptor_identities_13a5a5596e7b2fd7, []int{1}
 }
 func (m *SerializedIdemixIdentity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SerializedIdemixIdentity.Unmarshal(m, b)
@@ -152,28 +155,89 @@ func (m *SerializedIdemixIdentity) GetProof() []byte {
 	return nil
 }
 
+func (m *SerializedIdemixIdentity) GetAttributes() []*IdemixAttribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// IdemixAttribute represents a certified attribute of an idemix identity
+type IdemixAttribute struct {
+	// name is the name of the attribute
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value is the value of the attribute
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdemixAttribute) Reset()         { *m = IdemixAttribute{} }
+func (m *IdemixAttribute) String() string { return proto.CompactTextString(m) }
+func (*IdemixAttribute) ProtoMessage()    {}
+func (*IdemixAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identities_13a5a5596e7b2fd7, []int{2}
+}
+func (m *IdemixAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdemixAttribute.Unmarshal(m, b)
+}
+func (m *IdemixAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdemixAttribute.Marshal(b, m, deterministic)
+}
+func (dst *IdemixAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdemixAttribute.Merge(dst, src)
+}
+func (m *IdemixAttribute) XXX_Size() int {
+	return xxx_messageInfo_IdemixAttribute.Size(m)
+}
+func (m *IdemixAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdemixAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdemixAttribute proto.InternalMessageInfo
+
+func (m *IdemixAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IdemixAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SerializedIdentity)(nil), "msp.SerializedIdentity")
 	proto.RegisterType((*SerializedIdemixIdentity)(nil), "msp.SerializedIdemixIdentity")
-}
-
-func init() { proto.RegisterFile("msp/identities.proto", fileDescriptor_identities_8fa8af3e5bf2070a) }
-
-var fileDescriptor_identities_8fa8af3e5bf2070a = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x3f, 0x4f, 0xc3, 0x30,
-	0x10, 0x47, 0x95, 0x34, 0xe1, 0x8f, 0x55, 0x31, 0x98, 0x0e, 0x66, 0x2b, 0x9d, 0x32, 0xc5, 0x03,
-	0xdf, 0xa0, 0x12, 0x03, 0x03, 0x4b, 0x58, 0x80, 0xa5, 0x6a, 0xea, 0x6b, 0x7a, 0x52, 0x2e, 0x67,
-	0xd9, 0x8e, 0x54, 0x33, 0xf0, 0xd9, 0x51, 0x62, 0x40, 0xb0, 0xdd, 0xef, 0xe9, 0xe9, 0xc9, 0x16,
-	0x2b, 0xf2, 0x56, 0xa3, 0x81, 0x21, 0x60, 0x40, 0xf0, 0xb5, 0x75, 0x1c, 0x58, 0x2e, 0xc8, 0xdb,
-	0xcd, 0xa3, 0x90, 0x2f, 0xe0, 0x70, 0xdf, 0xe3, 0x07, 0x98, 0xa7, 0xa4, 0x44, 0xb9, 0x12, 0x25,
-	0x79, 0x8b, 0x46, 0x65, 0xeb, 0xac, 0xba, 0x6e, 0xd2, 0x90, 0x77, 0xe2, 0x0a, 0xcd, 0xae, 0x8d,
-	0x01, 0xbc, 0xca, 0xd7, 0x59, 0xb5, 0x6c, 0x2e, 0xd1, 0x6c, 0xa7, 0xb9, 0xf9, 0x14, 0xea, 0x5f,
-	0x86, 0xf0, 0xfc, 0x1b, 0xbb, 0x15, 0xe5, 0x10, 0x69, 0x77, 0x9e, 0x63, 0xcb, 0xa6, 0x18, 0x22,
-	0xbd, 0xfe, 0xc0, 0xf8, 0x1d, 0x9a, 0xe0, 0x9b, 0xbc, 0x11, 0x39, 0x8f, 0x6a, 0x31, 0x93, 0x9c,
-	0x47, 0x29, 0x45, 0xe1, 0xb8, 0x07, 0x55, 0x24, 0x67, 0xba, 0xa7, 0xa7, 0x59, 0xc7, 0x7c, 0x54,
-	0xe5, 0x0c, 0xd3, 0xd8, 0x3e, 0x8b, 0x7b, 0x76, 0x5d, 0x7d, 0x8a, 0x16, 0x5c, 0x0f, 0xa6, 0x03,
-	0x57, 0x1f, 0xf7, 0xad, 0xc3, 0x43, 0xfa, 0xab, 0xaf, 0xc9, 0xdb, 0xf7, 0xaa, 0xc3, 0x70, 0x1a,
-	0xdb, 0xfa, 0xc0, 0xa4, 0xff, 0x98, 0x3a, 0x99, 0x3a, 0x99, 0x9a, 0xbc, 0x6d, 0x2f, 0xe6, 0xfb,
-	0xe1, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdc, 0xc8, 0x62, 0x39, 0x01, 0x00, 0x00,
+	proto.RegisterType((*IdemixAttribute)(nil), "msp.IdemixAttribute")
+}
+
+func init() { proto.RegisterFile("msp/identities.proto", fileDescriptor_identities_13a5a5596e7b2fd7) }
+
+var fileDescriptor_identities_13a5a5596e7b2fd7 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0x95, 0xf4, 0xcf, 0xf7, 0xf5, 0x52, 0x81, 0x64, 0x3a, 0x98, 0xad, 0x74, 0xca, 0xe4,
+	0x48, 0xc0, 0xc6, 0x44, 0x25, 0x06, 0x06, 0x96, 0xb0, 0x00, 0x4b, 0x95, 0xd4, 0x6e, 0x7b, 0xa5,
+	0x38, 0xb6, 0x6c, 0x07, 0xd5, 0x3c, 0x17, 0x0f, 0x88, 0x6c, 0xd3, 0xaa, 0xb0, 0xdd, 0x73, 0xee,
+	0x4f, 0xf7, 0x1c, 0x1b, 0x66, 0xd2, 0xea, 0x12, 0xb9, 0xe8, 0x1c, 0x3a, 0x14, 0x96, 0x69, 0xa3,
+	0x9c, 0x22, 0x03, 0x69, 0xf5, 0xe2, 0x11, 0xc8, 0x8b, 0x30, 0x58, 0xb7, 0xf8, 0x29, 0xf8, 0x53,
+	0x42, 0x3c, 0x99, 0xc1, 0x48, 0x5a, 0x8d, 0x9c, 0x66, 0xf3, 0xac, 0x98, 0x54, 0x49, 0x90, 0x2b,
+	0xf8, 0x8f, 0x7c, 0xd5, 0x78, 0x27, 0x2c, 0xcd, 0xe7, 0x59, 0x31, 0xad, 0xfe, 0x21, 0x5f, 0x06,
+	0xb9, 0xf8, 0xca, 0x80, 0xfe, 0xba, 0x23, 0x71, 0x7f, 0xbc, 0x76, 0x09, 0xa3, 0xce, 0xcb, 0xd5,
+	0x3e, 0x5e, 0x9b, 0x56, 0xc3, 0xce, 0xcb, 0xd7, 0x83, 0xe9, 0x69, 0x7e, 0x34, 0xdf, 0xc8, 0x39,
+	0xe4, 0xaa, 0xa7, 0x83, 0xe8, 0xe4, 0xaa, 0x27, 0x04, 0x86, 0x46, 0xb5, 0x82, 0x0e, 0x13, 0x13,
+	0xe6, 0xd0, 0x4d, 0x1b, 0xa5, 0x36, 0x74, 0x14, 0xcd, 0x24, 0xc8, 0x1d, 0x40, 0xed, 0x9c, 0xc1,
+	0xa6, 0x0f, 0xed, 0xc6, 0xf3, 0x41, 0x71, 0x76, 0x33, 0x63, 0xd2, 0x6a, 0x96, 0xca, 0x3c, 0x1c,
+	0x96, 0xd5, 0x09, 0xb7, 0xb8, 0x87, 0x8b, 0x3f, 0xeb, 0x10, 0xd9, 0xd5, 0x52, 0xfc, 0xbc, 0x3c,
+	0xce, 0x21, 0xf2, 0xa3, 0x6e, 0x7b, 0x11, 0xbb, 0x4e, 0xaa, 0x24, 0x96, 0xcf, 0x70, 0xad, 0xcc,
+	0x96, 0xed, 0xbc, 0x16, 0xa6, 0x15, 0x7c, 0x2b, 0x0c, 0xdb, 0xd4, 0x8d, 0xc1, 0x75, 0xfa, 0x5f,
+	0x1b, 0xd2, 0xdf, 0x8b, 0x2d, 0xba, 0x5d, 0xdf, 0xb0, 0xb5, 0x92, 0xe5, 0x09, 0x59, 0x26, 0xb2,
+	0x4c, 0x64, 0x29, 0xad, 0x6e, 0xc6, 0x71, 0xbe, 0xfd, 0x1e, 0x00, 0xf8, 0xb6, 0xaa, 0x05, 0xad,
+	0x01, 0x00, 0x00,
 }
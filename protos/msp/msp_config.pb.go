@@ -36,7 +36,7 @@ func (m *MSPConfig) Reset()         { *m = MSPConfig{} }
 func (m *MSPConfig) String() string { return proto.CompactTextString(m) }
 func (*MSPConfig) ProtoMessage()    {}
 func (*MSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{0}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{0}
 }
 func (m *MSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPConfig.Unmarshal(m, b)
@@ -131,7 +131,7 @@ func (m *FabricMSPConfig) Reset()         { *m = FabricMSPConfig{} }
 func (m *FabricMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricMSPConfig) ProtoMessage()    {}
 func (*FabricMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{1}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{1}
 }
 func (m *FabricMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricMSPConfig.Unmarshal(m, b)
@@ -249,7 +249,7 @@ func (m *FabricCryptoConfig) Reset()         { *m = FabricCryptoConfig{} }
 func (m *FabricCryptoConfig) String() string { return proto.CompactTextString(m) }
 func (*FabricCryptoConfig) ProtoMessage()    {}
 func (*FabricCryptoConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{2}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{2}
 }
 func (m *FabricCryptoConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricCryptoConfig.Unmarshal(m, b)
@@ -295,17 +295,23 @@ type IdemixMSPConfig struct {
 	// revocation_pk is the public key used for revocation of credentials
 	RevocationPk []byte `protobuf:"bytes,4,opt,name=revocation_pk,json=revocationPk,proto3" json:"revocation_pk,omitempty"`
 	// epoch represents the current epoch (time interval) used for revocation
-	Epoch                int64    `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Epoch int64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// credential_revocation_information contains the serialized CredentialRevocationInformation
+	// of the current epoch, which signers use to prove that their credential is not revoked
+	CredentialRevocationInformation []byte `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
+	// issuers contains the issuers of credentials in addition to the one described by ipk,
+	// revocation_pk and credential_revocation_information, which is omitted if ipk is empty
+	Issuers              []*IdemixMSPIssuerConfig `protobuf:"bytes,7,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *IdemixMSPConfig) Reset()         { *m = IdemixMSPConfig{} }
 func (m *IdemixMSPConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPConfig) ProtoMessage()    {}
 func (*IdemixMSPConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{3}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{3}
 }
 func (m *IdemixMSPConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPConfig.Unmarshal(m, b)
@@ -360,6 +366,99 @@ func (m *IdemixMSPConfig) GetEpoch() int64 {
 	return 0
 }
 
+func (m *IdemixMSPConfig) GetCredentialRevocationInformation() []byte {
+	if m != nil {
+		return m.CredentialRevocationInformation
+	}
+	return nil
+}
+
+func (m *IdemixMSPConfig) GetIssuers() []*IdemixMSPIssuerConfig {
+	if m != nil {
+		return m.Issuers
+	}
+	return nil
+}
+
+// IdemixMSPIssuerConfig contains the key material of an issuer of idemix credentials,
+// along with the window of epochs in which the MSP accepts the credentials it issues
+type IdemixMSPIssuerConfig struct {
+	// ipk represents the (serialized) issuer public key
+	Ipk []byte `protobuf:"bytes,1,opt,name=ipk,proto3" json:"ipk,omitempty"`
+	// revocation_pk is the public key used for revocation of credentials
+	RevocationPk []byte `protobuf:"bytes,2,opt,name=revocation_pk,json=revocationPk,proto3" json:"revocation_pk,omitempty"`
+	// credential_revocation_information contains the serialized CredentialRevocationInformation
+	// of the current epoch for the credentials of this issuer
+	CredentialRevocationInformation []byte `protobuf:"bytes,3,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
+	// first_epoch is the first epoch in which the credentials of this issuer are accepted
+	FirstEpoch int64 `protobuf:"varint,4,opt,name=first_epoch,json=firstEpoch,proto3" json:"first_epoch,omitempty"`
+	// expiration_epoch is the first epoch in which the credentials of this issuer
+	// are no longer accepted, or 0 if they are accepted in every epoch from first_epoch on
+	ExpirationEpoch      int64    `protobuf:"varint,5,opt,name=expiration_epoch,json=expirationEpoch,proto3" json:"expiration_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IdemixMSPIssuerConfig) Reset()         { *m = IdemixMSPIssuerConfig{} }
+func (m *IdemixMSPIssuerConfig) String() string { return proto.CompactTextString(m) }
+func (*IdemixMSPIssuerConfig) ProtoMessage()    {}
+func (*IdemixMSPIssuerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{4}
+}
+func (m *IdemixMSPIssuerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IdemixMSPIssuerConfig.Unmarshal(m, b)
+}
+func (m *IdemixMSPIssuerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IdemixMSPIssuerConfig.Marshal(b, m, deterministic)
+}
+func (dst *IdemixMSPIssuerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdemixMSPIssuerConfig.Merge(dst, src)
+}
+func (m *IdemixMSPIssuerConfig) XXX_Size() int {
+	return xxx_messageInfo_IdemixMSPIssuerConfig.Size(m)
+}
+func (m *IdemixMSPIssuerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdemixMSPIssuerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdemixMSPIssuerConfig proto.InternalMessageInfo
+
+func (m *IdemixMSPIssuerConfig) GetIpk() []byte {
+	if m != nil {
+		return m.Ipk
+	}
+	return nil
+}
+
+func (m *IdemixMSPIssuerConfig) GetRevocationPk() []byte {
+	if m != nil {
+		return m.RevocationPk
+	}
+	return nil
+}
+
+func (m *IdemixMSPIssuerConfig) GetCredentialRevocationInformation() []byte {
+	if m != nil {
+		return m.CredentialRevocationInformation
+	}
+	return nil
+}
+
+func (m *IdemixMSPIssuerConfig) GetFirstEpoch() int64 {
+	if m != nil {
+		return m.FirstEpoch
+	}
+	return 0
+}
+
+func (m *IdemixMSPIssuerConfig) GetExpirationEpoch() int64 {
+	if m != nil {
+		return m.ExpirationEpoch
+	}
+	return 0
+}
+
 // IdemixMSPSIgnerConfig contains the crypto material to set up an idemix signing identity
 type IdemixMSPSignerConfig struct {
 	// cred represents the serialized idemix credential of the default signer
@@ -373,17 +472,20 @@ type IdemixMSPSignerConfig struct {
 	// enrollment_id contains the enrollment id of this signer
 	EnrollmentId string `protobuf:"bytes,5,opt,name=enrollment_id,json=enrollmentId,proto3" json:"enrollment_id,omitempty"`
 	// credential_revocation_information contains a serialized CredentialRevocationInformation
-	CredentialRevocationInformation []byte   `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
-	XXX_NoUnkeyedLiteral            struct{} `json:"-"`
-	XXX_unrecognized                []byte   `json:"-"`
-	XXX_sizecache                   int32    `json:"-"`
+	CredentialRevocationInformation []byte `protobuf:"bytes,6,opt,name=credential_revocation_information,json=credentialRevocationInformation,proto3" json:"credential_revocation_information,omitempty"`
+	// disclosed_attributes contains the values of the attributes, certified in the credential
+	// in addition to the ones above, that the default signer discloses
+	DisclosedAttributes  []*IdemixAttribute `protobuf:"bytes,7,rep,name=disclosed_attributes,json=disclosedAttributes,proto3" json:"disclosed_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *IdemixMSPSignerConfig) Reset()         { *m = IdemixMSPSignerConfig{} }
 func (m *IdemixMSPSignerConfig) String() string { return proto.CompactTextString(m) }
 func (*IdemixMSPSignerConfig) ProtoMessage()    {}
 func (*IdemixMSPSignerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{4}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{5}
 }
 func (m *IdemixMSPSignerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IdemixMSPSignerConfig.Unmarshal(m, b)
@@ -445,6 +547,13 @@ func (m *IdemixMSPSignerConfig) GetCredentialRevocationInformation() []byte {
 	return nil
 }
 
+func (m *IdemixMSPSignerConfig) GetDisclosedAttributes() []*IdemixAttribute {
+	if m != nil {
+		return m.DisclosedAttributes
+	}
+	return nil
+}
+
 // SigningIdentityInfo represents the configuration information
 // related to the signing identity the peer is to use for generating
 // endorsements
@@ -465,7 +574,7 @@ func (m *SigningIdentityInfo) Reset()         { *m = SigningIdentityInfo{} }
 func (m *SigningIdentityInfo) String() string { return proto.CompactTextString(m) }
 func (*SigningIdentityInfo) ProtoMessage()    {}
 func (*SigningIdentityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{5}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{6}
 }
 func (m *SigningIdentityInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SigningIdentityInfo.Unmarshal(m, b)
@@ -520,7 +629,7 @@ func (m *KeyInfo) Reset()         { *m = KeyInfo{} }
 func (m *KeyInfo) String() string { return proto.CompactTextString(m) }
 func (*KeyInfo) ProtoMessage()    {}
 func (*KeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{6}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{7}
 }
 func (m *KeyInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyInfo.Unmarshal(m, b)
@@ -577,7 +686,7 @@ func (m *FabricOUIdentifier) Reset()         { *m = FabricOUIdentifier{} }
 func (m *FabricOUIdentifier) String() string { return proto.CompactTextString(m) }
 func (*FabricOUIdentifier) ProtoMessage()    {}
 func (*FabricOUIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{7}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{8}
 }
 func (m *FabricOUIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricOUIdentifier.Unmarshal(m, b)
@@ -630,7 +739,7 @@ func (m *FabricNodeOUs) Reset()         { *m = FabricNodeOUs{} }
 func (m *FabricNodeOUs) String() string { return proto.CompactTextString(m) }
 func (*FabricNodeOUs) ProtoMessage()    {}
 func (*FabricNodeOUs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_config_e749e5bd1d6d997b, []int{8}
+	return fileDescriptor_msp_config_ec28ffdf962df9ba, []int{9}
 }
 func (m *FabricNodeOUs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FabricNodeOUs.Unmarshal(m, b)
@@ -676,6 +785,7 @@ func init() {
 	proto.RegisterType((*FabricMSPConfig)(nil), "msp.FabricMSPConfig")
 	proto.RegisterType((*FabricCryptoConfig)(nil), "msp.FabricCryptoConfig")
 	proto.RegisterType((*IdemixMSPConfig)(nil), "msp.IdemixMSPConfig")
+	proto.RegisterType((*IdemixMSPIssuerConfig)(nil), "msp.IdemixMSPIssuerConfig")
 	proto.RegisterType((*IdemixMSPSignerConfig)(nil), "msp.IdemixMSPSignerConfig")
 	proto.RegisterType((*SigningIdentityInfo)(nil), "msp.SigningIdentityInfo")
 	proto.RegisterType((*KeyInfo)(nil), "msp.KeyInfo")
@@ -683,61 +793,69 @@ func init() {
 	proto.RegisterType((*FabricNodeOUs)(nil), "msp.FabricNodeOUs")
 }
 
-func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_e749e5bd1d6d997b) }
-
-var fileDescriptor_msp_config_e749e5bd1d6d997b = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0x57, 0x92, 0x26, 0x77, 0x99, 0x38, 0x49, 0xd9, 0xeb, 0x15, 0x0b, 0x71, 0x77, 0xa9, 0x01,
-	0x91, 0x17, 0x52, 0xa9, 0x87, 0x84, 0x84, 0x78, 0xba, 0xc2, 0x09, 0x03, 0xa5, 0xd5, 0x56, 0x7d,
-	0xe1, 0xc5, 0xda, 0xd8, 0x9b, 0x64, 0x65, 0x7b, 0xd7, 0xda, 0x5d, 0x9f, 0x08, 0xe2, 0x99, 0x2f,
-	0xc0, 0x77, 0xe0, 0x99, 0x57, 0xbe, 0x1d, 0xda, 0x3f, 0x8d, 0x9d, 0x6b, 0x15, 0x78, 0x9b, 0x9d,
-	0xf9, 0xcd, 0xcf, 0xb3, 0xbf, 0x99, 0x59, 0xc3, 0x49, 0xa9, 0xaa, 0xf3, 0x52, 0x55, 0x49, 0x2a,
-	0xf8, 0x8a, 0xad, 0x17, 0x95, 0x14, 0x5a, 0xa0, 0x5e, 0xa9, 0xaa, 0xe8, 0x2b, 0x18, 0x5e, 0xdd,
-	0xde, 0x5c, 0x5a, 0x3f, 0x42, 0x70, 0xa4, 0xb7, 0x15, 0x0d, 0x3b, 0xb3, 0xce, 0xbc, 0x8f, 0xad,
-	0x8d, 0x4e, 0x61, 0xe0, 0xb2, 0xc2, 0xee, 0xac, 0x33, 0x0f, 0xb0, 0x3f, 0x45, 0x7f, 0x1f, 0xc1,
-	0xf4, 0x2d, 0x59, 0x4a, 0x96, 0xee, 0xe5, 0x73, 0x52, 0xba, 0xfc, 0x21, 0xb6, 0x36, 0x7a, 0x01,
-	0x20, 0x85, 0xd0, 0x49, 0x4a, 0xa5, 0x56, 0x61, 0x77, 0xd6, 0x9b, 0x07, 0x78, 0x68, 0x3c, 0x97,
-	0xc6, 0x81, 0xbe, 0x00, 0xc4, 0xb8, 0xa6, 0xb2, 0xa4, 0x19, 0x23, 0x9a, 0x7a, 0x58, 0xcf, 0xc2,
-	0x3e, 0x68, 0x47, 0x1c, 0xfc, 0x14, 0x06, 0x24, 0x2b, 0x19, 0x57, 0xe1, 0x91, 0x85, 0xf8, 0x13,
-	0xfa, 0x1c, 0xa6, 0x92, 0xbe, 0x13, 0x29, 0xd1, 0x4c, 0xf0, 0xa4, 0x60, 0x4a, 0x87, 0x7d, 0x0b,
-	0x98, 0x34, 0xee, 0x9f, 0x98, 0xd2, 0xe8, 0x12, 0x8e, 0x15, 0x5b, 0x73, 0xc6, 0xd7, 0x09, 0xcb,
-	0x28, 0xd7, 0x4c, 0x6f, 0xc3, 0xc1, 0xac, 0x33, 0x1f, 0x5d, 0x84, 0x8b, 0x52, 0x55, 0x8b, 0x5b,
-	0x17, 0x8c, 0x7d, 0x2c, 0xe6, 0x2b, 0x81, 0xa7, 0x6a, 0xdf, 0x89, 0x12, 0x78, 0x25, 0xe4, 0x9a,
-	0x70, 0xf6, 0x9b, 0x25, 0x26, 0x45, 0x52, 0x73, 0xa6, 0x3d, 0xe1, 0x8a, 0x51, 0xa9, 0xc2, 0x27,
-	0xb3, 0xde, 0x7c, 0x74, 0xf1, 0xa1, 0xe5, 0x74, 0x32, 0x5d, 0xdf, 0xc5, 0xbb, 0x38, 0x7e, 0xb1,
-	0x9f, 0x7f, 0xc7, 0x99, 0x6e, 0xa2, 0x0a, 0x7d, 0x03, 0xe3, 0x54, 0x6e, 0x2b, 0x2d, 0x7c, 0xc7,
-	0xc2, 0xa7, 0xb6, 0xc4, 0x36, 0xdd, 0xa5, 0x8d, 0x3b, 0xe1, 0x71, 0x90, 0xb6, 0x4e, 0xe8, 0x53,
-	0x98, 0xe8, 0x42, 0x25, 0x2d, 0xd9, 0x87, 0x56, 0x8b, 0x40, 0x17, 0x0a, 0xef, 0x94, 0xff, 0x12,
-	0x4e, 0x0d, 0xea, 0x11, 0xf5, 0xc1, 0xa2, 0x4f, 0x74, 0xa1, 0xe2, 0x07, 0x0d, 0xf8, 0x1a, 0xa6,
-	0x2b, 0xfb, 0xfd, 0x84, 0x8b, 0x8c, 0x26, 0xa2, 0x56, 0xe1, 0xc8, 0xd6, 0x86, 0x5a, 0xb5, 0xfd,
-	0x2c, 0x32, 0x7a, 0x7d, 0xa7, 0xf0, 0x78, 0xd5, 0x1c, 0x6b, 0x15, 0xfd, 0xd9, 0x01, 0xf4, 0xb0,
-	0x78, 0x74, 0x01, 0xcf, 0x8d, 0xc0, 0x44, 0xd7, 0x92, 0x26, 0x1b, 0xa2, 0x36, 0xc9, 0x8a, 0x94,
-	0xac, 0xd8, 0xfa, 0x31, 0x7a, 0xb6, 0x0b, 0x7e, 0x4f, 0xd4, 0xe6, 0xad, 0x0d, 0xa1, 0x18, 0xce,
-	0xee, 0xdb, 0xd7, 0x92, 0xdd, 0x67, 0xd7, 0x3c, 0x35, 0xb2, 0xda, 0x81, 0x1d, 0xe2, 0x97, 0xf7,
-	0xc0, 0x46, 0x60, 0x4b, 0xe4, 0x51, 0xd1, 0x5f, 0x1d, 0x98, 0xc6, 0x19, 0x2d, 0xd9, 0xaf, 0x87,
-	0x07, 0xf9, 0x18, 0x7a, 0xac, 0xca, 0xfd, 0x16, 0x18, 0x13, 0x5d, 0xc0, 0xc0, 0xd4, 0x46, 0x65,
-	0xd8, 0xb3, 0x12, 0x7c, 0x64, 0x25, 0xd8, 0x71, 0xdd, 0xda, 0x98, 0xef, 0x90, 0x47, 0xa2, 0x4f,
-	0x60, 0xdc, 0x1a, 0xd4, 0x2a, 0x0f, 0x8f, 0x2c, 0x5f, 0xd0, 0x38, 0x6f, 0x72, 0x74, 0x02, 0x7d,
-	0x5a, 0x89, 0x74, 0x13, 0xf6, 0x67, 0x9d, 0x79, 0x0f, 0xbb, 0x43, 0xf4, 0x47, 0x17, 0x9e, 0x3f,
-	0x4a, 0x6e, 0xca, 0x4d, 0x25, 0xcd, 0x6c, 0xb9, 0x01, 0xb6, 0x36, 0x9a, 0x40, 0x57, 0xdd, 0x57,
-	0xdb, 0x55, 0x39, 0xfa, 0x16, 0x5e, 0x1e, 0x9e, 0x59, 0x7b, 0x89, 0x21, 0xfe, 0xf8, 0xd0, 0x64,
-	0x9a, 0x2f, 0x49, 0x51, 0x50, 0x5b, 0x75, 0x1f, 0x5b, 0xdb, 0x5c, 0x89, 0x72, 0x29, 0x8a, 0xa2,
-	0xa4, 0xdc, 0x10, 0xda, 0xaa, 0x87, 0x38, 0x68, 0x9c, 0x71, 0x86, 0x7e, 0x80, 0x33, 0x53, 0x96,
-	0x21, 0x22, 0x45, 0xd2, 0x92, 0x80, 0xf1, 0x95, 0x90, 0xa5, 0xb5, 0xed, 0x22, 0x06, 0xf8, 0x55,
-	0x03, 0xc4, 0x3b, 0x5c, 0xdc, 0xc0, 0x22, 0x01, 0xcf, 0x1e, 0x59, 0x53, 0x53, 0x47, 0x55, 0x2f,
-	0x0b, 0x96, 0x26, 0xbe, 0x2b, 0x4e, 0x8e, 0xc0, 0x39, 0x9d, 0x60, 0xe8, 0x35, 0x4c, 0x2a, 0xc9,
-	0xde, 0x99, 0x61, 0xf7, 0xa8, 0xae, 0xed, 0x5d, 0x60, 0x7b, 0xf7, 0x23, 0x75, 0x1b, 0x3f, 0xf6,
-	0x18, 0x97, 0x14, 0xdd, 0xc2, 0x13, 0x1f, 0x41, 0x9f, 0xc1, 0x24, 0xa7, 0xed, 0x99, 0xf3, 0x33,
-	0x32, 0xce, 0x69, 0x6b, 0xc0, 0xd0, 0x19, 0x04, 0x06, 0x56, 0x12, 0x4d, 0x25, 0x23, 0x85, 0xef,
-	0xc3, 0x28, 0xa7, 0xdb, 0x2b, 0xef, 0x8a, 0x7e, 0xbf, 0x5f, 0x86, 0xf6, 0xc3, 0x80, 0x66, 0x30,
-	0x32, 0x4b, 0xc8, 0x56, 0x2c, 0x25, 0x9a, 0xfa, 0x2b, 0xb4, 0x5d, 0xff, 0xa3, 0x91, 0xdd, 0xff,
-	0x6e, 0x64, 0xf4, 0x4f, 0x07, 0xc6, 0x7b, 0xcb, 0x6a, 0x9e, 0x56, 0xca, 0xc9, 0xb2, 0x70, 0x1f,
-	0x7d, 0x8a, 0xfd, 0x09, 0xc5, 0x70, 0x92, 0x16, 0xcc, 0xb4, 0x56, 0xd4, 0xef, 0x7f, 0xe5, 0xc0,
-	0x0b, 0x87, 0x5c, 0xd2, 0x75, 0xdd, 0xba, 0xdc, 0x77, 0x80, 0x2a, 0x4a, 0xe5, 0x7b, 0x44, 0xbd,
-	0xc3, 0x44, 0xc7, 0x26, 0xa5, 0x4d, 0xf3, 0x26, 0x81, 0x33, 0x21, 0xd7, 0x8b, 0xcd, 0xb6, 0xa2,
-	0xb2, 0xa0, 0xd9, 0x9a, 0xca, 0x85, 0x7b, 0x68, 0xdc, 0x8f, 0x4d, 0x19, 0xa6, 0x37, 0xc7, 0x57,
-	0xaa, 0x72, 0xeb, 0x71, 0x43, 0xd2, 0x9c, 0xac, 0xe9, 0x2f, 0xf3, 0x35, 0xd3, 0x9b, 0x7a, 0xb9,
-	0x48, 0x45, 0x79, 0xde, 0xca, 0x3d, 0x77, 0xb9, 0xe7, 0x2e, 0xd7, 0xfc, 0x26, 0x97, 0x03, 0x6b,
-	0xbf, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x54, 0x67, 0x46, 0xdb, 0x38, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("msp/msp_config.proto", fileDescriptor_msp_config_ec28ffdf962df9ba) }
+
+var fileDescriptor_msp_config_ec28ffdf962df9ba = []byte{
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x93, 0x26, 0xbb, 0x39, 0x71, 0x7e, 0x98, 0xa6, 0xc5, 0x5a, 0xb1, 0xdb, 0xd4, 0x80,
+	0x08, 0x17, 0xa4, 0x52, 0x77, 0x25, 0x24, 0xc4, 0x0d, 0x5b, 0x76, 0xc1, 0x40, 0x69, 0xe5, 0xaa,
+	0x37, 0xdc, 0x58, 0x13, 0x7b, 0x92, 0x8c, 0x62, 0x7b, 0xac, 0x99, 0xf1, 0x6a, 0x83, 0x78, 0x0b,
+	0x5e, 0x82, 0x4b, 0x6e, 0x79, 0x0a, 0xde, 0x82, 0xe7, 0x40, 0xf3, 0x93, 0xd8, 0x6d, 0xa3, 0xd0,
+	0x0b, 0xee, 0x66, 0xce, 0xf9, 0xce, 0x37, 0xe7, 0x7c, 0x73, 0xe6, 0xd8, 0x30, 0xca, 0x44, 0x71,
+	0x96, 0x89, 0x22, 0x8a, 0x59, 0x3e, 0xa7, 0x8b, 0x69, 0xc1, 0x99, 0x64, 0xa8, 0x99, 0x89, 0xe2,
+	0x99, 0x76, 0xd1, 0x84, 0xe4, 0x92, 0x4a, 0x4a, 0x84, 0x71, 0xf9, 0x5f, 0x42, 0xe7, 0xf2, 0xe6,
+	0xfa, 0x42, 0xa3, 0x11, 0x82, 0x03, 0xb9, 0x2e, 0x88, 0xe7, 0x8c, 0x9d, 0x49, 0x2b, 0xd4, 0x6b,
+	0x74, 0x0c, 0x6d, 0xc3, 0xe5, 0x35, 0xc6, 0xce, 0xc4, 0x0d, 0xed, 0xce, 0xff, 0xf3, 0x00, 0x06,
+	0x6f, 0xf1, 0x8c, 0xd3, 0xf8, 0x4e, 0x7c, 0x8e, 0x33, 0x13, 0xdf, 0x09, 0xf5, 0x1a, 0x3d, 0x07,
+	0xe0, 0x8c, 0xc9, 0x28, 0x26, 0x5c, 0x0a, 0xaf, 0x31, 0x6e, 0x4e, 0xdc, 0xb0, 0xa3, 0x2c, 0x17,
+	0xca, 0x80, 0xbe, 0x00, 0x44, 0x73, 0x49, 0x78, 0x46, 0x12, 0x8a, 0x25, 0xb1, 0xb0, 0xa6, 0x86,
+	0x7d, 0x50, 0xf7, 0x18, 0xf8, 0x31, 0xb4, 0x71, 0x92, 0xd1, 0x5c, 0x78, 0x07, 0x1a, 0x62, 0x77,
+	0xe8, 0x33, 0x18, 0x70, 0xf2, 0x8e, 0xc5, 0x58, 0x52, 0x96, 0x47, 0x29, 0x15, 0xd2, 0x6b, 0x69,
+	0x40, 0xbf, 0x32, 0xff, 0x44, 0x85, 0x44, 0x17, 0x30, 0x14, 0x74, 0x91, 0xd3, 0x7c, 0x11, 0x59,
+	0x2d, 0xd6, 0x5e, 0x7b, 0xec, 0x4c, 0xba, 0xe7, 0xde, 0x34, 0x13, 0xc5, 0xf4, 0xc6, 0x38, 0x03,
+	0xeb, 0x0b, 0xf2, 0x39, 0x0b, 0x07, 0xe2, 0xae, 0x11, 0x45, 0x70, 0xc2, 0xf8, 0x02, 0xe7, 0xf4,
+	0x57, 0x4d, 0x8c, 0xd3, 0xa8, 0xcc, 0xa9, 0xb4, 0x84, 0x73, 0x4a, 0xb8, 0xf0, 0x9e, 0x8c, 0x9b,
+	0x93, 0xee, 0xf9, 0x87, 0x9a, 0xd3, 0xc8, 0x74, 0x75, 0x1b, 0x6c, 0xfd, 0xe1, 0xf3, 0xbb, 0xf1,
+	0xb7, 0x39, 0x95, 0x95, 0x57, 0xa0, 0xaf, 0xa1, 0x17, 0xf3, 0x75, 0x21, 0x99, 0xbd, 0x47, 0xef,
+	0xe9, 0xd8, 0xb9, 0x47, 0x77, 0xa1, 0xfd, 0x46, 0xf8, 0xd0, 0x8d, 0x6b, 0x3b, 0xf4, 0x09, 0xf4,
+	0x65, 0x2a, 0xa2, 0x9a, 0xec, 0x1d, 0xad, 0x85, 0x2b, 0x53, 0x11, 0x6e, 0x95, 0x7f, 0x05, 0xc7,
+	0x0a, 0xb5, 0x43, 0x7d, 0xd0, 0xe8, 0x91, 0x4c, 0x45, 0xf0, 0xe0, 0x02, 0xbe, 0x82, 0xc1, 0x5c,
+	0x9f, 0x1f, 0xe5, 0x2c, 0x21, 0x11, 0x2b, 0x85, 0xd7, 0xd5, 0xb9, 0xa1, 0x5a, 0x6e, 0x3f, 0xb3,
+	0x84, 0x5c, 0xdd, 0x8a, 0xb0, 0x37, 0xaf, 0xb6, 0xa5, 0xf0, 0x7f, 0x77, 0x00, 0x3d, 0x4c, 0x1e,
+	0x9d, 0xc3, 0x91, 0x12, 0x18, 0xcb, 0x92, 0x93, 0x68, 0x89, 0xc5, 0x32, 0x9a, 0xe3, 0x8c, 0xa6,
+	0x6b, 0xdb, 0x46, 0x87, 0x5b, 0xe7, 0xf7, 0x58, 0x2c, 0xdf, 0x6a, 0x17, 0x0a, 0xe0, 0x74, 0x73,
+	0x7d, 0x35, 0xd9, 0x6d, 0x74, 0x99, 0xc7, 0x4a, 0x56, 0xdd, 0xb0, 0x9d, 0xf0, 0xc5, 0x06, 0x58,
+	0x09, 0xac, 0x89, 0x2c, 0xca, 0xff, 0xa3, 0x01, 0x83, 0x20, 0x21, 0x19, 0x7d, 0xbf, 0xbf, 0x91,
+	0x87, 0xd0, 0xa4, 0xc5, 0xca, 0xbe, 0x02, 0xb5, 0x44, 0xe7, 0xd0, 0x56, 0xb9, 0x11, 0xee, 0x35,
+	0xb5, 0x04, 0xcf, 0xb4, 0x04, 0x5b, 0xae, 0x1b, 0xed, 0xb3, 0x37, 0x64, 0x91, 0xe8, 0x63, 0xe8,
+	0xd5, 0x1a, 0xb5, 0x58, 0x79, 0x07, 0x9a, 0xcf, 0xad, 0x8c, 0xd7, 0x2b, 0x34, 0x82, 0x16, 0x29,
+	0x58, 0xbc, 0xf4, 0x5a, 0x63, 0x67, 0xd2, 0x0c, 0xcd, 0x06, 0xfd, 0x00, 0xa7, 0x31, 0x27, 0xba,
+	0x08, 0x9c, 0x46, 0x35, 0x16, 0x9a, 0xcf, 0x19, 0xcf, 0xf4, 0x5a, 0xf7, 0xb2, 0x1b, 0x9e, 0x54,
+	0xc0, 0x70, 0x8b, 0x0b, 0x2a, 0x18, 0x7a, 0x05, 0x4f, 0xa8, 0x10, 0x65, 0xd5, 0xa9, 0xf7, 0x72,
+	0x0f, 0xb4, 0xd3, 0xe6, 0xbe, 0x81, 0xfa, 0xff, 0x38, 0x70, 0xb4, 0x13, 0xb2, 0x11, 0xc7, 0xa9,
+	0xc4, 0x79, 0x50, 0x68, 0x63, 0x47, 0xa1, 0x8f, 0x2a, 0xa9, 0xf9, 0xb8, 0x92, 0x4e, 0xa0, 0x3b,
+	0xa7, 0x5c, 0xc8, 0xc8, 0x48, 0x77, 0xa0, 0xa5, 0x03, 0x6d, 0x7a, 0xa3, 0xf5, 0xfb, 0x1c, 0x86,
+	0xe4, 0x7d, 0x41, 0xb9, 0x39, 0xa1, 0x2e, 0xf0, 0xa0, 0xb2, 0x6b, 0xa8, 0xff, 0x77, 0x03, 0x8e,
+	0x76, 0xde, 0xa3, 0xea, 0x0c, 0x95, 0x88, 0xad, 0x54, 0xaf, 0x51, 0x1f, 0x1a, 0x62, 0x53, 0x5f,
+	0x43, 0xac, 0xd0, 0xb7, 0xf0, 0x62, 0xff, 0x78, 0xd0, 0x25, 0x75, 0xc2, 0x8f, 0xf6, 0x0d, 0x01,
+	0x75, 0x12, 0x67, 0x29, 0xd1, 0x85, 0xb4, 0x42, 0xbd, 0x56, 0xa2, 0x92, 0x9c, 0xb3, 0x34, 0xcd,
+	0x48, 0xae, 0x08, 0x75, 0xfe, 0x9d, 0xd0, 0xad, 0x8c, 0x41, 0xf2, 0xbf, 0xf6, 0xc9, 0x77, 0x30,
+	0x4a, 0xa8, 0x88, 0x53, 0x26, 0x48, 0x12, 0x61, 0x29, 0x39, 0x9d, 0x95, 0x92, 0x6c, 0x9a, 0x66,
+	0x54, 0x6b, 0x9a, 0x6f, 0x36, 0xce, 0xf0, 0x70, 0x1b, 0xb1, 0xb5, 0x09, 0x9f, 0xc1, 0xe1, 0x8e,
+	0xd1, 0xaa, 0x0a, 0x2a, 0xca, 0x59, 0x4a, 0xe3, 0xc8, 0xbe, 0x24, 0xa3, 0xab, 0x6b, 0x8c, 0x46,
+	0x79, 0xf4, 0x12, 0xfa, 0x05, 0xa7, 0xef, 0xd4, 0x80, 0xb2, 0xa8, 0x86, 0x7e, 0x6f, 0xae, 0x3e,
+	0xfe, 0x47, 0x62, 0xa6, 0x74, 0xcf, 0x62, 0x4c, 0x90, 0x7f, 0x03, 0x4f, 0xac, 0x07, 0x7d, 0x0a,
+	0xfd, 0x15, 0xa9, 0xcf, 0x09, 0xfb, 0xae, 0x7b, 0x2b, 0x52, 0x1b, 0x0a, 0xe8, 0x14, 0x5c, 0x05,
+	0xcb, 0xb0, 0x24, 0x9c, 0xe2, 0xd4, 0x5e, 0x68, 0x77, 0x45, 0xd6, 0x97, 0xd6, 0xe4, 0xff, 0x06,
+	0xe8, 0xe1, 0x30, 0x47, 0x63, 0xe8, 0xaa, 0xc1, 0x49, 0xe7, 0x34, 0xc6, 0x92, 0xd8, 0x12, 0xea,
+	0xa6, 0x47, 0x74, 0x44, 0xe3, 0xbf, 0x3b, 0xc2, 0xff, 0xcb, 0x81, 0xde, 0x9d, 0x01, 0xab, 0x3e,
+	0x87, 0x24, 0xc7, 0xb3, 0xd4, 0x1c, 0xfa, 0x34, 0xb4, 0x3b, 0x14, 0xc0, 0x28, 0x4e, 0xa9, 0xea,
+	0x11, 0x56, 0xde, 0x3f, 0x65, 0xcf, 0x57, 0x09, 0x99, 0xa0, 0xab, 0xb2, 0x56, 0xdc, 0x1b, 0x40,
+	0x05, 0x21, 0xfc, 0x1e, 0x51, 0x73, 0x3f, 0xd1, 0x50, 0x85, 0xd4, 0x69, 0x5e, 0x47, 0x70, 0xca,
+	0xf8, 0x62, 0xba, 0x5c, 0x17, 0x84, 0xa7, 0x24, 0x59, 0x10, 0x3e, 0x35, 0x1f, 0x07, 0xf3, 0x1f,
+	0x22, 0x14, 0xd3, 0xeb, 0xe1, 0xa5, 0x28, 0xcc, 0x3b, 0xbb, 0xc6, 0xf1, 0x0a, 0x2f, 0xc8, 0x2f,
+	0x93, 0x05, 0x95, 0xcb, 0x72, 0x36, 0x8d, 0x59, 0x76, 0x56, 0x8b, 0x3d, 0x33, 0xb1, 0x67, 0x26,
+	0x56, 0xfd, 0xf0, 0xcc, 0xda, 0x7a, 0xfd, 0xf2, 0xdf, 0x01, 0x00, 0x58, 0xf3, 0x93, 0x15, 0x02,
+	0x09, 0x00, 0x00,
 }
@@ -32,7 +32,8 @@ const (
 	// identity
 	MSPPrincipal_ANONYMITY MSPPrincipal_Classification = 3
 	// an identity to be anonymous or nominal.
-	MSPPrincipal_COMBINED MSPPrincipal_Classification = 4
+	MSPPrincipal_COMBINED  MSPPrincipal_Classification = 4
+	MSPPrincipal_ATTRIBUTE MSPPrincipal_Classification = 5
 )
 
 var MSPPrincipal_Classification_name = map[int32]string{
@@ -41,6 +42,7 @@ var MSPPrincipal_Classification_name = map[int32]string{
 	2: "IDENTITY",
 	3: "ANONYMITY",
 	4: "COMBINED",
+	5: "ATTRIBUTE",
 }
 var MSPPrincipal_Classification_value = map[string]int32{
 	"ROLE":              0,
@@ -48,13 +50,14 @@ var MSPPrincipal_Classification_value = map[string]int32{
 	"IDENTITY":          2,
 	"ANONYMITY":         3,
 	"COMBINED":          4,
+	"ATTRIBUTE":         5,
 }
 
 func (x MSPPrincipal_Classification) String() string {
 	return proto.EnumName(MSPPrincipal_Classification_name, int32(x))
 }
 func (MSPPrincipal_Classification) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{0, 0}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{0, 0}
 }
 
 type MSPRole_MSPRoleType int32
@@ -83,7 +86,7 @@ func (x MSPRole_MSPRoleType) String() string {
 	return proto.EnumName(MSPRole_MSPRoleType_name, int32(x))
 }
 func (MSPRole_MSPRoleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{2, 0}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{2, 0}
 }
 
 type MSPIdentityAnonymity_MSPIdentityAnonymityType int32
@@ -106,27 +109,28 @@ func (x MSPIdentityAnonymity_MSPIdentityAnonymityType) String() string {
 	return proto.EnumName(MSPIdentityAnonymity_MSPIdentityAnonymityType_name, int32(x))
 }
 func (MSPIdentityAnonymity_MSPIdentityAnonymityType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{3, 0}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{3, 0}
 }
 
 // MSPPrincipal aims to represent an MSP-centric set of identities.
 // In particular, this structure allows for definition of
-//  - a group of identities that are member of the same MSP
-//  - a group of identities that are member of the same organization unit
-//    in the same MSP
-//  - a group of identities that are administering a specific MSP
-//  - a specific identity
+//   - a group of identities that are member of the same MSP
+//   - a group of identities that are member of the same organization unit
+//     in the same MSP
+//   - a group of identities that are administering a specific MSP
+//   - a specific identity
+//
 // Expressing these groups is done given two fields of the fields below
-//  - Classification, that defines the type of classification of identities
-//    in an MSP this principal would be defined on; Classification can take
-//    three values:
+//   - Classification, that defines the type of classification of identities
+//     in an MSP this principal would be defined on; Classification can take
+//     three values:
 //     (i)  ByMSPRole: that represents a classification of identities within
-//          MSP based on one of the two pre-defined MSP rules, "member" and "admin"
+//     MSP based on one of the two pre-defined MSP rules, "member" and "admin"
 //     (ii) ByOrganizationUnit: that represents a classification of identities
-//          within MSP based on the organization unit an identity belongs to
+//     within MSP based on the organization unit an identity belongs to
 //     (iii)ByIdentity that denotes that MSPPrincipal is mapped to a single
-//          identity/certificate; this would mean that the Principal bytes
-//          message
+//     identity/certificate; this would mean that the Principal bytes
+//     message
 type MSPPrincipal struct {
 	// Classification describes the way that one should process
 	// Principal. An Classification value of "ByOrganizationUnit" reflects
@@ -143,6 +147,8 @@ type MSPPrincipal struct {
 	// identity, respectively.
 	// For the Combined Classification type, the Principal is a marshalled
 	// CombinedPrincipal.
+	// For the Attribute Classification type, the Principal is a marshalled
+	// MSPAttribute.
 	Principal            []byte   `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -153,7 +159,7 @@ func (m *MSPPrincipal) Reset()         { *m = MSPPrincipal{} }
 func (m *MSPPrincipal) String() string { return proto.CompactTextString(m) }
 func (*MSPPrincipal) ProtoMessage()    {}
 func (*MSPPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{0}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{0}
 }
 func (m *MSPPrincipal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPPrincipal.Unmarshal(m, b)
@@ -209,7 +215,7 @@ func (m *OrganizationUnit) Reset()         { *m = OrganizationUnit{} }
 func (m *OrganizationUnit) String() string { return proto.CompactTextString(m) }
 func (*OrganizationUnit) ProtoMessage()    {}
 func (*OrganizationUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{1}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{1}
 }
 func (m *OrganizationUnit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationUnit.Unmarshal(m, b)
@@ -269,7 +275,7 @@ func (m *MSPRole) Reset()         { *m = MSPRole{} }
 func (m *MSPRole) String() string { return proto.CompactTextString(m) }
 func (*MSPRole) ProtoMessage()    {}
 func (*MSPRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{2}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{2}
 }
 func (m *MSPRole) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPRole.Unmarshal(m, b)
@@ -315,7 +321,7 @@ func (m *MSPIdentityAnonymity) Reset()         { *m = MSPIdentityAnonymity{} }
 func (m *MSPIdentityAnonymity) String() string { return proto.CompactTextString(m) }
 func (*MSPIdentityAnonymity) ProtoMessage()    {}
 func (*MSPIdentityAnonymity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{3}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{3}
 }
 func (m *MSPIdentityAnonymity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MSPIdentityAnonymity.Unmarshal(m, b)
@@ -357,7 +363,7 @@ func (m *CombinedPrincipal) Reset()         { *m = CombinedPrincipal{} }
 func (m *CombinedPrincipal) String() string { return proto.CompactTextString(m) }
 func (*CombinedPrincipal) ProtoMessage()    {}
 func (*CombinedPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msp_principal_9016cf1a8a7156cd, []int{4}
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{4}
 }
 func (m *CombinedPrincipal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CombinedPrincipal.Unmarshal(m, b)
@@ -384,54 +390,119 @@ func (m *CombinedPrincipal) GetPrincipals() []*MSPPrincipal {
 	return nil
 }
 
+// MSPAttribute governs the organization of the Principal
+// field of a policy principal when principal_classification has
+// indicated that an Idemix certified attribute is required
+type MSPAttribute struct {
+	// MSPIdentifier represents the identifier of the MSP this principal
+	// refers to
+	MspIdentifier string `protobuf:"bytes,1,opt,name=msp_identifier,json=mspIdentifier,proto3" json:"msp_identifier,omitempty"`
+	// Name is the name of the certified attribute
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Value is the value the certified attribute of an identity
+	// must be equal to
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MSPAttribute) Reset()         { *m = MSPAttribute{} }
+func (m *MSPAttribute) String() string { return proto.CompactTextString(m) }
+func (*MSPAttribute) ProtoMessage()    {}
+func (*MSPAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msp_principal_c047b062324d58f4, []int{5}
+}
+func (m *MSPAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MSPAttribute.Unmarshal(m, b)
+}
+func (m *MSPAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MSPAttribute.Marshal(b, m, deterministic)
+}
+func (dst *MSPAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MSPAttribute.Merge(dst, src)
+}
+func (m *MSPAttribute) XXX_Size() int {
+	return xxx_messageInfo_MSPAttribute.Size(m)
+}
+func (m *MSPAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MSPAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MSPAttribute proto.InternalMessageInfo
+
+func (m *MSPAttribute) GetMspIdentifier() string {
+	if m != nil {
+		return m.MspIdentifier
+	}
+	return ""
+}
+
+func (m *MSPAttribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MSPAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MSPPrincipal)(nil), "common.MSPPrincipal")
 	proto.RegisterType((*OrganizationUnit)(nil), "common.OrganizationUnit")
 	proto.RegisterType((*MSPRole)(nil), "common.MSPRole")
 	proto.RegisterType((*MSPIdentityAnonymity)(nil), "common.MSPIdentityAnonymity")
 	proto.RegisterType((*CombinedPrincipal)(nil), "common.CombinedPrincipal")
+	proto.RegisterType((*MSPAttribute)(nil), "common.MSPAttribute")
 	proto.RegisterEnum("common.MSPPrincipal_Classification", MSPPrincipal_Classification_name, MSPPrincipal_Classification_value)
 	proto.RegisterEnum("common.MSPRole_MSPRoleType", MSPRole_MSPRoleType_name, MSPRole_MSPRoleType_value)
 	proto.RegisterEnum("common.MSPIdentityAnonymity_MSPIdentityAnonymityType", MSPIdentityAnonymity_MSPIdentityAnonymityType_name, MSPIdentityAnonymity_MSPIdentityAnonymityType_value)
 }
 
 func init() {
-	proto.RegisterFile("msp/msp_principal.proto", fileDescriptor_msp_principal_9016cf1a8a7156cd)
-}
-
-var fileDescriptor_msp_principal_9016cf1a8a7156cd = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x6a, 0xdb, 0x30,
-	0x14, 0xc6, 0xeb, 0xa4, 0x6b, 0x9b, 0x93, 0x3f, 0xa8, 0x22, 0xa5, 0x81, 0x95, 0x11, 0xbc, 0x0d,
-	0x72, 0xe5, 0x40, 0xba, 0xed, 0x62, 0x77, 0x4e, 0x62, 0x86, 0x20, 0x96, 0x8d, 0xe3, 0x5c, 0xb4,
-	0x94, 0x05, 0xc7, 0x51, 0x52, 0x81, 0x6d, 0x19, 0xdb, 0xbd, 0xf0, 0xde, 0x65, 0x6f, 0xb0, 0xcb,
-	0x3d, 0xd5, 0x9e, 0x62, 0xd8, 0x6e, 0x12, 0x65, 0xeb, 0x60, 0x57, 0xf6, 0x39, 0xe7, 0xf7, 0x1d,
-	0x1d, 0x49, 0x9f, 0xe0, 0x3a, 0x4c, 0xe3, 0x61, 0x98, 0xc6, 0xcb, 0x38, 0xe1, 0x91, 0xcf, 0x63,
-	0x2f, 0xd0, 0xe2, 0x44, 0x64, 0x02, 0x9f, 0xf9, 0x22, 0x0c, 0x45, 0xa4, 0xfe, 0x52, 0xa0, 0x65,
-	0xce, 0x6d, 0x7b, 0x57, 0xc6, 0x5f, 0xa1, 0xb7, 0x67, 0x97, 0x7e, 0xe0, 0xa5, 0x29, 0xdf, 0x70,
-	0xdf, 0xcb, 0xb8, 0x88, 0x7a, 0x4a, 0x5f, 0x19, 0x74, 0x46, 0x6f, 0xb5, 0x4a, 0xab, 0xc9, 0x3a,
-	0x6d, 0x72, 0x84, 0x3a, 0xd7, 0xfb, 0x26, 0xc7, 0x05, 0x7c, 0x03, 0x8d, 0x7d, 0xa9, 0x57, 0xeb,
-	0x2b, 0x83, 0x96, 0x73, 0x48, 0xa8, 0x0f, 0xd0, 0xf9, 0x83, 0xbf, 0x80, 0x53, 0xc7, 0x9a, 0x19,
-	0xe8, 0x04, 0x5f, 0xc1, 0xa5, 0xe5, 0x7c, 0xd1, 0x29, 0xb9, 0xd7, 0x5d, 0x62, 0xd1, 0xe5, 0x82,
-	0x12, 0x17, 0x29, 0xb8, 0x05, 0x17, 0x64, 0x6a, 0x50, 0x97, 0xb8, 0x77, 0xa8, 0x86, 0xdb, 0xd0,
-	0xd0, 0xa9, 0x45, 0xef, 0xcc, 0x22, 0xac, 0x17, 0xc5, 0x89, 0x65, 0x8e, 0x09, 0x35, 0xa6, 0xe8,
-	0x54, 0xfd, 0xa9, 0x00, 0xb2, 0x92, 0xad, 0x17, 0xf1, 0x6f, 0x65, 0xf3, 0x45, 0xc4, 0x33, 0xfc,
-	0x1e, 0x3a, 0xc5, 0x01, 0xf1, 0x35, 0x8b, 0x32, 0xbe, 0xe1, 0x2c, 0x29, 0xb7, 0xd9, 0x70, 0xda,
-	0x61, 0x1a, 0x93, 0x7d, 0x12, 0x4f, 0xe1, 0x8d, 0x90, 0xa4, 0x5e, 0xb0, 0x7c, 0x8a, 0x78, 0x26,
-	0xcb, 0x6a, 0xa5, 0xec, 0xe6, 0x98, 0x2a, 0x96, 0x90, 0xba, 0xdc, 0xc2, 0x95, 0xcf, 0x92, 0x2a,
-	0x48, 0x65, 0x71, 0xbd, 0x3c, 0x89, 0xee, 0xa1, 0x78, 0x10, 0xa9, 0xdf, 0x15, 0x38, 0x37, 0xe7,
-	0xb6, 0x23, 0x02, 0xf6, 0xbf, 0xd3, 0x0e, 0xe1, 0x34, 0x11, 0x01, 0x2b, 0x67, 0xea, 0x8c, 0x5e,
-	0x4b, 0x37, 0x56, 0x74, 0xd9, 0x7d, 0xdd, 0x3c, 0x66, 0x4e, 0x09, 0xaa, 0x9f, 0xa1, 0x29, 0x25,
-	0x31, 0xc0, 0x99, 0x69, 0x98, 0x63, 0xc3, 0x41, 0x27, 0xb8, 0x01, 0xaf, 0xf4, 0xa9, 0x49, 0x28,
-	0x52, 0x8a, 0xf4, 0x64, 0x46, 0x0c, 0xea, 0xa2, 0x5a, 0x71, 0x31, 0xb6, 0x61, 0x38, 0xa8, 0xae,
-	0xfe, 0x50, 0xa0, 0x6b, 0xce, 0xed, 0x6a, 0xf9, 0x2c, 0xd7, 0x23, 0x11, 0xe5, 0x21, 0xcf, 0x72,
-	0xfc, 0x00, 0x1d, 0x6f, 0x17, 0x2c, 0xb3, 0x3c, 0x66, 0xcf, 0x0e, 0xfa, 0x28, 0xcd, 0xf3, 0x97,
-	0xea, 0xc5, 0x64, 0x39, 0x69, 0xdb, 0x93, 0x43, 0xf5, 0x13, 0xf4, 0xfe, 0x85, 0xe2, 0x26, 0x9c,
-	0x53, 0xcb, 0x24, 0x54, 0x9f, 0xa1, 0x93, 0x83, 0x27, 0xac, 0xc5, 0x1c, 0x29, 0x2a, 0x81, 0xcb,
-	0x89, 0x08, 0x57, 0x3c, 0x62, 0xeb, 0x83, 0xed, 0x3f, 0x00, 0xec, 0x5d, 0x98, 0xf6, 0x94, 0x7e,
-	0x7d, 0xd0, 0x1c, 0x75, 0x5f, 0x32, 0xba, 0x23, 0x71, 0x63, 0x1b, 0xde, 0x89, 0x64, 0xab, 0x3d,
-	0xe6, 0x31, 0x4b, 0x02, 0xb6, 0xde, 0xb2, 0x44, 0xdb, 0x78, 0xab, 0x84, 0xfb, 0xd5, 0x2b, 0x4b,
-	0x9f, 0x1b, 0xdc, 0x0f, 0xb6, 0x3c, 0x7b, 0x7c, 0x5a, 0x15, 0xe1, 0x50, 0x82, 0x87, 0x15, 0x3c,
-	0xac, 0xe0, 0xe2, 0x9d, 0xae, 0xce, 0xca, 0xff, 0xdb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x40,
-	0x36, 0xd2, 0xf9, 0xb9, 0x03, 0x00, 0x00,
+	proto.RegisterFile("msp/msp_principal.proto", fileDescriptor_msp_principal_c047b062324d58f4)
+}
+
+var fileDescriptor_msp_principal_c047b062324d58f4 = []byte{
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x54, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xad, 0xfc, 0x91, 0xc4, 0xe3, 0x0f, 0x94, 0xc5, 0x21, 0x86, 0x86, 0x52, 0xd4, 0x16, 0x7c,
+	0x92, 0xc1, 0x69, 0x7b, 0xe8, 0x4d, 0xb6, 0x45, 0x59, 0xb0, 0x24, 0xb3, 0x91, 0x0f, 0x09, 0xa5,
+	0x46, 0x96, 0x15, 0x67, 0x89, 0x25, 0x19, 0x49, 0x2e, 0xb8, 0xff, 0xa5, 0xff, 0xa0, 0xc7, 0xfe,
+	0xb9, 0xde, 0x3a, 0x5a, 0xd9, 0xb2, 0xdc, 0xa6, 0x90, 0x83, 0xd0, 0xce, 0xcc, 0x7b, 0xb3, 0x6f,
+	0x67, 0x66, 0x17, 0x2e, 0xfd, 0x78, 0xdd, 0xc3, 0x6f, 0xb6, 0x8e, 0x78, 0xe0, 0xf2, 0xb5, 0xb3,
+	0x52, 0xd7, 0x51, 0x98, 0x84, 0xe4, 0xc4, 0x0d, 0x7d, 0x3f, 0x0c, 0x94, 0xdf, 0x12, 0x34, 0x8c,
+	0x9b, 0xc9, 0x64, 0x1f, 0x26, 0x5f, 0xa1, 0x93, 0x63, 0x67, 0xee, 0xca, 0x89, 0x63, 0x7e, 0xcf,
+	0x5d, 0x27, 0xe1, 0x61, 0xd0, 0x91, 0x5e, 0x4b, 0xdd, 0x56, 0xff, 0x8d, 0x9a, 0x71, 0xd5, 0x22,
+	0x4f, 0x1d, 0x1e, 0x41, 0xd9, 0x65, 0x9e, 0xe4, 0x38, 0x40, 0xae, 0xa0, 0x96, 0x87, 0x3a, 0x25,
+	0x4c, 0xd8, 0x60, 0x07, 0x87, 0xf2, 0x08, 0xad, 0xbf, 0xf0, 0x67, 0x50, 0x61, 0xd6, 0x58, 0x97,
+	0x5f, 0x90, 0x0b, 0x38, 0xb7, 0xd8, 0x67, 0xcd, 0xa4, 0x77, 0x9a, 0x4d, 0x2d, 0x73, 0x36, 0x35,
+	0xa9, 0x2d, 0x4b, 0xa4, 0x01, 0x67, 0x74, 0xa4, 0x9b, 0x36, 0xb5, 0x6f, 0xe5, 0x12, 0x69, 0x42,
+	0x4d, 0x33, 0x2d, 0xf3, 0xd6, 0x48, 0xcd, 0x72, 0x1a, 0x1c, 0x5a, 0xc6, 0x80, 0x9a, 0xfa, 0x48,
+	0xae, 0x88, 0xa0, 0x6d, 0x33, 0x3a, 0x98, 0xda, 0xba, 0x5c, 0x55, 0x7e, 0x49, 0x20, 0x5b, 0xd1,
+	0xd2, 0x09, 0xf8, 0x77, 0xb1, 0xd7, 0x34, 0xe0, 0x09, 0x79, 0x07, 0xad, 0xb4, 0x5e, 0x7c, 0xe1,
+	0x05, 0x09, 0xaa, 0xf0, 0x22, 0x71, 0xea, 0x1a, 0x6b, 0xa2, 0x97, 0xe6, 0x4e, 0x32, 0x82, 0x57,
+	0x61, 0x81, 0x8a, 0xb5, 0xda, 0x20, 0xbb, 0x48, 0x2b, 0x09, 0xda, 0xd5, 0x31, 0x2a, 0xdd, 0xa2,
+	0x90, 0xe5, 0x1a, 0x2e, 0x5c, 0x2f, 0xca, 0x8c, 0xb8, 0x48, 0x2e, 0x8b, 0xc2, 0xb4, 0x0f, 0xc1,
+	0x03, 0x49, 0xf9, 0x21, 0xc1, 0x29, 0x96, 0x9e, 0x85, 0x2b, 0xef, 0xb9, 0x6a, 0x7b, 0x50, 0x89,
+	0x10, 0x2e, 0x34, 0xb5, 0xfa, 0x2f, 0x0b, 0x0d, 0x4c, 0xb3, 0xec, 0xff, 0xf6, 0x76, 0xed, 0x31,
+	0x01, 0x54, 0x3e, 0x41, 0xbd, 0xe0, 0x24, 0x00, 0x27, 0x86, 0x6e, 0x0c, 0x74, 0x86, 0x6d, 0xa8,
+	0x41, 0x55, 0x1b, 0x19, 0xd4, 0xc4, 0xd2, 0xa3, 0x7b, 0x38, 0xa6, 0x58, 0x7b, 0x2c, 0x3c, 0xf6,
+	0x69, 0xa2, 0x23, 0xa0, 0xac, 0xfc, 0x94, 0xa0, 0x8d, 0xe4, 0x6c, 0xfb, 0x64, 0xab, 0x05, 0x61,
+	0xb0, 0xf5, 0x71, 0x41, 0xbe, 0x40, 0xcb, 0xd9, 0x1b, 0xb3, 0x04, 0xf3, 0xee, 0x06, 0xea, 0x43,
+	0x41, 0xcf, 0x3f, 0xac, 0x27, 0x9d, 0x42, 0x69, 0xd3, 0x29, 0x9a, 0xca, 0x47, 0xe8, 0xfc, 0x0f,
+	0x4a, 0xea, 0x70, 0x6a, 0x5a, 0xa8, 0x59, 0x1b, 0xe3, 0x01, 0xf2, 0x11, 0xb1, 0xa6, 0x37, 0xb2,
+	0xa4, 0x50, 0x38, 0x1f, 0x86, 0xfe, 0x9c, 0x07, 0xde, 0xe2, 0x70, 0x0b, 0xde, 0x03, 0xe4, 0x43,
+	0x19, 0xa3, 0xcc, 0x72, 0xb7, 0xde, 0x6f, 0x3f, 0x35, 0xf7, 0xac, 0x80, 0x53, 0x66, 0xe2, 0x2e,
+	0x69, 0x49, 0x12, 0xf1, 0xf9, 0x26, 0x79, 0x76, 0x77, 0x08, 0x54, 0x02, 0xc7, 0xf7, 0x76, 0x13,
+	0x23, 0xd6, 0xa4, 0x0d, 0xd5, 0x6f, 0xce, 0x6a, 0xe3, 0x89, 0x49, 0xa8, 0xb1, 0xcc, 0x18, 0x4c,
+	0xe0, 0x2d, 0xce, 0x93, 0xfa, 0x80, 0x67, 0x8a, 0x56, 0xde, 0x62, 0xe9, 0x45, 0xea, 0xbd, 0x33,
+	0x8f, 0xb8, 0x9b, 0xdd, 0xea, 0x78, 0xa7, 0xf0, 0xae, 0xbb, 0xe4, 0xc9, 0xc3, 0x66, 0x9e, 0x9a,
+	0xbd, 0x02, 0xb8, 0x97, 0x81, 0x7b, 0x19, 0x38, 0x7d, 0x17, 0xe6, 0x27, 0x62, 0x7d, 0xfd, 0x07,
+	0x4a, 0x43, 0xe8, 0x78, 0x29, 0x04, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import common "github.com/hyperledger/fabric/protos/common"
 
 import (
@@ -57,7 +58,7 @@ func (x ServerStatus_StatusCode) String() string {
 	return proto.EnumName(ServerStatus_StatusCode_name, int32(x))
 }
 func (ServerStatus_StatusCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{0, 0}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{0, 0}
 }
 
 type ServerStatus struct {
@@ -71,7 +72,7 @@ func (m *ServerStatus) Reset()         { *m = ServerStatus{} }
 func (m *ServerStatus) String() string { return proto.CompactTextString(m) }
 func (*ServerStatus) ProtoMessage()    {}
 func (*ServerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{0}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{0}
 }
 func (m *ServerStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerStatus.Unmarshal(m, b)
@@ -110,7 +111,7 @@ func (m *LogLevelRequest) Reset()         { *m = LogLevelRequest{} }
 func (m *LogLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*LogLevelRequest) ProtoMessage()    {}
 func (*LogLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{1}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{1}
 }
 func (m *LogLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevelRequest.Unmarshal(m, b)
@@ -156,7 +157,7 @@ func (m *LogLevelResponse) Reset()         { *m = LogLevelResponse{} }
 func (m *LogLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*LogLevelResponse) ProtoMessage()    {}
 func (*LogLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{2}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{2}
 }
 func (m *LogLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLevelResponse.Unmarshal(m, b)
@@ -201,7 +202,7 @@ func (m *LogSpecRequest) Reset()         { *m = LogSpecRequest{} }
 func (m *LogSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*LogSpecRequest) ProtoMessage()    {}
 func (*LogSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{3}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{3}
 }
 func (m *LogSpecRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogSpecRequest.Unmarshal(m, b)
@@ -240,7 +241,7 @@ func (m *LogSpecResponse) Reset()         { *m = LogSpecResponse{} }
 func (m *LogSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*LogSpecResponse) ProtoMessage()    {}
 func (*LogSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{4}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{4}
 }
 func (m *LogSpecResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogSpecResponse.Unmarshal(m, b)
@@ -274,10 +275,856 @@ func (m *LogSpecResponse) GetError() string {
 	return ""
 }
 
+// TransientStoreEntriesRequest requests the oldest private write sets pending in
+// the transient store of a channel, or of every channel if channel_id is empty
+type TransientStoreEntriesRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MaxEntries           uint32   `protobuf:"varint,2,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransientStoreEntriesRequest) Reset()         { *m = TransientStoreEntriesRequest{} }
+func (m *TransientStoreEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*TransientStoreEntriesRequest) ProtoMessage()    {}
+func (*TransientStoreEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{5}
+}
+func (m *TransientStoreEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransientStoreEntriesRequest.Unmarshal(m, b)
+}
+func (m *TransientStoreEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransientStoreEntriesRequest.Marshal(b, m, deterministic)
+}
+func (dst *TransientStoreEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransientStoreEntriesRequest.Merge(dst, src)
+}
+func (m *TransientStoreEntriesRequest) XXX_Size() int {
+	return xxx_messageInfo_TransientStoreEntriesRequest.Size(m)
+}
+func (m *TransientStoreEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransientStoreEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransientStoreEntriesRequest proto.InternalMessageInfo
+
+func (m *TransientStoreEntriesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransientStoreEntriesRequest) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+type TransientStoreEntriesResponse struct {
+	Channels             []*ChannelTransientStoreEntries `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *TransientStoreEntriesResponse) Reset()         { *m = TransientStoreEntriesResponse{} }
+func (m *TransientStoreEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*TransientStoreEntriesResponse) ProtoMessage()    {}
+func (*TransientStoreEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{6}
+}
+func (m *TransientStoreEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransientStoreEntriesResponse.Unmarshal(m, b)
+}
+func (m *TransientStoreEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransientStoreEntriesResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransientStoreEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransientStoreEntriesResponse.Merge(dst, src)
+}
+func (m *TransientStoreEntriesResponse) XXX_Size() int {
+	return xxx_messageInfo_TransientStoreEntriesResponse.Size(m)
+}
+func (m *TransientStoreEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransientStoreEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransientStoreEntriesResponse proto.InternalMessageInfo
+
+func (m *TransientStoreEntriesResponse) GetChannels() []*ChannelTransientStoreEntries {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// ChannelTransientStoreEntries describes the private write sets pending in the
+// transient store of a channel
+type ChannelTransientStoreEntries struct {
+	ChannelId            string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TotalEntries         uint64                 `protobuf:"varint,2,opt,name=total_entries,json=totalEntries,proto3" json:"total_entries,omitempty"`
+	TotalSize            uint64                 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Oldest               []*TransientStoreEntry `protobuf:"bytes,4,rep,name=oldest,proto3" json:"oldest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ChannelTransientStoreEntries) Reset()         { *m = ChannelTransientStoreEntries{} }
+func (m *ChannelTransientStoreEntries) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransientStoreEntries) ProtoMessage()    {}
+func (*ChannelTransientStoreEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{7}
+}
+func (m *ChannelTransientStoreEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelTransientStoreEntries.Unmarshal(m, b)
+}
+func (m *ChannelTransientStoreEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelTransientStoreEntries.Marshal(b, m, deterministic)
+}
+func (dst *ChannelTransientStoreEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransientStoreEntries.Merge(dst, src)
+}
+func (m *ChannelTransientStoreEntries) XXX_Size() int {
+	return xxx_messageInfo_ChannelTransientStoreEntries.Size(m)
+}
+func (m *ChannelTransientStoreEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransientStoreEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransientStoreEntries proto.InternalMessageInfo
+
+func (m *ChannelTransientStoreEntries) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelTransientStoreEntries) GetTotalEntries() uint64 {
+	if m != nil {
+		return m.TotalEntries
+	}
+	return 0
+}
+
+func (m *ChannelTransientStoreEntries) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ChannelTransientStoreEntries) GetOldest() []*TransientStoreEntry {
+	if m != nil {
+		return m.Oldest
+	}
+	return nil
+}
+
+// TransientStoreEntry describes a private write set pending in a transient store
+type TransientStoreEntry struct {
+	TxId                  string               `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ReceivedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	ReceivedAtBlockHeight uint64               `protobuf:"varint,3,opt,name=received_at_block_height,json=receivedAtBlockHeight,proto3" json:"received_at_block_height,omitempty"`
+	Size                  uint64               `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
+}
+
+func (m *TransientStoreEntry) Reset()         { *m = TransientStoreEntry{} }
+func (m *TransientStoreEntry) String() string { return proto.CompactTextString(m) }
+func (*TransientStoreEntry) ProtoMessage()    {}
+func (*TransientStoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{8}
+}
+func (m *TransientStoreEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransientStoreEntry.Unmarshal(m, b)
+}
+func (m *TransientStoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransientStoreEntry.Marshal(b, m, deterministic)
+}
+func (dst *TransientStoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransientStoreEntry.Merge(dst, src)
+}
+func (m *TransientStoreEntry) XXX_Size() int {
+	return xxx_messageInfo_TransientStoreEntry.Size(m)
+}
+func (m *TransientStoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransientStoreEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransientStoreEntry proto.InternalMessageInfo
+
+func (m *TransientStoreEntry) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TransientStoreEntry) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+func (m *TransientStoreEntry) GetReceivedAtBlockHeight() uint64 {
+	if m != nil {
+		return m.ReceivedAtBlockHeight
+	}
+	return 0
+}
+
+func (m *TransientStoreEntry) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// ReconciliationStatusRequest requests the private data missing on the peer for a channel
+type ReconciliationStatusRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconciliationStatusRequest) Reset()         { *m = ReconciliationStatusRequest{} }
+func (m *ReconciliationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationStatusRequest) ProtoMessage()    {}
+func (*ReconciliationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{9}
+}
+func (m *ReconciliationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationStatusRequest.Unmarshal(m, b)
+}
+func (m *ReconciliationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReconciliationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationStatusRequest.Merge(dst, src)
+}
+func (m *ReconciliationStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationStatusRequest.Size(m)
+}
+func (m *ReconciliationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciliationStatusRequest proto.InternalMessageInfo
+
+func (m *ReconciliationStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// ReconciliationStatusResponse describes the private data missing on the peer for
+// a channel, and the outcome of the most recent attempts to reconcile it
+type ReconciliationStatusResponse struct {
+	ChannelId            string                  `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LastAttempt          *timestamp.Timestamp    `protobuf:"bytes,2,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastError            string                  `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	MissingPvtData       []*MissingPvtDataStatus `protobuf:"bytes,4,rep,name=missing_pvt_data,json=missingPvtData,proto3" json:"missing_pvt_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ReconciliationStatusResponse) Reset()         { *m = ReconciliationStatusResponse{} }
+func (m *ReconciliationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationStatusResponse) ProtoMessage()    {}
+func (*ReconciliationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{10}
+}
+func (m *ReconciliationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationStatusResponse.Unmarshal(m, b)
+}
+func (m *ReconciliationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReconciliationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationStatusResponse.Merge(dst, src)
+}
+func (m *ReconciliationStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationStatusResponse.Size(m)
+}
+func (m *ReconciliationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciliationStatusResponse proto.InternalMessageInfo
+
+func (m *ReconciliationStatusResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ReconciliationStatusResponse) GetLastAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+func (m *ReconciliationStatusResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReconciliationStatusResponse) GetMissingPvtData() []*MissingPvtDataStatus {
+	if m != nil {
+		return m.MissingPvtData
+	}
+	return nil
+}
+
+// MissingPvtDataStatus describes the private data of a collection missing on the peer
+type MissingPvtDataStatus struct {
+	Chaincode            string               `protobuf:"bytes,1,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
+	Collection           string               `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	BlockNums            []uint64             `protobuf:"varint,3,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
+	LastAttempt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MissingPvtDataStatus) Reset()         { *m = MissingPvtDataStatus{} }
+func (m *MissingPvtDataStatus) String() string { return proto.CompactTextString(m) }
+func (*MissingPvtDataStatus) ProtoMessage()    {}
+func (*MissingPvtDataStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{11}
+}
+func (m *MissingPvtDataStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissingPvtDataStatus.Unmarshal(m, b)
+}
+func (m *MissingPvtDataStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissingPvtDataStatus.Marshal(b, m, deterministic)
+}
+func (dst *MissingPvtDataStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingPvtDataStatus.Merge(dst, src)
+}
+func (m *MissingPvtDataStatus) XXX_Size() int {
+	return xxx_messageInfo_MissingPvtDataStatus.Size(m)
+}
+func (m *MissingPvtDataStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingPvtDataStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingPvtDataStatus proto.InternalMessageInfo
+
+func (m *MissingPvtDataStatus) GetChaincode() string {
+	if m != nil {
+		return m.Chaincode
+	}
+	return ""
+}
+
+func (m *MissingPvtDataStatus) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *MissingPvtDataStatus) GetBlockNums() []uint64 {
+	if m != nil {
+		return m.BlockNums
+	}
+	return nil
+}
+
+func (m *MissingPvtDataStatus) GetLastAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+func (m *MissingPvtDataStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// ReconcileRequest requests an immediate reconciliation of the private data missing
+// on the peer for the blocks of a channel in the range [start_block, end_block]
+type ReconcileRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	StartBlock           uint64   `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock             uint64   `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileRequest) Reset()         { *m = ReconcileRequest{} }
+func (m *ReconcileRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileRequest) ProtoMessage()    {}
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{12}
+}
+func (m *ReconcileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileRequest.Unmarshal(m, b)
+}
+func (m *ReconcileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReconcileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileRequest.Merge(dst, src)
+}
+func (m *ReconcileRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconcileRequest.Size(m)
+}
+func (m *ReconcileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileRequest proto.InternalMessageInfo
+
+func (m *ReconcileRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ReconcileRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *ReconcileRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+type ReconcileResponse struct {
+	Reconciled           uint64   `protobuf:"varint,1,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileResponse) Reset()         { *m = ReconcileResponse{} }
+func (m *ReconcileResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileResponse) ProtoMessage()    {}
+func (*ReconcileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{13}
+}
+func (m *ReconcileResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileResponse.Unmarshal(m, b)
+}
+func (m *ReconcileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReconcileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileResponse.Merge(dst, src)
+}
+func (m *ReconcileResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconcileResponse.Size(m)
+}
+func (m *ReconcileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileResponse proto.InternalMessageInfo
+
+func (m *ReconcileResponse) GetReconciled() uint64 {
+	if m != nil {
+		return m.Reconciled
+	}
+	return 0
+}
+
+// GossipViewRequest requests the membership and the channels as seen by the
+// gossip service of the peer, limited to a channel if channel_id is not empty
+type GossipViewRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipViewRequest) Reset()         { *m = GossipViewRequest{} }
+func (m *GossipViewRequest) String() string { return proto.CompactTextString(m) }
+func (*GossipViewRequest) ProtoMessage()    {}
+func (*GossipViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{14}
+}
+func (m *GossipViewRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipViewRequest.Unmarshal(m, b)
+}
+func (m *GossipViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipViewRequest.Marshal(b, m, deterministic)
+}
+func (dst *GossipViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipViewRequest.Merge(dst, src)
+}
+func (m *GossipViewRequest) XXX_Size() int {
+	return xxx_messageInfo_GossipViewRequest.Size(m)
+}
+func (m *GossipViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipViewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipViewRequest proto.InternalMessageInfo
+
+func (m *GossipViewRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type GossipViewResponse struct {
+	Self                 *GossipMember        `protobuf:"bytes,1,opt,name=self,proto3" json:"self,omitempty"`
+	Alive                []*GossipMember      `protobuf:"bytes,2,rep,name=alive,proto3" json:"alive,omitempty"`
+	Dead                 []*GossipMember      `protobuf:"bytes,3,rep,name=dead,proto3" json:"dead,omitempty"`
+	Channels             []*GossipChannelView `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GossipViewResponse) Reset()         { *m = GossipViewResponse{} }
+func (m *GossipViewResponse) String() string { return proto.CompactTextString(m) }
+func (*GossipViewResponse) ProtoMessage()    {}
+func (*GossipViewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{15}
+}
+func (m *GossipViewResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipViewResponse.Unmarshal(m, b)
+}
+func (m *GossipViewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipViewResponse.Marshal(b, m, deterministic)
+}
+func (dst *GossipViewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipViewResponse.Merge(dst, src)
+}
+func (m *GossipViewResponse) XXX_Size() int {
+	return xxx_messageInfo_GossipViewResponse.Size(m)
+}
+func (m *GossipViewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipViewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipViewResponse proto.InternalMessageInfo
+
+func (m *GossipViewResponse) GetSelf() *GossipMember {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+func (m *GossipViewResponse) GetAlive() []*GossipMember {
+	if m != nil {
+		return m.Alive
+	}
+	return nil
+}
+
+func (m *GossipViewResponse) GetDead() []*GossipMember {
+	if m != nil {
+		return m.Dead
+	}
+	return nil
+}
+
+func (m *GossipViewResponse) GetChannels() []*GossipChannelView {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// GossipMember describes a member of the gossip network. The ledger height,
+// chaincodes and left_channel are set only for the members of a channel
+type GossipMember struct {
+	PkiId                []byte             `protobuf:"bytes,1,opt,name=pki_id,json=pkiId,proto3" json:"pki_id,omitempty"`
+	Endpoint             string             `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	InternalEndpoint     string             `protobuf:"bytes,3,opt,name=internal_endpoint,json=internalEndpoint,proto3" json:"internal_endpoint,omitempty"`
+	LedgerHeight         uint64             `protobuf:"varint,4,opt,name=ledger_height,json=ledgerHeight,proto3" json:"ledger_height,omitempty"`
+	Chaincodes           []*GossipChaincode `protobuf:"bytes,5,rep,name=chaincodes,proto3" json:"chaincodes,omitempty"`
+	LeftChannel          bool               `protobuf:"varint,6,opt,name=left_channel,json=leftChannel,proto3" json:"left_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GossipMember) Reset()         { *m = GossipMember{} }
+func (m *GossipMember) String() string { return proto.CompactTextString(m) }
+func (*GossipMember) ProtoMessage()    {}
+func (*GossipMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{16}
+}
+func (m *GossipMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipMember.Unmarshal(m, b)
+}
+func (m *GossipMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipMember.Marshal(b, m, deterministic)
+}
+func (dst *GossipMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipMember.Merge(dst, src)
+}
+func (m *GossipMember) XXX_Size() int {
+	return xxx_messageInfo_GossipMember.Size(m)
+}
+func (m *GossipMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipMember proto.InternalMessageInfo
+
+func (m *GossipMember) GetPkiId() []byte {
+	if m != nil {
+		return m.PkiId
+	}
+	return nil
+}
+
+func (m *GossipMember) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GossipMember) GetInternalEndpoint() string {
+	if m != nil {
+		return m.InternalEndpoint
+	}
+	return ""
+}
+
+func (m *GossipMember) GetLedgerHeight() uint64 {
+	if m != nil {
+		return m.LedgerHeight
+	}
+	return 0
+}
+
+func (m *GossipMember) GetChaincodes() []*GossipChaincode {
+	if m != nil {
+		return m.Chaincodes
+	}
+	return nil
+}
+
+func (m *GossipMember) GetLeftChannel() bool {
+	if m != nil {
+		return m.LeftChannel
+	}
+	return false
+}
+
+type GossipChaincode struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GossipChaincode) Reset()         { *m = GossipChaincode{} }
+func (m *GossipChaincode) String() string { return proto.CompactTextString(m) }
+func (*GossipChaincode) ProtoMessage()    {}
+func (*GossipChaincode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{17}
+}
+func (m *GossipChaincode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipChaincode.Unmarshal(m, b)
+}
+func (m *GossipChaincode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipChaincode.Marshal(b, m, deterministic)
+}
+func (dst *GossipChaincode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipChaincode.Merge(dst, src)
+}
+func (m *GossipChaincode) XXX_Size() int {
+	return xxx_messageInfo_GossipChaincode.Size(m)
+}
+func (m *GossipChaincode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipChaincode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipChaincode proto.InternalMessageInfo
+
+func (m *GossipChaincode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GossipChaincode) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// GossipChannelView describes a channel as seen by the gossip service of the peer
+type GossipChannelView struct {
+	ChannelId            string                  `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Self                 *GossipMember           `protobuf:"bytes,2,opt,name=self,proto3" json:"self,omitempty"`
+	Peers                []*GossipMember         `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	LeaderElection       bool                    `protobuf:"varint,4,opt,name=leader_election,json=leaderElection,proto3" json:"leader_election,omitempty"`
+	IsLeader             bool                    `protobuf:"varint,5,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
+	LeaderPkiId          []byte                  `protobuf:"bytes,6,opt,name=leader_pki_id,json=leaderPkiId,proto3" json:"leader_pki_id,omitempty"`
+	AnchorPeers          []*GossipOrgAnchorPeers `protobuf:"bytes,7,rep,name=anchor_peers,json=anchorPeers,proto3" json:"anchor_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GossipChannelView) Reset()         { *m = GossipChannelView{} }
+func (m *GossipChannelView) String() string { return proto.CompactTextString(m) }
+func (*GossipChannelView) ProtoMessage()    {}
+func (*GossipChannelView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{18}
+}
+func (m *GossipChannelView) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipChannelView.Unmarshal(m, b)
+}
+func (m *GossipChannelView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipChannelView.Marshal(b, m, deterministic)
+}
+func (dst *GossipChannelView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipChannelView.Merge(dst, src)
+}
+func (m *GossipChannelView) XXX_Size() int {
+	return xxx_messageInfo_GossipChannelView.Size(m)
+}
+func (m *GossipChannelView) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipChannelView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipChannelView proto.InternalMessageInfo
+
+func (m *GossipChannelView) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *GossipChannelView) GetSelf() *GossipMember {
+	if m != nil {
+		return m.Self
+	}
+	return nil
+}
+
+func (m *GossipChannelView) GetPeers() []*GossipMember {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *GossipChannelView) GetLeaderElection() bool {
+	if m != nil {
+		return m.LeaderElection
+	}
+	return false
+}
+
+func (m *GossipChannelView) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *GossipChannelView) GetLeaderPkiId() []byte {
+	if m != nil {
+		return m.LeaderPkiId
+	}
+	return nil
+}
+
+func (m *GossipChannelView) GetAnchorPeers() []*GossipOrgAnchorPeers {
+	if m != nil {
+		return m.AnchorPeers
+	}
+	return nil
+}
+
+type GossipOrgAnchorPeers struct {
+	MspId                string        `protobuf:"bytes,1,opt,name=msp_id,json=mspId,proto3" json:"msp_id,omitempty"`
+	AnchorPeers          []*AnchorPeer `protobuf:"bytes,2,rep,name=anchor_peers,json=anchorPeers,proto3" json:"anchor_peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GossipOrgAnchorPeers) Reset()         { *m = GossipOrgAnchorPeers{} }
+func (m *GossipOrgAnchorPeers) String() string { return proto.CompactTextString(m) }
+func (*GossipOrgAnchorPeers) ProtoMessage()    {}
+func (*GossipOrgAnchorPeers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{19}
+}
+func (m *GossipOrgAnchorPeers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GossipOrgAnchorPeers.Unmarshal(m, b)
+}
+func (m *GossipOrgAnchorPeers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GossipOrgAnchorPeers.Marshal(b, m, deterministic)
+}
+func (dst *GossipOrgAnchorPeers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GossipOrgAnchorPeers.Merge(dst, src)
+}
+func (m *GossipOrgAnchorPeers) XXX_Size() int {
+	return xxx_messageInfo_GossipOrgAnchorPeers.Size(m)
+}
+func (m *GossipOrgAnchorPeers) XXX_DiscardUnknown() {
+	xxx_messageInfo_GossipOrgAnchorPeers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GossipOrgAnchorPeers proto.InternalMessageInfo
+
+func (m *GossipOrgAnchorPeers) GetMspId() string {
+	if m != nil {
+		return m.MspId
+	}
+	return ""
+}
+
+func (m *GossipOrgAnchorPeers) GetAnchorPeers() []*AnchorPeer {
+	if m != nil {
+		return m.AnchorPeers
+	}
+	return nil
+}
+
 type AdminOperation struct {
 	// Types that are valid to be assigned to Content:
 	//	*AdminOperation_LogReq
 	//	*AdminOperation_LogSpecReq
+	//	*AdminOperation_TransientStoreEntriesReq
+	//	*AdminOperation_ReconciliationStatusReq
+	//	*AdminOperation_ReconcileReq
+	//	*AdminOperation_GossipViewReq
 	Content              isAdminOperation_Content `protobuf_oneof:"content"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -288,7 +1135,7 @@ func (m *AdminOperation) Reset()         { *m = AdminOperation{} }
 func (m *AdminOperation) String() string { return proto.CompactTextString(m) }
 func (*AdminOperation) ProtoMessage()    {}
 func (*AdminOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{5}
+	return fileDescriptor_admin_758d5b4df56b4b05, []int{20}
 }
 func (m *AdminOperation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AdminOperation.Unmarshal(m, b)
@@ -320,10 +1167,34 @@ type AdminOperation_LogSpecReq struct {
 	LogSpecReq *LogSpecRequest `protobuf:"bytes,2,opt,name=logSpecReq,proto3,oneof"`
 }
 
+type AdminOperation_TransientStoreEntriesReq struct {
+	TransientStoreEntriesReq *TransientStoreEntriesRequest `protobuf:"bytes,3,opt,name=transientStoreEntriesReq,proto3,oneof"`
+}
+
+type AdminOperation_ReconciliationStatusReq struct {
+	ReconciliationStatusReq *ReconciliationStatusRequest `protobuf:"bytes,4,opt,name=reconciliationStatusReq,proto3,oneof"`
+}
+
+type AdminOperation_ReconcileReq struct {
+	ReconcileReq *ReconcileRequest `protobuf:"bytes,5,opt,name=reconcileReq,proto3,oneof"`
+}
+
+type AdminOperation_GossipViewReq struct {
+	GossipViewReq *GossipViewRequest `protobuf:"bytes,6,opt,name=gossipViewReq,proto3,oneof"`
+}
+
 func (*AdminOperation_LogReq) isAdminOperation_Content() {}
 
 func (*AdminOperation_LogSpecReq) isAdminOperation_Content() {}
 
+func (*AdminOperation_TransientStoreEntriesReq) isAdminOperation_Content() {}
+
+func (*AdminOperation_ReconciliationStatusReq) isAdminOperation_Content() {}
+
+func (*AdminOperation_ReconcileReq) isAdminOperation_Content() {}
+
+func (*AdminOperation_GossipViewReq) isAdminOperation_Content() {}
+
 func (m *AdminOperation) GetContent() isAdminOperation_Content {
 	if m != nil {
 		return m.Content
@@ -345,11 +1216,43 @@ func (m *AdminOperation) GetLogSpecReq() *LogSpecRequest {
 	return nil
 }
 
+func (m *AdminOperation) GetTransientStoreEntriesReq() *TransientStoreEntriesRequest {
+	if x, ok := m.GetContent().(*AdminOperation_TransientStoreEntriesReq); ok {
+		return x.TransientStoreEntriesReq
+	}
+	return nil
+}
+
+func (m *AdminOperation) GetReconciliationStatusReq() *ReconciliationStatusRequest {
+	if x, ok := m.GetContent().(*AdminOperation_ReconciliationStatusReq); ok {
+		return x.ReconciliationStatusReq
+	}
+	return nil
+}
+
+func (m *AdminOperation) GetReconcileReq() *ReconcileRequest {
+	if x, ok := m.GetContent().(*AdminOperation_ReconcileReq); ok {
+		return x.ReconcileReq
+	}
+	return nil
+}
+
+func (m *AdminOperation) GetGossipViewReq() *GossipViewRequest {
+	if x, ok := m.GetContent().(*AdminOperation_GossipViewReq); ok {
+		return x.GossipViewReq
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*AdminOperation) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _AdminOperation_OneofMarshaler, _AdminOperation_OneofUnmarshaler, _AdminOperation_OneofSizer, []interface{}{
 		(*AdminOperation_LogReq)(nil),
 		(*AdminOperation_LogSpecReq)(nil),
+		(*AdminOperation_TransientStoreEntriesReq)(nil),
+		(*AdminOperation_ReconciliationStatusReq)(nil),
+		(*AdminOperation_ReconcileReq)(nil),
+		(*AdminOperation_GossipViewReq)(nil),
 	}
 }
 
@@ -367,6 +1270,26 @@ func _AdminOperation_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LogSpecReq); err != nil {
 			return err
 		}
+	case *AdminOperation_TransientStoreEntriesReq:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TransientStoreEntriesReq); err != nil {
+			return err
+		}
+	case *AdminOperation_ReconciliationStatusReq:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ReconciliationStatusReq); err != nil {
+			return err
+		}
+	case *AdminOperation_ReconcileReq:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ReconcileReq); err != nil {
+			return err
+		}
+	case *AdminOperation_GossipViewReq:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GossipViewReq); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("AdminOperation.Content has unexpected type %T", x)
@@ -393,6 +1316,38 @@ func _AdminOperation_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Content = &AdminOperation_LogSpecReq{msg}
 		return true, err
+	case 3: // content.transientStoreEntriesReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TransientStoreEntriesRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_TransientStoreEntriesReq{msg}
+		return true, err
+	case 4: // content.reconciliationStatusReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReconciliationStatusRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_ReconciliationStatusReq{msg}
+		return true, err
+	case 5: // content.reconcileReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReconcileRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_ReconcileReq{msg}
+		return true, err
+	case 6: // content.gossipViewReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GossipViewRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_GossipViewReq{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -412,6 +1367,26 @@ func _AdminOperation_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *AdminOperation_TransientStoreEntriesReq:
+		s := proto.Size(x.TransientStoreEntriesReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AdminOperation_ReconciliationStatusReq:
+		s := proto.Size(x.ReconciliationStatusReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AdminOperation_ReconcileReq:
+		s := proto.Size(x.ReconcileReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AdminOperation_GossipViewReq:
+		s := proto.Size(x.GossipViewReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -425,6 +1400,21 @@ func init() {
 	proto.RegisterType((*LogLevelResponse)(nil), "protos.LogLevelResponse")
 	proto.RegisterType((*LogSpecRequest)(nil), "protos.LogSpecRequest")
 	proto.RegisterType((*LogSpecResponse)(nil), "protos.LogSpecResponse")
+	proto.RegisterType((*TransientStoreEntriesRequest)(nil), "protos.TransientStoreEntriesRequest")
+	proto.RegisterType((*TransientStoreEntriesResponse)(nil), "protos.TransientStoreEntriesResponse")
+	proto.RegisterType((*ChannelTransientStoreEntries)(nil), "protos.ChannelTransientStoreEntries")
+	proto.RegisterType((*TransientStoreEntry)(nil), "protos.TransientStoreEntry")
+	proto.RegisterType((*ReconciliationStatusRequest)(nil), "protos.ReconciliationStatusRequest")
+	proto.RegisterType((*ReconciliationStatusResponse)(nil), "protos.ReconciliationStatusResponse")
+	proto.RegisterType((*MissingPvtDataStatus)(nil), "protos.MissingPvtDataStatus")
+	proto.RegisterType((*ReconcileRequest)(nil), "protos.ReconcileRequest")
+	proto.RegisterType((*ReconcileResponse)(nil), "protos.ReconcileResponse")
+	proto.RegisterType((*GossipViewRequest)(nil), "protos.GossipViewRequest")
+	proto.RegisterType((*GossipViewResponse)(nil), "protos.GossipViewResponse")
+	proto.RegisterType((*GossipMember)(nil), "protos.GossipMember")
+	proto.RegisterType((*GossipChaincode)(nil), "protos.GossipChaincode")
+	proto.RegisterType((*GossipChannelView)(nil), "protos.GossipChannelView")
+	proto.RegisterType((*GossipOrgAnchorPeers)(nil), "protos.GossipOrgAnchorPeers")
 	proto.RegisterType((*AdminOperation)(nil), "protos.AdminOperation")
 	proto.RegisterEnum("protos.ServerStatus_StatusCode", ServerStatus_StatusCode_name, ServerStatus_StatusCode_value)
 }
@@ -448,6 +1438,10 @@ type AdminClient interface {
 	RevertLogLevels(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetLogSpec(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogSpecResponse, error)
 	SetLogSpec(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogSpecResponse, error)
+	GetTransientStoreEntries(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*TransientStoreEntriesResponse, error)
+	GetReconciliationStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ReconciliationStatusResponse, error)
+	Reconcile(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	GetGossipView(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*GossipViewResponse, error)
 }
 
 type adminClient struct {
@@ -521,6 +1515,42 @@ func (c *adminClient) SetLogSpec(ctx context.Context, in *common.Envelope, opts
 	return out, nil
 }
 
+func (c *adminClient) GetTransientStoreEntries(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*TransientStoreEntriesResponse, error) {
+	out := new(TransientStoreEntriesResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/GetTransientStoreEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetReconciliationStatus(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ReconciliationStatusResponse, error) {
+	out := new(ReconciliationStatusResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/GetReconciliationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Reconcile(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetGossipView(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*GossipViewResponse, error) {
+	out := new(GossipViewResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/GetGossipView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	GetStatus(context.Context, *common.Envelope) (*ServerStatus, error)
@@ -530,6 +1560,10 @@ type AdminServer interface {
 	RevertLogLevels(context.Context, *common.Envelope) (*empty.Empty, error)
 	GetLogSpec(context.Context, *common.Envelope) (*LogSpecResponse, error)
 	SetLogSpec(context.Context, *common.Envelope) (*LogSpecResponse, error)
+	GetTransientStoreEntries(context.Context, *common.Envelope) (*TransientStoreEntriesResponse, error)
+	GetReconciliationStatus(context.Context, *common.Envelope) (*ReconciliationStatusResponse, error)
+	Reconcile(context.Context, *common.Envelope) (*ReconcileResponse, error)
+	GetGossipView(context.Context, *common.Envelope) (*GossipViewResponse, error)
 }
 
 func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
@@ -662,6 +1696,78 @@ func _Admin_SetLogSpec_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_GetTransientStoreEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetTransientStoreEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetTransientStoreEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetTransientStoreEntries(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetReconciliationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetReconciliationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetReconciliationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetReconciliationStatus(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Reconcile(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetGossipView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetGossipView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/GetGossipView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetGossipView(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -694,48 +1800,122 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetLogSpec",
 			Handler:    _Admin_SetLogSpec_Handler,
 		},
+		{
+			MethodName: "GetTransientStoreEntries",
+			Handler:    _Admin_GetTransientStoreEntries_Handler,
+		},
+		{
+			MethodName: "GetReconciliationStatus",
+			Handler:    _Admin_GetReconciliationStatus_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _Admin_Reconcile_Handler,
+		},
+		{
+			MethodName: "GetGossipView",
+			Handler:    _Admin_GetGossipView_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/admin.proto",
 }
 
-func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor_admin_b2904393863b6bc5) }
-
-var fileDescriptor_admin_b2904393863b6bc5 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0x37, 0xda, 0x92, 0xd3, 0xd1, 0x05, 0x33, 0x6d, 0xa5, 0x13, 0x02, 0xe5, 0x0a, 0x84,
-	0x94, 0x88, 0x22, 0x34, 0x76, 0xc1, 0x45, 0x4b, 0x43, 0x87, 0xe8, 0xd2, 0x2a, 0x59, 0x85, 0x40,
-	0x42, 0x55, 0x9a, 0x9e, 0x79, 0x15, 0x6e, 0x9c, 0x39, 0x6e, 0xa5, 0xbd, 0x01, 0xcf, 0xc1, 0x23,
-	0xf0, 0x84, 0x28, 0x76, 0xc2, 0x2a, 0x56, 0x2e, 0xd8, 0xae, 0xec, 0x73, 0xfc, 0x7d, 0xdf, 0xf9,
-	0xb3, 0x0d, 0x66, 0x82, 0x28, 0x9c, 0x70, 0xb6, 0x98, 0xc7, 0x76, 0x22, 0xb8, 0xe4, 0xa4, 0xaa,
-	0x96, 0xb4, 0x75, 0x48, 0x39, 0xa7, 0x0c, 0x1d, 0x65, 0x4e, 0x97, 0xe7, 0x0e, 0x2e, 0x12, 0x79,
-	0xa5, 0x41, 0xad, 0x47, 0x11, 0x5f, 0x2c, 0x78, 0xec, 0xe8, 0x45, 0x3b, 0xad, 0x9f, 0x65, 0xd8,
-	0x09, 0x50, 0xac, 0x50, 0x04, 0x32, 0x94, 0xcb, 0x94, 0x1c, 0x41, 0x35, 0x55, 0xbb, 0x66, 0xf9,
-	0x59, 0xf9, 0x79, 0xa3, 0xfd, 0x54, 0x03, 0x53, 0x7b, 0x1d, 0x65, 0xeb, 0xe5, 0x3d, 0x9f, 0xa1,
-	0x9f, 0xc3, 0xad, 0x2f, 0x00, 0xd7, 0x5e, 0xf2, 0x00, 0x8c, 0xb1, 0xd7, 0x73, 0x3f, 0x7c, 0xf4,
-	0xdc, 0x9e, 0x59, 0x22, 0x75, 0xa8, 0x05, 0x67, 0x1d, 0xff, 0xcc, 0xed, 0x99, 0x65, 0x6d, 0x0c,
-	0x47, 0x23, 0xb7, 0x67, 0x6e, 0x11, 0x80, 0xea, 0xa8, 0x33, 0x0e, 0xdc, 0x9e, 0xb9, 0x4d, 0x0c,
-	0xa8, 0xb8, 0xbe, 0x3f, 0xf4, 0xcd, 0x7b, 0x19, 0x66, 0xec, 0x7d, 0xf2, 0x86, 0x9f, 0x3d, 0xb3,
-	0x62, 0x9d, 0xc2, 0xee, 0x80, 0xd3, 0x01, 0xae, 0x90, 0xf9, 0x78, 0xb9, 0xc4, 0x54, 0x92, 0x27,
-	0x00, 0x8c, 0xd3, 0xc9, 0x82, 0xcf, 0x96, 0x0c, 0x55, 0xaa, 0x86, 0x6f, 0x30, 0x4e, 0x4f, 0x95,
-	0x83, 0x1c, 0x42, 0x66, 0x4c, 0x58, 0x46, 0x69, 0x6e, 0xa9, 0xd3, 0xfb, 0x2c, 0x97, 0xb0, 0x3c,
-	0x30, 0xaf, 0xe5, 0xd2, 0x84, 0xc7, 0x29, 0xde, 0x49, 0xef, 0x25, 0x34, 0x06, 0x9c, 0x06, 0x09,
-	0x46, 0x45, 0x76, 0x8f, 0x21, 0x3b, 0x9d, 0xa4, 0x09, 0x46, 0xb9, 0x56, 0x8d, 0x69, 0x84, 0xd5,
-	0x55, 0xb5, 0x68, 0x70, 0x1e, 0xfb, 0xdf, 0x68, 0xb2, 0x07, 0x15, 0x14, 0x82, 0x8b, 0x3c, 0xa6,
-	0x36, 0xac, 0x1f, 0x65, 0x68, 0x74, 0xb2, 0xf1, 0x0f, 0x13, 0x14, 0xa1, 0x9c, 0xf3, 0x98, 0xbc,
-	0x82, 0x2a, 0xe3, 0xd4, 0xc7, 0x4b, 0xa5, 0x50, 0x6f, 0x1f, 0x14, 0x63, 0xfb, 0xab, 0x71, 0x27,
-	0x25, 0x3f, 0x07, 0x92, 0xb7, 0xaa, 0xe4, 0x3c, 0x6d, 0x15, 0xa0, 0xde, 0xde, 0x5f, 0xa3, 0xad,
-	0x15, 0x74, 0x52, 0xf2, 0xd7, 0xb0, 0x5d, 0x03, 0x6a, 0x11, 0x8f, 0x25, 0xc6, 0xb2, 0xfd, 0x6b,
-	0x1b, 0x2a, 0x2a, 0x15, 0xf2, 0x06, 0x8c, 0x3e, 0xca, 0xfc, 0x16, 0x99, 0x76, 0x7e, 0xcb, 0xdc,
-	0x78, 0x85, 0x8c, 0x27, 0xd8, 0xda, 0xdb, 0x74, 0x8f, 0xac, 0x12, 0x39, 0x82, 0x7a, 0x20, 0x43,
-	0x21, 0xb5, 0xfb, 0x3f, 0x88, 0x1d, 0x78, 0xd8, 0x47, 0xa9, 0xe7, 0x53, 0x14, 0xb9, 0x81, 0xde,
-	0xbc, 0xd9, 0x08, 0xdd, 0x76, 0x2d, 0x11, 0xdc, 0x51, 0xe2, 0x1d, 0xec, 0xfa, 0xb8, 0x42, 0x21,
-	0x8b, 0xb3, 0x4d, 0xb5, 0xef, 0xdb, 0xfa, 0x5d, 0xda, 0xc5, 0xbb, 0xb4, 0xdd, 0xec, 0x5d, 0x5a,
-	0x25, 0x72, 0x0c, 0xd0, 0x47, 0x99, 0x37, 0x7b, 0x03, 0xf3, 0xe0, 0xc6, 0x3c, 0xfe, 0x44, 0x3e,
-	0x06, 0x08, 0x6e, 0x47, 0xed, 0x7e, 0x03, 0x8b, 0x0b, 0x6a, 0x5f, 0x5c, 0x25, 0x28, 0x18, 0xce,
-	0x28, 0x0a, 0xfb, 0x3c, 0x9c, 0x8a, 0x79, 0x54, 0x50, 0xb2, 0x0f, 0xa6, 0xbb, 0xa3, 0xe6, 0x3a,
-	0x0a, 0xa3, 0xef, 0x21, 0xc5, 0xaf, 0x2f, 0xe8, 0x5c, 0x5e, 0x2c, 0xa7, 0x59, 0x18, 0x67, 0x8d,
-	0xe8, 0x68, 0xa2, 0xfe, 0x71, 0x52, 0x27, 0x23, 0x4e, 0xf5, 0x6f, 0xf4, 0xfa, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0x77, 0x16, 0x8f, 0xa8, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor_admin_758d5b4df56b4b05) }
+
+var fileDescriptor_admin_758d5b4df56b4b05 = []byte{
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdb, 0x52, 0x1b, 0x47,
+	0x13, 0x96, 0xd0, 0x01, 0xd4, 0x12, 0x20, 0xc6, 0xd8, 0xac, 0x01, 0xdb, 0xfc, 0x6b, 0xff, 0x15,
+	0x12, 0x57, 0x89, 0x0a, 0x2e, 0x17, 0x71, 0x12, 0xc7, 0x11, 0x46, 0x06, 0x2a, 0x9c, 0x6a, 0x05,
+	0x49, 0x25, 0x55, 0xc9, 0xd6, 0xb2, 0xdb, 0x2c, 0x5b, 0xec, 0xc9, 0x3b, 0x23, 0x05, 0xfb, 0x71,
+	0xf2, 0x0a, 0x49, 0xe5, 0x25, 0x72, 0x93, 0x5c, 0xe7, 0x32, 0x0f, 0x90, 0x57, 0x48, 0xed, 0x1c,
+	0xd0, 0x0a, 0x2d, 0x98, 0xc4, 0x57, 0xd2, 0x74, 0x7f, 0xfd, 0xcd, 0xf4, 0xcc, 0xd7, 0x3d, 0xb3,
+	0xd0, 0x8c, 0x11, 0x93, 0x15, 0xcb, 0x09, 0xbc, 0xb0, 0x15, 0x27, 0x11, 0x8b, 0x48, 0x95, 0xff,
+	0xd0, 0xf9, 0x05, 0x37, 0x8a, 0x5c, 0x1f, 0x57, 0xf8, 0xf0, 0xb8, 0x77, 0xb2, 0x82, 0x41, 0xcc,
+	0xde, 0x08, 0xd0, 0xfc, 0x83, 0xcb, 0x4e, 0xe6, 0x05, 0x48, 0x99, 0x15, 0xc4, 0x12, 0x70, 0xcb,
+	0x8e, 0x82, 0x20, 0x0a, 0x57, 0xc4, 0x8f, 0x34, 0x6a, 0x7c, 0x32, 0x3b, 0x0a, 0x4f, 0x3c, 0xb7,
+	0x97, 0x58, 0xcc, 0x53, 0x1e, 0xfd, 0xa7, 0x22, 0x34, 0xba, 0x98, 0xf4, 0x31, 0xe9, 0x32, 0x8b,
+	0xf5, 0x28, 0x59, 0x83, 0x2a, 0xe5, 0xff, 0xb4, 0xe2, 0x52, 0x71, 0x79, 0x6a, 0xf5, 0x81, 0x00,
+	0xd2, 0x56, 0x16, 0xd5, 0x12, 0x3f, 0x2f, 0x23, 0x07, 0x0d, 0x09, 0xd7, 0xbf, 0x05, 0x18, 0x58,
+	0xc9, 0x24, 0xd4, 0x8e, 0xf6, 0x36, 0x3a, 0xaf, 0xb6, 0xf7, 0x3a, 0x1b, 0xcd, 0x02, 0xa9, 0xc3,
+	0x78, 0xf7, 0xb0, 0x6d, 0x1c, 0x76, 0x36, 0x9a, 0x45, 0x31, 0xd8, 0x3f, 0x38, 0xe8, 0x6c, 0x34,
+	0xc7, 0x08, 0x40, 0xf5, 0xa0, 0x7d, 0xd4, 0xed, 0x6c, 0x34, 0x4b, 0xa4, 0x06, 0x95, 0x8e, 0x61,
+	0xec, 0x1b, 0xcd, 0x72, 0x8a, 0x39, 0xda, 0xfb, 0x6a, 0x6f, 0xff, 0x9b, 0xbd, 0x66, 0x45, 0xdf,
+	0x85, 0xe9, 0x9d, 0xc8, 0xdd, 0xc1, 0x3e, 0xfa, 0x06, 0xbe, 0xee, 0x21, 0x65, 0xe4, 0x1e, 0x80,
+	0x1f, 0xb9, 0x66, 0x10, 0x39, 0x3d, 0x1f, 0xf9, 0x52, 0x6b, 0x46, 0xcd, 0x8f, 0xdc, 0x5d, 0x6e,
+	0x20, 0x0b, 0x90, 0x0e, 0x4c, 0x3f, 0x0d, 0xd1, 0xc6, 0xb8, 0x77, 0xc2, 0x97, 0x14, 0xfa, 0x1e,
+	0x34, 0x07, 0x74, 0x34, 0x8e, 0x42, 0x8a, 0xef, 0xc5, 0xf7, 0x18, 0xa6, 0x76, 0x22, 0xb7, 0x1b,
+	0xa3, 0xad, 0x56, 0x77, 0x17, 0x52, 0xaf, 0x49, 0x63, 0xb4, 0x25, 0xd7, 0xb8, 0x2f, 0x10, 0xfa,
+	0x3a, 0xcf, 0x45, 0x80, 0xe5, 0xdc, 0x57, 0xa3, 0xc9, 0x2c, 0x54, 0x30, 0x49, 0xa2, 0x44, 0xce,
+	0x29, 0x06, 0xfa, 0x0f, 0xb0, 0x78, 0x98, 0x58, 0x21, 0xf5, 0x30, 0x64, 0x5d, 0x16, 0x25, 0xd8,
+	0x09, 0x59, 0xe2, 0x21, 0xcd, 0x6c, 0x8e, 0x7d, 0x6a, 0x85, 0x21, 0xfa, 0xa6, 0xe7, 0xa8, 0x64,
+	0xa4, 0x65, 0xdb, 0x21, 0x0f, 0xa0, 0x1e, 0x58, 0xe7, 0x26, 0x8a, 0x20, 0x4e, 0x3d, 0x69, 0x40,
+	0x60, 0x9d, 0x4b, 0x1a, 0xdd, 0x82, 0x7b, 0x57, 0xf0, 0xcb, 0x15, 0x7f, 0x09, 0x13, 0x92, 0x2e,
+	0x95, 0x49, 0x69, 0xb9, 0xbe, 0xfa, 0x48, 0xc9, 0xe4, 0xa5, 0xb0, 0xe7, 0xc7, 0x5f, 0x44, 0xe9,
+	0xbf, 0x16, 0x61, 0xf1, 0x3a, 0xe8, 0xbb, 0x72, 0x78, 0x08, 0x93, 0x2c, 0x62, 0x96, 0x3f, 0x94,
+	0x45, 0xd9, 0x68, 0x70, 0x63, 0x86, 0x43, 0x80, 0xa8, 0xf7, 0x16, 0xb5, 0x12, 0x47, 0xd4, 0xb8,
+	0xa5, 0xeb, 0xbd, 0x45, 0xf2, 0x04, 0xaa, 0x91, 0xef, 0x20, 0x65, 0x5a, 0x99, 0xe7, 0xb0, 0xa0,
+	0x72, 0x18, 0x5d, 0xd1, 0x1b, 0x43, 0x42, 0xf5, 0x5f, 0x8a, 0x70, 0x2b, 0xc7, 0x4f, 0x6e, 0x41,
+	0x85, 0x9d, 0x0f, 0x96, 0x5a, 0x66, 0xe7, 0xdb, 0x0e, 0xf9, 0x0c, 0xea, 0x09, 0xda, 0xe8, 0xf5,
+	0xd1, 0x31, 0x2d, 0xc6, 0xd7, 0x58, 0x5f, 0x9d, 0x6f, 0x89, 0x1a, 0x6e, 0xa9, 0x1a, 0x6e, 0x1d,
+	0xaa, 0x1a, 0x36, 0x40, 0xc1, 0xdb, 0x8c, 0xac, 0x81, 0x96, 0x09, 0x36, 0x8f, 0xfd, 0xc8, 0x3e,
+	0x33, 0x4f, 0xd1, 0x73, 0x4f, 0x99, 0xcc, 0xe5, 0xf6, 0x00, 0xbd, 0x9e, 0x7a, 0xb7, 0xb8, 0x93,
+	0x10, 0x28, 0xf3, 0x84, 0xcb, 0x1c, 0xc4, 0xff, 0xeb, 0x9f, 0xc3, 0x82, 0x81, 0x76, 0x14, 0xda,
+	0x9e, 0xef, 0xf1, 0xfa, 0x17, 0xb5, 0x7a, 0x33, 0xc5, 0xe8, 0x7f, 0x15, 0x61, 0x31, 0x3f, 0x7c,
+	0x50, 0x3e, 0xd7, 0x9d, 0xd6, 0x73, 0x68, 0xf8, 0x16, 0x65, 0xa6, 0xc5, 0x58, 0xda, 0xcc, 0x6e,
+	0xb0, 0x11, 0xf5, 0x14, 0xdf, 0x16, 0x70, 0x5e, 0x9c, 0x69, 0xb8, 0x28, 0x85, 0x92, 0x2c, 0x4e,
+	0x8b, 0xb2, 0x4e, 0x6a, 0x20, 0xaf, 0xa0, 0x19, 0x78, 0x94, 0x7a, 0xa1, 0x6b, 0xc6, 0x7d, 0x66,
+	0x3a, 0x16, 0xb3, 0xe4, 0x89, 0x2e, 0xaa, 0x13, 0xdd, 0x15, 0xfe, 0x83, 0x3e, 0xdb, 0xb0, 0x98,
+	0x25, 0x17, 0x3f, 0x15, 0x0c, 0x59, 0xf5, 0x3f, 0x8a, 0x30, 0x9b, 0x07, 0x24, 0x8b, 0x90, 0xe6,
+	0xe2, 0x85, 0x76, 0xe4, 0x60, 0x26, 0x39, 0x61, 0x20, 0xf7, 0x01, 0xec, 0xc8, 0xf7, 0xd1, 0x4e,
+	0xf7, 0x45, 0x16, 0x6a, 0xc6, 0x92, 0xae, 0x5e, 0x9c, 0x5d, 0xd8, 0x0b, 0xa8, 0x56, 0x5a, 0x2a,
+	0xa5, 0x2a, 0xe4, 0x96, 0xbd, 0x5e, 0x40, 0x47, 0xf6, 0xa6, 0xfc, 0x3e, 0x7b, 0x53, 0xb9, 0xb4,
+	0x37, 0x7a, 0x04, 0x4d, 0x75, 0x70, 0x78, 0xf3, 0xf6, 0x40, 0x99, 0x95, 0x48, 0xc5, 0xc9, 0xc2,
+	0x02, 0x6e, 0xe2, 0x2a, 0x4b, 0x9b, 0x21, 0x86, 0x8e, 0x74, 0x0b, 0x25, 0x4e, 0x60, 0xe8, 0x70,
+	0xa7, 0xfe, 0x04, 0x66, 0x32, 0x13, 0x4a, 0x79, 0xdc, 0x07, 0x48, 0x94, 0x51, 0xcc, 0x58, 0x36,
+	0x32, 0x16, 0x7d, 0x15, 0x66, 0x36, 0x23, 0x4a, 0xbd, 0xf8, 0x6b, 0x0f, 0x7f, 0xbc, 0xa1, 0x26,
+	0x7f, 0x2b, 0x02, 0xc9, 0x06, 0xc9, 0xa9, 0x96, 0xa1, 0x4c, 0xd1, 0x3f, 0xe1, 0xf8, 0xfa, 0xea,
+	0xac, 0x12, 0x80, 0x40, 0xee, 0x62, 0x70, 0x8c, 0x89, 0xc1, 0x11, 0xe4, 0x23, 0xa8, 0x58, 0xbe,
+	0xd7, 0x47, 0x6d, 0x6c, 0xa9, 0x74, 0x25, 0x54, 0x40, 0x52, 0x56, 0x07, 0x2d, 0x47, 0x2b, 0x5d,
+	0x03, 0xe5, 0x08, 0xf2, 0x34, 0xd3, 0x1a, 0x85, 0x08, 0xef, 0x0e, 0xa3, 0x65, 0xd7, 0xe3, 0x8b,
+	0x1e, 0xf4, 0xc3, 0xbf, 0x8b, 0xd0, 0xc8, 0xb2, 0x91, 0xdb, 0x50, 0x8d, 0xcf, 0x3c, 0x95, 0x79,
+	0xc3, 0xa8, 0xc4, 0x67, 0xde, 0xb6, 0x43, 0xe6, 0x21, 0xdd, 0xea, 0x38, 0xf2, 0x42, 0xa6, 0xee,
+	0x21, 0x35, 0x26, 0x8f, 0x61, 0xc6, 0x0b, 0x19, 0x26, 0x21, 0x6f, 0x8b, 0x12, 0x24, 0xaa, 0xa5,
+	0xa9, 0x1c, 0x1d, 0x05, 0x7e, 0x08, 0x93, 0x3e, 0x3a, 0x2e, 0x26, 0xaa, 0xa5, 0x88, 0x6e, 0xd1,
+	0x10, 0x46, 0xd9, 0x49, 0xd6, 0x00, 0x2e, 0x74, 0x4e, 0xb5, 0x0a, 0x4f, 0x67, 0x6e, 0x24, 0x1d,
+	0xe1, 0x37, 0x32, 0x50, 0xf2, 0x3f, 0x68, 0xf8, 0x78, 0xc2, 0x4c, 0x99, 0x9f, 0x56, 0x5d, 0x2a,
+	0x2e, 0x4f, 0x18, 0xf5, 0xd4, 0x26, 0xf3, 0xd7, 0x5f, 0xc0, 0xf4, 0x25, 0x86, 0xb4, 0x71, 0x85,
+	0x56, 0xa0, 0x4a, 0x8c, 0xff, 0x27, 0x1a, 0x8c, 0xf7, 0x31, 0xa1, 0x83, 0xd2, 0x52, 0x43, 0xfd,
+	0xe7, 0x31, 0xa5, 0x9a, 0xcc, 0x96, 0xbe, 0x4b, 0xdc, 0x4a, 0x1e, 0x63, 0x37, 0x91, 0x47, 0x8c,
+	0x98, 0xd0, 0x6b, 0xcf, 0x5c, 0x40, 0xc8, 0x07, 0x30, 0xed, 0xa3, 0xe5, 0x60, 0x62, 0xa2, 0xea,
+	0x03, 0x65, 0x9e, 0xf1, 0x94, 0x30, 0x77, 0xa4, 0x35, 0x2d, 0x1d, 0x8f, 0x9a, 0xc2, 0xc8, 0x8b,
+	0x75, 0xc2, 0x98, 0xf0, 0xe8, 0x0e, 0x1f, 0x13, 0x3d, 0x3d, 0x12, 0xce, 0x22, 0x4f, 0xbe, 0xca,
+	0x4f, 0xbe, 0x2e, 0x8c, 0x07, 0xfc, 0xfc, 0x5f, 0x40, 0xc3, 0x0a, 0xed, 0xd3, 0x28, 0x31, 0xc5,
+	0xe2, 0xc6, 0x87, 0xfb, 0x9c, 0x58, 0xdc, 0x7e, 0xe2, 0xb6, 0x39, 0xe8, 0x20, 0xc5, 0x18, 0x75,
+	0x6b, 0x30, 0xd0, 0x1d, 0x98, 0xcd, 0x03, 0xa5, 0x7a, 0x0b, 0x68, 0x3c, 0xd8, 0xb3, 0x4a, 0x40,
+	0xe3, 0xed, 0x54, 0xce, 0xc3, 0xf3, 0x89, 0x5a, 0x21, 0x6a, 0xbe, 0x01, 0xc3, 0xf0, 0x2c, 0xbf,
+	0x97, 0x60, 0xaa, 0x9d, 0xbe, 0x6d, 0xf7, 0x63, 0x14, 0xef, 0x4d, 0xf2, 0x31, 0x54, 0xfd, 0xc8,
+	0x35, 0xf0, 0xb5, 0x2c, 0xcd, 0x0b, 0x1d, 0x5d, 0x7a, 0xda, 0x6d, 0x15, 0x0c, 0x09, 0x24, 0x9f,
+	0x00, 0xc8, 0x87, 0x50, 0x1a, 0x26, 0x8e, 0xec, 0x4e, 0x26, 0x2c, 0xf3, 0xe4, 0xda, 0x2a, 0x18,
+	0x19, 0x2c, 0x39, 0x06, 0x8d, 0x5d, 0xf1, 0x42, 0xe2, 0x15, 0x91, 0x79, 0xb0, 0x5c, 0xf7, 0x92,
+	0xda, 0x2a, 0x18, 0x57, 0xf2, 0x10, 0x13, 0xe6, 0x92, 0xfc, 0x2b, 0x55, 0xf6, 0xf0, 0x87, 0x6a,
+	0x8a, 0x6b, 0x6e, 0xde, 0xad, 0x82, 0x71, 0x15, 0x0b, 0xf9, 0x02, 0x1a, 0x49, 0xa6, 0x77, 0x73,
+	0xbd, 0xd4, 0x57, 0xb5, 0xcb, 0xac, 0x38, 0xa0, 0x1a, 0xc2, 0x93, 0x36, 0x4c, 0xba, 0xd9, 0xae,
+	0xca, 0xf5, 0x34, 0xd2, 0x8f, 0x32, 0x2d, 0x77, 0xab, 0x60, 0x0c, 0x47, 0xac, 0xd7, 0x60, 0xdc,
+	0x8e, 0x42, 0x86, 0x21, 0x5b, 0xfd, 0xb3, 0x02, 0x15, 0x7e, 0xa4, 0xe4, 0x29, 0xd4, 0x36, 0x91,
+	0xc9, 0xbb, 0xb1, 0xd9, 0x92, 0x5f, 0x1a, 0x9d, 0xb0, 0x8f, 0x7e, 0x14, 0xe3, 0xfc, 0x6c, 0xde,
+	0x17, 0x83, 0x5e, 0x20, 0x6b, 0x50, 0xef, 0xa6, 0x97, 0x88, 0x30, 0xff, 0x8b, 0xc0, 0x36, 0xcc,
+	0x6c, 0x22, 0x13, 0x2f, 0x71, 0x25, 0x96, 0x9c, 0x70, 0x6d, 0x54, 0x50, 0xe2, 0x4e, 0x10, 0x14,
+	0xdd, 0xf7, 0xa4, 0x78, 0x0e, 0xd3, 0x06, 0xf6, 0x31, 0x61, 0xca, 0x97, 0x97, 0xfb, 0x9d, 0x91,
+	0x6b, 0xbb, 0x93, 0x7e, 0xbc, 0xe9, 0x05, 0xf2, 0x0c, 0x60, 0x13, 0x99, 0x14, 0x6d, 0x4e, 0xe4,
+	0xdc, 0x88, 0xae, 0x2f, 0x66, 0x7e, 0x06, 0xd0, 0xfd, 0x8f, 0xa1, 0x47, 0xa0, 0x6d, 0x22, 0xcb,
+	0x7f, 0x61, 0x8f, 0x12, 0xfd, 0xff, 0x1d, 0x35, 0x71, 0x41, 0xdb, 0x85, 0xb9, 0x4d, 0x64, 0x79,
+	0xb2, 0xce, 0x61, 0x7d, 0x74, 0x7d, 0x19, 0x5c, 0x90, 0x7e, 0x0a, 0x35, 0x85, 0xc0, 0x1c, 0x9a,
+	0xbb, 0x39, 0xba, 0xbf, 0x88, 0x7d, 0x01, 0x93, 0x9b, 0xc8, 0x06, 0x82, 0xce, 0x89, 0x9f, 0xcf,
+	0x93, 0xbd, 0x22, 0x58, 0xff, 0x1e, 0xf4, 0x28, 0x71, 0x5b, 0xa7, 0x6f, 0x62, 0x4c, 0xc4, 0x15,
+	0xd8, 0x3a, 0xb1, 0x8e, 0x13, 0xcf, 0x56, 0x51, 0x31, 0x62, 0xb2, 0xde, 0xe0, 0x05, 0x70, 0x60,
+	0xd9, 0x67, 0x96, 0x8b, 0xdf, 0x7d, 0xe8, 0x7a, 0xec, 0xb4, 0x77, 0x9c, 0xce, 0xb4, 0x92, 0x09,
+	0x5c, 0x11, 0x81, 0xe2, 0x13, 0x9d, 0xae, 0xa4, 0x81, 0xc7, 0xe2, 0xdb, 0xfe, 0xc9, 0x3f, 0x03,
+	0x00, 0x03, 0x1a, 0x7b, 0x8c, 0xf6, 0x0f, 0x00, 0x00,
 }
@@ -48,6 +48,7 @@ const (
 	ChaincodeMessage_GET_HISTORY_FOR_KEY ChaincodeMessage_Type = 19
 	ChaincodeMessage_GET_STATE_METADATA  ChaincodeMessage_Type = 20
 	ChaincodeMessage_PUT_STATE_METADATA  ChaincodeMessage_Type = 21
+	ChaincodeMessage_PURGE_PRIVATE_DATA  ChaincodeMessage_Type = 22
 )
 
 var ChaincodeMessage_Type_name = map[int32]string{
@@ -72,6 +73,7 @@ var ChaincodeMessage_Type_name = map[int32]string{
 	19: "GET_HISTORY_FOR_KEY",
 	20: "GET_STATE_METADATA",
 	21: "PUT_STATE_METADATA",
+	22: "PURGE_PRIVATE_DATA",
 }
 var ChaincodeMessage_Type_value = map[string]int32{
 	"UNDEFINED":           0,
@@ -95,13 +97,14 @@ var ChaincodeMessage_Type_value = map[string]int32{
 	"GET_HISTORY_FOR_KEY": 19,
 	"GET_STATE_METADATA":  20,
 	"PUT_STATE_METADATA":  21,
+	"PURGE_PRIVATE_DATA":  22,
 }
 
 func (x ChaincodeMessage_Type) String() string {
 	return proto.EnumName(ChaincodeMessage_Type_name, int32(x))
 }
 func (ChaincodeMessage_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{0, 0}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{0, 0}
 }
 
 type ChaincodeMessage struct {
@@ -125,7 +128,7 @@ func (m *ChaincodeMessage) Reset()         { *m = ChaincodeMessage{} }
 func (m *ChaincodeMessage) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeMessage) ProtoMessage()    {}
 func (*ChaincodeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{0}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{0}
 }
 func (m *ChaincodeMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeMessage.Unmarshal(m, b)
@@ -209,7 +212,7 @@ func (m *GetState) Reset()         { *m = GetState{} }
 func (m *GetState) String() string { return proto.CompactTextString(m) }
 func (*GetState) ProtoMessage()    {}
 func (*GetState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{1}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{1}
 }
 func (m *GetState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetState.Unmarshal(m, b)
@@ -255,7 +258,7 @@ func (m *GetStateMetadata) Reset()         { *m = GetStateMetadata{} }
 func (m *GetStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*GetStateMetadata) ProtoMessage()    {}
 func (*GetStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{2}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{2}
 }
 func (m *GetStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateMetadata.Unmarshal(m, b)
@@ -306,7 +309,7 @@ func (m *PutState) Reset()         { *m = PutState{} }
 func (m *PutState) String() string { return proto.CompactTextString(m) }
 func (*PutState) ProtoMessage()    {}
 func (*PutState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{3}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{3}
 }
 func (m *PutState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutState.Unmarshal(m, b)
@@ -360,7 +363,7 @@ func (m *PutStateMetadata) Reset()         { *m = PutStateMetadata{} }
 func (m *PutStateMetadata) String() string { return proto.CompactTextString(m) }
 func (*PutStateMetadata) ProtoMessage()    {}
 func (*PutStateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{4}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{4}
 }
 func (m *PutStateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutStateMetadata.Unmarshal(m, b)
@@ -417,7 +420,7 @@ func (m *DelState) Reset()         { *m = DelState{} }
 func (m *DelState) String() string { return proto.CompactTextString(m) }
 func (*DelState) ProtoMessage()    {}
 func (*DelState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{5}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{5}
 }
 func (m *DelState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelState.Unmarshal(m, b)
@@ -469,7 +472,7 @@ func (m *GetStateByRange) Reset()         { *m = GetStateByRange{} }
 func (m *GetStateByRange) String() string { return proto.CompactTextString(m) }
 func (*GetStateByRange) ProtoMessage()    {}
 func (*GetStateByRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{6}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{6}
 }
 func (m *GetStateByRange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStateByRange.Unmarshal(m, b)
@@ -534,7 +537,7 @@ func (m *GetQueryResult) Reset()         { *m = GetQueryResult{} }
 func (m *GetQueryResult) String() string { return proto.CompactTextString(m) }
 func (*GetQueryResult) ProtoMessage()    {}
 func (*GetQueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{7}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{7}
 }
 func (m *GetQueryResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetQueryResult.Unmarshal(m, b)
@@ -590,7 +593,7 @@ func (m *QueryMetadata) Reset()         { *m = QueryMetadata{} }
 func (m *QueryMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryMetadata) ProtoMessage()    {}
 func (*QueryMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{8}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{8}
 }
 func (m *QueryMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryMetadata.Unmarshal(m, b)
@@ -625,9 +628,13 @@ func (m *QueryMetadata) GetBookmark() string {
 }
 
 // GetHistoryForKey is the payload of a ChaincodeMessage. It contains a key
-// for which the historical values need to be retrieved.
+// for which the historical values need to be retrieved. The metadata, if
+// present, is a marshaled HistoryQueryMetadata bounding the query. If the
+// collection is specified, the key is a private data key of the collection.
 type GetHistoryForKey struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Collection           string   `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -637,7 +644,7 @@ func (m *GetHistoryForKey) Reset()         { *m = GetHistoryForKey{} }
 func (m *GetHistoryForKey) String() string { return proto.CompactTextString(m) }
 func (*GetHistoryForKey) ProtoMessage()    {}
 func (*GetHistoryForKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{9}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{9}
 }
 func (m *GetHistoryForKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHistoryForKey.Unmarshal(m, b)
@@ -664,6 +671,111 @@ func (m *GetHistoryForKey) GetKey() string {
 	return ""
 }
 
+func (m *GetHistoryForKey) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *GetHistoryForKey) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+// HistoryQueryMetadata bounds a history query to the modifications committed
+// in the blocks in the range [startBlock, endBlock] (an endBlock of zero denotes
+// no upper bound) or to the modifications made by the transactions with a
+// timestamp in the range [startTime, endTime) (an unset time denotes no bound).
+// A history query cannot be bounded by both a block range and a time range.
+type HistoryQueryMetadata struct {
+	StartBlock           uint64               `protobuf:"varint,1,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	EndBlock             uint64               `protobuf:"varint,2,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Descending           bool                 `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize             int32                `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Bookmark             string               `protobuf:"bytes,7,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HistoryQueryMetadata) Reset()         { *m = HistoryQueryMetadata{} }
+func (m *HistoryQueryMetadata) String() string { return proto.CompactTextString(m) }
+func (*HistoryQueryMetadata) ProtoMessage()    {}
+func (*HistoryQueryMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{10}
+}
+func (m *HistoryQueryMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryQueryMetadata.Unmarshal(m, b)
+}
+func (m *HistoryQueryMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryQueryMetadata.Marshal(b, m, deterministic)
+}
+func (dst *HistoryQueryMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryQueryMetadata.Merge(dst, src)
+}
+func (m *HistoryQueryMetadata) XXX_Size() int {
+	return xxx_messageInfo_HistoryQueryMetadata.Size(m)
+}
+func (m *HistoryQueryMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryQueryMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryQueryMetadata proto.InternalMessageInfo
+
+func (m *HistoryQueryMetadata) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *HistoryQueryMetadata) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *HistoryQueryMetadata) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *HistoryQueryMetadata) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
 type QueryStateNext struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -675,7 +787,7 @@ func (m *QueryStateNext) Reset()         { *m = QueryStateNext{} }
 func (m *QueryStateNext) String() string { return proto.CompactTextString(m) }
 func (*QueryStateNext) ProtoMessage()    {}
 func (*QueryStateNext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{10}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{11}
 }
 func (m *QueryStateNext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateNext.Unmarshal(m, b)
@@ -713,7 +825,7 @@ func (m *QueryStateClose) Reset()         { *m = QueryStateClose{} }
 func (m *QueryStateClose) String() string { return proto.CompactTextString(m) }
 func (*QueryStateClose) ProtoMessage()    {}
 func (*QueryStateClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{11}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{12}
 }
 func (m *QueryStateClose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateClose.Unmarshal(m, b)
@@ -752,7 +864,7 @@ func (m *QueryResultBytes) Reset()         { *m = QueryResultBytes{} }
 func (m *QueryResultBytes) String() string { return proto.CompactTextString(m) }
 func (*QueryResultBytes) ProtoMessage()    {}
 func (*QueryResultBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{12}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{13}
 }
 func (m *QueryResultBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResultBytes.Unmarshal(m, b)
@@ -798,7 +910,7 @@ func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{13}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{14}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -860,7 +972,7 @@ func (m *QueryResponseMetadata) Reset()         { *m = QueryResponseMetadata{} }
 func (m *QueryResponseMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryResponseMetadata) ProtoMessage()    {}
 func (*QueryResponseMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{14}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{15}
 }
 func (m *QueryResponseMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponseMetadata.Unmarshal(m, b)
@@ -906,7 +1018,7 @@ func (m *StateMetadata) Reset()         { *m = StateMetadata{} }
 func (m *StateMetadata) String() string { return proto.CompactTextString(m) }
 func (*StateMetadata) ProtoMessage()    {}
 func (*StateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{15}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{16}
 }
 func (m *StateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadata.Unmarshal(m, b)
@@ -951,7 +1063,7 @@ func (m *StateMetadataResult) Reset()         { *m = StateMetadataResult{} }
 func (m *StateMetadataResult) String() string { return proto.CompactTextString(m) }
 func (*StateMetadataResult) ProtoMessage()    {}
 func (*StateMetadataResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_3c6cdf1ba834f673, []int{16}
+	return fileDescriptor_chaincode_shim_a97de4ae6e493ffa, []int{17}
 }
 func (m *StateMetadataResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadataResult.Unmarshal(m, b)
@@ -989,6 +1101,7 @@ func init() {
 	proto.RegisterType((*GetQueryResult)(nil), "protos.GetQueryResult")
 	proto.RegisterType((*QueryMetadata)(nil), "protos.QueryMetadata")
 	proto.RegisterType((*GetHistoryForKey)(nil), "protos.GetHistoryForKey")
+	proto.RegisterType((*HistoryQueryMetadata)(nil), "protos.HistoryQueryMetadata")
 	proto.RegisterType((*QueryStateNext)(nil), "protos.QueryStateNext")
 	proto.RegisterType((*QueryStateClose)(nil), "protos.QueryStateClose")
 	proto.RegisterType((*QueryResultBytes)(nil), "protos.QueryResultBytes")
@@ -1104,73 +1217,79 @@ var _ChaincodeSupport_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_3c6cdf1ba834f673)
-}
-
-var fileDescriptor_chaincode_shim_3c6cdf1ba834f673 = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x73, 0xda, 0x46,
-	0x14, 0x0e, 0x06, 0x8c, 0x78, 0xd8, 0x78, 0xb3, 0x8e, 0x5d, 0xc2, 0x4c, 0x5a, 0xca, 0xf4, 0x40,
-	0x2f, 0xd0, 0xd0, 0x1e, 0x7a, 0xe8, 0x4c, 0x06, 0xc3, 0x1a, 0x33, 0xb6, 0x05, 0x59, 0xc9, 0x99,
-	0xb8, 0x17, 0x8d, 0x90, 0xd6, 0x42, 0x63, 0xa1, 0x55, 0xa5, 0x25, 0x0d, 0xbd, 0xf5, 0xda, 0x7f,
-	0xa9, 0x7f, 0x58, 0xaf, 0x9d, 0xd5, 0x2f, 0x03, 0xae, 0x93, 0x4e, 0x4e, 0xe8, 0x7b, 0xef, 0xdb,
-	0xef, 0xfd, 0xda, 0x87, 0x04, 0x2f, 0x03, 0xc6, 0xc2, 0x9e, 0xb5, 0x30, 0x5d, 0xdf, 0xe2, 0x36,
-	0x33, 0xa2, 0x85, 0xbb, 0xec, 0x06, 0x21, 0x17, 0x1c, 0xef, 0xc7, 0x3f, 0x51, 0xb3, 0xb9, 0x43,
-	0x61, 0x1f, 0x98, 0x2f, 0x12, 0x4e, 0xf3, 0x38, 0xf6, 0x05, 0x21, 0x0f, 0x78, 0x64, 0x7a, 0xa9,
-	0xf1, 0x1b, 0x87, 0x73, 0xc7, 0x63, 0xbd, 0x18, 0xcd, 0x57, 0x77, 0x3d, 0xe1, 0x2e, 0x59, 0x24,
-	0xcc, 0x65, 0x90, 0x10, 0xda, 0x7f, 0x97, 0x01, 0x0d, 0x33, 0xbd, 0x6b, 0x16, 0x45, 0xa6, 0xc3,
-	0xf0, 0x6b, 0x28, 0x89, 0x75, 0xc0, 0x1a, 0x85, 0x56, 0xa1, 0x53, 0xef, 0xbf, 0x4a, 0xa8, 0x51,
-	0x77, 0x97, 0xd7, 0xd5, 0xd7, 0x01, 0xa3, 0x31, 0x15, 0xff, 0x0c, 0xd5, 0x5c, 0xba, 0xb1, 0xd7,
-	0x2a, 0x74, 0x6a, 0xfd, 0x66, 0x37, 0x09, 0xde, 0xcd, 0x82, 0x77, 0xf5, 0x8c, 0x41, 0x1f, 0xc8,
-	0xb8, 0x01, 0x95, 0xc0, 0x5c, 0x7b, 0xdc, 0xb4, 0x1b, 0xc5, 0x56, 0xa1, 0x73, 0x40, 0x33, 0x88,
-	0x31, 0x94, 0xc4, 0x47, 0xd7, 0x6e, 0x94, 0x5a, 0x85, 0x4e, 0x95, 0xc6, 0xcf, 0xb8, 0x0f, 0x4a,
-	0x56, 0x62, 0xa3, 0x1c, 0x87, 0x39, 0xcd, 0xd2, 0xd3, 0x5c, 0xc7, 0x67, 0xf6, 0x2c, 0xf5, 0xd2,
-	0x9c, 0x87, 0xdf, 0xc0, 0xd1, 0x4e, 0xcb, 0x1a, 0xfb, 0xdb, 0x47, 0xf3, 0xca, 0x88, 0xf4, 0xd2,
-	0xba, 0xb5, 0x85, 0xf1, 0x2b, 0x00, 0x6b, 0x61, 0xfa, 0x3e, 0xf3, 0x0c, 0xd7, 0x6e, 0x54, 0xe2,
-	0x74, 0xaa, 0xa9, 0x65, 0x62, 0xb7, 0xff, 0xd9, 0x83, 0x92, 0x6c, 0x05, 0x3e, 0x84, 0xea, 0x8d,
-	0x3a, 0x22, 0xe7, 0x13, 0x95, 0x8c, 0xd0, 0x33, 0x7c, 0x00, 0x0a, 0x25, 0xe3, 0x89, 0xa6, 0x13,
-	0x8a, 0x0a, 0xb8, 0x0e, 0x90, 0x21, 0x32, 0x42, 0x7b, 0x58, 0x81, 0xd2, 0x44, 0x9d, 0xe8, 0xa8,
-	0x88, 0xab, 0x50, 0xa6, 0x64, 0x30, 0xba, 0x45, 0x25, 0x7c, 0x04, 0x35, 0x9d, 0x0e, 0x54, 0x6d,
-	0x30, 0xd4, 0x27, 0x53, 0x15, 0x95, 0xa5, 0xe4, 0x70, 0x7a, 0x3d, 0xbb, 0x22, 0x3a, 0x19, 0xa1,
-	0x7d, 0x49, 0x25, 0x94, 0x4e, 0x29, 0xaa, 0x48, 0xcf, 0x98, 0xe8, 0x86, 0xa6, 0x0f, 0x74, 0x82,
-	0x14, 0x09, 0x67, 0x37, 0x19, 0xac, 0x4a, 0x38, 0x22, 0x57, 0x29, 0x04, 0xfc, 0x02, 0xd0, 0x44,
-	0x7d, 0x37, 0xbd, 0x24, 0xc6, 0xf0, 0x62, 0x30, 0x51, 0x87, 0xd3, 0x11, 0x41, 0xb5, 0x24, 0x41,
-	0x6d, 0x36, 0x55, 0x35, 0x82, 0x0e, 0xf1, 0x29, 0xe0, 0x5c, 0xd0, 0x38, 0xbb, 0x35, 0xe8, 0x40,
-	0x1d, 0x13, 0x54, 0x97, 0x67, 0xa5, 0xfd, 0xed, 0x0d, 0xa1, 0xb7, 0x06, 0x25, 0xda, 0xcd, 0x95,
-	0x8e, 0x8e, 0xa4, 0x35, 0xb1, 0x24, 0x7c, 0x95, 0xbc, 0xd7, 0x11, 0xc2, 0x27, 0xf0, 0x7c, 0xd3,
-	0x3a, 0xbc, 0x9a, 0x6a, 0x04, 0x3d, 0x97, 0xd9, 0x5c, 0x12, 0x32, 0x1b, 0x5c, 0x4d, 0xde, 0x11,
-	0x84, 0xf1, 0x57, 0x70, 0x2c, 0x15, 0x2f, 0x26, 0x9a, 0x3e, 0xa5, 0xb7, 0xc6, 0xf9, 0x94, 0x1a,
-	0x97, 0xe4, 0x16, 0x1d, 0x6f, 0xa7, 0x70, 0x4d, 0xf4, 0xc1, 0x68, 0xa0, 0x0f, 0xd0, 0x0b, 0x69,
-	0xcf, 0x8b, 0x7b, 0xb0, 0x9f, 0xb4, 0x7f, 0x01, 0x65, 0xcc, 0x84, 0x26, 0x4c, 0xc1, 0x30, 0x82,
-	0xe2, 0x3d, 0x5b, 0xc7, 0x77, 0xb6, 0x4a, 0xe5, 0x23, 0xfe, 0x1a, 0xc0, 0xe2, 0x9e, 0xc7, 0x2c,
-	0xe1, 0x72, 0x3f, 0xbe, 0x94, 0x55, 0xba, 0x61, 0x69, 0x8f, 0x00, 0x65, 0xa7, 0xaf, 0x99, 0x30,
-	0x6d, 0x53, 0x98, 0x5f, 0xa0, 0x42, 0x41, 0x99, 0xad, 0x9e, 0xcc, 0xe1, 0x05, 0x94, 0x3f, 0x98,
-	0xde, 0x8a, 0xc5, 0x07, 0x0f, 0x68, 0x02, 0x76, 0x34, 0x8b, 0x8f, 0x34, 0x7f, 0x07, 0x94, 0x69,
-	0xfe, 0xef, 0xcc, 0x1e, 0xa9, 0xe0, 0xd7, 0xa0, 0x2c, 0xd3, 0xd3, 0xf1, 0x0e, 0xd5, 0xfa, 0x27,
-	0xf9, 0xae, 0x6c, 0x4a, 0xd3, 0x9c, 0x26, 0x1b, 0x3a, 0x62, 0xde, 0x97, 0x36, 0xf4, 0xcf, 0x02,
-	0x1c, 0x65, 0x1d, 0x3d, 0x5b, 0x53, 0xd3, 0x77, 0x18, 0x6e, 0x82, 0x12, 0x09, 0x33, 0x14, 0x97,
-	0xb9, 0x54, 0x8e, 0xf1, 0x29, 0xec, 0x33, 0xdf, 0x96, 0x9e, 0x44, 0x2b, 0x45, 0x9f, 0x2d, 0xac,
-	0xb9, 0x53, 0xd8, 0xc1, 0x46, 0x05, 0x73, 0xa8, 0x8f, 0x99, 0x78, 0xbb, 0x62, 0xe1, 0x9a, 0xb2,
-	0x68, 0xe5, 0x09, 0x39, 0x82, 0xdf, 0x24, 0x4c, 0xc3, 0x27, 0xe0, 0x73, 0xb5, 0x6c, 0xc5, 0x28,
-	0xee, 0xc4, 0x18, 0xc3, 0x61, 0x1c, 0x20, 0x9f, 0x4d, 0x13, 0x94, 0xc0, 0x74, 0x98, 0xe6, 0xfe,
-	0x91, 0xfc, 0x69, 0x96, 0x69, 0x8e, 0xa5, 0x6f, 0xce, 0xf9, 0xfd, 0xd2, 0x0c, 0xef, 0xd3, 0x30,
-	0x39, 0x6e, 0x7f, 0x17, 0xdf, 0xc0, 0x0b, 0x37, 0x12, 0x3c, 0x5c, 0x9f, 0xf3, 0x50, 0x16, 0xff,
-	0xa8, 0xed, 0xed, 0x16, 0xd4, 0xe3, 0x70, 0x71, 0x5f, 0x55, 0xf6, 0x51, 0xe0, 0x3a, 0xec, 0xb9,
-	0x76, 0x4a, 0xd9, 0x73, 0xed, 0xf6, 0xb7, 0x70, 0xf4, 0xc0, 0x18, 0x7a, 0x3c, 0x62, 0x8f, 0x28,
-	0x3f, 0x01, 0xda, 0x68, 0xca, 0xd9, 0x5a, 0xb0, 0x08, 0xb7, 0xa0, 0x16, 0x3e, 0xc0, 0x98, 0x7c,
-	0x40, 0x37, 0x4d, 0xed, 0xbf, 0x0a, 0x69, 0xa9, 0x94, 0x45, 0x01, 0xf7, 0x23, 0x86, 0xfb, 0x50,
-	0x49, 0x08, 0x92, 0x5f, 0xec, 0xd4, 0xfa, 0x8d, 0xec, 0x4e, 0xed, 0xca, 0xd3, 0x8c, 0x88, 0x5f,
-	0x82, 0xb2, 0x30, 0x23, 0x63, 0xc9, 0xc3, 0x64, 0x0f, 0x14, 0x5a, 0x59, 0x98, 0xd1, 0x35, 0x0f,
-	0xb3, 0x34, 0x8b, 0x59, 0x9a, 0x9f, 0x1c, 0xad, 0x03, 0x27, 0x5b, 0xb9, 0xe4, 0xed, 0xef, 0xc3,
-	0xc9, 0x1d, 0x13, 0xd6, 0x82, 0xd9, 0x46, 0xc8, 0x2c, 0x1e, 0xda, 0x91, 0x61, 0xf1, 0x95, 0x2f,
-	0xd2, 0x59, 0x1c, 0xa7, 0x4e, 0x9a, 0xf8, 0x86, 0xd2, 0xf5, 0xc9, 0xb1, 0xbc, 0x81, 0xc3, 0xed,
-	0xdd, 0x6b, 0x40, 0x45, 0x66, 0xf1, 0x30, 0x97, 0x0c, 0xfe, 0xf7, 0x7e, 0xb7, 0xcf, 0xe1, 0x78,
-	0x7b, 0xc3, 0x92, 0x9b, 0xd8, 0x83, 0x0a, 0xf3, 0x45, 0xe8, 0xb2, 0xac, 0x77, 0x4f, 0xec, 0x63,
-	0xc6, 0xea, 0xbf, 0xdf, 0x78, 0x39, 0x6b, 0xab, 0x20, 0xe0, 0xa1, 0xc0, 0x23, 0x50, 0x28, 0x73,
-	0xdc, 0x48, 0xb0, 0x10, 0x37, 0x9e, 0x7a, 0x35, 0x37, 0x9f, 0xf4, 0xb4, 0x9f, 0x75, 0x0a, 0x3f,
-	0x14, 0xce, 0xa6, 0xd0, 0xe6, 0xa1, 0xd3, 0x5d, 0xac, 0x03, 0x16, 0x7a, 0xcc, 0x76, 0x58, 0xd8,
-	0xbd, 0x33, 0xe7, 0xa1, 0x6b, 0x65, 0xe7, 0xe4, 0xd7, 0xc4, 0xaf, 0xdf, 0x3b, 0xae, 0x58, 0xac,
-	0xe6, 0x5d, 0x8b, 0x2f, 0x7b, 0x1b, 0xd4, 0x5e, 0x42, 0x4d, 0xbe, 0x2a, 0xa2, 0x9e, 0xa4, 0xce,
-	0x93, 0x4f, 0x94, 0x1f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x41, 0xbd, 0x1a, 0x01, 0xc6, 0x08,
-	0x00, 0x00,
+	proto.RegisterFile("peer/chaincode_shim.proto", fileDescriptor_chaincode_shim_a97de4ae6e493ffa)
+}
+
+var fileDescriptor_chaincode_shim_a97de4ae6e493ffa = []byte{
+	// 1112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x73, 0xe2, 0xc6,
+	0x13, 0x5e, 0x1e, 0x36, 0xa2, 0x6d, 0xe3, 0xd9, 0xf1, 0xe3, 0xa7, 0xa5, 0x6a, 0xf7, 0x47, 0x38,
+	0x91, 0x0b, 0x64, 0xc9, 0x1e, 0x72, 0x48, 0xd5, 0x16, 0x86, 0x31, 0x4b, 0xd9, 0x06, 0x76, 0x24,
+	0x6f, 0xad, 0x73, 0x51, 0x84, 0x34, 0x2b, 0x54, 0x16, 0x1a, 0x45, 0x1a, 0x36, 0x4b, 0x6e, 0xb9,
+	0xe6, 0x92, 0x7b, 0xfe, 0xad, 0xfc, 0x43, 0xa9, 0xd1, 0xcb, 0x80, 0x63, 0xbb, 0xb2, 0x27, 0xf8,
+	0xba, 0xbf, 0xee, 0xfe, 0xa6, 0xd5, 0xf3, 0x80, 0x17, 0x01, 0x63, 0x61, 0xc7, 0x9a, 0x9b, 0xae,
+	0x6f, 0x71, 0x9b, 0x19, 0xd1, 0xdc, 0x5d, 0xb4, 0x83, 0x90, 0x0b, 0x8e, 0x77, 0xe3, 0x9f, 0xa8,
+	0x5e, 0xdf, 0xa2, 0xb0, 0xcf, 0xcc, 0x17, 0x09, 0xa7, 0x7e, 0x14, 0xfb, 0x82, 0x90, 0x07, 0x3c,
+	0x32, 0xbd, 0xd4, 0xf8, 0x7f, 0x87, 0x73, 0xc7, 0x63, 0x9d, 0x18, 0xcd, 0x96, 0x9f, 0x3a, 0xc2,
+	0x5d, 0xb0, 0x48, 0x98, 0x8b, 0x20, 0x21, 0x34, 0xff, 0xde, 0x01, 0xd4, 0xcf, 0xf2, 0x5d, 0xb1,
+	0x28, 0x32, 0x1d, 0x86, 0x5f, 0x43, 0x59, 0xac, 0x02, 0xa6, 0x16, 0x1a, 0x85, 0x56, 0xad, 0xfb,
+	0x32, 0xa1, 0x46, 0xed, 0x6d, 0x5e, 0x5b, 0x5f, 0x05, 0x8c, 0xc6, 0x54, 0xfc, 0x03, 0x54, 0xf3,
+	0xd4, 0x6a, 0xb1, 0x51, 0x68, 0xed, 0x75, 0xeb, 0xed, 0xa4, 0x78, 0x3b, 0x2b, 0xde, 0xd6, 0x33,
+	0x06, 0xbd, 0x23, 0x63, 0x15, 0x2a, 0x81, 0xb9, 0xf2, 0xb8, 0x69, 0xab, 0xa5, 0x46, 0xa1, 0xb5,
+	0x4f, 0x33, 0x88, 0x31, 0x94, 0xc5, 0x17, 0xd7, 0x56, 0xcb, 0x8d, 0x42, 0xab, 0x4a, 0xe3, 0xff,
+	0xb8, 0x0b, 0x4a, 0xb6, 0x44, 0x75, 0x27, 0x2e, 0x73, 0x9a, 0xc9, 0xd3, 0x5c, 0xc7, 0x67, 0xf6,
+	0x34, 0xf5, 0xd2, 0x9c, 0x87, 0xdf, 0xc2, 0xe1, 0x56, 0xcb, 0xd4, 0xdd, 0xcd, 0xd0, 0x7c, 0x65,
+	0x44, 0x7a, 0x69, 0xcd, 0xda, 0xc0, 0xf8, 0x25, 0x80, 0x35, 0x37, 0x7d, 0x9f, 0x79, 0x86, 0x6b,
+	0xab, 0x95, 0x58, 0x4e, 0x35, 0xb5, 0x8c, 0xec, 0xe6, 0x9f, 0x25, 0x28, 0xcb, 0x56, 0xe0, 0x03,
+	0xa8, 0x5e, 0x8f, 0x07, 0xe4, 0x7c, 0x34, 0x26, 0x03, 0xf4, 0x0c, 0xef, 0x83, 0x42, 0xc9, 0x70,
+	0xa4, 0xe9, 0x84, 0xa2, 0x02, 0xae, 0x01, 0x64, 0x88, 0x0c, 0x50, 0x11, 0x2b, 0x50, 0x1e, 0x8d,
+	0x47, 0x3a, 0x2a, 0xe1, 0x2a, 0xec, 0x50, 0xd2, 0x1b, 0xdc, 0xa0, 0x32, 0x3e, 0x84, 0x3d, 0x9d,
+	0xf6, 0xc6, 0x5a, 0xaf, 0xaf, 0x8f, 0x26, 0x63, 0xb4, 0x23, 0x53, 0xf6, 0x27, 0x57, 0xd3, 0x4b,
+	0xa2, 0x93, 0x01, 0xda, 0x95, 0x54, 0x42, 0xe9, 0x84, 0xa2, 0x8a, 0xf4, 0x0c, 0x89, 0x6e, 0x68,
+	0x7a, 0x4f, 0x27, 0x48, 0x91, 0x70, 0x7a, 0x9d, 0xc1, 0xaa, 0x84, 0x03, 0x72, 0x99, 0x42, 0xc0,
+	0xc7, 0x80, 0x46, 0xe3, 0x0f, 0x93, 0x0b, 0x62, 0xf4, 0xdf, 0xf5, 0x46, 0xe3, 0xfe, 0x64, 0x40,
+	0xd0, 0x5e, 0x22, 0x50, 0x9b, 0x4e, 0xc6, 0x1a, 0x41, 0x07, 0xf8, 0x14, 0x70, 0x9e, 0xd0, 0x38,
+	0xbb, 0x31, 0x68, 0x6f, 0x3c, 0x24, 0xa8, 0x26, 0x63, 0xa5, 0xfd, 0xfd, 0x35, 0xa1, 0x37, 0x06,
+	0x25, 0xda, 0xf5, 0xa5, 0x8e, 0x0e, 0xa5, 0x35, 0xb1, 0x24, 0xfc, 0x31, 0xf9, 0xa8, 0x23, 0x84,
+	0x4f, 0xe0, 0xf9, 0xba, 0xb5, 0x7f, 0x39, 0xd1, 0x08, 0x7a, 0x2e, 0xd5, 0x5c, 0x10, 0x32, 0xed,
+	0x5d, 0x8e, 0x3e, 0x10, 0x84, 0xf1, 0xff, 0xe0, 0x48, 0x66, 0x7c, 0x37, 0xd2, 0xf4, 0x09, 0xbd,
+	0x31, 0xce, 0x27, 0xd4, 0xb8, 0x20, 0x37, 0xe8, 0x68, 0x53, 0xc2, 0x15, 0xd1, 0x7b, 0x83, 0x9e,
+	0xde, 0x43, 0xc7, 0xd2, 0x3e, 0xbd, 0xbe, 0x67, 0x3f, 0x49, 0xec, 0x74, 0x48, 0x8c, 0x29, 0x1d,
+	0x7d, 0x90, 0xbe, 0xd8, 0x7e, 0xda, 0xfc, 0x11, 0x94, 0x21, 0x13, 0x9a, 0x30, 0x05, 0xc3, 0x08,
+	0x4a, 0xb7, 0x6c, 0x15, 0xcf, 0x72, 0x95, 0xca, 0xbf, 0xf8, 0x15, 0x80, 0xc5, 0x3d, 0x8f, 0x59,
+	0xc2, 0xe5, 0x7e, 0x3c, 0xac, 0x55, 0xba, 0x66, 0x69, 0x0e, 0x00, 0x65, 0xd1, 0x57, 0x4c, 0x98,
+	0xb6, 0x29, 0xcc, 0xaf, 0xc8, 0x42, 0x41, 0x99, 0x2e, 0x1f, 0xd4, 0x70, 0x0c, 0x3b, 0x9f, 0x4d,
+	0x6f, 0xc9, 0xe2, 0xc0, 0x7d, 0x9a, 0x80, 0xad, 0x9c, 0xa5, 0x7b, 0x39, 0x7f, 0x05, 0x34, 0x5d,
+	0xfe, 0x47, 0x65, 0xf7, 0xb2, 0xe0, 0xd7, 0xa0, 0x2c, 0xd2, 0xe8, 0x78, 0x6f, 0xed, 0x75, 0x4f,
+	0xf2, 0x3d, 0xb4, 0x9e, 0x9a, 0xe6, 0x34, 0xd9, 0xd0, 0x01, 0xf3, 0xbe, 0xb6, 0xa1, 0xbf, 0x17,
+	0xe0, 0x30, 0xeb, 0xe8, 0xd9, 0x8a, 0x9a, 0xbe, 0xc3, 0x70, 0x1d, 0x94, 0x48, 0x98, 0xa1, 0xb8,
+	0xc8, 0x53, 0xe5, 0x18, 0x9f, 0xc2, 0x2e, 0xf3, 0x6d, 0xe9, 0x49, 0x72, 0xa5, 0xe8, 0xc9, 0x85,
+	0xd5, 0xb7, 0x16, 0xb6, 0xbf, 0xb6, 0x82, 0x19, 0xd4, 0x86, 0x4c, 0xbc, 0x5f, 0xb2, 0x70, 0x45,
+	0x59, 0xb4, 0xf4, 0x84, 0xfc, 0x04, 0xbf, 0x48, 0x98, 0x96, 0x4f, 0xc0, 0x53, 0x6b, 0xd9, 0xa8,
+	0x51, 0xda, 0xaa, 0x31, 0x84, 0x83, 0xb8, 0x40, 0xfe, 0x6d, 0xea, 0xa0, 0x04, 0xa6, 0xc3, 0x34,
+	0xf7, 0xb7, 0xe4, 0x30, 0xdd, 0xa1, 0x39, 0x96, 0xbe, 0x19, 0xe7, 0xb7, 0x0b, 0x33, 0xbc, 0x4d,
+	0xcb, 0xe4, 0xb8, 0xf9, 0x73, 0x3c, 0x81, 0xef, 0xdc, 0x48, 0xf0, 0x70, 0x75, 0xce, 0x43, 0xb9,
+	0xf8, 0xfb, 0x6d, 0x5f, 0x97, 0x52, 0xdc, 0x94, 0xf2, 0xe4, 0x24, 0xfd, 0x55, 0x84, 0xe3, 0x34,
+	0xff, 0xa6, 0xe4, 0x57, 0x00, 0xf1, 0x77, 0x38, 0xf3, 0xb8, 0x75, 0x1b, 0x57, 0x2b, 0xd3, 0x35,
+	0x8b, 0x2c, 0xca, 0x7c, 0x3b, 0xf1, 0x16, 0x63, 0x6f, 0x8e, 0xe5, 0x25, 0x10, 0x33, 0xe5, 0x39,
+	0xaf, 0x96, 0x9e, 0xbe, 0x04, 0x72, 0x32, 0x7e, 0x03, 0x15, 0xe6, 0xdb, 0x71, 0x5c, 0xf9, 0xc9,
+	0xb8, 0x8c, 0x2a, 0xb5, 0xda, 0x2c, 0xb2, 0x98, 0x6f, 0xbb, 0xbe, 0x13, 0x5f, 0x07, 0x0a, 0x5d,
+	0xb3, 0x6c, 0xb4, 0x7f, 0xf7, 0x91, 0xf6, 0x57, 0xb6, 0xda, 0xdf, 0x80, 0x5a, 0xdc, 0x94, 0x78,
+	0x60, 0xc7, 0xec, 0x8b, 0xc0, 0x35, 0x28, 0xba, 0x76, 0xda, 0xfb, 0xa2, 0x6b, 0x37, 0xbf, 0x81,
+	0xc3, 0x3b, 0x46, 0xdf, 0xe3, 0x11, 0xbb, 0x47, 0x79, 0x03, 0x68, 0x6d, 0xda, 0xce, 0x56, 0x82,
+	0x45, 0xb8, 0x01, 0x7b, 0xe1, 0x1d, 0x8c, 0xc9, 0xfb, 0x74, 0xdd, 0xd4, 0xfc, 0xa3, 0x90, 0xce,
+	0x10, 0x65, 0x51, 0xc0, 0xfd, 0x88, 0xe1, 0x2e, 0x54, 0x12, 0x82, 0xe4, 0x97, 0x5a, 0x7b, 0x5d,
+	0x35, 0xdb, 0xac, 0xdb, 0xe9, 0x69, 0x46, 0xc4, 0x2f, 0x40, 0x99, 0x9b, 0x91, 0xb1, 0xe0, 0x61,
+	0x72, 0xc0, 0x28, 0xb4, 0x32, 0x37, 0xa3, 0x2b, 0x1e, 0x66, 0x32, 0x4b, 0x99, 0xcc, 0x47, 0xf7,
+	0x8c, 0x03, 0x27, 0x1b, 0x5a, 0xf2, 0x21, 0xe9, 0xc2, 0xc9, 0x27, 0x26, 0xac, 0x39, 0xb3, 0x8d,
+	0x90, 0x59, 0x3c, 0xb4, 0x23, 0xc3, 0xe2, 0x4b, 0x5f, 0xa4, 0x43, 0x7e, 0x94, 0x3a, 0x69, 0xe2,
+	0xeb, 0x4b, 0xd7, 0xa3, 0xf3, 0xfe, 0x16, 0x0e, 0x36, 0x0f, 0x35, 0x15, 0x2a, 0x52, 0xc5, 0xdd,
+	0xc0, 0x67, 0xf0, 0xdf, 0x0f, 0xce, 0xe6, 0x39, 0x1c, 0x6d, 0x1e, 0x5d, 0xc9, 0x16, 0xef, 0xc8,
+	0xb1, 0x12, 0xa1, 0xcb, 0xb2, 0xde, 0x3d, 0x70, 0xd0, 0x65, 0xac, 0xee, 0xc7, 0xb5, 0xd7, 0x90,
+	0xb6, 0x0c, 0x02, 0x1e, 0x0a, 0x3c, 0x00, 0x85, 0x32, 0xc7, 0x8d, 0x04, 0x0b, 0xb1, 0xfa, 0xd0,
+	0x5b, 0xa8, 0xfe, 0xa0, 0xa7, 0xf9, 0xac, 0x55, 0xf8, 0xae, 0x70, 0x36, 0x81, 0x26, 0x0f, 0x9d,
+	0xf6, 0x7c, 0x15, 0xb0, 0xd0, 0x63, 0xb6, 0xc3, 0xc2, 0xf6, 0x27, 0x73, 0x16, 0xba, 0x56, 0x16,
+	0x27, 0x9f, 0x6f, 0x3f, 0x7d, 0xeb, 0xb8, 0x62, 0xbe, 0x9c, 0xb5, 0x2d, 0xbe, 0xe8, 0xac, 0x51,
+	0x3b, 0x09, 0x35, 0x79, 0xc6, 0x45, 0x1d, 0x49, 0x9d, 0x25, 0x6f, 0xc2, 0xef, 0xff, 0x19, 0x00,
+	0xc8, 0x8b, 0x03, 0x28, 0x37, 0x0a, 0x00, 0x00,
 }
@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
 import common "github.com/hyperledger/fabric/protos/common"
+import rwset "github.com/hyperledger/fabric/protos/ledger/rwset"
 
 import (
 	context "golang.org/x/net/context"
@@ -39,7 +40,7 @@ func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
 func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
 func (*FilteredBlock) ProtoMessage()    {}
 func (*FilteredBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{0}
+	return fileDescriptor_events_423f8dd397b2e661, []int{0}
 }
 func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredBlock.Unmarshal(m, b)
@@ -98,7 +99,7 @@ func (m *FilteredTransaction) Reset()         { *m = FilteredTransaction{} }
 func (m *FilteredTransaction) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransaction) ProtoMessage()    {}
 func (*FilteredTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{1}
+	return fileDescriptor_events_423f8dd397b2e661, []int{1}
 }
 func (m *FilteredTransaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransaction.Unmarshal(m, b)
@@ -231,7 +232,7 @@ func (m *FilteredTransactionActions) Reset()         { *m = FilteredTransactionA
 func (m *FilteredTransactionActions) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransactionActions) ProtoMessage()    {}
 func (*FilteredTransactionActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{2}
+	return fileDescriptor_events_423f8dd397b2e661, []int{2}
 }
 func (m *FilteredTransactionActions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredTransactionActions.Unmarshal(m, b)
@@ -271,7 +272,7 @@ func (m *FilteredChaincodeAction) Reset()         { *m = FilteredChaincodeAction
 func (m *FilteredChaincodeAction) String() string { return proto.CompactTextString(m) }
 func (*FilteredChaincodeAction) ProtoMessage()    {}
 func (*FilteredChaincodeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{3}
+	return fileDescriptor_events_423f8dd397b2e661, []int{3}
 }
 func (m *FilteredChaincodeAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilteredChaincodeAction.Unmarshal(m, b)
@@ -304,6 +305,7 @@ type DeliverResponse struct {
 	//	*DeliverResponse_Status
 	//	*DeliverResponse_Block
 	//	*DeliverResponse_FilteredBlock
+	//	*DeliverResponse_BlockAndPrivateData
 	Type                 isDeliverResponse_Type `protobuf_oneof:"Type"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
@@ -314,7 +316,7 @@ func (m *DeliverResponse) Reset()         { *m = DeliverResponse{} }
 func (m *DeliverResponse) String() string { return proto.CompactTextString(m) }
 func (*DeliverResponse) ProtoMessage()    {}
 func (*DeliverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_events_8af932975aef5a3c, []int{4}
+	return fileDescriptor_events_423f8dd397b2e661, []int{4}
 }
 func (m *DeliverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeliverResponse.Unmarshal(m, b)
@@ -350,12 +352,18 @@ type DeliverResponse_FilteredBlock struct {
 	FilteredBlock *FilteredBlock `protobuf:"bytes,3,opt,name=filtered_block,json=filteredBlock,proto3,oneof"`
 }
 
+type DeliverResponse_BlockAndPrivateData struct {
+	BlockAndPrivateData *BlockAndPrivateData `protobuf:"bytes,4,opt,name=block_and_private_data,json=blockAndPrivateData,proto3,oneof"`
+}
+
 func (*DeliverResponse_Status) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_Block) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_FilteredBlock) isDeliverResponse_Type() {}
 
+func (*DeliverResponse_BlockAndPrivateData) isDeliverResponse_Type() {}
+
 func (m *DeliverResponse) GetType() isDeliverResponse_Type {
 	if m != nil {
 		return m.Type
@@ -384,12 +392,20 @@ func (m *DeliverResponse) GetFilteredBlock() *FilteredBlock {
 	return nil
 }
 
+func (m *DeliverResponse) GetBlockAndPrivateData() *BlockAndPrivateData {
+	if x, ok := m.GetType().(*DeliverResponse_BlockAndPrivateData); ok {
+		return x.BlockAndPrivateData
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*DeliverResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _DeliverResponse_OneofMarshaler, _DeliverResponse_OneofUnmarshaler, _DeliverResponse_OneofSizer, []interface{}{
 		(*DeliverResponse_Status)(nil),
 		(*DeliverResponse_Block)(nil),
 		(*DeliverResponse_FilteredBlock)(nil),
+		(*DeliverResponse_BlockAndPrivateData)(nil),
 	}
 }
 
@@ -410,6 +426,11 @@ func _DeliverResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.FilteredBlock); err != nil {
 			return err
 		}
+	case *DeliverResponse_BlockAndPrivateData:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlockAndPrivateData); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("DeliverResponse.Type has unexpected type %T", x)
@@ -443,6 +464,14 @@ func _DeliverResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Type = &DeliverResponse_FilteredBlock{msg}
 		return true, err
+	case 4: // Type.block_and_private_data
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockAndPrivateData)
+		err := b.DecodeMessage(msg)
+		m.Type = &DeliverResponse_BlockAndPrivateData{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -465,6 +494,11 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *DeliverResponse_BlockAndPrivateData:
+		s := proto.Size(x.BlockAndPrivateData)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -472,12 +506,63 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// BlockAndPrivateData contains a block along with the private data
+// the requesting client is authorized to read. The private data map
+// is keyed by the sequence numbers of the transactions in the block.
+type BlockAndPrivateData struct {
+	Block                *common.Block                       `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	PrivateDataMap       map[uint64]*rwset.TxPvtReadWriteSet `protobuf:"bytes,2,rep,name=private_data_map,json=privateDataMap,proto3" json:"private_data_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *BlockAndPrivateData) Reset()         { *m = BlockAndPrivateData{} }
+func (m *BlockAndPrivateData) String() string { return proto.CompactTextString(m) }
+func (*BlockAndPrivateData) ProtoMessage()    {}
+func (*BlockAndPrivateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_423f8dd397b2e661, []int{5}
+}
+func (m *BlockAndPrivateData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockAndPrivateData.Unmarshal(m, b)
+}
+func (m *BlockAndPrivateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockAndPrivateData.Marshal(b, m, deterministic)
+}
+func (dst *BlockAndPrivateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAndPrivateData.Merge(dst, src)
+}
+func (m *BlockAndPrivateData) XXX_Size() int {
+	return xxx_messageInfo_BlockAndPrivateData.Size(m)
+}
+func (m *BlockAndPrivateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAndPrivateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAndPrivateData proto.InternalMessageInfo
+
+func (m *BlockAndPrivateData) GetBlock() *common.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockAndPrivateData) GetPrivateDataMap() map[uint64]*rwset.TxPvtReadWriteSet {
+	if m != nil {
+		return m.PrivateDataMap
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FilteredBlock)(nil), "protos.FilteredBlock")
 	proto.RegisterType((*FilteredTransaction)(nil), "protos.FilteredTransaction")
 	proto.RegisterType((*FilteredTransactionActions)(nil), "protos.FilteredTransactionActions")
 	proto.RegisterType((*FilteredChaincodeAction)(nil), "protos.FilteredChaincodeAction")
 	proto.RegisterType((*DeliverResponse)(nil), "protos.DeliverResponse")
+	proto.RegisterType((*BlockAndPrivateData)(nil), "protos.BlockAndPrivateData")
+	proto.RegisterMapType((map[uint64]*rwset.TxPvtReadWriteSet)(nil), "protos.BlockAndPrivateData.PrivateDataMapEntry")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -500,6 +585,10 @@ type DeliverClient interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverFilteredClient, error)
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of block and private data replies is received
+	DeliverWithPrivateData(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithPrivateDataClient, error)
 }
 
 type deliverClient struct {
@@ -572,6 +661,37 @@ func (x *deliverDeliverFilteredClient) Recv() (*DeliverResponse, error) {
 	return m, nil
 }
 
+func (c *deliverClient) DeliverWithPrivateData(ctx context.Context, opts ...grpc.CallOption) (Deliver_DeliverWithPrivateDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Deliver_serviceDesc.Streams[2], "/protos.Deliver/DeliverWithPrivateData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deliverDeliverWithPrivateDataClient{stream}
+	return x, nil
+}
+
+type Deliver_DeliverWithPrivateDataClient interface {
+	Send(*common.Envelope) error
+	Recv() (*DeliverResponse, error)
+	grpc.ClientStream
+}
+
+type deliverDeliverWithPrivateDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *deliverDeliverWithPrivateDataClient) Send(m *common.Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithPrivateDataClient) Recv() (*DeliverResponse, error) {
+	m := new(DeliverResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DeliverServer is the server API for Deliver service.
 type DeliverServer interface {
 	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
@@ -582,6 +702,10 @@ type DeliverServer interface {
 	// Payload data as a marshaled orderer.SeekInfo message,
 	// then a stream of **filtered** block replies is received
 	DeliverFiltered(Deliver_DeliverFilteredServer) error
+	// deliver first requires an Envelope of type ab.DELIVER_SEEK_INFO with
+	// Payload data as a marshaled orderer.SeekInfo message,
+	// then a stream of block and private data replies is received
+	DeliverWithPrivateData(Deliver_DeliverWithPrivateDataServer) error
 }
 
 func RegisterDeliverServer(s *grpc.Server, srv DeliverServer) {
@@ -640,6 +764,32 @@ func (x *deliverDeliverFilteredServer) Recv() (*common.Envelope, error) {
 	return m, nil
 }
 
+func _Deliver_DeliverWithPrivateData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeliverServer).DeliverWithPrivateData(&deliverDeliverWithPrivateDataServer{stream})
+}
+
+type Deliver_DeliverWithPrivateDataServer interface {
+	Send(*DeliverResponse) error
+	Recv() (*common.Envelope, error)
+	grpc.ServerStream
+}
+
+type deliverDeliverWithPrivateDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *deliverDeliverWithPrivateDataServer) Send(m *DeliverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deliverDeliverWithPrivateDataServer) Recv() (*common.Envelope, error) {
+	m := new(common.Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Deliver_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Deliver",
 	HandlerType: (*DeliverServer)(nil),
@@ -657,47 +807,63 @@ var _Deliver_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "DeliverWithPrivateData",
+			Handler:       _Deliver_DeliverWithPrivateData_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "peer/events.proto",
 }
 
-func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_8af932975aef5a3c) }
-
-var fileDescriptor_events_8af932975aef5a3c = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x08, 0xea, 0x44, 0x49, 0xdb, 0x2d, 0x4d, 0xa3, 0x20, 0xd4, 0xc8, 0x12, 0xc8,
-	0x5c, 0x62, 0x64, 0x6e, 0x1c, 0x40, 0xa4, 0x3f, 0x0a, 0x12, 0x87, 0x6a, 0x09, 0x1c, 0x7a, 0xc0,
-	0x5a, 0xdb, 0x13, 0xc7, 0xd4, 0xf1, 0x5a, 0xbb, 0x9b, 0x28, 0x79, 0x04, 0xde, 0x80, 0x67, 0xe0,
-	0x09, 0x39, 0x22, 0xaf, 0xbd, 0x49, 0x9a, 0x52, 0x24, 0x4e, 0xf6, 0xce, 0x7c, 0x3f, 0x33, 0xb3,
-	0x63, 0xc3, 0x51, 0x8e, 0x28, 0x5c, 0x5c, 0x60, 0xa6, 0xe4, 0x20, 0x17, 0x5c, 0x71, 0xd2, 0xd0,
-	0x0f, 0xd9, 0x3b, 0x0e, 0xf9, 0x6c, 0xc6, 0x33, 0xb7, 0x7c, 0x94, 0xc9, 0xde, 0x59, 0xcc, 0x79,
-	0x9c, 0xa2, 0xab, 0x4f, 0xc1, 0x7c, 0xe2, 0xaa, 0x64, 0x86, 0x52, 0xb1, 0x59, 0x5e, 0x01, 0x7a,
-	0x5a, 0x30, 0x9c, 0xb2, 0x24, 0x0b, 0x79, 0x84, 0xbe, 0x96, 0xae, 0x72, 0x1d, 0x9d, 0x53, 0x82,
-	0x65, 0x92, 0x85, 0x2a, 0x31, 0xa2, 0xf6, 0x4f, 0x0b, 0x5a, 0x57, 0x49, 0xaa, 0x50, 0x60, 0x34,
-	0x4c, 0x79, 0x78, 0x4b, 0x9e, 0x03, 0x84, 0x53, 0x96, 0x65, 0x98, 0xfa, 0x49, 0xd4, 0xb5, 0xfa,
-	0x96, 0xb3, 0x4f, 0xf7, 0xab, 0xc8, 0xc7, 0x88, 0x74, 0xa0, 0x91, 0xcd, 0x67, 0x01, 0x8a, 0xee,
-	0xa3, 0xbe, 0xe5, 0xd4, 0x69, 0x75, 0x22, 0xd7, 0x70, 0x32, 0xa9, 0x74, 0xfc, 0x2d, 0x1b, 0xd9,
-	0xad, 0xf7, 0xf7, 0x9c, 0xa6, 0xf7, 0xac, 0xf4, 0x93, 0x03, 0x63, 0x36, 0xde, 0x60, 0xe8, 0xd3,
-	0xc9, 0xfd, 0xa0, 0xb4, 0x7f, 0x5b, 0x70, 0xfc, 0x17, 0x34, 0x21, 0x50, 0x57, 0xcb, 0x75, 0x69,
-	0xfa, 0x9d, 0xbc, 0x84, 0xba, 0x5a, 0xe5, 0xa8, 0x6b, 0x6a, 0x7b, 0x64, 0x50, 0x0d, 0x6e, 0x84,
-	0x2c, 0x42, 0x31, 0x5e, 0xe5, 0x48, 0x75, 0x9e, 0x5c, 0x01, 0x51, 0x4b, 0x7f, 0xc1, 0xd2, 0x24,
-	0x62, 0x85, 0x98, 0x5f, 0x0c, 0xaa, 0xbb, 0xa7, 0x59, 0x5d, 0x53, 0xe2, 0x78, 0xf9, 0x75, 0x0d,
-	0x38, 0xe7, 0x11, 0xd2, 0x43, 0xb5, 0x13, 0x21, 0x5f, 0xe0, 0x78, 0xab, 0x49, 0x7f, 0xd3, 0xab,
-	0xe5, 0x34, 0x3d, 0xfb, 0x1f, 0xbd, 0x7e, 0x28, 0x91, 0xa3, 0x1a, 0x25, 0xea, 0x5e, 0x74, 0xd8,
-	0x80, 0xfa, 0x05, 0x53, 0xcc, 0xfe, 0x0e, 0xbd, 0x87, 0xb9, 0xe4, 0x13, 0x1c, 0x6d, 0x2e, 0xd9,
-	0x58, 0x5b, 0x7a, 0xcc, 0x67, 0xbb, 0xd6, 0xe7, 0x06, 0x58, 0x92, 0xe9, 0x61, 0x78, 0x37, 0x20,
-	0xed, 0x1b, 0x38, 0x7d, 0x00, 0x4c, 0xde, 0xc3, 0xc1, 0xce, 0x36, 0xe9, 0xa1, 0x37, 0xbd, 0x8e,
-	0xb1, 0x59, 0x33, 0x2e, 0x8b, 0x2c, 0x6d, 0x87, 0x77, 0xce, 0xf6, 0x2f, 0x0b, 0x0e, 0x2e, 0x30,
-	0x4d, 0x16, 0x28, 0x28, 0xca, 0x9c, 0x67, 0x12, 0x89, 0x03, 0x0d, 0xa9, 0x98, 0x9a, 0x4b, 0xad,
-	0xd5, 0xf6, 0xda, 0xe6, 0xb2, 0x3e, 0xeb, 0xe8, 0xa8, 0x46, 0xab, 0x3c, 0x79, 0x01, 0x8f, 0x83,
-	0x62, 0x25, 0xf5, 0xad, 0x36, 0xbd, 0x96, 0x01, 0xea, 0x3d, 0x1d, 0xd5, 0x68, 0x99, 0x25, 0xef,
-	0xa0, 0xbd, 0xde, 0xbc, 0x12, 0xbf, 0xa7, 0xf1, 0x27, 0xbb, 0xb3, 0x30, 0xbc, 0xd6, 0x64, 0x3b,
-	0x50, 0x0c, 0xbd, 0xd8, 0x10, 0xef, 0x87, 0x05, 0x4f, 0xaa, 0x62, 0xc9, 0xdb, 0xcd, 0xeb, 0xa1,
-	0xb1, 0xbd, 0xcc, 0x16, 0x98, 0xf2, 0x1c, 0x7b, 0xa7, 0x46, 0x78, 0xa7, 0x35, 0xbb, 0xe6, 0x58,
-	0xaf, 0x2d, 0x32, 0x5c, 0xf7, 0x6c, 0x8c, 0xff, 0x5b, 0x63, 0xf8, 0x0d, 0x6c, 0x2e, 0xe2, 0xc1,
-	0x74, 0x95, 0xa3, 0x48, 0x31, 0x8a, 0x51, 0x0c, 0x26, 0x2c, 0x10, 0x49, 0x68, 0x68, 0xc5, 0xe7,
-	0x3c, 0x6c, 0xe9, 0x29, 0xcb, 0x6b, 0x16, 0xde, 0xb2, 0x18, 0x6f, 0x5e, 0xc5, 0x89, 0x9a, 0xce,
-	0x83, 0xc2, 0xcb, 0xdd, 0x62, 0xba, 0x25, 0xb3, 0xfc, 0x6f, 0x48, 0xb7, 0x60, 0x06, 0xe5, 0x8f,
-	0xe6, 0xcd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x19, 0xd1, 0xa9, 0x84, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("peer/events.proto", fileDescriptor_events_423f8dd397b2e661) }
+
+var fileDescriptor_events_423f8dd397b2e661 = []byte{
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xda, 0x48,
+	0x14, 0xc5, 0x81, 0xb0, 0xca, 0x20, 0x08, 0x19, 0x36, 0xc4, 0x62, 0xb5, 0x4a, 0xe4, 0xd5, 0xae,
+	0xd8, 0x17, 0xbb, 0xa2, 0x2f, 0x55, 0x1e, 0x5a, 0x85, 0x7c, 0x88, 0x48, 0xad, 0x84, 0x26, 0xb4,
+	0x51, 0x53, 0xa9, 0xd6, 0x60, 0x5f, 0xc0, 0x8d, 0xb1, 0xad, 0x99, 0x81, 0xc2, 0x3f, 0xe9, 0x0f,
+	0xeb, 0x2f, 0xe9, 0x53, 0x9f, 0xaa, 0xca, 0x33, 0x1e, 0xbe, 0x42, 0x22, 0xe5, 0x05, 0xcf, 0xdc,
+	0x7b, 0xce, 0xdc, 0x7b, 0xe7, 0x1c, 0x0d, 0xe8, 0x20, 0x01, 0x60, 0x0e, 0x4c, 0x21, 0x12, 0xdc,
+	0x4e, 0x58, 0x2c, 0x62, 0x5c, 0x94, 0x1f, 0xde, 0xa8, 0x79, 0xf1, 0x78, 0x1c, 0x47, 0x8e, 0xfa,
+	0xa8, 0x64, 0xe3, 0x78, 0x18, 0xc7, 0xc3, 0x10, 0x1c, 0xb9, 0xeb, 0x4f, 0x06, 0x8e, 0x08, 0xc6,
+	0xc0, 0x05, 0x1d, 0x27, 0x19, 0xc0, 0x0c, 0xc1, 0x1f, 0x02, 0x73, 0xd8, 0x57, 0x0e, 0x42, 0xfd,
+	0x66, 0x99, 0x86, 0x2c, 0xe5, 0x8d, 0x68, 0x10, 0x79, 0xb1, 0x0f, 0xae, 0x2c, 0x9a, 0xe5, 0xea,
+	0x32, 0x27, 0x18, 0x8d, 0x38, 0xf5, 0x44, 0xa0, 0xcb, 0x59, 0xdf, 0x0c, 0x54, 0xbe, 0x0a, 0x42,
+	0x01, 0x0c, 0xfc, 0x76, 0x18, 0x7b, 0xf7, 0xf8, 0x6f, 0x84, 0xbc, 0x11, 0x8d, 0x22, 0x08, 0xdd,
+	0xc0, 0x37, 0x8d, 0x13, 0xa3, 0xb9, 0x47, 0xf6, 0xb2, 0xc8, 0xb5, 0x8f, 0xeb, 0xa8, 0x18, 0x4d,
+	0xc6, 0x7d, 0x60, 0xe6, 0xce, 0x89, 0xd1, 0x2c, 0x90, 0x6c, 0x87, 0xbb, 0xe8, 0x70, 0x90, 0x9d,
+	0xe3, 0xae, 0x94, 0xe1, 0x66, 0xe1, 0x24, 0xdf, 0x2c, 0xb5, 0xfe, 0x52, 0xf5, 0xb8, 0xad, 0x8b,
+	0xf5, 0x96, 0x18, 0xf2, 0xe7, 0xe0, 0x61, 0x90, 0x5b, 0x3f, 0x0d, 0x54, 0xdb, 0x82, 0xc6, 0x18,
+	0x15, 0xc4, 0x6c, 0xd1, 0x9a, 0x5c, 0xe3, 0xff, 0x50, 0x41, 0xcc, 0x13, 0x90, 0x3d, 0x55, 0x5a,
+	0xd8, 0xce, 0xae, 0xb4, 0x03, 0xd4, 0x07, 0xd6, 0x9b, 0x27, 0x40, 0x64, 0x1e, 0x5f, 0x21, 0x2c,
+	0x66, 0xee, 0x94, 0x86, 0x81, 0x4f, 0xd3, 0xc3, 0xdc, 0xf4, 0xa2, 0xcc, 0xbc, 0x64, 0x99, 0xba,
+	0xc5, 0xde, 0xec, 0xc3, 0x02, 0x70, 0x1e, 0xfb, 0x40, 0xaa, 0x62, 0x23, 0x82, 0xdf, 0xa3, 0xda,
+	0xca, 0x90, 0xee, 0x72, 0x56, 0xa3, 0x59, 0x6a, 0x59, 0x4f, 0xcc, 0x7a, 0xa6, 0x90, 0x9d, 0x1c,
+	0xc1, 0xe2, 0x41, 0xb4, 0x5d, 0x44, 0x85, 0x0b, 0x2a, 0xa8, 0xf5, 0x05, 0x35, 0x1e, 0xe7, 0xe2,
+	0xb7, 0xe8, 0x60, 0x29, 0xb2, 0x2e, 0x6d, 0xc8, 0x6b, 0x3e, 0xde, 0x2c, 0x7d, 0xae, 0x81, 0x8a,
+	0x4c, 0xaa, 0xde, 0x7a, 0x80, 0x5b, 0x77, 0xe8, 0xe8, 0x11, 0x30, 0x7e, 0x83, 0xf6, 0x37, 0xdc,
+	0x24, 0x2f, 0xbd, 0xd4, 0xaa, 0xeb, 0x32, 0x0b, 0xc6, 0x65, 0x9a, 0x25, 0x15, 0x6f, 0x6d, 0x6f,
+	0xfd, 0x32, 0xd0, 0xfe, 0x05, 0x84, 0xc1, 0x14, 0x18, 0x01, 0x9e, 0xc4, 0x11, 0x07, 0xdc, 0x44,
+	0x45, 0x2e, 0xa8, 0x98, 0x70, 0x79, 0x56, 0xa5, 0x55, 0xd1, 0x62, 0xdd, 0xc8, 0x68, 0x27, 0x47,
+	0xb2, 0x3c, 0xfe, 0x17, 0xed, 0xf6, 0x53, 0x4b, 0x4a, 0x55, 0x4b, 0xad, 0xb2, 0x06, 0x4a, 0x9f,
+	0x76, 0x72, 0x44, 0x65, 0xf1, 0x6b, 0x54, 0x59, 0x38, 0x4f, 0xe1, 0xf3, 0x12, 0x7f, 0xb8, 0x79,
+	0x17, 0x9a, 0x57, 0x1e, 0xac, 0x19, 0x9e, 0xa0, 0xba, 0xa4, 0xb9, 0x34, 0xf2, 0xdd, 0x84, 0x05,
+	0x53, 0x2a, 0xc0, 0xf5, 0xa9, 0xa0, 0x99, 0x9c, 0x0b, 0xeb, 0x4a, 0xf8, 0x59, 0xe4, 0x77, 0x15,
+	0x26, 0x55, 0xaa, 0x93, 0x23, 0xb5, 0xfe, 0xc3, 0x70, 0x2a, 0x64, 0xea, 0x3a, 0xeb, 0x87, 0x81,
+	0x6a, 0x5b, 0x68, 0xf8, 0x1f, 0x3d, 0x9a, 0xb1, 0x65, 0x34, 0x3d, 0xd8, 0x47, 0x54, 0x5d, 0x6d,
+	0xc7, 0x1d, 0xd3, 0xc4, 0xdc, 0x91, 0x32, 0x3b, 0x4f, 0xb4, 0x64, 0xaf, 0xac, 0xdf, 0xd1, 0xe4,
+	0x32, 0x12, 0x6c, 0x4e, 0x2a, 0xc9, 0x5a, 0xb0, 0xf1, 0x09, 0xd5, 0xb6, 0xc0, 0x70, 0x15, 0xe5,
+	0xef, 0x61, 0x2e, 0x9b, 0x2a, 0x90, 0x74, 0x89, 0x6d, 0xb4, 0x3b, 0xa5, 0xe1, 0x04, 0x32, 0x0d,
+	0x4c, 0x5b, 0x3d, 0x38, 0xbd, 0x59, 0x77, 0x2a, 0x08, 0x50, 0xff, 0x96, 0x05, 0x02, 0x6e, 0x40,
+	0x10, 0x05, 0x3b, 0xdd, 0x79, 0x65, 0xb4, 0xbe, 0x1b, 0xe8, 0x8f, 0x4c, 0x75, 0x7c, 0xba, 0x5c,
+	0x56, 0xf5, 0x90, 0x97, 0xd1, 0x14, 0xc2, 0x38, 0x81, 0xc6, 0x91, 0x1e, 0x63, 0xc3, 0x23, 0x56,
+	0xae, 0x69, 0xbc, 0x30, 0x70, 0x7b, 0x61, 0x1e, 0xad, 0xe0, 0xf3, 0xcf, 0xb8, 0x46, 0xf5, 0x2c,
+	0x71, 0x1b, 0x88, 0xd1, 0xaa, 0x04, 0xcf, 0x3d, 0xaa, 0xfd, 0x19, 0x59, 0x31, 0x1b, 0xda, 0xa3,
+	0x79, 0x02, 0x4c, 0xbd, 0xc1, 0xf6, 0x80, 0xf6, 0x59, 0xe0, 0x69, 0x5a, 0xfa, 0xc4, 0xb6, 0xcb,
+	0xd2, 0xf9, 0xbc, 0x4b, 0xbd, 0x7b, 0x3a, 0x84, 0xbb, 0xff, 0x87, 0x81, 0x18, 0x4d, 0xfa, 0x69,
+	0x2d, 0x67, 0x85, 0xe9, 0x28, 0xa6, 0x7a, 0xe5, 0xb9, 0x93, 0x32, 0xfb, 0xea, 0x6f, 0xe1, 0xe5,
+	0xef, 0x01, 0x00, 0x1f, 0x5b, 0x30, 0x4a, 0x32, 0x06, 0x00, 0x00,
 }
@@ -33,7 +33,7 @@ func (m *ChaincodeQueryResponse) Reset()         { *m = ChaincodeQueryResponse{}
 func (m *ChaincodeQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeQueryResponse) ProtoMessage()    {}
 func (*ChaincodeQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{0}
+	return fileDescriptor_query_61999f6f80c214e5, []int{0}
 }
 func (m *ChaincodeQueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeQueryResponse.Unmarshal(m, b)
@@ -81,7 +81,11 @@ type ChaincodeInfo struct {
 	//                H(name || version) ||
 	//                H(CodePackage)
 	//              )
-	Id                   []byte   `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Id []byte `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	// the marshaled SignaturePolicyEnvelope of the endorsement policy. This
+	// will be blank if the query is returning information about installed
+	// chaincodes.
+	Policy               []byte   `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -91,7 +95,7 @@ func (m *ChaincodeInfo) Reset()         { *m = ChaincodeInfo{} }
 func (m *ChaincodeInfo) String() string { return proto.CompactTextString(m) }
 func (*ChaincodeInfo) ProtoMessage()    {}
 func (*ChaincodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{1}
+	return fileDescriptor_query_61999f6f80c214e5, []int{1}
 }
 func (m *ChaincodeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChaincodeInfo.Unmarshal(m, b)
@@ -160,6 +164,13 @@ func (m *ChaincodeInfo) GetId() []byte {
 	return nil
 }
 
+func (m *ChaincodeInfo) GetPolicy() []byte {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 // ChannelQueryResponse returns information about each channel that pertains
 // to a query in lscc.go, such as GetChannels (returns all channels for a
 // given peer)
@@ -174,7 +185,7 @@ func (m *ChannelQueryResponse) Reset()         { *m = ChannelQueryResponse{} }
 func (m *ChannelQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelQueryResponse) ProtoMessage()    {}
 func (*ChannelQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{2}
+	return fileDescriptor_query_61999f6f80c214e5, []int{2}
 }
 func (m *ChannelQueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelQueryResponse.Unmarshal(m, b)
@@ -213,7 +224,7 @@ func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
 func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelInfo) ProtoMessage()    {}
 func (*ChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_query_37e5d407118e73f5, []int{3}
+	return fileDescriptor_query_61999f6f80c214e5, []int{3}
 }
 func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelInfo.Unmarshal(m, b)
@@ -247,27 +258,28 @@ func init() {
 	proto.RegisterType((*ChannelInfo)(nil), "protos.ChannelInfo")
 }
 
-func init() { proto.RegisterFile("peer/query.proto", fileDescriptor_query_37e5d407118e73f5) }
+func init() { proto.RegisterFile("peer/query.proto", fileDescriptor_query_61999f6f80c214e5) }
 
-var fileDescriptor_query_37e5d407118e73f5 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xdf, 0x4a, 0xc3, 0x30,
-	0x14, 0xc6, 0xe9, 0xfe, 0xba, 0x33, 0x15, 0x89, 0x53, 0x72, 0x23, 0x8c, 0x5e, 0x4d, 0x90, 0x16,
-	0x14, 0x5f, 0xc0, 0x5d, 0xc8, 0xae, 0x86, 0xbd, 0xf4, 0x46, 0xba, 0xe4, 0x6c, 0x0d, 0x6c, 0x49,
-	0x4c, 0xba, 0xc1, 0x9e, 0xc6, 0x57, 0x95, 0x93, 0xac, 0xa3, 0xbb, 0xea, 0x39, 0xbf, 0xef, 0x17,
-	0xca, 0x97, 0xc0, 0x9d, 0x45, 0x74, 0xf9, 0xef, 0x1e, 0xdd, 0x31, 0xb3, 0xce, 0xd4, 0x86, 0x0d,
-	0xc2, 0xc7, 0xa7, 0x4b, 0x78, 0x9c, 0x57, 0xa5, 0xd2, 0xc2, 0x48, 0xfc, 0xa2, 0xbc, 0x40, 0x6f,
-	0x8d, 0xf6, 0xc8, 0xde, 0x01, 0x44, 0x93, 0x78, 0x9e, 0x4c, 0xbb, 0xb3, 0xf1, 0xeb, 0x43, 0x3c,
-	0xed, 0xb3, 0xf3, 0x99, 0x85, 0x5e, 0x9b, 0xa2, 0x25, 0xa6, 0x7f, 0x09, 0xdc, 0x5c, 0xa4, 0x8c,
-	0x41, 0x4f, 0x97, 0x3b, 0xe4, 0xc9, 0x34, 0x99, 0x8d, 0x8a, 0x30, 0x33, 0x0e, 0xc3, 0x03, 0x3a,
-	0xaf, 0x8c, 0xe6, 0x9d, 0x80, 0x9b, 0x95, 0x6c, 0x5b, 0xd6, 0x15, 0xef, 0x46, 0x9b, 0x66, 0x36,
-	0x81, 0xbe, 0xd2, 0x76, 0x5f, 0xf3, 0x5e, 0x80, 0x71, 0x21, 0x13, 0xbd, 0x10, 0xbc, 0x1f, 0x4d,
-	0x9a, 0x89, 0x1d, 0x88, 0x0d, 0x22, 0xa3, 0x99, 0xdd, 0x42, 0x47, 0x49, 0x3e, 0x9c, 0x26, 0xb3,
-	0xeb, 0xa2, 0xa3, 0x64, 0xfa, 0x09, 0x93, 0x79, 0x55, 0x6a, 0x8d, 0xdb, 0xcb, 0xc2, 0x39, 0x5c,
-	0x89, 0xc8, 0x9b, 0xba, 0xf7, 0xad, 0xba, 0xc4, 0x43, 0xd9, 0xb3, 0x94, 0xbe, 0xc0, 0xb8, 0x15,
-	0xb0, 0xa7, 0x70, 0x61, 0xb4, 0xfe, 0x28, 0x79, 0x6a, 0x3b, 0x3a, 0x91, 0x85, 0xfc, 0x58, 0x42,
-	0x6a, 0xdc, 0x26, 0xab, 0x8e, 0x16, 0xdd, 0x16, 0xe5, 0x06, 0x5d, 0xb6, 0x2e, 0x57, 0x4e, 0x89,
-	0xe6, 0x27, 0xf4, 0x46, 0xdf, 0xcf, 0x1b, 0x55, 0x57, 0xfb, 0x55, 0x26, 0xcc, 0x2e, 0x6f, 0xa9,
-	0x79, 0x54, 0xf3, 0xa8, 0xe6, 0xa4, 0xae, 0xe2, 0x13, 0xbe, 0xfd, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0x94, 0xb3, 0xbd, 0xdd, 0x01, 0x00, 0x00,
+var fileDescriptor_query_61999f6f80c214e5 = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x49, 0xff, 0x77, 0xaa, 0x22, 0x6b, 0x2d, 0x7b, 0x11, 0x4a, 0x4e, 0x15, 0x24, 0x01,
+	0xc5, 0x17, 0xb0, 0x07, 0xe9, 0xa9, 0x98, 0xa3, 0x17, 0x49, 0x37, 0xd3, 0x66, 0xa1, 0xdd, 0x5d,
+	0x77, 0xd3, 0x42, 0x9f, 0xcc, 0xd7, 0x93, 0xd9, 0x4d, 0x4a, 0x7a, 0xca, 0xcc, 0xef, 0xfb, 0x2d,
+	0x81, 0x6f, 0xe0, 0xde, 0x20, 0xda, 0xf4, 0xf7, 0x88, 0xf6, 0x9c, 0x18, 0xab, 0x2b, 0xcd, 0x06,
+	0xfe, 0xe3, 0xe2, 0x35, 0xcc, 0x96, 0x65, 0x2e, 0x95, 0xd0, 0x05, 0x7e, 0x51, 0x9e, 0xa1, 0x33,
+	0x5a, 0x39, 0x64, 0xef, 0x00, 0xa2, 0x49, 0x1c, 0x8f, 0xe6, 0xdd, 0xc5, 0xe4, 0xf5, 0x31, 0xbc,
+	0x76, 0xc9, 0xe5, 0xcd, 0x4a, 0x6d, 0x75, 0xd6, 0x12, 0xe3, 0xbf, 0x08, 0x6e, 0xaf, 0x52, 0xc6,
+	0xa0, 0xa7, 0xf2, 0x03, 0xf2, 0x68, 0x1e, 0x2d, 0xc6, 0x99, 0x9f, 0x19, 0x87, 0xe1, 0x09, 0xad,
+	0x93, 0x5a, 0xf1, 0x8e, 0xc7, 0xcd, 0x4a, 0xb6, 0xc9, 0xab, 0x92, 0x77, 0x83, 0x4d, 0x33, 0x9b,
+	0x42, 0x5f, 0x2a, 0x73, 0xac, 0x78, 0xcf, 0xc3, 0xb0, 0x90, 0x89, 0x4e, 0x08, 0xde, 0x0f, 0x26,
+	0xcd, 0xc4, 0x4e, 0xc4, 0x06, 0x81, 0xd1, 0xcc, 0xee, 0xa0, 0x23, 0x0b, 0x3e, 0x9c, 0x47, 0x8b,
+	0x9b, 0xac, 0x23, 0x0b, 0x36, 0x83, 0x81, 0xd1, 0x7b, 0x29, 0xce, 0x7c, 0xe4, 0x59, 0xbd, 0xc5,
+	0x9f, 0x30, 0x5d, 0x96, 0xb9, 0x52, 0xb8, 0xbf, 0x2e, 0x22, 0x85, 0x91, 0x08, 0xbc, 0xa9, 0xe1,
+	0xa1, 0x55, 0x03, 0x71, 0x5f, 0xc2, 0x45, 0x8a, 0x5f, 0x60, 0xd2, 0x0a, 0xd8, 0x93, 0x2f, 0x92,
+	0xd6, 0x1f, 0x59, 0xd4, 0x2d, 0x8c, 0x6b, 0xb2, 0x2a, 0x3e, 0xd6, 0x10, 0x6b, 0xbb, 0x4b, 0xca,
+	0xb3, 0x41, 0xbb, 0xc7, 0x62, 0x87, 0x36, 0xd9, 0xe6, 0x1b, 0x2b, 0x45, 0xf3, 0x13, 0xba, 0xdd,
+	0xf7, 0xf3, 0x4e, 0x56, 0xe5, 0x71, 0x93, 0x08, 0x7d, 0x48, 0x5b, 0x6a, 0x1a, 0xd4, 0x34, 0xa8,
+	0x29, 0xa9, 0x9b, 0x70, 0xda, 0xb7, 0xff, 0x01, 0x00, 0x5d, 0xd4, 0x75, 0x2f, 0xf5, 0x01, 0x00,
+	0x00,
 }
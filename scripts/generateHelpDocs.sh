@@ -44,6 +44,19 @@ for x in "peer channel" "peer channel create" "peer channel fetch" "peer channel
 done
 cat docs/wrappers/peer_channel_postscript.md >> $DOC
 
+DOC=docs/source/commands/peergossip.md
+cat docs/wrappers/peer_gossip_preamble.md > $DOC
+
+for x in "peer gossip" "peer gossip view"; do
+  echo "" >> $DOC
+  echo "##" $x >> $DOC
+  echo "\`\`\`" >> $DOC
+  .build/bin/${x} --help 1>> $DOC 2>/dev/null
+  echo "\`\`\`" >> $DOC
+  echo "" >> $DOC
+done
+cat docs/wrappers/peer_gossip_postscript.md >> $DOC
+
 DOC=docs/source/commands/peerlogging.md
 cat docs/wrappers/peer_logging_preamble.md > $DOC
 
@@ -60,7 +73,7 @@ cat docs/wrappers/peer_logging_postscript.md >> $DOC
 DOC=docs/source/commands/peernode.md
 cat docs/wrappers/peer_node_preamble.md > $DOC
 
-for x in "peer node start" "peer node status"; do
+for x in "peer node start" "peer node status" "peer node reconcile" "peer node reconcilestatus"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC